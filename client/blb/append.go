@@ -0,0 +1,78 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"context"
+	"time"
+
+	log "github.com/golang/glog"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+// Appends:
+//
+// An append first asks the curator to reserve space at the end of the blob.
+// The curator hands out reservations in order, so every appender gets its own
+// range and nobody overwrites anybody else. The appender then writes its data
+// into that range like a regular write.
+//
+// Appenders whose ranges share a tract, or who need new tracts at the same
+// time, race with each other to extend the blob. The curator only accepts one
+// extension of a given tract, so the losers see a conflict and try the write
+// again, at which point the tract exists and they write into it.
+//
+// If an appender dies between reserving and writing, its range is left as a
+// hole that reads as zeros.
+
+const (
+	// How many times to retry a write that conflicted with another appender.
+	maxAppendConflicts = 20
+
+	// How long to wait before retrying, multiplied by the number of attempts.
+	appendConflictBackoff = 10 * time.Millisecond
+)
+
+// reserveAppend reserves 'length' bytes at the end of the blob, starting no
+// earlier than 'minOffset', and returns the start of the reserved range.
+func (cli *Client) reserveAppend(ctx context.Context, id core.BlobID, length, minOffset int64) (int64, core.Error) {
+	addr, lookupWasCached, err := cli.lookup(ctx, id.Partition())
+	if err != core.NoError {
+		return 0, err
+	}
+	off, err := cli.curators.ReserveAppend(ctx, addr, id, length, minOffset)
+	if err != core.NoError && lookupWasCached {
+		// Maybe we're talking to the wrong curator.
+		cli.lookupCache.invalidate(id.Partition())
+		return cli.reserveAppend(ctx, id, length, minOffset)
+	}
+	return off, err
+}
+
+// appendAt writes 'b' into space at 'offset' that was reserved with
+// reserveAppend, retrying writes that conflict with other appenders.
+func (cli *Client) appendAt(ctx context.Context, id core.BlobID, b []byte, offset int64) (int, core.Error) {
+	for attempt := 1; ; attempt++ {
+		n, err := cli.writeAt(ctx, id, b, offset)
+		if !isAppendConflict(err) || attempt >= maxAppendConflicts {
+			return n, err
+		}
+		log.Infof("append to blob %s at offset %d conflicted (%s), attempt #%d", id, offset, err, attempt)
+		cli.tractCache.invalidate(id)
+		time.Sleep(time.Duration(attempt) * appendConflictBackoff)
+	}
+}
+
+// isAppendConflict returns true if 'err' can result from a concurrent
+// appender extending the blob at the same time as us.
+func isAppendConflict(err core.Error) bool {
+	switch err {
+	case core.ErrExtendConflict, // Someone else's extend was acked first.
+		core.ErrAlreadyExists, // Someone else created the tract on the same host.
+		core.ErrNoSuchTract:   // Someone else extended the blob after we looked.
+		return true
+	}
+	return false
+}
@@ -34,6 +34,22 @@ type Blob struct {
 	// Whether reading or writing is allowed.
 	allowRead, allowWrite bool
 
+	// Whether Close should seal the blob.
+	sealOnClose bool
+
+	// Appends go at or after this offset. It's only known after the first
+	// Append, and then kept up to date by our own appends and writes.
+	appendMin      int64
+	appendMinKnown bool
+
+	// If the blob is compressed, the frame index and buffers. Offsets are then
+	// logical (uncompressed) offsets.
+	comp *compression
+
+	// If the blob is encrypted, its cipher. Compressed data is encrypted after
+	// compressing.
+	enc *encryption
+
 	// A Blob does network requests that we would like to be cancellable and
 	// support other nice contexty stuff, but the Read and Write functions in
 	// ReadWriteSeeker don't accept contexts. So we reuse the context from the
@@ -60,7 +76,15 @@ func (b *Blob) ReadAt(p []byte, offset int64) (int, error) {
 	if !b.allowRead {
 		return 0, core.ErrInvalidState.Error()
 	}
+	if b.comp != nil {
+		return b.readCompressed(p, offset)
+	}
+	return b.readStored(p, offset)
+}
 
+// readRaw reads the bytes stored at 'offset', without decompressing or
+// decrypting them.
+func (b *Blob) readRaw(p []byte, offset int64) (int, error) {
 	var n int
 	var err core.Error
 
@@ -99,11 +123,22 @@ func (b *Blob) Write(p []byte) (int, error) {
 // number of bytes written from 'p' and any error encountered that caused the
 // write to stop early. Different from Write, this method doesn't change the
 // internal offset of 'b' for the next Write or Read.
+//
+// Compressed blobs can only be written sequentially, at the end, and data is
+// buffered until a whole frame is ready. Call Flush or Close to write the rest.
 func (b *Blob) WriteAt(p []byte, offset int64) (int, error) {
 	if !b.allowWrite {
 		return 0, core.ErrInvalidState.Error()
 	}
+	if b.comp != nil {
+		return b.writeCompressed(p, offset)
+	}
+	return b.writeStored(p, offset)
+}
 
+// writeRaw stores 'p' at 'offset' as it is, without compressing or encrypting
+// it.
+func (b *Blob) writeRaw(p []byte, offset int64) (int, error) {
 	var n int
 	var err core.Error
 
@@ -125,11 +160,131 @@ func (b *Blob) WriteAt(p []byte, offset int64) (int, error) {
 	b.cli.metricWriteSizes.Observe(float64(n))
 	b.cli.metricWriteDurations.Observe(float64(time.Since(st)) / 1e9)
 
+	if end := offset + int64(n); b.appendMinKnown && end > b.appendMin {
+		b.appendMin = end
+	}
 	return n, err.Error()
 }
 
-// ByteLength returns the length of the blob in bytes.
+// Append writes 'p' at the end of 'b', and returns the offset where it was
+// written. Multiple clients can append to the same blob concurrently without
+// overwriting each other's data, though the order of their appends is
+// undefined. Append doesn't change the internal offset of 'b'. Compressed and
+// encrypted blobs don't support Append.
+func (b *Blob) Append(p []byte) (int64, error) {
+	if !b.allowWrite {
+		return 0, core.ErrInvalidState.Error()
+	}
+	if len(p) == 0 || b.comp != nil || b.enc != nil {
+		return 0, core.ErrInvalidArgument.Error()
+	}
+
+	// The curator only knows where appends end, so make sure we don't append
+	// over data that was written some other way.
+	if !b.appendMinKnown {
+		length, err := b.ByteLength()
+		if err != nil {
+			return 0, err
+		}
+		b.appendMin, b.appendMinKnown = length, true
+	}
+
+	var off int64
+	var n int
+	var err core.Error
+
+	st := time.Now()
+
+	// Reserving isn't idempotent, so reserve and write separately. A retried
+	// reservation may leave a hole, but never overwrites anything.
+	b.cli.retrier.Do(b.ctx, func(seq int) bool {
+		log.Infof("reserve append of %d bytes to blob %s, attempt #%d", len(p), b.id, seq)
+		off, err = b.cli.reserveAppend(b.ctx, b.id, int64(len(p)), b.appendMin)
+		return !core.IsRetriableError(err)
+	})
+	if err != core.NoError {
+		return 0, err.Error()
+	}
+	b.cli.retrier.Do(b.ctx, func(seq int) bool {
+		log.V(1).Infof("append blob %s at offset %d, attempt #%d", b.id, off, seq)
+		n, err = b.cli.appendAt(b.ctx, b.id, p, off)
+		return !core.IsRetriableError(err)
+	})
+
+	b.cli.metricWriteBytes.Add(float64(n))
+	b.cli.metricWriteSizes.Observe(float64(n))
+	b.cli.metricWriteDurations.Observe(float64(time.Since(st)) / 1e9)
+
+	if err != core.NoError {
+		return 0, err.Error()
+	}
+	if end := off + int64(n); end > b.appendMin {
+		b.appendMin = end
+	}
+	return off, nil
+}
+
+// Truncate changes the length of 'b' to 'size' bytes. Tracts past the new end
+// are removed from the blob and their space is freed. If 'size' is past the
+// current end, the blob is extended with zeros. Truncate doesn't change the
+// internal offset of 'b'.
+//
+// Truncate isn't atomic: if it fails, the data after 'size' in the tract that
+// 'size' falls in may already read as zeros. Compressed and encrypted blobs
+// can't be truncated.
+func (b *Blob) Truncate(size int64) error {
+	if !b.allowWrite {
+		return core.ErrInvalidState.Error()
+	}
+	if b.comp != nil || b.enc != nil {
+		return core.ErrInvalidArgument.Error()
+	}
+
+	var err core.Error
+	b.cli.retrier.Do(b.ctx, func(seq int) bool {
+		log.Infof("truncate blob %s to %d bytes, attempt #%d", b.id, size, seq)
+		err = b.cli.truncate(b.ctx, b.id, size)
+		return !core.IsRetriableError(err)
+	})
+	if err == core.NoError {
+		b.appendMin, b.appendMinKnown = size, true
+	}
+	return err.Error()
+}
+
+// PunchHole makes the 'length' bytes of 'b' at 'offset' read as zeros, and
+// frees the space used by the tracts that lie entirely inside them. The length
+// of 'b' doesn't change; the part of the range past its end is ignored.
+// Compressed and encrypted blobs don't support PunchHole.
+func (b *Blob) PunchHole(offset, length int64) error {
+	if !b.allowWrite {
+		return core.ErrInvalidState.Error()
+	}
+	if b.comp != nil || b.enc != nil {
+		return core.ErrInvalidArgument.Error()
+	}
+
+	var err core.Error
+	b.cli.retrier.Do(b.ctx, func(seq int) bool {
+		log.Infof("punch hole of %d bytes at offset %d in blob %s, attempt #%d", length, offset, b.id, seq)
+		err = b.cli.punchHole(b.ctx, b.id, offset, length)
+		return !core.IsRetriableError(err)
+	})
+	return err.Error()
+}
+
+// ByteLength returns the length of the blob in bytes. For compressed blobs,
+// it's the uncompressed length as of when the blob was opened, plus anything
+// written through 'b'.
 func (b *Blob) ByteLength() (int64, error) {
+	if b.comp != nil {
+		return b.comp.end(), nil
+	}
+	return b.storedLength()
+}
+
+// rawLength returns the number of bytes stored in the blob.
+func (b *Blob) rawLength() (int64, error) {
 	var n int64
 	var berr core.Error
 
@@ -201,6 +356,70 @@ func (b *Blob) Seek(offset int64, whence int) (int64, error) {
 	return newOffset, nil
 }
 
+// Seal seals a write-once blob, committing its contents. After this, the blob
+// can be opened for reading by others but can't be written.
+func (b *Blob) Seal() error {
+	if err := b.Flush(); err != nil {
+		return err
+	}
+	if err := b.cli.Seal(b.ctx, BlobID(b.id)); err != nil {
+		return err
+	}
+	b.allowWrite, b.sealOnClose = false, false
+	return nil
+}
+
+// Flush writes data buffered for a compressed blob, and publishes what has
+// been written to a compressed or encrypted blob, so that other clients see
+// it. Other blobs aren't buffered, so it does nothing for them.
+func (b *Blob) Flush() error {
+	if !b.allowWrite || (b.comp == nil && b.enc == nil) {
+		return nil
+	}
+	md := make(map[string]string)
+	if b.comp != nil {
+		if err := b.flushCompressed(md); err != nil {
+			return err
+		}
+	}
+	var length int64
+	if b.enc != nil && b.enc.aead != nil {
+		var err error
+		if length, err = b.enc.flush(md); err != nil {
+			log.Errorf("couldn't seal the length of blob %s: %s", b.id, err)
+			return err
+		}
+	}
+	if len(md) == 0 {
+		return nil
+	}
+	if err := b.cli.setMetadata(b.ctx, b.id, core.BlobInfo{Metadata: md}); err != nil {
+		return err
+	}
+	if b.comp != nil {
+		b.comp.published = append(b.comp.published[:0], b.comp.frames...)
+	}
+	if b.enc != nil && b.enc.aead != nil {
+		b.enc.setPublished(length)
+	}
+	return nil
+}
+
+// Close seals the blob if it was created as write-once, and flushes it if it's
+// compressed or encrypted. The blob can't be written after Close.
+func (b *Blob) Close() error {
+	if err := b.Flush(); err != nil {
+		return err
+	}
+	if b.sealOnClose {
+		if err := b.Seal(); err != nil {
+			return err
+		}
+	}
+	b.allowWrite = false
+	return nil
+}
+
 // ID returns the BlobID of 'b'.
 func (b *Blob) ID() BlobID {
 	return BlobID(b.id)
@@ -0,0 +1,171 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/golang/glog"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/server"
+)
+
+// End-to-end checksums:
+//
+// The client keeps a running checksum of each tract as it's written, and the
+// curator stores it in the tract metadata. A checksum covers a prefix of the
+// tract, so appending to a tract (the common case) just extends it. Other
+// writes that change data covered by the checksum clear it before writing, so
+// that a failure in the middle can never leave a checksum that doesn't match
+// the data. Updates are conditional on the previous value, so concurrent
+// writers to the same tract also end up clearing it.
+
+// checksumUpdates figures out how writing 'b' at 'offset' to 'tracts' affects
+// their checksums. 'pre' should be applied before writing the data, and 'post'
+// after it was written successfully.
+func (cli *Client) checksumUpdates(tracts []core.TractInfo, b []byte, offset int64) (pre, post []core.ChecksumUpdate) {
+	var position int
+	for _, tract := range tracts {
+		thisB, thisOffset := cli.getNextRange(b, offset, &position)
+		old := tract.Checksum
+		switch {
+		case thisOffset == int64(old.Length):
+			// Appending to the checksummed prefix (or starting a new one).
+			post = append(post, core.ChecksumUpdate{Index: tract.Tract.Index, Old: old, New: old.Extend(thisB)})
+		case thisOffset < int64(old.Length):
+			// Overwriting checksummed data.
+			pre = append(pre, core.ChecksumUpdate{Index: tract.Tract.Index, Old: old})
+			if thisOffset == 0 {
+				var empty core.TractChecksum
+				post = append(post, core.ChecksumUpdate{Index: tract.Tract.Index, New: empty.Extend(thisB)})
+			}
+		default:
+			// Writing past the checksummed prefix leaves it valid.
+		}
+	}
+	return
+}
+
+// checksumBatch collects checksum updates for tracts of a blob, so that they
+// can be sent to the curator together. Updates that are applied after the data
+// was written only extend checksums or set them on tracts that were cleared
+// first, so it's safe to drop a batch if a later part of the write fails.
+type checksumBatch struct {
+	tracts  []core.TractInfo
+	updates []core.ChecksumUpdate
+}
+
+// add adds 'updates' for 'tracts' to the batch.
+func (c *checksumBatch) add(tracts []core.TractInfo, updates []core.ChecksumUpdate) {
+	c.tracts = append(c.tracts, tracts...)
+	c.updates = append(c.updates, updates...)
+}
+
+// updateChecksums sends checksum updates for 'id' to the curator, and keeps
+// our cached tracts in sync with them.
+func (cli *Client) updateChecksums(ctx context.Context, addr string, id core.BlobID, tracts []core.TractInfo, updates []core.ChecksumUpdate) core.Error {
+	if len(updates) == 0 {
+		return core.NoError
+	}
+	if err := cli.curators.UpdateChecksums(ctx, addr, id, updates); err != core.NoError {
+		return err
+	}
+	cli.cacheChecksums(id, tracts, updates)
+	return core.NoError
+}
+
+// cacheChecksums updates our cached tracts of 'id' with checksum updates that
+// the curator applied.
+func (cli *Client) cacheChecksums(id core.BlobID, tracts []core.TractInfo, updates []core.ChecksumUpdate) {
+	if len(updates) > 0 && cli.useCache() {
+		byIndex := make(map[core.TractKey]core.TractChecksum, len(updates))
+		for _, u := range updates {
+			byIndex[u.Index] = u.New
+		}
+		var changed []core.TractInfo
+		for _, tract := range tracts {
+			if c, ok := byIndex[tract.Tract.Index]; ok {
+				tract.Checksum = c
+				changed = append(changed, tract)
+			}
+		}
+		cli.tractCache.put(id, changed)
+	}
+}
+
+// verifyChecksums checks data that was read from 'tracts' against their
+// checksums. bufs[i] holds the data read from tracts[i], starting at
+// offsets[i]. Only tracts whose whole checksummed prefix was read are checked.
+func verifyChecksums(tracts []core.TractInfo, bufs [][]byte, offsets []int64) core.Error {
+	for i, tract := range tracts {
+		c := tract.Checksum
+		if !c.Present() || offsets[i] != 0 || len(bufs[i]) < c.Length {
+			continue
+		}
+		if !c.Verify(bufs[i]) {
+			log.Errorf("checksum mismatch reading tract %s", tract.Tract)
+			return core.ErrChecksumMismatch
+		}
+	}
+	return core.NoError
+}
+
+// VerifyBlob reads all tracts of the blob 'id' that have end-to-end checksums,
+// and checks that their data matches. It returns core.ErrChecksumMismatch if
+// any don't.
+func (cli *Client) VerifyBlob(ctx context.Context, id BlobID) error {
+	var berr core.Error
+	cli.retrier.Do(ctx, func(seq int) bool {
+		log.Infof("verify blob %s, attempt #%d", id, seq)
+		berr = cli.verifyBlobOnce(ctx, core.BlobID(id))
+		return !core.IsRetriableError(berr)
+	})
+	return berr.Error()
+}
+
+func (cli *Client) verifyBlobOnce(ctx context.Context, id core.BlobID) core.Error {
+	addr, info, err := cli.statBlob(ctx, id)
+	if err != core.NoError {
+		return err
+	}
+	if info.NumTracts == 0 {
+		return core.NoError
+	}
+	// Use fresh metadata, since we're going to compare against it.
+	tracts, err := cli.curators.GetTracts(ctx, addr, id, 0, info.NumTracts, false, false)
+	if err != core.NoError {
+		return err
+	}
+
+	// Read tracts in batches, to limit concurrency and memory use.
+	sem := server.NewSemaphore(ParallelRPCs)
+	for start := 0; start < len(tracts); start += ParallelRPCs {
+		batch := tracts[start:min(start+ParallelRPCs, len(tracts))]
+		results := make([]tractResult, len(batch))
+		bufs := make([][]byte, len(batch))
+		offsets := make([]int64, len(batch))
+		var wg sync.WaitGroup
+		for i := range batch {
+			if !batch[i].Checksum.Present() {
+				continue
+			}
+			bufs[i] = make([]byte, batch[i].Checksum.Length)
+			wg.Add(1)
+			go cli.readOneTract(ctx, addr, &results[i], &wg, sem, &batch[i], bufs[i], 0)
+		}
+		wg.Wait()
+
+		for _, res := range results {
+			if res.err != core.NoError && res.err != core.ErrEOF {
+				return res.err
+			}
+		}
+		if err := verifyChecksums(batch, bufs, offsets); err != core.NoError {
+			return err
+		}
+	}
+	return core.NoError
+}
@@ -81,6 +81,25 @@ type Options struct {
 
 	// How the client decides whether to attempt client-side RS reconstruction.
 	ReconstructBehavior ReconstructBehavior
+
+	// Whether and when the client sends hedged reads to slow tractservers.
+	HedgeBehavior HedgeBehavior
+
+	// How many tract reads a StreamReader keeps in flight. The default is 4.
+	StreamReadahead int
+
+	// How many tracts a StreamWriter writes in parallel. It also buffers up to
+	// this many more, to extend the blob for them at once. The default is 4.
+	StreamWriteBehind int
+
+	// Protects the data keys of encrypted blobs. It's needed to create, read,
+	// or write encrypted blobs.
+	KeyProvider KeyProvider
+
+	// The partition whose curator stores the blob namespace. It must match
+	// the curators' NamespacePartition. The default is
+	// core.DefaultNamespacePartition.
+	NamespacePartition core.PartitionID
 }
 
 // Client exposes a simple interface to Blb users for requesting services and
@@ -116,6 +135,19 @@ type Client struct {
 	// Reconstruct behavior.
 	reconstructState reconstructState
 
+	// Hedged read behavior and latency tracking.
+	hedge *hedgeState
+
+	// Parallelism of StreamReader and StreamWriter.
+	streamReadahead   int
+	streamWriteBehind int
+
+	// Key provider for encrypted blobs, if any.
+	keys KeyProvider
+
+	// The partition whose curator stores the namespace.
+	namespace core.PartitionID
+
 	// Metrics we collect.
 	metricOpen           prometheus.Observer
 	metricCreate         prometheus.Observer
@@ -151,9 +183,19 @@ func newBaseClient(options *Options) *Client {
 		}
 	}
 
+	if options.StreamReadahead <= 0 {
+		options.StreamReadahead = defaultStreamReadahead
+	}
+	if options.StreamWriteBehind <= 0 {
+		options.StreamWriteBehind = defaultStreamWriteBehind
+	}
+
 	if options.Instance == "" {
 		options.Instance = "default"
 	}
+	if options.NamespacePartition == 0 {
+		options.NamespacePartition = core.DefaultNamespacePartition
+	}
 	if options.Cluster == "" {
 		options.Cluster = clustersniff.Cluster()
 	}
@@ -165,6 +207,11 @@ func newBaseClient(options *Options) *Client {
 		cluster:              options.Cluster,
 		retrier:              retrier,
 		reconstructState:     makeReconstructState(options.ReconstructBehavior),
+		hedge:                makeHedgeState(options.HedgeBehavior),
+		streamReadahead:      options.StreamReadahead,
+		streamWriteBehind:    options.StreamWriteBehind,
+		keys:                 options.KeyProvider,
+		namespace:            options.NamespacePartition,
 		metricOpen:           clientOpLatenciesSet.WithLabelValues("open", options.Instance),
 		metricCreate:         clientOpLatenciesSet.WithLabelValues("create", options.Instance),
 		metricReadDurations:  clientOpLatenciesSet.WithLabelValues("read", options.Instance),
@@ -199,8 +246,8 @@ func NewMockClient() *Client {
 	}
 	cli := newBaseClient(&options)
 	cli.master = newMemMasterConnection([]string{"1", "2", "3"})
-	cli.curators = newMemCuratorTalker()
 	cli.tractservers = newMemTractserverTalker(nil)
+	cli.curators = newMemCuratorTalker(cli.tractservers)
 	return cli
 }
 
@@ -243,22 +290,74 @@ func (cli *Client) Create(opts ...createOpt) (*Blob, error) {
 
 // createOnce creates a blob with the given options.
 func (cli *Client) createOnce(options createOptions) (*Blob, core.Error) {
-	// Contact master for a proper curator.
-	addr, err := cli.master.MasterCreateBlob(options.ctx)
-	if core.NoError != err {
-		return nil, err
+	var comp *compression
+	var enc *encryption
+	md := options.metadata
+	if hasReserved(options.metadata) {
+		return nil, core.ErrInvalidArgument
+	}
+	if options.codec != "" || options.encrypt || options.stored != nil {
+		md = make(map[string]string, len(options.metadata)+len(options.stored)+4)
+		for k, v := range options.metadata {
+			md[k] = v
+		}
+	}
+	if options.stored != nil {
+		// A copy is stored the same way as the original.
+		if options.codec != "" || options.encrypt {
+			return nil, core.ErrInvalidArgument
+		}
+		for k, v := range options.stored {
+			md[k] = v
+		}
+		var err core.Error
+		if comp, err = openCompression(md); err != core.NoError {
+			return nil, err
+		}
+		// The copy's ID isn't known yet, but its blocks are bound to the
+		// original's, which storedMetadata recorded.
+		if enc, err = openEncryption(0, md, cli.keys); err != core.NoError {
+			return nil, err
+		}
+	}
+	if options.codec != "" {
+		if !validCodec(options.codec) {
+			return nil, core.ErrInvalidArgument
+		}
+		comp = &compression{codec: options.codec}
+		md[codecKey] = string(options.codec)
+	}
+	if options.encrypt {
+		var encMD map[string]string
+		var err core.Error
+		if enc, encMD, err = newEncryption(cli.keys); err != core.NoError {
+			return nil, err
+		}
+		for k, v := range encMD {
+			md[k] = v
+		}
+	}
+	if options.codec != "" {
+		comp.otherSize = core.MetadataSize(md)
 	}
 
 	// Contact the curator for creating a BlobID.
 	metadata := core.BlobInfo{
-		Repl:    options.repl,
-		Hint:    options.hint,
-		Expires: options.expires,
-	}
-	id, err := cli.curators.CreateBlob(options.ctx, addr, metadata)
+		Repl:      options.repl,
+		Hint:      options.hint,
+		Expires:   options.expires,
+		Metadata:  md,
+		WriteOnce: options.writeOnce,
+		Owner:     options.owner,
+		ACL:       options.acl,
+	}
+	id, addr, err := cli.createBlobOnce(options.ctx, options.name, metadata)
 	if core.NoError != err {
 		return nil, err
 	}
+	if enc != nil && enc.blob == 0 {
+		enc.blob = id
+	}
 
 	if cli.useCache() {
 		// A write will usually follow a successful create, we should cache
@@ -273,12 +372,43 @@ func (cli *Client) createOnce(options createOptions) (*Blob, core.Error) {
 		id:  id,
 		// Both reading and writing are allowed on a new blob (it wouldn't be
 		// very useful otherwise).
-		allowRead:  true,
-		allowWrite: true,
-		ctx:        options.ctx,
+		allowRead:   true,
+		allowWrite:  true,
+		sealOnClose: options.writeOnce,
+		comp:        comp,
+		enc:         enc,
+		ctx:         options.ctx,
 	}, core.NoError
 }
 
+// createBlobOnce asks a curator to create a blob, which is bound to 'name' if
+// that's set, and returns its ID and the address of the curator.
+func (cli *Client) createBlobOnce(ctx context.Context, name string, metadata core.BlobInfo) (core.BlobID, string, core.Error) {
+	if name == "" {
+		// Contact master for a proper curator.
+		addr, err := cli.master.MasterCreateBlob(ctx)
+		if core.NoError != err {
+			return 0, "", err
+		}
+		id, err := cli.curators.CreateBlob(ctx, addr, "", metadata)
+		return id, addr, err
+	}
+
+	// Named blobs are created by the curator that owns the namespace, so that
+	// the name is bound in the same Raft command.
+	addr, lookupWasCached, err := cli.lookup(ctx, cli.namespace)
+	if core.NoError != err {
+		return 0, "", err
+	}
+	id, err := cli.curators.CreateBlob(ctx, addr, name, metadata)
+	if err != core.NoError && lookupWasCached {
+		// Maybe we're talking to the wrong curator.
+		cli.lookupCache.invalidate(cli.namespace)
+		return cli.createBlobOnce(ctx, name, metadata)
+	}
+	return id, addr, err
+}
+
 // ClusterID returns the cluster this client is connecting to
 func (cli *Client) ClusterID() string {
 	return cli.cluster
@@ -311,6 +441,9 @@ func (cli *Client) Open(id BlobID, mode string, opts ...openOpt) (*Blob, error)
 		o(&options)
 	}
 	options.ctx = context.WithValue(options.ctx, priorityKey, options.pri)
+	if options.verify {
+		options.ctx = context.WithValue(options.ctx, verifyKey, true)
+	}
 
 	// Check mode.
 	if strings.Trim(mode, "rws") != "" {
@@ -324,7 +457,7 @@ func (cli *Client) Open(id BlobID, mode string, opts ...openOpt) (*Blob, error)
 
 	cli.retrier.Do(options.ctx, func(seq int) bool {
 		log.Infof("open blob %v, attempt #%d", id, seq)
-		blob, berr = cli.openOnce(options.ctx, core.BlobID(id), mode)
+		blob, berr = cli.openOnce(options.ctx, core.BlobID(id), mode, options.allowUnsealed)
 		// Return false if we want to retry this operation, true otherwise.
 		// Ditto for all the code below.
 		return !core.IsRetriableError(berr)
@@ -336,7 +469,7 @@ func (cli *Client) Open(id BlobID, mode string, opts ...openOpt) (*Blob, error)
 }
 
 // openOnce opens a blob referenced by 'id'.
-func (cli *Client) openOnce(ctx context.Context, id core.BlobID, mode string) (*Blob, core.Error) {
+func (cli *Client) openOnce(ctx context.Context, id core.BlobID, mode string, allowUnsealed bool) (*Blob, core.Error) {
 	allowRead := strings.Contains(mode, "r")
 	allowWrite := strings.Contains(mode, "w")
 
@@ -348,20 +481,46 @@ func (cli *Client) openOnce(ctx context.Context, id core.BlobID, mode string) (*
 	// Do a non-cached read on tract metadata. This isn't technically necessary,
 	// but it ensures that we contact the curator directly in the next step so
 	// that we really do return an error here if the blob has been deleted or
-	// is unreachable.
-	tracts, err := cli.curators.GetTracts(ctx, addr, id, 0, 0, allowRead, allowWrite)
+	// is unreachable. The reply also carries the blob's metadata, which we need
+	// to know if it's compressed or encrypted.
+	tracts, info, err := cli.curators.GetTractsWithInfo(ctx, addr, id, 0, 0, allowRead, allowWrite, allowUnsealed)
 	if err != core.NoError {
 		if lookupWasCached {
 			// Maybe we're talking to the wrong curator.
 			cli.lookupCache.invalidate(id.Partition())
-			return cli.openOnce(ctx, id, mode)
+			return cli.openOnce(ctx, id, mode, allowUnsealed)
 		}
 		return nil, err
 	}
+	var comp *compression
+	var enc *encryption
+	if allowRead || allowWrite {
+		if info == nil {
+			// An older curator didn't include the info.
+			stat, err := cli.curators.StatBlob(ctx, addr, id)
+			if err != core.NoError {
+				return nil, err
+			}
+			info = &stat
+		}
+		// Older curators don't refuse to read unsealed blobs themselves.
+		if allowRead && !allowUnsealed && info.WriteOnce && !info.Sealed {
+			return nil, core.ErrNotSealed
+		}
+		if comp, err = openCompression(info.Metadata); err != core.NoError {
+			return nil, err
+		}
+		if enc, err = openEncryption(id, info.Metadata, cli.keys); err != core.NoError {
+			return nil, err
+		}
+	}
 	if cli.useCache() {
 		// We still want to cache the first tract so a subsequent access on the
 		// first tract of the blob doesn't need to talk to curator.
 		cli.tractCache.put(id, tracts)
+		if info != nil && !hasReserved(info.Metadata) {
+			cli.tractCache.setPlain(id)
+		}
 	}
 
 	// Create and return a Blob.
@@ -370,10 +529,41 @@ func (cli *Client) openOnce(ctx context.Context, id core.BlobID, mode string) (*
 		id:         id,
 		allowRead:  allowRead,
 		allowWrite: allowWrite,
+		comp:       comp,
+		enc:        enc,
 		ctx:        ctx,
 	}, core.NoError
 }
 
+// Seal seals the write-once blob 'id', after which it can be opened for
+// reading but not written. It will retry the operation internally according to
+// the retry policy specified by users if the operation failed due to
+// "retriable" errors.
+func (cli *Client) Seal(ctx context.Context, id BlobID) error {
+	var berr core.Error
+	cli.retrier.Do(ctx, func(seq int) bool {
+		log.Infof("seal blob %s, attempt #%d", id, seq)
+		berr = cli.sealOnce(ctx, core.BlobID(id))
+		return !core.IsRetriableError(berr)
+	})
+	return berr.Error()
+}
+
+// sealOnce seals the blob 'id'.
+func (cli *Client) sealOnce(ctx context.Context, id core.BlobID) core.Error {
+	addr, lookupWasCached, err := cli.lookup(ctx, id.Partition())
+	if core.NoError != err {
+		return err
+	}
+	err = cli.curators.SealBlob(ctx, addr, id)
+	if err != core.NoError && lookupWasCached {
+		// Maybe we're talking to the wrong curator.
+		cli.lookupCache.invalidate(id.Partition())
+		return cli.sealOnce(ctx, id)
+	}
+	return err
+}
+
 // Delete deletes 'blob'. It will retry the operation internally according to
 // the retry policy specified by users if the operation failed due to
 // "retriable" errors.
@@ -444,6 +634,42 @@ func (cli *Client) undeleteOnce(ctx context.Context, blob core.BlobID) core.Erro
 	return err
 }
 
+// ListDeleted returns an iterator over blobs that have been deleted but not
+// yet purged. Their info has the time they were deleted and the time they
+// will be purged. See ListBlobsWithInfo.
+func (cli *Client) ListDeleted(ctx context.Context) BlobInfoIterator {
+	return cli.ListBlobsWithInfo(ctx, core.BlobFilter{Deleted: true})
+}
+
+// Purge removes a deleted 'blob' for good, without waiting for the undelete
+// grace period to pass. The blob can't be undeleted afterwards.
+func (cli *Client) Purge(ctx context.Context, id BlobID) error {
+	var berr core.Error
+	cli.retrier.Do(ctx, func(seq int) bool {
+		log.Infof("purge blob %v, attempt #%d", id, seq)
+		berr = cli.purgeOnce(ctx, core.BlobID(id))
+		return !core.IsRetriableError(berr)
+	})
+	return berr.Error()
+}
+
+// purgeOnce purges 'blob'.
+func (cli *Client) purgeOnce(ctx context.Context, blob core.BlobID) core.Error {
+	log.V(1).Infof("purge blob %d", blob)
+
+	addr, lookupWasCached, err := cli.lookup(ctx, blob.Partition())
+	if core.NoError != err {
+		return err
+	}
+	err = cli.curators.PurgeBlob(ctx, addr, blob)
+	if err != core.NoError && lookupWasCached {
+		// Maybe we're talking to the wrong curator.
+		cli.lookupCache.invalidate(blob.Partition())
+		return cli.purgeOnce(ctx, blob)
+	}
+	return err
+}
+
 // GetTracts returns a subset of the tracts for the blob. It will retry the
 // operation internally according to the retry policy specified by users if the
 // operation failed due to "retriable" errors.
@@ -487,12 +713,23 @@ func (cli *Client) getTractsOnce(ctx context.Context, id core.BlobID, start, end
 }
 
 // SetMetadata allows changing various fields of blob metadata. Currently
-// changing the storage hint, mtime, atime are supported.
+// changing the storage hint, mtime, atime, expiry time, and user metadata are
+// supported. Keys in metadata.Metadata with empty values are removed from the
+// blob's user metadata, others are added or replaced. Keys starting with
+// "blb." are reserved and can't be changed.
 func (cli *Client) SetMetadata(ctx context.Context, id BlobID, metadata core.BlobInfo) error {
+	if hasReserved(metadata.Metadata) {
+		return core.ErrInvalidArgument.Error()
+	}
+	return cli.setMetadata(ctx, core.BlobID(id), metadata)
+}
+
+// setMetadata is SetMetadata without the check for reserved keys.
+func (cli *Client) setMetadata(ctx context.Context, id core.BlobID, metadata core.BlobInfo) error {
 	var berr core.Error
 	cli.retrier.Do(ctx, func(seq int) bool {
 		log.Infof("set metadata on %s: %+v, attempt #%d", id, metadata, seq)
-		berr = cli.setMetadataOnce(ctx, core.BlobID(id), metadata)
+		berr = cli.setMetadataOnce(ctx, id, metadata)
 		return !core.IsRetriableError(berr)
 	})
 	return berr.Error()
@@ -609,10 +846,14 @@ func (cli *Client) writeAt(ctx context.Context, id core.BlobID, b []byte, offset
 		return 0, core.ErrReadOnlyStorageClass
 	}
 
+	// Checksum updates for what we write are sent once the data is written,
+	// with the ack for new tracts if there are any.
+	var post checksumBatch
+
 	// For existing tracts, write directly.
 	var writePos int
 	if start < info.NumTracts {
-		if writePos, err = cli.writeExistingTracts(ctx, id, start, min(end, info.NumTracts), b, offset, curatorAddr); err != core.NoError {
+		if writePos, err = cli.writeExistingTracts(ctx, id, start, min(end, info.NumTracts), b, offset, curatorAddr, &post); err != core.NoError {
 			return 0, err
 		}
 		start = info.NumTracts
@@ -630,15 +871,22 @@ func (cli *Client) writeAt(ctx context.Context, id core.BlobID, b []byte, offset
 			}
 		}
 		// Create and write tracts in [start, end).
-		createPos, err = cli.createWriteTracts(ctx, id, start, end, b, offset, curatorAddr)
+		createPos, err = cli.createWriteTracts(ctx, id, start, end, b, offset, curatorAddr, &post)
+		return writePos + createPos, err
+	}
+
+	// The data is written, so failing to update checksums isn't fatal.
+	if err = cli.updateChecksums(ctx, curatorAddr, id, post.tracts, post.updates); err != core.NoError {
+		log.Errorf("failed to update checksums for blob %s: %s", id, err)
 	}
-	return writePos + createPos, err
+	return writePos, core.NoError
 }
 
 // Write bytes to existing tracts in the range [start, end) from the give blob.
 // The caller needs to make sure that these tracts exist in curator's durable
 // state and thus can be returned by getTracts call; otherwise error will be
-// returned.
+// returned. Checksum updates for the written data are added to 'post' for the
+// caller to send.
 func (cli *Client) writeExistingTracts(
 	ctx context.Context,
 	id core.BlobID,
@@ -646,7 +894,8 @@ func (cli *Client) writeExistingTracts(
 	end int,
 	b []byte,
 	offset int64,
-	curatorAddr string) (int, core.Error) {
+	curatorAddr string,
+	post *checksumBatch) (int, core.Error) {
 
 	// Contact the curator for the TractInfo's.
 	tracts, tractsWereCached, err := cli.getTracts(ctx, curatorAddr, id, start, end)
@@ -659,6 +908,14 @@ func (cli *Client) writeExistingTracts(
 		return 0, core.ErrNoSuchTract
 	}
 
+	// Shared tracts need to be copied before they can be written.
+	if unshared, err := cli.unshareTracts(ctx, curatorAddr, id, tracts); err != core.NoError {
+		return 0, err
+	} else if unshared {
+		cli.tractCache.invalidate(id)
+		return cli.writeExistingTracts(ctx, id, start, end, b, offset, curatorAddr, post)
+	}
+
 	// Check for the same replication factor across all tracts.
 	repl := len(tracts[0].Hosts)
 	for _, tract := range tracts {
@@ -675,6 +932,12 @@ func (cli *Client) writeExistingTracts(
 		}
 	}
 
+	// Clear any checksums that this write is going to invalidate.
+	preChecksums, postChecksums := cli.checksumUpdates(tracts, b, offset)
+	if err = cli.updateChecksums(ctx, curatorAddr, id, tracts, preChecksums); err != core.NoError {
+		return 0, err
+	}
+
 	// We set up an array of Errors for results and each goroutine writes into
 	// its own slot. This is simpler than using a channel and is closer to how
 	// readAt works. The WaitGroup is used to block the caller on the completion
@@ -710,7 +973,7 @@ func (cli *Client) writeExistingTracts(
 		// failed.
 		if tractsWereCached {
 			cli.tractCache.invalidate(id)
-			return cli.writeExistingTracts(ctx, id, start, end, b, offset, curatorAddr)
+			return cli.writeExistingTracts(ctx, id, start, end, b, offset, curatorAddr, post)
 		}
 
 		// We couldn't talk to one of the tractservers, and we're pretty sure that the
@@ -736,6 +999,8 @@ func (cli *Client) writeExistingTracts(
 		// PL-1153: Return partial writes if a nonzero prefix succeeded.
 		return 0, results[i]
 	}
+
+	post.add(tracts, postChecksums)
 	return position, core.NoError
 }
 
@@ -797,7 +1062,7 @@ func (cli *Client) createEmptyTracts(
 	}
 
 	// Ack the success of extend to curator.
-	if err = cli.curators.AckExtendBlob(ctx, curatorAddr, id, newTracts); core.NoError != err {
+	if err = cli.curators.AckExtendBlob(ctx, curatorAddr, id, newTracts, nil); core.NoError != err {
 		log.Errorf("failed to ack extending blob %s with new tracts %+v: %s", id, newTracts, err)
 	}
 	return err
@@ -805,7 +1070,9 @@ func (cli *Client) createEmptyTracts(
 
 // Create new tracts in the range [start, end) from the given blob and write
 // bytes to them. The caller needs to make sure that these tracts don't already
-// exist in curator's durable state yet; otherwise error will be returned.
+// exist in curator's durable state yet; otherwise error will be returned. The
+// checksum updates in 'post', and those for the new tracts, are applied with
+// the ack for the new tracts.
 func (cli *Client) createWriteTracts(
 	ctx context.Context,
 	id core.BlobID,
@@ -813,7 +1080,8 @@ func (cli *Client) createWriteTracts(
 	end int,
 	b []byte,
 	offset int64,
-	curatorAddr string) (int, core.Error) {
+	curatorAddr string,
+	post *checksumBatch) (int, core.Error) {
 
 	// Contact curator to allocate tractservers.
 	var newTracts []core.TractInfo
@@ -864,11 +1132,15 @@ func (cli *Client) createWriteTracts(
 		}
 	}
 
-	// Ack the success of extend to curator.
-	if err := cli.curators.AckExtendBlob(ctx, curatorAddr, id, newTracts); core.NoError != err {
+	// Ack the success of extend to curator, with the checksums. New tracts
+	// have no checksums yet, so there's nothing to clear first.
+	_, postChecksums := cli.checksumUpdates(newTracts, b, offset)
+	post.add(newTracts, postChecksums)
+	if err := cli.curators.AckExtendBlob(ctx, curatorAddr, id, newTracts, post.updates); core.NoError != err {
 		log.Errorf("failed to ack extending blob %s with new tracts %+v: %s", id, newTracts, err)
 		return 0, err
 	}
+	cli.cacheChecksums(id, post.tracts, post.updates)
 
 	return position, core.NoError
 }
@@ -909,7 +1181,7 @@ func (cli *Client) createOneTract(
 	sem.Acquire()
 	defer sem.Release()
 
-	*result = cli.tractservers.Create(ctx, host, tsid, tract.Tract, thisB, thisOffset)
+	*result = cli.tractservers.Create(ctx, host, tsid, tract.Tract, tract.Version, thisB, thisOffset)
 
 	log.V(1).Infof("create %s to %s: %s", tract.Tract, host, *result)
 }
@@ -949,8 +1221,8 @@ func (cli *Client) readAt(ctx context.Context, id core.BlobID, b []byte, offset
 	if core.NoError != err {
 		if err == core.ErrNoSuchTract {
 			// This means the blob exists but the required tracts don't exist
-			// in curator's state. Because we don't allow tracts truncation, this
-			// means we must have read past the last tract, return EOF error
+			// in curator's state, so we must have read past the last tract
+			// (possibly because the blob was truncated). Return EOF error
 			// directly.
 			return 0, core.ErrEOF
 		}
@@ -998,14 +1270,16 @@ func (cli *Client) readAt(ctx context.Context, id core.BlobID, b []byte, offset
 	// we need to examine them in order to handle EOFs and short reads.
 	sem := server.NewSemaphore(ParallelRPCs)
 	results := make([]tractResult, len(tracts))
+	bufs := make([][]byte, len(tracts))
+	offsets := make([]int64, len(tracts))
 	var wg sync.WaitGroup
 	position := 0
 
 	// Fire off a goroutine to position each tract.
 	for idx := range tracts {
-		thisB, thisOffset := cli.getNextRange(b, offset, &position)
+		bufs[idx], offsets[idx] = cli.getNextRange(b, offset, &position)
 		wg.Add(1)
-		go cli.readOneTract(ctx, addr, &results[idx], &wg, sem, &tracts[idx], thisB, thisOffset)
+		go cli.readOneTract(ctx, addr, &results[idx], &wg, sem, &tracts[idx], bufs[idx], offsets[idx])
 	}
 
 	wg.Wait()
@@ -1046,6 +1320,17 @@ func (cli *Client) readAt(ctx context.Context, id core.BlobID, b []byte, offset
 		return cli.readAt(ctx, id, b, offset)
 	}
 
+	if (err == core.NoError || err == core.ErrEOF) && verifyFromContext(ctx) {
+		if verr := verifyChecksums(tracts, bufs, offsets); verr != core.NoError {
+			if tractsWereCached {
+				// Maybe the checksums we have are out of date.
+				cli.tractCache.invalidate(id)
+				return cli.readAt(ctx, id, b, offset)
+			}
+			return 0, verr
+		}
+	}
+
 	// Maybe kick off FixVersion rpcs. Do this after the cache invalidate/retry
 	// since we don't want to do extra work if the only reason for version
 	// mismatches is that we have out of date cached data.
@@ -1094,8 +1379,12 @@ func (cli *Client) readOneTract(
 	sem.Acquire()
 	defer sem.Release()
 
-	if len(tract.Hosts) > 0 {
+	if len(tract.Hosts) > 0 && cli.hedge.Enabled {
+		cli.readOneTractReplicatedHedged(ctx, curAddr, result, tract, thisB, thisOffset)
+	} else if len(tract.Hosts) > 0 {
 		cli.readOneTractReplicated(ctx, curAddr, result, tract, thisB, thisOffset)
+	} else if tract.RS.Present() && cli.hedge.Enabled && cli.shouldReconstruct(tract) {
+		cli.readOneTractRSHedged(ctx, curAddr, result, tract, thisB, thisOffset)
 	} else if tract.RS.Present() {
 		cli.readOneTractRS(ctx, curAddr, result, tract, thisB, thisOffset)
 	} else {
@@ -1167,7 +1456,24 @@ func (cli *Client) readOneTractRS(
 	length := min(len(thisB), int(tract.RS.Length))
 	offset := int64(tract.RS.Offset) + thisOffset
 	read, err := cli.tractservers.ReadInto(ctx, tract.RS.Host, rsTract, core.RSChunkVersion, thisB[:length], offset)
+	cli.finishOneTractRS(ctx, curAddr, result, tract, thisB, offset, length, read, err)
+}
 
+// finishOneTractRS fills in 'result' after reading 'length' bytes at 'offset'
+// in the RS chunk holding 'tract' returned 'read' and 'err'. If the read
+// failed, it tries to reconstruct the data.
+func (cli *Client) finishOneTractRS(
+	ctx context.Context,
+	curAddr string,
+	result *tractResult,
+	tract *core.TractInfo,
+	thisB []byte,
+	offset int64,
+	length int,
+	read int,
+	err core.Error) {
+
+	rsTract := tract.RS.Chunk.ToTractID()
 	if err != core.NoError && err != core.ErrEOF {
 		log.V(1).Infof("rs read %s from tractserver at address %s: %s", tract.Tract, tract.RS.Host, err)
 		// If we failed to read from a TS, report that to the curator. Defer so
@@ -1424,7 +1730,10 @@ func min(a, b int) int {
 
 type contextKey int
 
-const priorityKey contextKey = iota
+const (
+	priorityKey contextKey = iota
+	verifyKey
+)
 
 func priorityFromContext(ctx context.Context) core.Priority {
 	if pri, ok := ctx.Value(priorityKey).(core.Priority); ok {
@@ -1432,3 +1741,10 @@ func priorityFromContext(ctx context.Context) core.Priority {
 	}
 	return core.Priority_TSDEFAULT
 }
+
+// verifyFromContext returns true if reads should be checked against end-to-end
+// checksums.
+func verifyFromContext(ctx context.Context) bool {
+	verify, _ := ctx.Value(verifyKey).(bool)
+	return verify
+}
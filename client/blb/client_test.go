@@ -6,13 +6,20 @@ package blb
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"io"
+	"io/ioutil"
 	"math/rand"
 	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/westerndigitalcorporation/blb/pkg/retry"
 	"github.com/westerndigitalcorporation/blb/pkg/slices"
 
 	"github.com/westerndigitalcorporation/blb/internal/core"
@@ -78,8 +85,8 @@ func newClient(trace tsTraceFunc) *Client {
 	}
 	cli := newBaseClient(&options)
 	cli.master = newMemMasterConnection([]string{"1", "2", "3"})
-	cli.curators = newMemCuratorTalker()
 	cli.tractservers = newMemTractserverTalker(trace)
+	cli.curators = newMemCuratorTalker(cli.tractservers)
 	return cli
 }
 
@@ -508,3 +515,1318 @@ func TestListBlobs(t *testing.T) {
 		t.Errorf("ListBlobs: expected %v, got %v", blobs, out)
 	}
 }
+
+// Test listing blobs with a filter.
+func TestListBlobsWithInfo(t *testing.T) {
+	var cold, out []string
+	cli := newClient(nil)
+	// create enough to spread across partitions
+	for i := 0; i < 100; i++ {
+		if i%3 == 0 {
+			blob, err := cli.Create(StorageCold)
+			if err != nil {
+				t.Fatal("can't create blob", err)
+			}
+			cold = append(cold, blob.ID().String())
+		} else {
+			createBlob(t, cli)
+		}
+	}
+
+	iter := cli.ListBlobsWithInfo(context.Background(), core.BlobFilter{Hints: []core.StorageHint{core.StorageHint_COLD}})
+	for {
+		blobs, err := iter()
+		if err != nil {
+			t.Fatalf("ListBlobsWithInfo: error iterating: %v", err)
+		}
+		if blobs == nil {
+			break
+		}
+		for _, b := range blobs {
+			if b.Info.Hint != core.StorageHint_COLD {
+				t.Errorf("ListBlobsWithInfo: %s doesn't match filter: %+v", b.ID, b.Info)
+			}
+			out = append(out, b.ID.String())
+		}
+	}
+
+	// Partitions are listed in parallel, so the order isn't fixed.
+	sort.Strings(cold)
+	sort.Strings(out)
+	if !slices.EqualStrings(cold, out) {
+		t.Errorf("ListBlobsWithInfo: expected %v, got %v", cold, out)
+	}
+}
+
+// Test listing, purging, and restoring deleted blobs.
+func TestTrash(t *testing.T) {
+	cli := newClient(nil)
+	b1 := createBlob(t, cli)
+	b2 := createBlob(t, cli)
+	createBlob(t, cli)
+
+	listDeleted := func() map[BlobID]bool {
+		out := make(map[BlobID]bool)
+		iter := cli.ListDeleted(context.Background())
+		for {
+			blobs, err := iter()
+			if err != nil {
+				t.Fatalf("ListDeleted: error iterating: %v", err)
+			}
+			if blobs == nil {
+				return out
+			}
+			for _, b := range blobs {
+				if b.Info.Deleted.IsZero() {
+					t.Errorf("ListDeleted: %s has no deleted time", b.ID)
+				}
+				out[b.ID] = true
+			}
+		}
+	}
+
+	if err := cli.Purge(context.Background(), b1.ID()); err == nil {
+		t.Errorf("purged a blob that wasn't deleted")
+	}
+	for _, b := range []*Blob{b1, b2} {
+		if err := cli.Delete(context.Background(), b.ID()); err != nil {
+			t.Fatalf("delete failed: %s", err)
+		}
+	}
+	if l := listDeleted(); len(l) != 2 || !l[b1.ID()] || !l[b2.ID()] {
+		t.Errorf("ListDeleted: expected %s and %s, got %v", b1.ID(), b2.ID(), l)
+	}
+
+	if err := cli.Purge(context.Background(), b1.ID()); err != nil {
+		t.Fatalf("purge failed: %s", err)
+	}
+	if err := cli.Undelete(context.Background(), b1.ID()); err == nil {
+		t.Errorf("undeleted a purged blob")
+	}
+	if err := cli.Undelete(context.Background(), b2.ID()); err != nil {
+		t.Fatalf("undelete failed: %s", err)
+	}
+	if l := listDeleted(); len(l) != 0 {
+		t.Errorf("ListDeleted: expected nothing, got %v", l)
+	}
+	if _, err := cli.Open(b2.ID(), "r"); err != nil {
+		t.Errorf("couldn't open undeleted blob: %s", err)
+	}
+}
+
+// Test watching events across partitions and resuming from a cursor.
+func TestWatchEvents(t *testing.T) {
+	cli := newClient(nil)
+	var blobs []*Blob
+	for i := 0; i < 6; i++ {
+		blobs = append(blobs, createBlob(t, cli))
+	}
+	checkWrite(t, blobs[0], makeData(100))
+	if err := cli.Delete(context.Background(), blobs[1].ID()); err != nil {
+		t.Fatalf("delete failed: %s", err)
+	}
+
+	// Collects events until we have 'n' or time out.
+	watch := func(from EventCursor, n int) (map[core.BlobEventType][]BlobID, EventCursor) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		iter := cli.WatchEvents(ctx, from)
+		got := make(map[core.BlobEventType][]BlobID)
+		var cursor EventCursor
+		for count := 0; count < n; {
+			var events []core.BlobEvent
+			var err error
+			events, cursor, err = iter()
+			if err != nil {
+				t.Fatalf("WatchEvents: error iterating: %v", err)
+			}
+			for _, ev := range events {
+				got[ev.Type] = append(got[ev.Type], BlobID(ev.Blob))
+			}
+			count += len(events)
+		}
+		return got, cursor
+	}
+
+	got, cursor := watch(nil, 8)
+	if len(got[core.BlobCreated]) != 6 || len(got[core.BlobWritten]) != 1 || len(got[core.BlobDeleted]) != 1 {
+		t.Errorf("WatchEvents: unexpected events: %v", got)
+	}
+
+	// Resuming only returns new events.
+	if err := cli.Undelete(context.Background(), blobs[1].ID()); err != nil {
+		t.Fatalf("undelete failed: %s", err)
+	}
+	parsed, err := ParseEventCursor(cursor.String())
+	if err != nil || !reflect.DeepEqual(parsed, cursor) {
+		t.Fatalf("cursor %v didn't survive parsing: %v, %v", cursor, parsed, err)
+	}
+	got, _ = watch(parsed, 1)
+	if exp := map[core.BlobEventType][]BlobID{core.BlobUndeleted: {blobs[1].ID()}}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("WatchEvents: expected %v, got %v", exp, got)
+	}
+}
+
+// Test setting user metadata at create time and changing it later.
+func TestUserMetadata(t *testing.T) {
+	cli := newClient(nil)
+	blob, err := cli.Create(WithMetadata(map[string]string{"owner": "bob", "type": "text/plain"}))
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+
+	md := core.BlobInfo{Metadata: map[string]string{"owner": "", "tag": "x"}}
+	if err := cli.SetMetadata(context.Background(), blob.ID(), md); err != nil {
+		t.Fatalf("SetMetadata failed: %s", err)
+	}
+
+	info, err := blob.Stat()
+	if err != nil {
+		t.Fatalf("failed to stat the blob: %s", err)
+	}
+	exp := map[string]string{"tag": "x", "type": "text/plain"}
+	if !reflect.DeepEqual(info.Metadata, exp) {
+		t.Errorf("expected metadata %v, got %v", exp, info.Metadata)
+	}
+
+	// Keys starting with "blb." are reserved.
+	md = core.BlobInfo{Metadata: map[string]string{codecKey: "snappy"}}
+	if err := cli.SetMetadata(context.Background(), blob.ID(), md); !core.ErrInvalidArgument.Is(err) {
+		t.Errorf("expected ErrInvalidArgument setting a reserved key, got %v", err)
+	}
+	if _, err := cli.Create(WithMetadata(md.Metadata)); !core.ErrInvalidArgument.Is(err) {
+		t.Errorf("expected ErrInvalidArgument creating with a reserved key, got %v", err)
+	}
+}
+
+// Test that checksums follow writes and catch corrupted data.
+func TestChecksums(t *testing.T) {
+	cli := newClient(nil)
+	blob, err := cli.Create()
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	// Appends extend checksums, and writes that start a tract set them.
+	checkWrite(t, blob, makeData(core.TractLength+1000))
+	checkWrite(t, blob, makeData(2000))
+	blob.Seek(0, os.SEEK_SET)
+	checkWrite(t, blob, makeData(100))
+	// An overwrite in the middle clears the checksum.
+	blob.Seek(core.TractLength+500, os.SEEK_SET)
+	checkWrite(t, blob, makeData(100))
+
+	tracts, berr := cli.getTractsOnce(context.Background(), core.BlobID(blob.ID()), 0, 2)
+	if berr != core.NoError {
+		t.Fatalf("GetTracts failed: %s", berr)
+	}
+	if c := tracts[0].Checksum; c.Length != 100 {
+		t.Errorf("expected checksum of 100 bytes on tract 0, got %+v", c)
+	}
+	if c := tracts[1].Checksum; c.Present() {
+		t.Errorf("expected no checksum on tract 1, got %+v", c)
+	}
+	if err := cli.VerifyBlob(context.Background(), blob.ID()); err != nil {
+		t.Fatalf("VerifyBlob failed: %s", err)
+	}
+
+	// Corrupt the first tract on every tractserver.
+	tt := cli.tractservers.(*memTractserverTalker)
+	for _, ts := range tt.tractservers {
+		for id, data := range ts.data {
+			if id.Index == 0 {
+				data[10]++
+			}
+		}
+	}
+	if err := cli.VerifyBlob(context.Background(), blob.ID()); !core.ErrChecksumMismatch.Is(err) {
+		t.Errorf("expected checksum mismatch, got %v", err)
+	}
+
+	// Reads only check data if asked to.
+	blob.Seek(0, os.SEEK_SET)
+	checkRead(t, blob, 200)
+	blob, err = cli.Open(blob.ID(), "r", OpenVerifyChecksums)
+	if err != nil {
+		t.Fatalf("open failed: %s", err)
+	}
+	if _, err := blob.Read(make([]byte, 200)); !core.ErrChecksumMismatch.Is(err) {
+		t.Errorf("expected checksum mismatch, got %v", err)
+	}
+}
+
+// Test that checksums for writes that extend a blob are sent with the ack for
+// the new tracts.
+func TestChecksumsWithExtend(t *testing.T) {
+	cli := newClient(nil)
+	talker := &checksumCountTalker{CuratorTalker: cli.curators}
+	cli.curators = talker
+	blob, err := cli.Create()
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	checkWrite(t, blob, makeData(1000))
+	checkWrite(t, blob, makeData(core.TractLength))
+	if talker.updates != 0 {
+		t.Errorf("expected no separate checksum updates, got %d", talker.updates)
+	}
+	// Appending within a tract still needs one.
+	checkWrite(t, blob, makeData(100))
+	if talker.updates != 1 {
+		t.Errorf("expected one checksum update, got %d", talker.updates)
+	}
+
+	tracts, berr := cli.getTractsOnce(context.Background(), core.BlobID(blob.ID()), 0, 2)
+	if berr != core.NoError {
+		t.Fatalf("GetTracts failed: %s", berr)
+	}
+	if c := tracts[0].Checksum; c.Length != core.TractLength {
+		t.Errorf("expected checksum of the whole tract 0, got %+v", c)
+	}
+	if c := tracts[1].Checksum; c.Length != 1100 {
+		t.Errorf("expected checksum of 1100 bytes on tract 1, got %+v", c)
+	}
+	if err := cli.VerifyBlob(context.Background(), blob.ID()); err != nil {
+		t.Fatalf("VerifyBlob failed: %s", err)
+	}
+}
+
+// checksumCountTalker counts calls to UpdateChecksums.
+type checksumCountTalker struct {
+	CuratorTalker
+	updates int
+}
+
+func (c *checksumCountTalker) UpdateChecksums(ctx context.Context, addr string, blob core.BlobID, updates []core.ChecksumUpdate) core.Error {
+	c.updates++
+	return c.CuratorTalker.UpdateChecksums(ctx, addr, blob, updates)
+}
+
+// Test that write-once blobs can't be read until sealed or written after.
+func TestWriteOnce(t *testing.T) {
+	cli := newClient(nil)
+	blob, err := cli.Create(WriteOnce)
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	data := makeData(1000)
+	checkWrite(t, blob, data)
+
+	if _, err := cli.Open(blob.ID(), "r"); !core.ErrNotSealed.Is(err) {
+		t.Errorf("expected ErrNotSealed opening an unsealed blob, got %v", err)
+	}
+	if _, err := cli.Open(blob.ID(), "r", OpenAllowUnsealed); err != nil {
+		t.Errorf("expected opening with OpenAllowUnsealed to work, got %s", err)
+	}
+
+	if err := blob.Close(); err != nil {
+		t.Fatalf("close failed: %s", err)
+	}
+	if _, err := blob.Write(data); !core.ErrInvalidState.Is(err) {
+		t.Errorf("expected writing a closed blob to fail, got %v", err)
+	}
+
+	reader, err := cli.Open(blob.ID(), "r")
+	if err != nil {
+		t.Fatalf("open failed: %s", err)
+	}
+	if got := checkRead(t, reader, len(data)); !bytes.Equal(got, data) {
+		t.Errorf("read wrong data")
+	}
+	if _, err := cli.Open(blob.ID(), "w"); !core.ErrSealed.Is(err) {
+		t.Errorf("expected ErrSealed opening a sealed blob for writing, got %v", err)
+	}
+
+	// Regular blobs can't be sealed.
+	plain, _ := cli.Create()
+	if err := plain.Seal(); !core.ErrInvalidArgument.Is(err) {
+		t.Errorf("expected ErrInvalidArgument sealing a regular blob, got %v", err)
+	}
+}
+
+// Test that appends go after existing data and after each other.
+func TestAppend(t *testing.T) {
+	cli := newClient(nil)
+	blob, err := cli.Create()
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	checkWrite(t, blob, makeData(100))
+
+	for _, exp := range []int64{100, 150} {
+		if off, err := blob.Append(makeData(50)); err != nil || off != exp {
+			t.Errorf("expected append at %d, got %d, %v", exp, off, err)
+		}
+	}
+	if n, err := blob.ByteLength(); err != nil || n != 200 {
+		t.Errorf("expected length 200, got %d, %v", n, err)
+	}
+}
+
+// Test that concurrent appenders never overwrite each other.
+func TestConcurrentAppend(t *testing.T) {
+	cli := NewMockClient()
+	blob, err := cli.Create()
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+
+	const appenders, appends = 8, 10
+	type record struct {
+		off  int64
+		data []byte
+	}
+	records := make([][]record, appenders)
+	var wg sync.WaitGroup
+	for a := 0; a < appenders; a++ {
+		wg.Add(1)
+		go func(a int) {
+			defer wg.Done()
+			// Each appender uses its own handle, like a separate process would.
+			b, err := cli.Open(blob.ID(), "w")
+			if err != nil {
+				t.Errorf("open failed: %s", err)
+				return
+			}
+			for i := 0; i < appends; i++ {
+				// Use sizes that will often cross tract boundaries.
+				data := bytes.Repeat([]byte{byte(a*appends + i + 1)}, 1+rand.Intn(core.TractLength/16))
+				off, err := b.Append(data)
+				if err != nil {
+					t.Errorf("append failed: %s", err)
+					return
+				}
+				records[a] = append(records[a], record{off, data})
+			}
+		}(a)
+	}
+	wg.Wait()
+
+	for _, rs := range records {
+		for _, r := range rs {
+			p := make([]byte, len(r.data))
+			if n, err := blob.ReadAt(p, r.off); err != nil || n != len(p) {
+				t.Fatalf("read at %d failed: %d, %v", r.off, n, err)
+			}
+			if !bytes.Equal(p, r.data) {
+				t.Errorf("data appended at %d was overwritten", r.off)
+			}
+		}
+	}
+}
+
+// Test that clones and the blobs they were cloned from don't see each other's
+// writes.
+func TestClone(t *testing.T) {
+	cli := newClient(nil)
+	ctx := context.Background()
+	blob, err := cli.Create()
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	data := makeData(core.TractLength + 1000)
+	checkWrite(t, blob, data)
+
+	cloneID, err := cli.Clone(ctx, blob.ID())
+	if err != nil {
+		t.Fatalf("clone failed: %s", err)
+	}
+	clone, err := cli.Open(cloneID, "rw")
+	if err != nil {
+		t.Fatalf("open failed: %s", err)
+	}
+	if got := checkRead(t, clone, len(data)); !bytes.Equal(got, data) {
+		t.Errorf("clone has wrong data")
+	}
+
+	readAll := func(b *Blob) []byte {
+		p := make([]byte, len(data))
+		if n, err := b.ReadAt(p, 0); err != nil || n != len(p) {
+			t.Fatalf("read failed: %d, %v", n, err)
+		}
+		return p
+	}
+	write := func(b *Blob, p []byte, off int64) {
+		if n, err := b.WriteAt(p, off); err != nil || n != len(p) {
+			t.Fatalf("write failed: %d, %v", n, err)
+		}
+	}
+
+	// Write the first tract of the original, and the second of the clone.
+	patch := bytes.Repeat([]byte{0xaa}, 100)
+	write(blob, patch, 10)
+	write(clone, patch, core.TractLength+10)
+
+	expBlob := append([]byte(nil), data...)
+	copy(expBlob[10:], patch)
+	expClone := append([]byte(nil), data...)
+	copy(expClone[core.TractLength+10:], patch)
+	if !bytes.Equal(readAll(blob), expBlob) {
+		t.Errorf("original has wrong data after writes")
+	}
+	if !bytes.Equal(readAll(clone), expClone) {
+		t.Errorf("clone has wrong data after writes")
+	}
+
+	// A clone of a clone sees the clone's data.
+	id2, err := cli.Clone(ctx, cloneID)
+	if err != nil {
+		t.Fatalf("clone failed: %s", err)
+	}
+	clone2, _ := cli.Open(id2, "rw")
+	write(clone, patch, 0)
+	if !bytes.Equal(readAll(clone2), expClone) {
+		t.Errorf("second clone has wrong data")
+	}
+
+	if _, err := cli.Clone(ctx, BlobID(core.BlobIDFromParts(1, 12345))); !core.ErrNoSuchBlob.Is(err) {
+		t.Errorf("expected ErrNoSuchBlob cloning a missing blob, got %v", err)
+	}
+}
+
+// Test that writers with tracts cached from before a clone don't change the
+// clone.
+func TestCloneCachedWriter(t *testing.T) {
+	cli := newClient(nil)
+	ctx := context.Background()
+	blob, err := cli.Create()
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	data := makeData(core.TractLength + 1000)
+	checkWrite(t, blob, data)
+
+	// Another client has the tracts cached.
+	other := newClient(nil)
+	other.master, other.curators, other.tractservers = cli.master, cli.curators, cli.tractservers
+	other.EnableCache(true)
+	stale, err := other.Open(blob.ID(), "rw")
+	if err != nil {
+		t.Fatalf("open failed: %s", err)
+	}
+	checkRead(t, stale, len(data))
+
+	cloneID, err := cli.Clone(ctx, blob.ID())
+	if err != nil {
+		t.Fatalf("clone failed: %s", err)
+	}
+	patch := bytes.Repeat([]byte{0xaa}, 100)
+	if n, err := stale.WriteAt(patch, 10); err != nil || n != len(patch) {
+		t.Fatalf("write failed: %d, %v", n, err)
+	}
+
+	clone, _ := cli.Open(cloneID, "r")
+	if got := checkRead(t, clone, len(data)); !bytes.Equal(got, data) {
+		t.Errorf("clone sees a write through tracts cached before it was made")
+	}
+	exp := append([]byte(nil), data...)
+	copy(exp[10:], patch)
+	got := make([]byte, len(data))
+	if n, err := stale.ReadAt(got, 0); err != nil || n != len(got) {
+		t.Fatalf("read failed: %d, %v", n, err)
+	}
+	if !bytes.Equal(got, exp) {
+		t.Errorf("original has wrong data after write")
+	}
+}
+
+// Test appending copies of blobs.
+func TestAppendAligned(t *testing.T) {
+	cli := newClient(nil)
+	ctx := context.Background()
+
+	create := func(data []byte) *Blob {
+		b, err := cli.Create()
+		if err != nil {
+			t.Fatalf("create failed: %s", err)
+		}
+		if len(data) > 0 {
+			checkWrite(t, b, data)
+		}
+		return b
+	}
+	// The fake master puts 'a' on another curator than 'dst', so the client
+	// copies it, and 'b' on the same one, so tractservers copy it.
+	dstData, aData, bData := makeData(100), makeData(core.TractLength+1000), makeData(500)
+	dst, a, empty, b := create(dstData), create(aData), create(nil), create(bData)
+
+	offsets, err := cli.AppendAligned(ctx, dst.ID(), a.ID(), empty.ID(), b.ID())
+	if err != nil {
+		t.Fatalf("append failed: %s", err)
+	}
+	exp := []int64{core.TractLength, 3 * core.TractLength, 3 * core.TractLength}
+	if !reflect.DeepEqual(offsets, exp) {
+		t.Fatalf("wrong offsets: got %v, expected %v", offsets, exp)
+	}
+
+	// Gaps before tract boundaries read as zeros.
+	expData := make([]byte, 3*core.TractLength+len(bData))
+	copy(expData, dstData)
+	copy(expData[offsets[0]:], aData)
+	copy(expData[offsets[2]:], bData)
+	dst.Seek(0, os.SEEK_SET)
+	if got := checkRead(t, dst, len(expData)); !bytes.Equal(got, expData) {
+		t.Errorf("appended blob has wrong data")
+	}
+
+	// The sources are unchanged, and so is the copy when they're written.
+	if n, err := a.WriteAt(bytes.Repeat([]byte{0xaa}, 100), 0); err != nil || n != 100 {
+		t.Fatalf("write failed: %d, %v", n, err)
+	}
+	p := make([]byte, 100)
+	if _, err := dst.ReadAt(p, offsets[0]); err != nil || !bytes.Equal(p, aData[:100]) {
+		t.Errorf("copy changed after writing the source: %v", err)
+	}
+
+	if _, err := cli.AppendAligned(ctx, dst.ID(), BlobID(core.BlobIDFromParts(1, 12345))); !core.ErrNoSuchBlob.Is(err) {
+		t.Errorf("expected ErrNoSuchBlob appending a missing blob, got %v", err)
+	}
+
+	// If the curator copies the tracts but the reply is lost, the retry
+	// finds them there.
+	lossy := &lossyCopyTalker{CuratorTalker: cli.curators, lost: 1}
+	cli.curators = lossy
+	cli.retrier = retry.Retrier{MaxNumRetries: 2}
+	offsets, err = cli.AppendAligned(ctx, dst.ID(), b.ID())
+	if err != nil || lossy.lost != 0 {
+		t.Fatalf("append with a lost reply failed: %v", err)
+	}
+	if n, _ := dst.ByteLength(); n != offsets[0]+int64(len(bData)) {
+		t.Errorf("expected one copy of the source, got length %d", n)
+	}
+}
+
+// lossyCopyTalker loses the replies to CopyTracts.
+type lossyCopyTalker struct {
+	CuratorTalker
+	lost int
+}
+
+func (l *lossyCopyTalker) CopyTracts(ctx context.Context, addr string, blob core.BlobID, first core.TractKey, src core.BlobID, start, end int) core.Error {
+	err := l.CuratorTalker.CopyTracts(ctx, addr, blob, first, src, start, end)
+	if err == core.NoError && l.lost > 0 {
+		l.lost--
+		return core.ErrRPC
+	}
+	return err
+}
+
+// Test copying blobs.
+func TestCopy(t *testing.T) {
+	cli := newClient(nil)
+	ctx := context.Background()
+	blob, err := cli.Create(WithMetadata(map[string]string{"k": "v"}))
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	data := makeData(2*core.TractLength + 1000)
+	checkWrite(t, blob, data)
+
+	id, err := cli.Copy(ctx, blob.ID(), ReplFactor(2), WriteOnce)
+	if err != nil {
+		t.Fatalf("copy failed: %s", err)
+	}
+	copied, err := cli.Open(id, "r")
+	if err != nil {
+		t.Fatalf("open failed: %s", err)
+	}
+	if got := checkRead(t, copied, len(data)); !bytes.Equal(got, data) {
+		t.Errorf("copy has wrong data")
+	}
+	info, err := copied.Stat()
+	if err != nil {
+		t.Fatalf("stat failed: %s", err)
+	}
+	if info.Repl != 2 || !info.Sealed || len(info.Metadata) != 0 {
+		t.Errorf("copy has wrong info: %+v", info)
+	}
+	if err := cli.VerifyBlob(ctx, id); err != nil {
+		t.Errorf("copy doesn't match checksums: %s", err)
+	}
+
+	// Unsealed write-once blobs can't be read, so they can't be copied.
+	unsealed, err := cli.Create(WriteOnce)
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	if _, err := cli.Copy(ctx, unsealed.ID()); !core.ErrNotSealed.Is(err) {
+		t.Errorf("expected ErrNotSealed copying an unsealed blob, got %v", err)
+	}
+
+	// Copies of compressed blobs are compressed the same way.
+	compressed, err := cli.Create(CreateCompressed(CodecSnappy))
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	checkWrite(t, compressed, data)
+	if err := compressed.Close(); err != nil {
+		t.Fatalf("close failed: %s", err)
+	}
+	if id, err = cli.Copy(ctx, compressed.ID()); err != nil {
+		t.Fatalf("copy failed: %s", err)
+	}
+	if copied, err = cli.Open(id, "r"); err != nil {
+		t.Fatalf("open failed: %s", err)
+	}
+	if got := checkRead(t, copied, len(data)); !bytes.Equal(got, data) {
+		t.Errorf("copy of compressed blob has wrong data")
+	}
+	if _, err := cli.Copy(ctx, compressed.ID(), CreateCompressed(CodecFlate)); !core.ErrInvalidArgument.Is(err) {
+		t.Errorf("expected ErrInvalidArgument recompressing a copy, got %v", err)
+	}
+	if _, err := cli.Copy(ctx, blob.ID(), CreateCompressed(CodecFlate)); !core.ErrInvalidArgument.Is(err) {
+		t.Errorf("expected ErrInvalidArgument compressing a copy, got %v", err)
+	}
+
+	// AppendAligned copies stored bytes, so it doesn't take compressed blobs.
+	if _, err := cli.AppendAligned(ctx, id, blob.ID()); !core.ErrInvalidArgument.Is(err) {
+		t.Errorf("expected ErrInvalidArgument appending to a compressed blob, got %v", err)
+	}
+	if _, err := cli.AppendAligned(ctx, blob.ID(), compressed.ID()); !core.ErrInvalidArgument.Is(err) {
+		t.Errorf("expected ErrInvalidArgument appending a compressed blob, got %v", err)
+	}
+	if n, _ := blob.ByteLength(); n != int64(len(data)) {
+		t.Errorf("failed append changed the blob's length to %d", n)
+	}
+}
+
+// Test truncating and extending blobs.
+func TestTruncate(t *testing.T) {
+	cli := newClient(nil)
+	ctx := context.Background()
+	blob, err := cli.Create()
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	data := makeData(3*core.TractLength + 1000)
+	checkWrite(t, blob, data)
+
+	check := func(b *Blob, exp []byte) {
+		if length, err := b.ByteLength(); err != nil || length != int64(len(exp)) {
+			t.Fatalf("expected length %d, got %d (%v)", len(exp), length, err)
+		}
+		p := make([]byte, len(exp)+10)
+		n, err := b.ReadAt(p, 0)
+		if n != len(exp) || !bytes.Equal(p[:n], exp) {
+			t.Fatalf("read %d bytes with wrong data (%v)", n, err)
+		}
+	}
+
+	// Cut the blob in the middle of a tract, and again on a tract boundary.
+	size := core.TractLength + 10
+	if err := blob.Truncate(int64(size)); err != nil {
+		t.Fatalf("truncate failed: %s", err)
+	}
+	check(blob, data[:size])
+	if err := cli.VerifyBlob(ctx, blob.ID()); err != nil {
+		t.Errorf("truncated blob doesn't match checksums: %s", err)
+	}
+	if err := blob.Truncate(core.TractLength); err != nil {
+		t.Fatalf("truncate failed: %s", err)
+	}
+	check(blob, data[:core.TractLength])
+
+	// Extending pads with zeros, and new tracts don't bring back old data.
+	if err := blob.Truncate(int64(size)); err != nil {
+		t.Fatalf("extend failed: %s", err)
+	}
+	exp := append(append([]byte(nil), data[:core.TractLength]...), make([]byte, 10)...)
+	check(blob, exp)
+
+	// Truncating a clone leaves the original alone, and vice versa.
+	cloneID, err := cli.Clone(ctx, blob.ID())
+	if err != nil {
+		t.Fatalf("clone failed: %s", err)
+	}
+	clone, _ := cli.Open(cloneID, "rw")
+	if err := clone.Truncate(5); err != nil {
+		t.Fatalf("truncate of clone failed: %s", err)
+	}
+	check(clone, exp[:5])
+	check(blob, exp)
+	if err := blob.Truncate(0); err != nil {
+		t.Fatalf("truncate failed: %s", err)
+	}
+	check(blob, nil)
+	check(clone, exp[:5])
+
+	if err := blob.Truncate(-1); !core.ErrInvalidArgument.Is(err) {
+		t.Errorf("expected ErrInvalidArgument, got %v", err)
+	}
+	sealed, _ := cli.Create(WriteOnce)
+	checkWrite(t, sealed, data[:100])
+	if err := cli.Seal(ctx, sealed.ID()); err != nil {
+		t.Fatalf("seal failed: %s", err)
+	}
+	if err := sealed.Truncate(10); !core.ErrSealed.Is(err) {
+		t.Errorf("expected ErrSealed, got %v", err)
+	}
+}
+
+// Test punching holes in blobs.
+func TestPunchHole(t *testing.T) {
+	cli := newClient(nil)
+	ctx := context.Background()
+	blob, err := cli.Create()
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	data := makeData(4*core.TractLength + 1000)
+	checkWrite(t, blob, data)
+
+	// The hole covers tracts 1 and 2, and parts of 0 and 3.
+	off, length := int64(core.TractLength-10), int64(2*core.TractLength+20)
+	if err := blob.PunchHole(off, length); err != nil {
+		t.Fatalf("punch failed: %s", err)
+	}
+	readAll := func() []byte {
+		p := make([]byte, len(data))
+		if n, err := blob.ReadAt(p, 0); err != nil || n != len(p) {
+			t.Fatalf("read failed: %d, %v", n, err)
+		}
+		return p
+	}
+	exp := append([]byte(nil), data...)
+	copy(exp[off:off+length], make([]byte, length))
+	if !bytes.Equal(readAll(), exp) {
+		t.Errorf("wrong data after punching a hole")
+	}
+	if err := cli.VerifyBlob(ctx, blob.ID()); err != nil {
+		t.Errorf("blob doesn't match checksums: %s", err)
+	}
+
+	// The tracts inside the hole don't take up any space.
+	tracts, err := cli.GetTracts(ctx, blob.ID(), 1, 3)
+	if err != nil {
+		t.Fatalf("get tracts failed: %s", err)
+	}
+	for _, tract := range tracts {
+		if n, err := cli.statTract(ctx, tract); err != core.NoError || n != 0 {
+			t.Errorf("expected tract %s to be empty, got %d bytes (%s)", tract.Tract, n, err)
+		}
+	}
+
+	// The part of the hole past the end is ignored, and the last tract keeps
+	// its length.
+	if err := blob.PunchHole(3*core.TractLength, 2*core.TractLength); err != nil {
+		t.Fatalf("punch failed: %s", err)
+	}
+	copy(exp[3*core.TractLength:], make([]byte, len(exp)-3*core.TractLength))
+	if !bytes.Equal(readAll(), exp) {
+		t.Errorf("wrong data after punching a hole at the end")
+	}
+}
+
+func TestReadV(t *testing.T) {
+	fail := func(e tsTraceEntry) core.Error {
+		// Reads from the first two tractservers fail.
+		if !e.write && !strings.HasSuffix(e.addr, "2") {
+			return core.ErrRPC
+		}
+		return core.NoError
+	}
+	cli := newClient(fail)
+	ctx := context.Background()
+	b1, b2 := createBlob(t, cli), createBlob(t, cli)
+	d1, d2 := makeData(2*core.TractLength+5000), makeData(3000)
+	checkWrite(t, b1, d1)
+	checkWrite(t, b2, d2)
+	b3, err := cli.Create(CreateCompressed(CodecSnappy))
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	checkWrite(t, b3, d2)
+	if err := b3.Close(); err != nil {
+		t.Fatalf("close failed: %s", err)
+	}
+
+	ranges := []ReadRange{
+		{Blob: b1.ID(), Off: 100, Len: 200},
+		{Blob: b2.ID(), Off: 0, Len: 3000},
+		{Blob: b1.ID(), Off: core.TractLength - 50, Len: core.TractLength + 100},
+		{Blob: b2.ID(), Off: 2000, Len: 2000},
+		{Blob: b1.ID(), Off: 3 * core.TractLength, Len: 10},
+		{Blob: b1.ID(), Off: 5, Len: 0},
+		{Blob: b3.ID(), Off: 0, Len: 10},
+		{Blob: b3.ID(), Off: 3 * core.TractLength, Len: 10},
+	}
+	exp := []struct {
+		b   []byte
+		err error
+	}{
+		{d1[100:300], nil},
+		{d2, nil},
+		{d1[core.TractLength-50 : 2*core.TractLength+50], nil},
+		{d2[2000:], io.EOF},
+		{nil, io.EOF},
+		{nil, nil},
+		{nil, core.ErrInvalidArgument.Error()},
+		{nil, core.ErrInvalidArgument.Error()},
+	}
+
+	res := cli.ReadV(ctx, ranges)
+	if len(res) != len(ranges) {
+		t.Fatalf("expected %d results, got %d", len(ranges), len(res))
+	}
+	for i, r := range res {
+		if r.Err != exp[i].err || !bytes.Equal(r.B, exp[i].b) {
+			t.Errorf("range %d: got %d bytes, %v; expected %d bytes, %v", i, len(r.B), r.Err, len(exp[i].b), exp[i].err)
+		}
+	}
+}
+
+// slowTractserverTalker delays reads from tractservers whose address ends in
+// 'slow' until they're canceled.
+type slowTractserverTalker struct {
+	TractserverTalker
+	slow     string
+	canceled chan string
+}
+
+func (s *slowTractserverTalker) Read(ctx context.Context, addr string, id core.TractID, version int, length int, off int64) ([]byte, core.Error) {
+	if strings.HasSuffix(addr, s.slow) {
+		<-ctx.Done()
+		s.canceled <- addr
+		return nil, core.ErrCanceled
+	}
+	return s.TractserverTalker.Read(ctx, addr, id, version, length, off)
+}
+
+func TestHedgedRead(t *testing.T) {
+	cli := newClient(nil)
+	cli.hedge = makeHedgeState(HedgeBehavior{Enabled: true, MinDelay: time.Millisecond})
+	blob := createBlob(t, cli)
+	data := makeData(2*core.TractLength + 100)
+	checkWrite(t, blob, data)
+
+	// Every tract has a replica on a tractserver that never answers. Read a
+	// few times so that we're sure to try it first for some tract.
+	slow := &slowTractserverTalker{cli.tractservers, "-0", make(chan string, 100)}
+	cli.tractservers = slow
+	for i := 0; i < 10; i++ {
+		p := make([]byte, len(data))
+		if n, err := blob.ReadAt(p, 0); err != nil || n != len(p) {
+			t.Fatalf("read failed: %d, %v", n, err)
+		}
+		if !bytes.Equal(p, data) {
+			t.Errorf("wrong data from hedged read")
+		}
+	}
+
+	// Reads to the slow replica got canceled.
+	select {
+	case <-slow.canceled:
+	case <-time.After(time.Second):
+		t.Errorf("slow read wasn't canceled")
+	}
+}
+
+func TestHedgeDelay(t *testing.T) {
+	h := makeHedgeState(HedgeBehavior{Enabled: true, MinDelay: time.Millisecond})
+	if d := h.delay("a"); d != time.Millisecond {
+		t.Errorf("expected min delay with no samples, got %s", d)
+	}
+	for i := 1; i <= 2*hedgeWindowSize; i++ {
+		h.record("a", time.Duration(i)*time.Millisecond)
+	}
+	// Only the most recent samples count.
+	if d := h.delay("a"); d != 196*time.Millisecond {
+		t.Errorf("expected p95 of recent samples, got %s", d)
+	}
+}
+
+func TestStreamReader(t *testing.T) {
+	cli := newClient(nil)
+	blob := createBlob(t, cli)
+	data := makeData(3*core.TractLength + 12345)
+	checkWrite(t, blob, data)
+	blob.Seek(0, os.SEEK_SET)
+
+	r := NewStreamReader(blob)
+	defer r.Close()
+	var got []byte
+	p := make([]byte, 1<<20+7)
+	for {
+		n, err := r.Read(p)
+		got = append(got, p[:n]...)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("read failed: %s", err)
+		}
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("wrong data from stream reader: got %d bytes, expected %d", len(got), len(data))
+	}
+
+	// Seek into the middle of a tract and read the rest.
+	off := int64(core.TractLength + 99)
+	if _, err := r.Seek(off, os.SEEK_SET); err != nil {
+		t.Fatalf("seek failed: %s", err)
+	}
+	var rest bytes.Buffer
+	if _, err := rest.ReadFrom(r); err != nil {
+		t.Fatalf("read failed: %s", err)
+	}
+	if !bytes.Equal(rest.Bytes(), data[off:]) {
+		t.Errorf("wrong data after seek")
+	}
+}
+
+func TestStreamWriter(t *testing.T) {
+	cli := newClient(nil)
+	talker := &extendCountTalker{CuratorTalker: cli.curators}
+	cli.curators = talker
+	blob := createBlob(t, cli)
+	data := makeData(3*core.TractLength + 12345)
+
+	w := NewStreamWriter(blob)
+	for p := data; len(p) > 0; {
+		n := min(len(p), 1<<20+7)
+		if _, err := w.Write(p[:n]); err != nil {
+			t.Fatalf("write failed: %s", err)
+		}
+		p = p[n:]
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("flush failed: %s", err)
+	}
+	got := make([]byte, len(data))
+	if n, err := blob.ReadAt(got, 0); err != nil || n != len(data) {
+		t.Fatalf("read failed: %d, %v", n, err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("wrong data from stream writer")
+	}
+	if n, _ := blob.ByteLength(); n != int64(len(data)) {
+		t.Errorf("expected length %d, got %d", len(data), n)
+	}
+	// The four tracts are one batch, so the blob is extended once.
+	if talker.extends != 1 {
+		t.Errorf("expected one extend, got %d", talker.extends)
+	}
+}
+
+// extendCountTalker counts calls to ExtendBlob.
+type extendCountTalker struct {
+	CuratorTalker
+	extends int
+}
+
+func (e *extendCountTalker) ExtendBlob(ctx context.Context, addr string, blob core.BlobID, numTracts int) ([]core.TractInfo, core.Error) {
+	e.extends++
+	return e.CuratorTalker.ExtendBlob(ctx, addr, blob, numTracts)
+}
+
+func TestStreamWriterError(t *testing.T) {
+	fail := func(e tsTraceEntry) core.Error {
+		if e.write && e.length > 0 {
+			return core.ErrRPC
+		}
+		return core.NoError
+	}
+	blob := createBlob(t, newClient(fail))
+	w := NewStreamWriter(blob)
+	if _, err := w.Write(makeData(100)); err != nil {
+		t.Fatalf("buffered write shouldn't fail: %s", err)
+	}
+	if err := w.Close(); err == nil {
+		t.Errorf("close succeeded when it shouldn't have")
+	}
+}
+
+// Test writing and reading compressed blobs.
+func TestCompressed(t *testing.T) {
+	for _, codec := range []Codec{CodecSnappy, CodecFlate} {
+		cli := newClient(nil)
+		blob, err := cli.Create(CreateCompressed(codec), WithMetadata(map[string]string{"owner": "bob"}))
+		if err != nil {
+			t.Fatalf("create failed: %s", err)
+		}
+
+		// Mix compressible and incompressible frames, ending in a partial one.
+		data := makeData(2 * core.TractLength)
+		random := make([]byte, core.TractLength/2)
+		rand.Read(random)
+		data = append(data, random...)
+
+		checkWrite(t, blob, data[:100])
+		if _, err := blob.WriteAt(data, 50); err == nil {
+			t.Errorf("overwrite of a compressed blob should fail")
+		}
+		if _, err := blob.Append(data); err == nil {
+			t.Errorf("append to a compressed blob should fail")
+		}
+		checkWrite(t, blob, data[100:])
+		if err := blob.Close(); err != nil {
+			t.Fatalf("close failed: %s", err)
+		}
+
+		// The first frame is compressed and the last isn't.
+		if f := blob.comp.frames; len(f) != 3 || f[0].v&1 != 0 || f[0].v>>1 >= core.TractLength || f[2].v&1 == 0 {
+			t.Errorf("%s: unexpected frame index %v", codec, f)
+		}
+		blob, err = cli.Open(blob.ID(), "rw")
+		if err != nil {
+			t.Fatalf("open failed: %s", err)
+		}
+		if n, _ := blob.Seek(0, os.SEEK_END); n != int64(len(data)) {
+			t.Errorf("%s: expected length %d, got %d", codec, len(data), n)
+		}
+		got := make([]byte, 3000)
+		off := int64(core.TractLength - 1000)
+		if n, err := blob.ReadAt(got, off); err != nil || !bytes.Equal(got[:n], data[off:off+3000]) {
+			t.Errorf("%s: read across frames failed: %d, %v", codec, n, err)
+		}
+		info, _ := blob.Stat()
+		if info.Metadata["owner"] != "bob" || info.Metadata[codecKey] != string(codec) {
+			t.Errorf("%s: unexpected metadata %v", codec, info.Metadata)
+		}
+
+		// Continue writing after the partial frame. The rewritten frame goes
+		// somewhere new, so readers of the old index aren't affected until
+		// it's flushed.
+		more := makeData(core.TractLength)
+		checkWrite(t, blob, more)
+		if f := blob.comp.frames; f[2].tract == 2 {
+			t.Errorf("%s: partial frame was rewritten in place", codec)
+		}
+		old, err := cli.Open(blob.ID(), "r")
+		if err != nil {
+			t.Fatalf("open failed: %s", err)
+		}
+		if n, err := old.ReadAt(got, int64(len(data)-3000)); n != 3000 || !bytes.Equal(got, data[len(data)-3000:]) {
+			t.Errorf("%s: read of unflushed blob failed: %d, %v", codec, n, err)
+		}
+		data = append(data, more...)
+		if err := blob.Close(); err != nil {
+			t.Fatalf("close failed: %s", err)
+		}
+
+		blob, err = cli.Open(blob.ID(), "r")
+		if err != nil {
+			t.Fatalf("open failed: %s", err)
+		}
+		var buf bytes.Buffer
+		if _, err := buf.ReadFrom(NewReadaheadBlob(blob)); err != nil {
+			t.Fatalf("read failed: %s", err)
+		}
+		if !bytes.Equal(buf.Bytes(), data) {
+			t.Errorf("%s: got %d bytes, expected %d", codec, buf.Len(), len(data))
+		}
+	}
+
+	if _, err := newClient(nil).Create(CreateCompressed("lz77")); err == nil {
+		t.Errorf("create with an unknown codec should fail")
+	}
+
+	// The frame index has to fit in metadata, which is checked before
+	// writing a frame.
+	big := map[string]string{"big": strings.Repeat("x", core.MaxMetadataSize-40)}
+	blob, err := newClient(nil).Create(CreateCompressed(CodecSnappy), WithMetadata(big))
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	if _, err := blob.Write(makeData(8 * core.TractLength)); err != core.ErrMetadataTooLarge.Error() {
+		t.Errorf("expected frame index to be too large, got %v", err)
+	}
+	if n, _ := blob.rawLength(); n != 0 {
+		t.Errorf("expected nothing to be written, got %d bytes", n)
+	}
+}
+
+// Test that Open gets the blob's metadata along with its tracts, and falls
+// back to a stat if the curator doesn't return it.
+func TestOpenInfo(t *testing.T) {
+	cli := newClient(nil)
+	blob, err := cli.Create(CreateCompressed(CodecSnappy))
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	data := makeData(1000)
+	checkWrite(t, blob, data)
+	if err := blob.Close(); err != nil {
+		t.Fatalf("close failed: %s", err)
+	}
+
+	for _, old := range []bool{false, true} {
+		talker := &statCountTalker{CuratorTalker: cli.curators, old: old}
+		cli.curators = talker
+		blob, err = cli.Open(blob.ID(), "r")
+		if err != nil {
+			t.Fatalf("open failed: %s", err)
+		}
+		if blob.comp == nil {
+			t.Errorf("old=%v: expected blob to be compressed", old)
+		}
+		if want := map[bool]int{false: 0, true: 1}[old]; talker.stats != want {
+			t.Errorf("old=%v: expected %d stats, got %d", old, want, talker.stats)
+		}
+		cli.curators = talker.CuratorTalker
+	}
+}
+
+// statCountTalker counts calls to StatBlob. If 'old' is set it acts like a
+// curator that doesn't return blob info with tracts.
+type statCountTalker struct {
+	CuratorTalker
+	old   bool
+	stats int
+}
+
+func (s *statCountTalker) GetTractsWithInfo(ctx context.Context, addr string, blob core.BlobID, start, end int, forRead, forWrite, allowUnsealed bool) ([]core.TractInfo, *core.BlobInfo, core.Error) {
+	tracts, info, err := s.CuratorTalker.GetTractsWithInfo(ctx, addr, blob, start, end, forRead, forWrite, allowUnsealed)
+	if s.old {
+		info = nil
+	}
+	return tracts, info, err
+}
+
+func (s *statCountTalker) StatBlob(ctx context.Context, addr string, blob core.BlobID) (core.BlobInfo, core.Error) {
+	s.stats++
+	return s.CuratorTalker.StatBlob(ctx, addr, blob)
+}
+
+// Test writing and reading encrypted blobs, and reading them without the key.
+func TestEncrypted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blbkeys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	key := make([]byte, 32)
+	rand.Read(key)
+	if err := ioutil.WriteFile(filepath.Join(dir, "k1"), []byte(hex.EncodeToString(key)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cli := newClient(nil)
+	cli.keys = NewFileKeyProvider(dir, "k1")
+	blob, err := cli.Create(CreateEncrypted)
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+
+	// Write whole blocks, then part of a block, then past the end, which
+	// fills the gap with zeros.
+	data := makeData(3*encBlockSize + 100)
+	checkWrite(t, blob, data)
+	if _, err := blob.WriteAt([]byte("hello"), encBlockSize+10); err != nil {
+		t.Fatalf("write failed: %s", err)
+	}
+	copy(data[encBlockSize+10:], "hello")
+	if _, err := blob.WriteAt([]byte("world"), 6*encBlockSize+10); err != nil {
+		t.Fatalf("write failed: %s", err)
+	}
+	data = append(data, make([]byte, 6*encBlockSize+10-len(data))...)
+	data = append(data, "world"...)
+
+	if n, _ := blob.ByteLength(); n != int64(len(data)) {
+		t.Errorf("expected length %d, got %d", len(data), n)
+	}
+	got := make([]byte, len(data)+10)
+	if n, err := blob.ReadAt(got, 0); err != io.EOF || !bytes.Equal(got[:n], data) {
+		t.Errorf("read failed or returned wrong data: %d, %v", n, err)
+	}
+	raw := make([]byte, 1000)
+	if _, err := blob.readRaw(raw, 0); err != nil || bytes.Contains(raw, data[:100]) {
+		t.Errorf("stored data isn't encrypted: %v", err)
+	}
+
+	// Other clients see the data once it's flushed.
+	before, err := cli.Open(blob.ID(), "r")
+	if err != nil {
+		t.Fatalf("open failed: %s", err)
+	}
+	if n, _ := before.ByteLength(); n != 0 {
+		t.Errorf("expected unflushed data to be invisible, got length %d", n)
+	}
+	if err := blob.Close(); err != nil {
+		t.Fatalf("close failed: %s", err)
+	}
+	after, err := cli.Open(blob.ID(), "r")
+	if err != nil {
+		t.Fatalf("open failed: %s", err)
+	}
+	if n, err := after.ReadAt(got, 0); err != io.EOF || !bytes.Equal(got[:n], data) {
+		t.Errorf("read after close failed or returned wrong data: %d, %v", n, err)
+	}
+
+	// Copies share the data key and can be read and written.
+	cp, err := cli.Copy(context.Background(), blob.ID())
+	if err != nil {
+		t.Fatalf("copy failed: %s", err)
+	}
+	cpBlob, err := cli.Open(cp, "rw")
+	if err != nil {
+		t.Fatalf("open failed: %s", err)
+	}
+	if n, err := cpBlob.ReadAt(got, 0); err != io.EOF || !bytes.Equal(got[:n], data) {
+		t.Errorf("read of copy failed or returned wrong data: %d, %v", n, err)
+	}
+	if _, err := cpBlob.WriteAt([]byte("copy"), 10); err != nil {
+		t.Fatalf("write to copy failed: %s", err)
+	}
+	if n, err := cpBlob.ReadAt(got[:20], 0); err != nil || !bytes.Equal(got[10:n], append([]byte("copy"), data[14:20]...)) {
+		t.Errorf("read of copy failed or returned wrong data: %d, %v", n, err)
+	}
+
+	// Blocks can't be swapped between blobs with the same data key.
+	e1 := &encryption{aead: after.enc.aead, blob: 1}
+	e2 := &encryption{aead: after.enc.aead, blob: 2}
+	slot, err := e1.seal(0, data[:100])
+	if err != nil {
+		t.Fatalf("seal failed: %s", err)
+	}
+	if _, err := e2.open(0, slot); err == nil {
+		t.Errorf("block of blob 1 opened as a block of blob 2")
+	}
+	if blk, err := e1.open(0, slot); err != nil || !bytes.Equal(blk, data[:100]) {
+		t.Errorf("open failed: %v", err)
+	}
+
+	// Zeroed or missing blocks don't read as zeros.
+	if _, err := blob.writeRaw(make([]byte, encSlotSize), 4*encSlotSize); err != nil {
+		t.Fatalf("raw write failed: %s", err)
+	}
+	if _, err := after.ReadAt(got[:10], 4*encBlockSize); !core.ErrCorruptData.Is(err) {
+		t.Errorf("expected zeroed block to be corrupt, got %v", err)
+	}
+	if err := cli.truncate(context.Background(), blob.id, 6*encSlotSize); err != core.NoError {
+		t.Fatalf("truncate failed: %s", err)
+	}
+	if _, err := after.ReadAt(got[:10], 6*encBlockSize); !core.ErrCorruptData.Is(err) {
+		t.Errorf("expected truncated blob to be corrupt, got %v", err)
+	}
+
+	// Without the key, the blob can be opened but not read or written.
+	other := newClient(nil)
+	other.master, other.curators, other.tractservers = cli.master, cli.curators, cli.tractservers
+	noKey, err := other.Open(blob.ID(), "rw")
+	if err != nil {
+		t.Fatalf("open failed: %s", err)
+	}
+	if _, err := noKey.ReadAt(got, 0); !core.ErrKeyUnavailable.Is(err) {
+		t.Errorf("expected ErrKeyUnavailable, got %v", err)
+	}
+	if _, err := noKey.Write(data); !core.ErrKeyUnavailable.Is(err) {
+		t.Errorf("expected ErrKeyUnavailable, got %v", err)
+	}
+
+	// Compressed blobs can be encrypted too.
+	blob, err = cli.Create(CreateCompressed(CodecSnappy), CreateEncrypted)
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	data = makeData(core.TractLength + 1000)
+	checkWrite(t, blob, data)
+	if err := blob.Close(); err != nil {
+		t.Fatalf("close failed: %s", err)
+	}
+	if blob, err = cli.Open(blob.ID(), "r"); err != nil {
+		t.Fatalf("open failed: %s", err)
+	}
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(NewReadaheadBlob(blob)); err != nil || !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("read failed or returned wrong data: %d, %v", buf.Len(), err)
+	}
+}
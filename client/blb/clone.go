@@ -0,0 +1,82 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"context"
+
+	log "github.com/golang/glog"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+// Clones:
+//
+// A clone starts out sharing all of its tracts with the blob it was cloned
+// from, so cloning is cheap no matter how big the blob is. Shared tracts are
+// copied on write: before writing a shared tract, the writer has the curator
+// copy it for each blob sharing it until it isn't shared anymore, and then
+// writes as usual.
+//
+// Writers only find out that a tract is shared when they get tract info from
+// the curator. To catch writers that had tracts cached before the clone, the
+// curator gives tracts a new version when they become shared. Writes with the
+// old version fail, and the writer gets the tracts again.
+
+// Clone creates a new blob with the same contents and metadata as blob 'id',
+// and returns its ID. It will retry the operation internally according to the
+// retry policy specified by users if the operation failed due to "retriable"
+// errors.
+func (cli *Client) Clone(ctx context.Context, id BlobID) (BlobID, error) {
+	var clone core.BlobID
+	var berr core.Error
+	cli.retrier.Do(ctx, func(seq int) bool {
+		log.Infof("clone blob %s, attempt #%d", id, seq)
+		clone, berr = cli.cloneOnce(ctx, core.BlobID(id))
+		return !core.IsRetriableError(berr)
+	})
+	return BlobID(clone), berr.Error()
+}
+
+// cloneOnce clones the blob 'id'.
+func (cli *Client) cloneOnce(ctx context.Context, id core.BlobID) (core.BlobID, core.Error) {
+	addr, lookupWasCached, err := cli.lookup(ctx, id.Partition())
+	if core.NoError != err {
+		return 0, err
+	}
+	clone, err := cli.curators.CloneBlob(ctx, addr, id)
+	if err != core.NoError && lookupWasCached {
+		// Maybe we're talking to the wrong curator.
+		cli.lookupCache.invalidate(id.Partition())
+		return cli.cloneOnce(ctx, id)
+	}
+	if err == core.NoError {
+		// Our cached tracts for 'id' don't know that they're shared now.
+		cli.tractCache.invalidate(id)
+	}
+	return clone, err
+}
+
+// unshareTracts makes sure that none of 'tracts' of blob 'id' are shared. It
+// returns true if any were, in which case the caller has to get them again.
+func (cli *Client) unshareTracts(ctx context.Context, curatorAddr string, id core.BlobID, tracts []core.TractInfo) (bool, core.Error) {
+	var unshared bool
+	for _, tract := range tracts {
+		if !tract.Shared {
+			continue
+		}
+		if err := cli.unshareTract(ctx, curatorAddr, core.TractID{Blob: id, Index: tract.Tract.Index}); err != core.NoError {
+			return false, err
+		}
+		unshared = true
+	}
+	return unshared, core.NoError
+}
+
+// unshareTract has the curator copy the tract 'id' for each blob that shares
+// it, until it isn't shared anymore.
+func (cli *Client) unshareTract(ctx context.Context, curatorAddr string, id core.TractID) core.Error {
+	log.V(1).Infof("unsharing tract %s", id)
+	return cli.curators.UnshareTract(ctx, curatorAddr, id)
+}
@@ -0,0 +1,388 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"sync"
+
+	log "github.com/golang/glog"
+	"github.com/golang/snappy"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+// Codec is a compression algorithm for compressed blobs.
+type Codec string
+
+const (
+	// CodecSnappy is fast, with a modest compression ratio.
+	CodecSnappy Codec = "snappy"
+
+	// CodecFlate is slower than CodecSnappy, but compresses better.
+	CodecFlate Codec = "flate"
+)
+
+// Keys in blob metadata that describe a compressed blob. Users shouldn't
+// change these.
+const (
+	codecKey  = "blb.codec"
+	framesKey = "blb.frames"
+	lengthKey = "blb.length"
+)
+
+// Compressed blobs are stored as a series of frames. Each frame holds
+// TractLength bytes of data (except the last, which may hold less) and is
+// compressed separately into the start of its own tract, so that we can read
+// any part of the blob by reading and decompressing the frames that cover it.
+// Frames that don't get smaller when compressed are stored as they are.
+//
+// The frame index records, for each frame, the tract it's stored in and its
+// stored length, shifted left by one, with the low bit set if the frame is
+// stored uncompressed. It's kept in blob metadata as base64-encoded varints,
+// along with the logical length of the blob. This limits compressed blobs to
+// a couple hundred frames.
+//
+// A frame is never written over in place: the last frame is rewritten when
+// more data is added to it, and each new version goes to a tract that the
+// published index doesn't refer to. The index is published on Flush, so a
+// writer that dies before then leaves the blob as it was at the last Flush.
+type compression struct {
+	codec Codec
+
+	// The frame index, and the logical length of the frames in it.
+	frames []frame
+	length int64
+
+	// The frame index as of the last Flush, and the size of the rest of the
+	// blob's metadata, for staying under MaxMetadataSize.
+	published []frame
+	otherSize int
+
+	// The last frame, when writing. It holds the data in frame 'bufFrame'. If
+	// 'dirty' is set, it hasn't been written yet.
+	buf      []byte
+	bufFrame int
+	dirty    bool
+
+	// The most recently read frame. Blob copies made by StreamReader share
+	// this, so it's protected by 'lock'.
+	lock       sync.Mutex
+	cache      []byte
+	cacheFrame int
+}
+
+// frame is an entry in the frame index.
+type frame struct {
+	tract int
+	v     uint64
+}
+
+func validCodec(codec Codec) bool {
+	return codec == CodecSnappy || codec == CodecFlate
+}
+
+// openCompression returns the compression state for a blob with metadata
+// 'md', or nil if the blob isn't compressed.
+func openCompression(md map[string]string) (*compression, core.Error) {
+	codec, ok := md[codecKey]
+	if !ok {
+		return nil, core.NoError
+	}
+	c := &compression{codec: Codec(codec)}
+	if !validCodec(c.codec) {
+		log.Errorf("blob compressed with unknown codec %q", codec)
+		return nil, core.ErrInvalidState
+	}
+	if s, ok := md[lengthKey]; ok {
+		length, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, core.ErrInvalidState
+		}
+		c.length = length
+	}
+	b, err := base64.RawStdEncoding.DecodeString(md[framesKey])
+	if err != nil {
+		return nil, core.ErrInvalidState
+	}
+	for len(b) > 0 {
+		t, n := binary.Uvarint(b)
+		if n <= 0 {
+			return nil, core.ErrInvalidState
+		}
+		v, m := binary.Uvarint(b[n:])
+		if m <= 0 {
+			return nil, core.ErrInvalidState
+		}
+		c.frames = append(c.frames, frame{tract: int(t), v: v})
+		b = b[n+m:]
+	}
+	if c.length > int64(len(c.frames))*core.TractLength {
+		return nil, core.ErrInvalidState
+	}
+	c.published = append([]frame(nil), c.frames...)
+	for k, v := range md {
+		if k != framesKey && k != lengthKey {
+			c.otherSize += len(k) + len(v)
+		}
+	}
+	return c, core.NoError
+}
+
+// metadata returns the blob metadata that describes the frame index.
+func (c *compression) metadata() map[string]string {
+	var b []byte
+	var tmp [binary.MaxVarintLen64]byte
+	for _, f := range c.frames {
+		b = append(b, tmp[:binary.PutUvarint(tmp[:], uint64(f.tract))]...)
+		b = append(b, tmp[:binary.PutUvarint(tmp[:], f.v)]...)
+	}
+	return map[string]string{
+		framesKey: base64.RawStdEncoding.EncodeToString(b),
+		lengthKey: strconv.FormatInt(c.length, 10),
+	}
+}
+
+// indexFits returns true if the frame index still fits in blob metadata with
+// 'n' frames in it.
+func (c *compression) indexFits(n int) bool {
+	// Each entry takes at most 3 bytes for the tract and 4 for the stored
+	// length, and the length takes at most 19 digits.
+	const maxEntry, maxLength = 7, 19
+	size := c.otherSize + len(framesKey) + len(lengthKey) + maxLength
+	size += base64.RawStdEncoding.EncodedLen(n * maxEntry)
+	return size <= core.MaxMetadataSize
+}
+
+// freeTract returns a tract that no frame in the current or the published
+// frame index is stored in.
+func (c *compression) freeTract() int {
+	used := make(map[int]bool)
+	for _, f := range c.frames {
+		used[f.tract] = true
+	}
+	for _, f := range c.published {
+		used[f.tract] = true
+	}
+	t := 0
+	for used[t] {
+		t++
+	}
+	return t
+}
+
+// end returns the logical length of the blob, including unwritten data.
+func (c *compression) end() int64 {
+	if c.buf != nil {
+		return int64(c.bufFrame)*core.TractLength + int64(len(c.buf))
+	}
+	return c.length
+}
+
+// compress compresses 'data' for storing. It returns the stored data and the
+// frame index entry for it.
+func (c *compression) compress(data []byte) ([]byte, uint64) {
+	var out []byte
+	switch c.codec {
+	case CodecSnappy:
+		out = snappy.Encode(nil, data)
+	case CodecFlate:
+		var buf bytes.Buffer
+		w, _ := flate.NewWriter(&buf, flate.DefaultCompression)
+		w.Write(data)
+		w.Close()
+		out = buf.Bytes()
+	}
+	if len(out) >= len(data) {
+		return data, uint64(len(data))<<1 | 1
+	}
+	return out, uint64(len(out)) << 1
+}
+
+// decompress returns the data of a frame stored as 'stored' with index
+// entry 'v'.
+func (c *compression) decompress(stored []byte, v uint64) ([]byte, error) {
+	if v&1 != 0 {
+		return stored, nil
+	}
+	switch c.codec {
+	case CodecSnappy:
+		return snappy.Decode(nil, stored)
+	case CodecFlate:
+		return ioutil.ReadAll(flate.NewReader(bytes.NewReader(stored)))
+	}
+	return nil, core.ErrInvalidState.Error()
+}
+
+// readCompressed reads the logical data at 'offset' of a compressed blob.
+func (b *Blob) readCompressed(p []byte, offset int64) (int, error) {
+	c := b.comp
+	end := c.end()
+	n := 0
+	for n < len(p) && offset < end {
+		f := int(offset / core.TractLength)
+		var data []byte
+		if c.buf != nil && f == c.bufFrame {
+			data = c.buf
+		} else {
+			var err error
+			if data, err = b.readFrame(f); err != nil {
+				return n, err
+			}
+		}
+		start := offset - int64(f)*core.TractLength
+		if start >= int64(len(data)) {
+			return n, core.ErrInvalidState.Error()
+		}
+		copied := copy(p[n:], data[start:])
+		n += copied
+		offset += int64(copied)
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// readFrame returns the logical data in frame 'f'.
+func (b *Blob) readFrame(f int) ([]byte, error) {
+	c := b.comp
+	c.lock.Lock()
+	if c.cache != nil && c.cacheFrame == f {
+		data := c.cache
+		c.lock.Unlock()
+		return data, nil
+	}
+	c.lock.Unlock()
+
+	v := c.frames[f].v
+	stored := make([]byte, v>>1)
+	if n, err := b.readStored(stored, int64(c.frames[f].tract)*core.TractLength); err != nil && !(err == io.EOF && n == len(stored)) {
+		return nil, err
+	}
+
+	data, derr := c.decompress(stored, v)
+	if derr != nil {
+		log.Errorf("couldn't decompress frame %d of blob %s: %s", f, b.id, derr)
+		return nil, core.ErrInvalidState.Error()
+	}
+	want := c.length - int64(f)*core.TractLength
+	if want > core.TractLength {
+		want = core.TractLength
+	}
+	if int64(len(data)) != want {
+		log.Errorf("frame %d of blob %s has %d bytes, expected %d", f, b.id, len(data), want)
+		return nil, core.ErrInvalidState.Error()
+	}
+
+	c.lock.Lock()
+	c.cache, c.cacheFrame = data, f
+	c.lock.Unlock()
+	return data, nil
+}
+
+// writeCompressed writes 'p' at 'offset' of a compressed blob. Compressed
+// blobs can only be written sequentially, so 'offset' must be the end of the
+// blob.
+func (b *Blob) writeCompressed(p []byte, offset int64) (int, error) {
+	c := b.comp
+	if offset != c.end() {
+		return 0, core.ErrInvalidArgument.Error()
+	}
+
+	if c.buf == nil {
+		// Start with the last frame if it's not full.
+		c.bufFrame = int(c.length / core.TractLength)
+		c.buf = []byte{}
+		if c.length%core.TractLength != 0 {
+			data, err := b.readFrame(c.bufFrame)
+			if err != nil {
+				return 0, err
+			}
+			c.buf = append(c.buf, data...)
+		}
+	}
+
+	n := 0
+	for n < len(p) {
+		take := min(core.TractLength-len(c.buf), len(p)-n)
+		c.buf = append(c.buf, p[n:n+take]...)
+		c.dirty = true
+		n += take
+		if len(c.buf) == core.TractLength {
+			if err := b.writeFrame(); err != nil {
+				return n - len(c.buf), err
+			}
+			c.bufFrame++
+			c.buf = []byte{}
+		}
+	}
+	return n, nil
+}
+
+// flushCompressed writes the last frame, if it has changed, and adds the frame
+// index to 'md' if it hasn't been published.
+func (b *Blob) flushCompressed(md map[string]string) error {
+	c := b.comp
+	if c.dirty {
+		if err := b.writeFrame(); err != nil {
+			return err
+		}
+	}
+	if !framesEqual(c.frames, c.published) {
+		for k, v := range c.metadata() {
+			md[k] = v
+		}
+	}
+	return nil
+}
+
+func framesEqual(a, b []frame) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// writeFrame writes the frame in the write buffer to a free tract and updates
+// the frame index. The index isn't published until the next flush.
+func (b *Blob) writeFrame() error {
+	c := b.comp
+	if !c.indexFits(c.bufFrame + 1) {
+		return core.ErrMetadataTooLarge.Error()
+	}
+	stored, v := c.compress(c.buf)
+
+	// The frame's old location may still be in the published index, so it
+	// goes somewhere else.
+	f := frame{tract: c.freeTract(), v: v}
+	if _, err := b.writeStored(stored, int64(f.tract)*core.TractLength); err != nil {
+		return err
+	}
+
+	if c.bufFrame == len(c.frames) {
+		c.frames = append(c.frames, f)
+	} else {
+		c.frames[c.bufFrame] = f
+	}
+	c.length = int64(c.bufFrame)*core.TractLength + int64(len(c.buf))
+	c.dirty = false
+
+	c.lock.Lock()
+	if c.cacheFrame == c.bufFrame {
+		c.cache = nil
+	}
+	c.lock.Unlock()
+	return nil
+}
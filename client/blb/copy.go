@@ -0,0 +1,277 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"context"
+	"strings"
+
+	log "github.com/golang/glog"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+// Copies:
+//
+// Blobs are copied a tract at a time, by tractservers pulling the data from
+// each other like they do for re-replication. The client asks the
+// destination's curator to copy a few tracts of the source at a time. The
+// curator looks up the source tracts, allocates the new tracts, has
+// tractservers copy the data, and then adds the tracts to the end of the
+// destination.
+//
+// The curator can only look up tracts of its own blobs. If the source is on
+// another curator, the client copies the data itself instead, reading it from
+// the source and writing it to the destination.
+
+// copyBatchTracts is how many tracts we ask the curator to copy at once. All
+// of them have to be copied within one curator RPC.
+const copyBatchTracts = 8
+
+// AppendAligned appends copies of the blobs 'srcs' to blob 'dst', and returns
+// the offset in 'dst' where each copy starts. Each copy starts on the next
+// tract boundary, so that tractservers can copy whole tracts. This is not a
+// contiguous concatenation: if 'dst' or one of the sources doesn't end on a
+// tract boundary, the gap before the next copy reads as zeros. Blobs whose
+// lengths are all multiples of core.TractLength, except maybe the last
+// source's, do end up contiguous.
+//
+// AppendAligned copies stored bytes, so it fails with ErrInvalidArgument if
+// 'dst' or any of 'srcs' is compressed or encrypted. It isn't atomic: if it
+// fails, some of the sources may have been copied to 'dst' already. Writers
+// that extend 'dst' at the same time make it fail with ErrExtendConflict,
+// unless the client is copying the data itself, see above.
+func (cli *Client) AppendAligned(ctx context.Context, dst BlobID, srcs ...BlobID) ([]int64, error) {
+	log.Infof("append %d blobs to blob %s", len(srcs), dst)
+	offsets, err := cli.appendAligned(ctx, core.BlobID(dst), srcs, false)
+	return offsets, err.Error()
+}
+
+// Copy creates a new blob with 'opts', copies the contents of blob 'src' into
+// it, and returns its ID. User metadata isn't copied; use WithMetadata to set
+// it. The copy is stored the same way as 'src': if that's compressed or
+// encrypted, the copy uses the same codec and data key. Either way it can't be
+// created with CreateCompressed or CreateEncrypted. If the copy fails, the new
+// blob is deleted.
+func (cli *Client) Copy(ctx context.Context, src BlobID, opts ...createOpt) (BlobID, error) {
+	info, serr := cli.statRetry(ctx, core.BlobID(src))
+	if serr != core.NoError {
+		return 0, serr.Error()
+	}
+	stored := storedMetadata(core.BlobID(src), info.Metadata)
+	opts = append([]createOpt{CreateContext(ctx), func(o *createOptions) { o.stored = stored }}, opts...)
+
+	b, err := cli.Create(opts...)
+	if err != nil {
+		return 0, err
+	}
+	if _, cerr := cli.appendAligned(ctx, core.BlobID(b.ID()), []BlobID{src}, true); cerr != core.NoError {
+		err = cerr.Error()
+	} else {
+		// Write-once copies are sealed on close.
+		err = b.Close()
+	}
+	if err != nil {
+		if derr := cli.Delete(ctx, b.ID()); derr != nil {
+			log.Errorf("couldn't delete failed copy %s of blob %s: %s", b.ID(), src, derr)
+		}
+		return 0, err
+	}
+	return b.ID(), nil
+}
+
+// statRetry gets information about 'id', retrying if needed.
+func (cli *Client) statRetry(ctx context.Context, id core.BlobID) (info core.BlobInfo, err core.Error) {
+	cli.retrier.Do(ctx, func(seq int) bool {
+		_, info, err = cli.statBlob(ctx, id)
+		return !core.IsRetriableError(err)
+	})
+	return
+}
+
+// storedMetadata returns the reserved keys of 'md', the metadata of blob 'id',
+// which a copy of the blob needs for its data to be read. Encrypted blocks are
+// bound to a blob ID, so a copy records the ID the original's are bound to.
+func storedMetadata(id core.BlobID, md map[string]string) map[string]string {
+	stored := make(map[string]string)
+	for k, v := range md {
+		if strings.HasPrefix(k, reservedPrefix) {
+			stored[k] = v
+		}
+	}
+	if _, ok := stored[cipherKey]; ok {
+		if _, ok := stored[encBlobKey]; !ok {
+			stored[encBlobKey] = id.String()
+		}
+	}
+	return stored
+}
+
+// appendAligned copies the tracts of 'srcs' to the end of 'dst'. Unless
+// 'asStored' is set, none of the blobs may be compressed or encrypted.
+func (cli *Client) appendAligned(ctx context.Context, dst core.BlobID, srcs []BlobID, asStored bool) ([]int64, core.Error) {
+	info, err := cli.statRetry(ctx, dst)
+	if err != core.NoError {
+		return nil, err
+	}
+	if !asStored {
+		// Check all of them before copying anything.
+		if hasReserved(info.Metadata) {
+			return nil, core.ErrInvalidArgument
+		}
+		for _, src := range srcs {
+			sinfo, err := cli.statRetry(ctx, core.BlobID(src))
+			if err != core.NoError {
+				return nil, err
+			}
+			if hasReserved(sinfo.Metadata) {
+				return nil, core.ErrInvalidArgument
+			}
+		}
+	}
+
+	// Whatever we had cached for 'dst' is out of date now.
+	defer cli.tractCache.invalidate(dst)
+
+	next := info.NumTracts
+	offsets := make([]int64, len(srcs))
+	for i, src := range srcs {
+		offsets[i] = int64(next) * core.TractLength
+		if next, err = cli.appendOne(ctx, dst, next, core.BlobID(src)); err != core.NoError {
+			return nil, err
+		}
+	}
+	return offsets, core.NoError
+}
+
+// appendOne copies the tracts of 'src' to 'dst', which has 'next' tracts, and
+// returns the new number of tracts in 'dst'.
+func (cli *Client) appendOne(ctx context.Context, dst core.BlobID, next int, src core.BlobID) (int, core.Error) {
+	info, err := cli.statRetry(ctx, src)
+	if err != core.NoError {
+		return next, err
+	}
+	if info.WriteOnce && !info.Sealed {
+		return next, core.ErrNotSealed
+	}
+	if next+info.NumTracts > core.MaxBlobSize {
+		return next, core.ErrBlobFull
+	}
+
+	addr, _, err := cli.lookup(ctx, dst.Partition())
+	if err != core.NoError {
+		return next, err
+	}
+	srcAddr, _, err := cli.lookup(ctx, src.Partition())
+	if err != core.NoError {
+		return next, err
+	}
+	if srcAddr != addr {
+		return cli.appendThrough(ctx, dst, next, src, info.NumTracts)
+	}
+
+	for start := 0; start < info.NumTracts; start += copyBatchTracts {
+		end := start + copyBatchTracts
+		if end > info.NumTracts {
+			end = info.NumTracts
+		}
+		var maybeCopied bool
+		cli.retrier.Do(ctx, func(seq int) bool {
+			log.Infof("copy tracts [%d, %d) of blob %s to blob %s, attempt #%d", start, end, src, dst, seq)
+			err = cli.copyTractsOnce(ctx, dst, core.TractKey(next), src, start, end)
+			if err == core.ErrExtendConflict && maybeCopied && cli.haveCopies(ctx, dst, next, src, start, end) {
+				// An earlier attempt got through, but we didn't hear back.
+				err = core.NoError
+			}
+			maybeCopied = maybeCopied || core.IsRetriableError(err)
+			return !core.IsRetriableError(err)
+		})
+		if err != core.NoError {
+			return next, err
+		}
+		next += end - start
+	}
+	return next, core.NoError
+}
+
+// appendThrough copies the data of 'src', which has 'n' tracts, to 'dst' at
+// tract 'next' by reading and writing it here, and returns the new number of
+// tracts in 'dst'. Unlike copies made by tractservers, a writer that extends
+// 'dst' at the same time isn't noticed once the copy has started.
+func (cli *Client) appendThrough(ctx context.Context, dst core.BlobID, next int, src core.BlobID, n int) (int, core.Error) {
+	info, err := cli.statRetry(ctx, dst)
+	if err != core.NoError {
+		return next, err
+	}
+	if info.NumTracts != next {
+		return next, core.ErrExtendConflict
+	}
+
+	// The data is stored as it is, so we read and write it a tract at a time
+	// without decoding it.
+	b := make([]byte, core.TractLength)
+	for i := 0; i < n; i++ {
+		var got int
+		cli.retrier.Do(ctx, func(seq int) bool {
+			log.Infof("copy tract %d of blob %s to blob %s, attempt #%d", i, src, dst, seq)
+			got, err = cli.readAt(ctx, src, b, int64(i)*core.TractLength)
+			return !core.IsRetriableError(err)
+		})
+		if err != core.NoError && err != core.ErrEOF {
+			return next, err
+		}
+		if got == 0 {
+			break
+		}
+		cli.retrier.Do(ctx, func(seq int) bool {
+			_, err = cli.writeAt(ctx, dst, b[:got], int64(next+i)*core.TractLength)
+			return !core.IsRetriableError(err)
+		})
+		if err != core.NoError {
+			return next, err
+		}
+	}
+	return next + n, core.NoError
+}
+
+// haveCopies returns true if 'dst' ends with copies of the tracts [start, end)
+// of 'src', starting at tract 'first'. Copies have the same checksums as the
+// originals, but either may not have one yet, so this can't be sure if a
+// writer extended 'dst' at the same time.
+func (cli *Client) haveCopies(ctx context.Context, dst core.BlobID, first int, src core.BlobID, start, end int) bool {
+	_, info, err := cli.statBlob(ctx, dst)
+	if err != core.NoError || info.NumTracts != first+end-start {
+		return false
+	}
+	tracts, err := cli.getTractsOnce(ctx, src, start, end)
+	if err != core.NoError {
+		return false
+	}
+	copies, err := cli.getTractsOnce(ctx, dst, first, info.NumTracts)
+	if err != core.NoError || len(copies) != len(tracts) {
+		return false
+	}
+	for i, c := range copies {
+		if c.Checksum.Present() && tracts[i].Checksum.Present() && c.Checksum != tracts[i].Checksum {
+			return false
+		}
+	}
+	return true
+}
+
+// copyTractsOnce asks the curator of 'dst' to copy the tracts [start, end) of
+// 'src' to the end of it.
+func (cli *Client) copyTractsOnce(ctx context.Context, dst core.BlobID, first core.TractKey, src core.BlobID, start, end int) core.Error {
+	addr, lookupWasCached, err := cli.lookup(ctx, dst.Partition())
+	if err != core.NoError {
+		return err
+	}
+	err = cli.curators.CopyTracts(ctx, addr, dst, first, src, start, end)
+	if err != core.NoError && lookupWasCached {
+		// Maybe we're talking to the wrong curator.
+		cli.lookupCache.invalidate(dst.Partition())
+		return cli.copyTractsOnce(ctx, dst, first, src, start, end)
+	}
+	return err
+}
@@ -10,16 +10,23 @@ import (
 
 // CuratorTalker manages connections to curators.
 type CuratorTalker interface {
-	// CreateBlob creates a blob. Only Repl, Hint, and Expires in options are used.
-	CreateBlob(ctx context.Context, addr string, metadata core.BlobInfo) (core.BlobID, core.Error)
+	// CreateBlob creates a blob. Only Repl, Hint, Expires, Metadata,
+	// WriteOnce, Owner, and ACL in 'metadata' are used. If 'name' isn't
+	// empty, the blob is bound to it in the same operation, and 'addr' must
+	// be the curator that owns the namespace.
+	CreateBlob(ctx context.Context, addr string, name string, metadata core.BlobInfo) (core.BlobID, core.Error)
 
 	// ExtendBlob extends 'blob' until it has 'numTracts' tracts, and returns
 	// the new tracts.
 	ExtendBlob(ctx context.Context, addr string, blob core.BlobID, numTracts int) ([]core.TractInfo, core.Error)
 
 	// AckExtendBlob acks the success of extending 'blob' with the new
-	// tracts 'tracts'.
-	AckExtendBlob(ctx context.Context, addr string, blob core.BlobID, tracts []core.TractInfo) core.Error
+	// tracts 'tracts', and applies the checksum updates 'checksums' with it.
+	AckExtendBlob(ctx context.Context, addr string, blob core.BlobID, tracts []core.TractInfo, checksums []core.ChecksumUpdate) core.Error
+
+	// ReserveAppend reserves 'length' bytes at the end of 'blob', starting no
+	// earlier than 'minOffset', and returns where the reserved space starts.
+	ReserveAppend(ctx context.Context, addr string, blob core.BlobID, length, minOffset int64) (int64, core.Error)
 
 	// DeleteBlob deletes 'blob'.
 	DeleteBlob(ctx context.Context, addr string, blob core.BlobID) core.Error
@@ -27,12 +34,43 @@ type CuratorTalker interface {
 	// Undelete tries to un-delete 'blob'.
 	UndeleteBlob(ctx context.Context, addr string, blob core.BlobID) core.Error
 
+	// PurgeBlob removes a deleted blob for good, without waiting for the
+	// undelete period to pass.
+	PurgeBlob(ctx context.Context, addr string, blob core.BlobID) core.Error
+
+	// SealBlob seals the write-once blob 'blob'.
+	SealBlob(ctx context.Context, addr string, blob core.BlobID) core.Error
+
+	// TruncateBlob drops the tracts of 'blob' beyond 'size' bytes.
+	TruncateBlob(ctx context.Context, addr string, blob core.BlobID, size int64) core.Error
+
+	// CloneBlob creates a blob that shares the tracts of 'blob'.
+	CloneBlob(ctx context.Context, addr string, blob core.BlobID) (core.BlobID, core.Error)
+
+	// UnshareTract has tractservers copy the shared tract 'id' for every blob
+	// that shares it, so that it can be written.
+	UnshareTract(ctx context.Context, addr string, id core.TractID) core.Error
+
+	// CopyTracts extends 'blob', which has 'first' tracts, with copies of
+	// tracts [start, end) of 'src' made by tractservers. Both blobs have to be
+	// on the curator at 'addr'.
+	CopyTracts(ctx context.Context, addr string, blob core.BlobID, first core.TractKey, src core.BlobID, start, end int) core.Error
+
 	// SetMetadata changes some metadata for the given blob.
 	SetMetadata(ctx context.Context, addr string, blob core.BlobID, md core.BlobInfo) core.Error
 
-	// GetTracts retrieves the tracts ['start', 'end') for 'blob'.
+	// UpdateChecksums changes end-to-end checksums for tracts of 'blob'.
+	UpdateChecksums(ctx context.Context, addr string, blob core.BlobID, updates []core.ChecksumUpdate) core.Error
+
+	// GetTracts retrieves the tracts ['start', 'end') for 'blob'. If 'forRead'
+	// is set, it fails for write-once blobs that aren't sealed yet.
 	GetTracts(ctx context.Context, addr string, blob core.BlobID, start, end int, forRead, forWrite bool) ([]core.TractInfo, core.Error)
 
+	// GetTractsWithInfo is like GetTracts but also returns information about
+	// the blob. The info is nil if the curator doesn't support returning it.
+	// If 'allowUnsealed' is set, unsealed write-once blobs can be read.
+	GetTractsWithInfo(ctx context.Context, addr string, blob core.BlobID, start, end int, forRead, forWrite, allowUnsealed bool) ([]core.TractInfo, *core.BlobInfo, core.Error)
+
 	// StatBlob gets information about a blob.
 	StatBlob(ctx context.Context, addr string, blob core.BlobID) (core.BlobInfo, core.Error)
 
@@ -51,4 +89,33 @@ type CuratorTalker interface {
 	// be a contiguous range of the id space, and will be in order. An empty
 	// return value means that no blobs in that part of the id space exist.
 	ListBlobs(ctx context.Context, addr string, partition core.PartitionID, start core.BlobKey) ([]core.BlobKey, core.Error)
+
+	// ListBlobsWithInfo gets blob ids in a given partition that match 'filter',
+	// with their info, starting at 'start'. The server may return any number
+	// of blobs, including none. If 'more' is true, there may be more matching
+	// blobs starting at 'next'.
+	ListBlobsWithInfo(ctx context.Context, addr string, partition core.PartitionID, start core.BlobKey, filter core.BlobFilter) (keys []core.BlobKey, infos []core.BlobInfo, next core.BlobKey, more bool, err core.Error)
+
+	// GetEvents gets changes to blobs in a given partition that come after
+	// raft index 'after', or the oldest ones the curator has if 'after' is
+	// zero. The server may return any number of events, including none. The
+	// next call should use 'next' as 'after'.
+	GetEvents(ctx context.Context, addr string, partition core.PartitionID, after uint64) (events []core.BlobEvent, next uint64, err core.Error)
+
+	// LookupName returns the blob that 'name' is bound to.
+	LookupName(ctx context.Context, addr string, name string) (core.BlobID, core.Error)
+
+	// Rename atomically moves the binding of 'from' to 'to', or every name in
+	// the directory 'from' if both end with core.NameSeparator. If 'replace'
+	// is non-zero, 'to' must be bound to that blob, and the binding is
+	// replaced.
+	Rename(ctx context.Context, addr string, from, to string, replace core.BlobID) core.Error
+
+	// UnbindName removes 'name' from the namespace. If 'blob' is non-zero, the
+	// name is only removed if it's bound to that blob.
+	UnbindName(ctx context.Context, addr string, name string, blob core.BlobID) core.Error
+
+	// ListNames gets a batch of entries directly under 'prefix' whose names
+	// sort after 'start'. An empty return value means there are no more.
+	ListNames(ctx context.Context, addr string, prefix, start string) ([]core.NameEntry, core.Error)
 }
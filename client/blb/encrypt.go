@@ -0,0 +1,487 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	log "github.com/golang/glog"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+// KeyProvider protects the data keys of encrypted blobs. Each encrypted blob
+// has its own random data key, which is stored in the blob's metadata only
+// after the key provider wraps (encrypts) it with a master key that blb never
+// sees.
+type KeyProvider interface {
+	// WrapKey encrypts 'key'. It returns the ID of the master key it used,
+	// and the wrapped key.
+	WrapKey(key []byte) (keyID string, wrapped []byte, err error)
+
+	// UnwrapKey decrypts a key that was wrapped with master key 'keyID'.
+	UnwrapKey(keyID string, wrapped []byte) ([]byte, error)
+}
+
+// FileKeyProvider is a KeyProvider that keeps master keys in a directory. Each
+// key is a file holding a hex-encoded 32-byte AES key, named by its key ID.
+// New data keys are wrapped with the key named Current; older keys are still
+// used to unwrap existing data keys, so keys can be rotated by adding a file
+// and changing Current.
+type FileKeyProvider struct {
+	Dir     string
+	Current string
+}
+
+// NewFileKeyProvider returns a FileKeyProvider that reads keys from 'dir' and
+// wraps new data keys with the key named 'current'.
+func NewFileKeyProvider(dir, current string) *FileKeyProvider {
+	return &FileKeyProvider{Dir: dir, Current: current}
+}
+
+// WrapKey implements KeyProvider.
+func (p *FileKeyProvider) WrapKey(key []byte) (string, []byte, error) {
+	aead, err := p.masterKey(p.Current)
+	if err != nil {
+		return "", nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+	return p.Current, aead.Seal(nonce, nonce, key, []byte(p.Current)), nil
+}
+
+// UnwrapKey implements KeyProvider.
+func (p *FileKeyProvider) UnwrapKey(keyID string, wrapped []byte) ([]byte, error) {
+	aead, err := p.masterKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, fmt.Errorf("wrapped key is too short")
+	}
+	ns := aead.NonceSize()
+	return aead.Open(nil, wrapped[:ns], wrapped[ns:], []byte(keyID))
+}
+
+// masterKey reads the key 'keyID' and returns a cipher for it.
+func (p *FileKeyProvider) masterKey(keyID string) (cipher.AEAD, error) {
+	if keyID == "" || filepath.Base(keyID) != keyID {
+		return nil, fmt.Errorf("invalid key ID %q", keyID)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(p.Dir, keyID))
+	if err != nil {
+		return nil, err
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(b)))
+	if err != nil {
+		return nil, fmt.Errorf("key %q isn't hex-encoded: %s", keyID, err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("key %q should be 32 bytes, not %d", keyID, len(key))
+	}
+	return newGCM(key)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Keys in blob metadata that describe an encrypted blob. Users shouldn't
+// change these.
+const (
+	cipherKey    = "blb.cipher"
+	keyIDKey     = "blb.keyid"
+	dataKeyKey   = "blb.key"
+	encLengthKey = "blb.enclength"
+	encBlobKey   = "blb.encblob"
+
+	// The only cipher we support so far.
+	cipherAESGCM = "aes-256-gcm-64k"
+)
+
+// Encrypted blobs are stored as a series of encBlockSize blocks, each sealed
+// separately with AES-GCM under the blob's data key, so that any part of the
+// blob can be read or written by reading or writing the blocks that cover it.
+// Each block is stored in a slot of encSlotSize bytes, as a random nonce
+// followed by the ciphertext and tag. The blob ID and block index are
+// authenticated along with the data, so blocks can't be moved around, or
+// swapped with blocks of another blob that has the same data key. Copies of a
+// blob share its data key and start out with its blocks, so they record the
+// original's ID in metadata and authenticate their blocks with that.
+//
+// All blocks but the last are full, and there are no holes: writing past the
+// end fills the gap with encrypted zeros. The length of the data is sealed
+// with the data key and kept in blob metadata, so that a tractserver can't
+// cut the blob short either. Like the frame index of a compressed blob, it's
+// published on Flush.
+const (
+	encBlockSize = 64 * 1024
+	encNonceSize = 12
+	encOverhead  = encNonceSize + 16
+	encSlotSize  = encBlockSize + encOverhead
+)
+
+// encryption is the cipher for an encrypted blob.
+type encryption struct {
+	// The cipher for the data key, or nil if we couldn't get it.
+	aead cipher.AEAD
+
+	// The blob ID that's authenticated with each block.
+	blob core.BlobID
+
+	// The length of the data as of the last Flush, and the length including
+	// what's been written through this Blob since.
+	lock      sync.Mutex
+	published int64
+	length    int64
+}
+
+// newEncryption makes a data key for a new blob. It returns the cipher and the
+// blob metadata that records the wrapped key. The caller sets the blob ID once
+// the blob has been created.
+func newEncryption(keys KeyProvider) (*encryption, map[string]string, core.Error) {
+	if keys == nil {
+		log.Errorf("can't create an encrypted blob without a key provider")
+		return nil, nil, core.ErrInvalidArgument
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		log.Errorf("couldn't generate a data key: %s", err)
+		return nil, nil, core.ErrKeyUnavailable
+	}
+	keyID, wrapped, err := keys.WrapKey(key)
+	if err != nil {
+		log.Errorf("couldn't wrap data key: %s", err)
+		return nil, nil, core.ErrKeyUnavailable
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, nil, core.ErrKeyUnavailable
+	}
+	e := &encryption{aead: aead}
+	length, err := e.sealLength(0)
+	if err != nil {
+		log.Errorf("couldn't seal length: %s", err)
+		return nil, nil, core.ErrKeyUnavailable
+	}
+	md := map[string]string{
+		cipherKey:    cipherAESGCM,
+		keyIDKey:     keyID,
+		dataKeyKey:   base64.StdEncoding.EncodeToString(wrapped),
+		encLengthKey: length,
+	}
+	return e, md, core.NoError
+}
+
+// openEncryption returns the cipher for blob 'id' with metadata 'md', or nil if
+// the blob isn't encrypted. If the data key can't be unwrapped, the blob can
+// still be opened, but reads and writes will fail with ErrKeyUnavailable.
+func openEncryption(id core.BlobID, md map[string]string, keys KeyProvider) (*encryption, core.Error) {
+	name, ok := md[cipherKey]
+	if !ok {
+		return nil, core.NoError
+	}
+	if name != cipherAESGCM {
+		log.Errorf("blob encrypted with unknown cipher %q", name)
+		return nil, core.ErrInvalidState
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(md[dataKeyKey])
+	if err != nil {
+		return nil, core.ErrInvalidState
+	}
+	if s, ok := md[encBlobKey]; ok {
+		if id, err = core.ParseBlobID(s); err != nil {
+			log.Errorf("bad blob ID for encrypted blocks %q", s)
+			return nil, core.ErrInvalidState
+		}
+	}
+	if keys == nil {
+		log.Errorf("no key provider for encrypted blob")
+		return &encryption{}, core.NoError
+	}
+	key, err := keys.UnwrapKey(md[keyIDKey], wrapped)
+	if err != nil {
+		log.Errorf("couldn't unwrap data key with key %q: %s", md[keyIDKey], err)
+		return &encryption{}, core.NoError
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		log.Errorf("bad data key: %s", err)
+		return &encryption{}, core.NoError
+	}
+	e := &encryption{aead: aead, blob: id}
+	if e.published, err = e.openLength(md[encLengthKey]); err != nil {
+		log.Errorf("couldn't open sealed length: %s", err)
+		return nil, core.ErrCorruptData
+	}
+	e.length = e.published
+	return e, core.NoError
+}
+
+// sealLength encrypts the length of the data for storing in metadata.
+func (e *encryption) sealLength(n int64) (string, error) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(n))
+	nonce := make([]byte, encNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(e.aead.Seal(nonce, nonce, b[:], []byte(encLengthKey))), nil
+}
+
+// openLength decrypts a length sealed by sealLength.
+func (e *encryption) openLength(s string) (int64, error) {
+	sealed, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return 0, err
+	}
+	if len(sealed) < encNonceSize {
+		return 0, fmt.Errorf("sealed length is too short")
+	}
+	b, err := e.aead.Open(nil, sealed[:encNonceSize], sealed[encNonceSize:], []byte(encLengthKey))
+	if err != nil {
+		return 0, err
+	}
+	if len(b) != 8 {
+		return 0, fmt.Errorf("sealed length has %d bytes", len(b))
+	}
+	return int64(binary.BigEndian.Uint64(b)), nil
+}
+
+// end returns the length of the data, including unpublished writes.
+func (e *encryption) end() int64 {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.length
+}
+
+// extend records that data was written up to 'end'.
+func (e *encryption) extend(end int64) {
+	e.lock.Lock()
+	if end > e.length {
+		e.length = end
+	}
+	e.lock.Unlock()
+}
+
+// flush adds the sealed length to 'md' if it hasn't been published. It
+// returns the length, to pass to setPublished once 'md' has been stored.
+func (e *encryption) flush(md map[string]string) (int64, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.length != e.published {
+		sealed, err := e.sealLength(e.length)
+		if err != nil {
+			return 0, err
+		}
+		md[encLengthKey] = sealed
+	}
+	return e.length, nil
+}
+
+// setPublished records that metadata with length 'n' has been stored.
+func (e *encryption) setPublished(n int64) {
+	e.lock.Lock()
+	e.published = n
+	e.lock.Unlock()
+}
+
+// blockData returns the data that's authenticated with block 'i'.
+func (e *encryption) blockData(i int64) []byte {
+	var ad [16]byte
+	binary.BigEndian.PutUint64(ad[:8], uint64(e.blob))
+	binary.BigEndian.PutUint64(ad[8:], uint64(i))
+	return ad[:]
+}
+
+// seal encrypts block 'i' into a slot.
+func (e *encryption) seal(i int64, data []byte) ([]byte, error) {
+	slot := make([]byte, encNonceSize, encNonceSize+len(data)+encOverhead)
+	if _, err := rand.Read(slot); err != nil {
+		return nil, err
+	}
+	return e.aead.Seal(slot, slot, data, e.blockData(i)), nil
+}
+
+// open decrypts the slot of block 'i'.
+func (e *encryption) open(i int64, slot []byte) ([]byte, error) {
+	if len(slot) <= encOverhead {
+		return nil, core.ErrCorruptData.Error()
+	}
+	return e.aead.Open(nil, slot[:encNonceSize], slot[encNonceSize:], e.blockData(i))
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// storedLength returns the length of the data stored in the blob, after
+// decrypting it.
+func (b *Blob) storedLength() (int64, error) {
+	if b.enc == nil {
+		return b.rawLength()
+	}
+	if b.enc.aead == nil {
+		return 0, core.ErrKeyUnavailable.Error()
+	}
+	return b.enc.end(), nil
+}
+
+// readStored reads the data stored at 'offset', decrypting it if the blob is
+// encrypted.
+func (b *Blob) readStored(p []byte, offset int64) (int, error) {
+	if b.enc == nil {
+		return b.readRaw(p, offset)
+	}
+	if b.enc.aead == nil {
+		return 0, core.ErrKeyUnavailable.Error()
+	}
+	end := min64(offset+int64(len(p)), b.enc.end())
+	if end <= offset {
+		if len(p) == 0 {
+			return 0, nil
+		}
+		return 0, io.EOF
+	}
+	first := offset / encBlockSize
+	blocks, err := b.readBlocks(first, (end-1)/encBlockSize)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for i, blk := range blocks {
+		start := offset + int64(n) - (first+int64(i))*encBlockSize
+		if start >= int64(len(blk)) {
+			break
+		}
+		n += copy(p[n:], blk[start:])
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// readBlocks reads and decrypts blocks 'first' through 'last'. It returns
+// fewer blocks if the blob ends before 'last'. Stored data that's missing or
+// doesn't decrypt is reported as corrupt.
+func (b *Blob) readBlocks(first, last int64) ([][]byte, error) {
+	length := b.enc.end()
+	buf := make([]byte, (last-first+1)*encSlotSize)
+	n, err := b.readRaw(buf, first*encSlotSize)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	buf = buf[:n]
+
+	var blocks [][]byte
+	for i := first; i <= last && i*encBlockSize < length; i++ {
+		// The last block may have been extended by a write that hasn't been
+		// published yet, so it can hold more than we want.
+		want := min64(length-i*encBlockSize, encBlockSize)
+		slot := buf[:min(len(buf), encSlotSize)]
+		buf = buf[len(slot):]
+		blk, err := b.enc.open(i, slot)
+		if err == nil && int64(len(blk)) < want {
+			err = fmt.Errorf("block has %d bytes, expected %d", len(blk), want)
+		}
+		if err != nil {
+			log.Errorf("couldn't decrypt block %d of blob %s: %s", i, b.id, err)
+			return nil, core.ErrCorruptData.Error()
+		}
+		blocks = append(blocks, blk[:want])
+	}
+	return blocks, nil
+}
+
+// writeStored stores 'p' at 'offset', encrypting it if the blob is encrypted.
+// Blocks that 'p' only covers part of are read, updated, and written again.
+func (b *Blob) writeStored(p []byte, offset int64) (int, error) {
+	if b.enc == nil {
+		return b.writeRaw(p, offset)
+	}
+	if b.enc.aead == nil {
+		return 0, core.ErrKeyUnavailable.Error()
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	length := b.enc.end()
+	end := offset + int64(len(p))
+	first, last := offset/encBlockSize, (end-1)/encBlockSize
+
+	// If we're writing past the end of the blob, start at the end, and fill
+	// the gap with zeros.
+	from := first
+	if lb := length / encBlockSize; lb < first {
+		from = lb
+	}
+
+	var slots []byte
+	start := from
+	for i := from; i <= last; i++ {
+		lo := max64(offset, i*encBlockSize)
+		hi := min64(end, (i+1)*encBlockSize)
+
+		// Start with the existing data if we don't overwrite all of it.
+		var blk []byte
+		if i*encBlockSize < length && (lo > i*encBlockSize || hi < min64(length, (i+1)*encBlockSize)) {
+			blocks, err := b.readBlocks(i, i)
+			if err != nil {
+				return 0, err
+			}
+			if len(blocks) == 1 {
+				blk = blocks[0]
+			}
+		}
+		if need := hi - i*encBlockSize; int64(len(blk)) < need {
+			blk = append(blk, make([]byte, need-int64(len(blk)))...)
+		}
+		if i >= first {
+			copy(blk[lo-i*encBlockSize:], p[lo-offset:hi-offset])
+		}
+		slot, err := b.enc.seal(i, blk)
+		if err != nil {
+			log.Errorf("couldn't encrypt block %d of blob %s: %s", i, b.id, err)
+			return 0, err
+		}
+		slots = append(slots, slot...)
+
+		// Don't buffer too much of a large gap.
+		if len(slots) >= core.TractLength || i == last {
+			if _, err := b.writeRaw(slots, start*encSlotSize); err != nil {
+				return 0, err
+			}
+			slots, start = slots[:0], i+1
+		}
+	}
+	b.enc.extend(end)
+	return len(p), nil
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+const (
+	// How long to wait before asking a partition for events again, if it had
+	// none last time.
+	eventPollInterval = 500 * time.Millisecond
+
+	// How often to look for new partitions while watching.
+	eventPartitionInterval = time.Minute
+)
+
+// EventCursor records how far a watcher has gotten in the events of each
+// partition. Partitions that aren't in the cursor start from the oldest events
+// that the curators still have.
+type EventCursor map[core.PartitionID]uint64
+
+// String returns the cursor in a form that ParseEventCursor accepts, so that
+// watchers can save it and resume later.
+func (c EventCursor) String() string {
+	var parts []string
+	for p, idx := range c {
+		parts = append(parts, fmt.Sprintf("%d:%d", p, idx))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+// ParseEventCursor parses the output of EventCursor.String.
+func ParseEventCursor(s string) (EventCursor, error) {
+	c := make(EventCursor)
+	if s == "" {
+		return c, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		fields := strings.Split(part, ":")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid event cursor entry %q", part)
+		}
+		p, err := strconv.ParseUint(fields[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid partition in event cursor entry %q: %s", part, err)
+		}
+		idx, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid index in event cursor entry %q: %s", part, err)
+		}
+		c[core.PartitionID(p)] = idx
+	}
+	return c, nil
+}
+
+func (c EventCursor) copy() EventCursor {
+	o := make(EventCursor, len(c))
+	for p, idx := range c {
+		o[p] = idx
+	}
+	return o
+}
+
+// EventIterator returns the next batch of events, waiting until there are
+// some, and a cursor that resumes after them.
+type EventIterator func() ([]core.BlobEvent, EventCursor, error)
+
+// eventBatch is one batch of events, or an error, from watching one partition.
+type eventBatch struct {
+	partition core.PartitionID
+	events    []core.BlobEvent
+	next      uint64
+	err       core.Error
+}
+
+// WatchEvents returns an iterator over changes to blobs in all partitions,
+// starting after 'from'. Events from one partition are in order, but events
+// from different partitions are merged in no particular order. The events
+// come from the curators' replicated state, so watching continues across
+// curator leader changes.
+//
+// Clients should save the cursor returned with each batch, and pass it to
+// WatchEvents to resume after an error or a restart. If the curator no longer
+// has the events after the cursor, the iterator returns an error that is
+// core.ErrEventsTrimmed. Cancel 'ctx' to stop watching.
+func (cli *Client) WatchEvents(ctx context.Context, from EventCursor) EventIterator {
+	ch := make(chan eventBatch, ParallelRPCs)
+	go cli.watchAll(ctx, from.copy(), ch)
+
+	cursor := from.copy()
+	return func() ([]core.BlobEvent, EventCursor, error) {
+		for {
+			batch, ok := <-ch
+			if !ok {
+				return nil, cursor.copy(), ctx.Err()
+			}
+			if batch.err != core.NoError {
+				return nil, cursor.copy(), batch.err.Error()
+			}
+			cursor[batch.partition] = batch.next
+			if len(batch.events) > 0 {
+				return batch.events, cursor.copy(), nil
+			}
+		}
+	}
+}
+
+// watchAll watches all partitions, including ones that are added later, and
+// sends their events to 'ch'. It closes 'ch' when 'ctx' is done.
+func (cli *Client) watchAll(ctx context.Context, from EventCursor, ch chan<- eventBatch) {
+	defer close(ch)
+
+	send := func(b eventBatch) bool {
+		select {
+		case ch <- b:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	watching := make(map[core.PartitionID]bool)
+	for {
+		var partitions []core.PartitionID
+		var berr core.Error
+		cli.retrier.Do(ctx, func(seq int) bool {
+			log.Infof("ListPartitions, attempt #%d", seq)
+			partitions, berr = cli.master.ListPartitions(ctx)
+			return !core.IsRetriableError(berr)
+		})
+		if berr != core.NoError {
+			send(eventBatch{err: berr})
+			return
+		}
+
+		for _, partition := range partitions {
+			if watching[partition] {
+				continue
+			}
+			watching[partition] = true
+			wg.Add(1)
+			go func(partition core.PartitionID) {
+				defer wg.Done()
+				cli.watchPartition(ctx, partition, from[partition], send)
+			}(partition)
+		}
+
+		select {
+		case <-time.After(eventPartitionInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// watchPartition passes events in 'partition' after 'after' to 'send', until
+// 'send' returns false or there's an error.
+func (cli *Client) watchPartition(ctx context.Context, partition core.PartitionID, after uint64, send func(eventBatch) bool) {
+	for {
+		var events []core.BlobEvent
+		var next uint64
+		var berr core.Error
+		cli.retrier.Do(ctx, func(seq int) bool {
+			log.V(1).Infof("GetEvents(%x, %d), attempt #%d", partition, after, seq)
+			events, next, berr = cli.getEventsOnce(ctx, partition, after)
+			return !core.IsRetriableError(berr)
+		})
+		if berr != core.NoError {
+			send(eventBatch{err: berr})
+			return
+		}
+
+		if next != after {
+			if !send(eventBatch{partition: partition, events: events, next: next}) {
+				return
+			}
+			after = next
+			continue
+		}
+
+		// Nothing new, wait a bit.
+		select {
+		case <-time.After(eventPollInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (cli *Client) getEventsOnce(ctx context.Context, partition core.PartitionID, after uint64) ([]core.BlobEvent, uint64, core.Error) {
+	addr, curatorWasCached, err := cli.lookup(ctx, partition)
+	if err != core.NoError {
+		return nil, after, err
+	}
+	events, next, err := cli.curators.GetEvents(ctx, addr, partition, after)
+	if err != core.NoError && curatorWasCached {
+		// Maybe we got the wrong curator from the cache.
+		cli.lookupCache.invalidate(partition)
+		return cli.getEventsOnce(ctx, partition, after)
+	}
+	return events, next, err
+}
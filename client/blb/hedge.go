@@ -0,0 +1,290 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+const (
+	defaultHedgePercentile = 0.95
+	defaultHedgeMinDelay   = 10 * time.Millisecond
+
+	// How many recent read latencies we keep per tractserver.
+	hedgeWindowSize = 100
+
+	// How many latencies we need before we trust the percentile.
+	hedgeMinSamples = 10
+)
+
+// HedgeBehavior lets clients control hedged reads. If a tractserver is slow to
+// answer a read, the client sends the same read to another replica (or, for
+// RS-coded tracts, starts a client-side reconstruction) and uses whichever
+// answers first. The loser is canceled.
+type HedgeBehavior struct {
+	Enabled bool
+
+	// We hedge after this percentile of recent read latencies from the
+	// tractserver. The default is 0.95.
+	Percentile float64
+
+	// We always wait at least this long before hedging. This is also how long we
+	// wait for tractservers that we haven't seen enough reads from. The default
+	// is 10ms.
+	MinDelay time.Duration
+}
+
+type hedgeState struct {
+	HedgeBehavior
+
+	lock      sync.Mutex
+	latencies map[string]*latencyWindow
+}
+
+func makeHedgeState(behavior HedgeBehavior) *hedgeState {
+	s := &hedgeState{HedgeBehavior: behavior}
+	if s.Percentile <= 0 || s.Percentile > 1 {
+		s.Percentile = defaultHedgePercentile
+	}
+	if s.MinDelay <= 0 {
+		s.MinDelay = defaultHedgeMinDelay
+	}
+	s.latencies = make(map[string]*latencyWindow)
+	return s
+}
+
+// record adds a latency of a read from 'host'.
+func (s *hedgeState) record(host string, d time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	w := s.latencies[host]
+	if w == nil {
+		w = &latencyWindow{}
+		s.latencies[host] = w
+	}
+	w.add(d)
+}
+
+// delay returns how long we should wait for a read from 'host' before hedging.
+func (s *hedgeState) delay(host string) time.Duration {
+	s.lock.Lock()
+	w := s.latencies[host]
+	var d time.Duration
+	if w != nil && len(w.samples) >= hedgeMinSamples {
+		d = w.percentile(s.Percentile)
+	}
+	s.lock.Unlock()
+
+	if d < s.MinDelay {
+		d = s.MinDelay
+	}
+	return d
+}
+
+// latencyWindow holds the most recent latencies seen from one tractserver.
+type latencyWindow struct {
+	samples []time.Duration
+	next    int
+}
+
+func (w *latencyWindow) add(d time.Duration) {
+	if len(w.samples) < hedgeWindowSize {
+		w.samples = append(w.samples, d)
+	} else {
+		w.samples[w.next] = d
+	}
+	w.next = (w.next + 1) % hedgeWindowSize
+}
+
+func (w *latencyWindow) percentile(p float64) time.Duration {
+	sorted := make([]time.Duration, len(w.samples))
+	copy(sorted, w.samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	idx := int(p * float64(len(sorted)))
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// hedgedRead is the outcome of one read sent by a hedged read.
+type hedgedRead struct {
+	host string
+	b    []byte
+	err  core.Error
+}
+
+// readHedged reads from 'host' and sends the result to 'done'. Successful
+// reads feed the latency tracking.
+func (cli *Client) readHedged(ctx context.Context, done chan<- hedgedRead, host string, id core.TractID, version int, length int, off int64) {
+	st := time.Now()
+	b, err := cli.tractservers.Read(ctx, host, id, version, length, off)
+	if err == core.NoError || err == core.ErrEOF {
+		cli.hedge.record(host, time.Since(st))
+	}
+	done <- hedgedRead{host, b, err}
+}
+
+// readOneTractReplicatedHedged is like readOneTractReplicated, but doesn't
+// wait for a slow replica to fail before trying the next one. Each read gets
+// its own buffer since a slow read may still be running when we return; the
+// reads still running are canceled, which makes the RPC layer send a cancel
+// request to the tractserver.
+func (cli *Client) readOneTractReplicatedHedged(
+	ctx context.Context,
+	curAddr string,
+	result *tractResult,
+	tract *core.TractInfo,
+	thisB []byte,
+	thisOffset int64) {
+
+	var hosts []string
+	for _, n := range rand.Perm(len(tract.Hosts)) {
+		if tract.Hosts[n] == "" {
+			log.V(1).Infof("read %s from tsid %d: no host", tract.Tract, tract.TSIDs[n])
+			continue
+		}
+		hosts = append(hosts, tract.Hosts[n])
+	}
+	if len(hosts) == 0 {
+		*result = tractResult{0, 0, core.ErrAllocHost, ""}
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan hedgedRead, len(hosts))
+
+	var badVersionHost string
+	var hedgeC <-chan time.Time
+	started, outstanding := 0, 0
+	startNext := func() {
+		host := hosts[started]
+		go cli.readHedged(ctx, done, host, tract.Tract, tract.Version, len(thisB), thisOffset)
+		started++
+		outstanding++
+		hedgeC = nil
+		if started < len(hosts) {
+			hedgeC = time.After(cli.hedge.delay(host))
+		}
+	}
+
+	startNext()
+	err := core.ErrAllocHost
+	for outstanding > 0 {
+		select {
+		case <-hedgeC:
+			log.V(1).Infof("read %s from %s is slow, hedging to %s", tract.Tract, hosts[started-1], hosts[started])
+			startNext()
+
+		case r := <-done:
+			outstanding--
+			err = r.err
+			if err == core.ErrVersionMismatch {
+				badVersionHost = r.host
+			}
+			log.V(1).Infof("read %s from tractserver at address %s: %s", tract.Tract, r.host, err)
+			if err != core.NoError && err != core.ErrEOF {
+				// See readOneTractReplicated.
+				defer func(host string, err core.Error) {
+					couldRecover := result.err == core.NoError || result.err == core.ErrEOF
+					go cli.curators.ReportBadTS(context.Background(), curAddr, tract.Tract, host, "read", err, couldRecover)
+				}(r.host, err)
+				if started < len(hosts) {
+					startNext() // try another host now
+				}
+				continue
+			}
+			read := copy(thisB, r.b)
+			for i := read; i < len(thisB); i++ {
+				thisB[i] = 0 // Pad with zeros. See comment in readOneTractReplicated.
+			}
+			*result = tractResult{len(thisB), read, err, badVersionHost}
+			return
+		}
+	}
+
+	log.V(1).Infof("read %s all hosts failed", tract.Tract)
+	*result = tractResult{0, 0, err, badVersionHost}
+}
+
+// readOneTractRSHedged is like readOneTractRS, but starts a reconstruction in
+// parallel if the tractserver holding the data is slow.
+func (cli *Client) readOneTractRSHedged(
+	ctx context.Context,
+	curAddr string,
+	result *tractResult,
+	tract *core.TractInfo,
+	thisB []byte,
+	thisOffset int64) {
+
+	rsTract := tract.RS.Chunk.ToTractID()
+	length := min(len(thisB), int(tract.RS.Length))
+	offset := int64(tract.RS.Offset) + thisOffset
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan hedgedRead, 1)
+	go cli.readHedged(ctx, done, tract.RS.Host, rsTract, core.RSChunkVersion, length, offset)
+
+	select {
+	case r := <-done:
+		read := copy(thisB, r.b)
+		cli.finishOneTractRS(ctx, curAddr, result, tract, thisB, offset, length, read, r.err)
+		return
+	case <-time.After(cli.hedge.delay(tract.RS.Host)):
+	}
+
+	log.V(1).Infof("rs read %s from %s is slow, starting reconstruction", tract.Tract, tract.RS.Host)
+	reconB := make([]byte, len(thisB))
+	var reconResult tractResult
+	reconDone := make(chan struct{})
+	go func() {
+		st := time.Now()
+		cli.reconstructOneTract(ctx, &reconResult, tract, reconB, offset, length)
+		cli.metricReconDuration.Observe(float64(time.Since(st)) / 1e9)
+		cli.metricReconBytes.Add(float64(reconResult.read))
+		close(reconDone)
+	}()
+
+	select {
+	case r := <-done:
+		if r.err == core.NoError || r.err == core.ErrEOF {
+			read := copy(thisB, r.b)
+			cli.finishOneTractRS(ctx, curAddr, result, tract, thisB, offset, length, read, r.err)
+			return
+		}
+		// The read failed, so the reconstruction is all we have.
+		log.V(1).Infof("rs read %s from tractserver at address %s: %s", tract.Tract, tract.RS.Host, r.err)
+		<-reconDone
+		copy(thisB, reconB)
+		*result = reconResult
+		couldRecover := result.err == core.NoError || result.err == core.ErrEOF
+		go cli.curators.ReportBadTS(context.Background(), curAddr, rsTract, tract.RS.Host, "read", r.err, couldRecover)
+
+	case <-reconDone:
+		if reconResult.err == core.NoError || reconResult.err == core.ErrEOF {
+			copy(thisB, reconB)
+			*result = reconResult
+			return
+		}
+		// The reconstruction failed, so wait for the read.
+		r := <-done
+		read := copy(thisB, r.b)
+		if r.err != core.NoError && r.err != core.ErrEOF {
+			log.V(1).Infof("rs read %s from tractserver at address %s: %s", tract.Tract, tract.RS.Host, r.err)
+			go cli.curators.ReportBadTS(context.Background(), curAddr, rsTract, tract.RS.Host, "read", r.err, false)
+			*result = tractResult{len(thisB), 0, r.err, ""}
+			return
+		}
+		cli.finishOneTractRS(ctx, curAddr, result, tract, thisB, offset, length, read, r.err)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/golang/glog"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/server"
+)
+
+// BlobWithInfo is a blob and its info, as returned by ListBlobsWithInfo.
+type BlobWithInfo struct {
+	ID   BlobID
+	Info core.BlobInfo
+}
+
+// BlobInfoIterator is a function that returns blobs with their info in batches.
+type BlobInfoIterator func() ([]BlobWithInfo, error)
+
+// listBatch is one batch of results, or an error, from listing one partition.
+type listBatch struct {
+	blobs []BlobWithInfo
+	err   core.Error
+}
+
+// ListBlobsWithInfo returns an iterator that lists all blobs that match
+// 'filter', with their info, in batches. The filtering is done by the
+// curators. Partitions are listed in parallel, so batches from different
+// partitions come in no particular order. Clients should keep calling the
+// iterator until it returns nil, or an error. Clients that stop early should
+// cancel 'ctx'.
+//
+// Like ListBlobs, this is not guaranteed to return all blobs if cluster
+// membership or raft leadership changes during iteration.
+func (cli *Client) ListBlobsWithInfo(ctx context.Context, filter core.BlobFilter) BlobInfoIterator {
+	ch := make(chan listBatch, ParallelRPCs)
+	go cli.listAllWithInfo(ctx, filter, ch)
+
+	var done bool
+	return func() ([]BlobWithInfo, error) {
+		for !done {
+			batch, ok := <-ch
+			if !ok {
+				done = true
+			} else if batch.err != core.NoError {
+				done = true
+				return nil, batch.err.Error()
+			} else if len(batch.blobs) > 0 {
+				return batch.blobs, nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+// listAllWithInfo lists all partitions in parallel and sends the results to
+// 'ch'. It closes 'ch' when done.
+func (cli *Client) listAllWithInfo(ctx context.Context, filter core.BlobFilter, ch chan<- listBatch) {
+	defer close(ch)
+
+	send := func(b listBatch) bool {
+		select {
+		case ch <- b:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	var partitions []core.PartitionID
+	var berr core.Error
+	cli.retrier.Do(ctx, func(seq int) bool {
+		log.Infof("ListPartitions, attempt #%d", seq)
+		partitions, berr = cli.master.ListPartitions(ctx)
+		return !core.IsRetriableError(berr)
+	})
+	if berr != core.NoError {
+		send(listBatch{err: berr})
+		return
+	}
+
+	sem := server.NewSemaphore(ParallelRPCs)
+	var wg sync.WaitGroup
+	for _, partition := range partitions {
+		wg.Add(1)
+		go func(partition core.PartitionID) {
+			defer wg.Done()
+			sem.Acquire()
+			defer sem.Release()
+			if err := cli.listPartitionWithInfo(ctx, partition, filter, send); err != core.NoError {
+				send(listBatch{err: err})
+			}
+		}(partition)
+	}
+	wg.Wait()
+}
+
+// listPartitionWithInfo lists the blobs in 'partition' that match 'filter' and
+// passes them to 'send'. It stops early if 'send' returns false.
+func (cli *Client) listPartitionWithInfo(ctx context.Context, partition core.PartitionID, filter core.BlobFilter, send func(listBatch) bool) core.Error {
+	var start core.BlobKey
+	for {
+		var keys []core.BlobKey
+		var infos []core.BlobInfo
+		var next core.BlobKey
+		var more bool
+		var berr core.Error
+		cli.retrier.Do(ctx, func(seq int) bool {
+			log.Infof("ListBlobsWithInfo(%x), attempt #%d", partition, seq)
+			keys, infos, next, more, berr = cli.listBlobsWithInfoOnce(ctx, partition, start, filter)
+			return !core.IsRetriableError(berr)
+		})
+		if berr != core.NoError {
+			return berr
+		}
+
+		if len(keys) > 0 {
+			blobs := make([]BlobWithInfo, len(keys))
+			for i, key := range keys {
+				blobs[i] = BlobWithInfo{ID: BlobID(core.BlobIDFromParts(partition, key)), Info: infos[i]}
+			}
+			if !send(listBatch{blobs: blobs}) {
+				return core.NoError
+			}
+		}
+		if !more {
+			return core.NoError
+		}
+		start = next
+	}
+}
+
+func (cli *Client) listBlobsWithInfoOnce(ctx context.Context, partition core.PartitionID, start core.BlobKey, filter core.BlobFilter) ([]core.BlobKey, []core.BlobInfo, core.BlobKey, bool, core.Error) {
+	addr, curatorWasCached, err := cli.lookup(ctx, partition)
+	if err != core.NoError {
+		return nil, nil, 0, false, err
+	}
+	keys, infos, next, more, err := cli.curators.ListBlobsWithInfo(ctx, addr, partition, start, filter)
+	if err != core.NoError && curatorWasCached {
+		// Maybe we got the wrong curator from the cache.
+		cli.lookupCache.invalidate(partition)
+		return cli.listBlobsWithInfoOnce(ctx, partition, start, filter)
+	}
+	return keys, infos, next, more, err
+}
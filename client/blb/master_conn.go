@@ -21,4 +21,11 @@ type MasterConnection interface {
 
 	// GetTractserverInfo gets tractserver state.
 	GetTractserverInfo(ctx context.Context) (info []core.TractserverInfo, err core.Error)
+
+	// GetQuotas returns the quotas and usage of blob owners.
+	GetQuotas(ctx context.Context) (quotas []core.TenantQuota, err core.Error)
+
+	// SetQuota sets the quota of blob owner 'owner', or removes it if 'limit'
+	// is zero.
+	SetQuota(ctx context.Context, owner string, limit core.TenantUsage) core.Error
 }
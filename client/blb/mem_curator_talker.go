@@ -9,15 +9,29 @@ import (
 	"log"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/westerndigitalcorporation/blb/internal/core"
 )
 
 // memBlobInfo holds the data for one blob in memory.
 type memBlobInfo struct {
-	repl   int              // Replication factor
-	tracts []core.TractInfo // Where are my tracts
+	repl      int               // Replication factor
+	hint      core.StorageHint  // Storage hint
+	tracts    []core.TractInfo  // Where are my tracts
+	metadata  map[string]string // User metadata
+	writeOnce bool              // Must be sealed before reading
+	sealed    bool              // Has been sealed
+	appendOff int64             // Where the next append goes
+	owner     string            // Who owns the blob
+	acl       core.ACL          // Who may use the blob
+	deleted   time.Time         // When it was deleted, if it's in the trash
+
+	// How many times each tract key past the end has been handed out by
+	// ExtendBlob. Racing writers can be given the same keys.
+	allocated map[core.TractKey]int
 }
 
 // memCurator simulates a fake curator in memory.
@@ -25,30 +39,88 @@ type memCurator struct {
 	partition core.PartitionID              // What partition am I responsible for
 	nextBlob  core.BlobKey                  // Next unused blob key
 	blobs     map[core.BlobKey]*memBlobInfo // Tract location data
+	trash     map[core.BlobKey]*memBlobInfo // Deleted blobs that can be undeleted
 	nextTSID  core.TractserverID            // Next unused tractserver id
+	names     map[string]core.BlobID        // Namespace, if partition is core.DefaultNamespacePartition
+	events    []core.BlobEvent              // Every change, Index is position+1
+}
+
+// nameConflicts returns true if binding 'name' would make a path both a blob
+// and a directory. A name equal to 'ignore' doesn't count.
+func (tc *memCurator) nameConflicts(name, ignore string) bool {
+	dir := strings.TrimSuffix(name, core.NameSeparator) + core.NameSeparator
+	for n := range tc.names {
+		if n != ignore && (strings.HasPrefix(name, n+core.NameSeparator) || strings.HasPrefix(n, dir)) {
+			return true
+		}
+	}
+	return false
+}
+
+// addEvent records a change to a blob.
+func (tc *memCurator) addEvent(t core.BlobEventType, blob core.BlobID) {
+	tc.events = append(tc.events, core.BlobEvent{Type: t, Blob: blob, Index: uint64(len(tc.events) + 1)})
 }
 
 // extendTo ensures that the given blob has at least numTracts tracts.
 func (bi *memBlobInfo) extendTo(id core.BlobID, numTracts int, tsid core.TractserverID) (newTracts []core.TractInfo) {
 	for i := 0; i < numTracts-len(bi.tracts); i++ {
 		tractID := core.TractID{Blob: id, Index: core.TractKey(len(bi.tracts) + i)}
-		var tsids []core.TractserverID
-		var hosts []string
-		for r := 0; r < bi.repl; r++ {
-			tsids = append(tsids, tsid)
-			tsid++
-			// Use a fixed naming scheme for hosts:
+		newTracts = append(newTracts, bi.allocate(tractID, tsid))
+		tsid += core.TractserverID(bi.repl)
+	}
+	return
+}
+
+// allocate picks hosts for a new tract.
+func (bi *memBlobInfo) allocate(tractID core.TractID, tsid core.TractserverID) core.TractInfo {
+	var tsids []core.TractserverID
+	var hosts []string
+	if bi.allocated == nil {
+		bi.allocated = make(map[core.TractKey]int)
+	}
+	gen := bi.allocated[tractID.Index]
+	bi.allocated[tractID.Index]++
+	for r := 0; r < bi.repl; r++ {
+		tsids = append(tsids, tsid)
+		tsid++
+		// Use a fixed naming scheme for hosts. If the tract was handed out
+		// before, use different hosts, like a real curator would likely
+		// pick, so leftovers from a failed extend don't get in the way.
+		if gen == 0 {
 			hosts = append(hosts, fmt.Sprintf("ts-%s-%d", tractID, r))
+		} else {
+			hosts = append(hosts, fmt.Sprintf("ts-%s-%d.%d", tractID, r, gen))
 		}
-		ti := core.TractInfo{Tract: tractID, Version: 1, Hosts: hosts, TSIDs: tsids}
-		newTracts = append(newTracts, ti)
 	}
-	return
+	return core.TractInfo{Tract: tractID, Version: 1, Hosts: hosts, TSIDs: tsids}
 }
 
-// ackExtend commit the new tracts to the give blob.
-func (bi *memBlobInfo) ackExtend(id core.BlobID, newTracts []core.TractInfo) {
+// ackExtend commit the new tracts to the give blob. Like the real curator, it
+// fails if someone else extended the blob in the meantime.
+func (bi *memBlobInfo) ackExtend(id core.BlobID, newTracts []core.TractInfo) core.Error {
+	if len(newTracts) > 0 && int(newTracts[0].Tract.Index) != len(bi.tracts) {
+		return core.ErrExtendConflict
+	}
 	bi.tracts = append(bi.tracts, newTracts...)
+	return core.NoError
+}
+
+// updateChecksums applies checksum updates to the tracts of the blob. An
+// update whose old value doesn't match clears the checksum instead.
+func (bi *memBlobInfo) updateChecksums(updates []core.ChecksumUpdate) core.Error {
+	for _, u := range updates {
+		if int(u.Index) >= len(bi.tracts) {
+			return core.ErrNoSuchTract
+		}
+		ti := &bi.tracts[u.Index]
+		if ti.Checksum == u.Old {
+			ti.Checksum = u.New
+		} else {
+			ti.Checksum = core.TractChecksum{}
+		}
+	}
+	return core.NoError
 }
 
 // memCuratorTalker manages a set of fake curators in memory.
@@ -58,20 +130,38 @@ func (bi *memBlobInfo) ackExtend(id core.BlobID, newTracts []core.TractInfo) {
 type memCuratorTalker struct {
 	lock     sync.Mutex
 	curators map[string]*memCurator
+
+	// The fake tractservers, for copying tracts.
+	tractservers TractserverTalker
 }
 
 // CreateBlob creates a new blob.
-func (cc *memCuratorTalker) CreateBlob(ctx context.Context, addr string, metadata core.BlobInfo) (core.BlobID, core.Error) {
+func (cc *memCuratorTalker) CreateBlob(ctx context.Context, addr string, name string, metadata core.BlobInfo) (core.BlobID, core.Error) {
 	cc.lock.Lock()
 	defer cc.lock.Unlock()
 	tc := cc.getCurator(addr)
+	if name != "" {
+		if tc.names == nil {
+			return 0, core.ErrWrongCurator
+		}
+		if _, ok := tc.names[name]; ok || tc.nameConflicts(name, "") {
+			return 0, core.ErrAlreadyExists
+		}
+	}
 
 	blobKey := tc.nextBlob
 	blob := core.BlobIDFromParts(tc.partition, blobKey)
 	tc.nextBlob++
 
-	bi := &memBlobInfo{repl: metadata.Repl}
+	bi := &memBlobInfo{repl: metadata.Repl, hint: metadata.Hint, metadata: make(map[string]string), writeOnce: metadata.WriteOnce, owner: metadata.Owner, acl: metadata.ACL}
+	for k, v := range metadata.Metadata {
+		bi.metadata[k] = v
+	}
 	tc.blobs[blobKey] = bi
+	tc.addEvent(core.BlobCreated, blob)
+	if name != "" {
+		tc.names[name] = blob
+	}
 
 	return blob, core.NoError
 }
@@ -86,12 +176,15 @@ func (cc *memCuratorTalker) ExtendBlob(ctx context.Context, addr string, blob co
 	if !ok {
 		return nil, core.ErrNoSuchBlob
 	}
+	if bi.sealed {
+		return nil, core.ErrSealed
+	}
 	tsid := tc.nextTSID
 	tc.nextTSID += core.TractserverID(numTracts)
 	return bi.extendTo(blob, numTracts, tsid), core.NoError
 }
 
-func (cc *memCuratorTalker) AckExtendBlob(ctx context.Context, addr string, blob core.BlobID, tracts []core.TractInfo) core.Error {
+func (cc *memCuratorTalker) AckExtendBlob(ctx context.Context, addr string, blob core.BlobID, tracts []core.TractInfo, checksums []core.ChecksumUpdate) core.Error {
 	cc.lock.Lock()
 	defer cc.lock.Unlock()
 	tc := cc.getCurator(addr)
@@ -100,35 +193,357 @@ func (cc *memCuratorTalker) AckExtendBlob(ctx context.Context, addr string, blob
 	if !ok {
 		return core.ErrNoSuchBlob
 	}
-	bi.ackExtend(blob, tracts)
+	if err := bi.ackExtend(blob, tracts); err != core.NoError {
+		return err
+	}
+	if err := bi.updateChecksums(checksums); err != core.NoError {
+		return err
+	}
+	tc.addEvent(core.BlobWritten, blob)
 	return core.NoError
 }
 
+// ReserveAppend reserves space at the end of a blob.
+func (cc *memCuratorTalker) ReserveAppend(ctx context.Context, addr string, blob core.BlobID, length, minOffset int64) (int64, core.Error) {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+
+	bi, ok := tc.blobs[blob.ID()]
+	if !ok {
+		return 0, core.ErrNoSuchBlob
+	}
+	if bi.sealed {
+		return 0, core.ErrSealed
+	}
+	off := bi.appendOff
+	if off < minOffset {
+		off = minOffset
+	}
+	bi.appendOff = off + length
+	return off, core.NoError
+}
+
 // DeleteBlob deletes the given blob.
 func (cc *memCuratorTalker) DeleteBlob(ctx context.Context, addr string, blob core.BlobID) core.Error {
 	cc.lock.Lock()
 	defer cc.lock.Unlock()
 	tc := cc.getCurator(addr)
 
-	if _, ok := tc.blobs[blob.ID()]; !ok {
+	bi, ok := tc.blobs[blob.ID()]
+	if !ok {
 		return core.ErrNoSuchBlob
 	}
+	bi.deleted = time.Now()
+	tc.trash[blob.ID()] = bi
 	delete(tc.blobs, blob.ID())
+	tc.addEvent(core.BlobDeleted, blob)
+	for name, id := range tc.names {
+		if id == blob {
+			delete(tc.names, name)
+		}
+	}
 	return core.NoError
 }
 
-// UndeleteBlob always fails to undelete the blob.
+// UndeleteBlob moves a blob out of the trash.
 func (cc *memCuratorTalker) UndeleteBlob(ctx context.Context, addr string, blob core.BlobID) core.Error {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+
+	if bi, ok := tc.trash[blob.ID()]; ok {
+		bi.deleted = time.Time{}
+		tc.blobs[blob.ID()] = bi
+		delete(tc.trash, blob.ID())
+		tc.addEvent(core.BlobUndeleted, blob)
+	} else if _, ok := tc.blobs[blob.ID()]; !ok {
+		return core.ErrNoSuchBlob
+	}
+	return core.NoError
+}
+
+// PurgeBlob removes a blob from the trash.
+func (cc *memCuratorTalker) PurgeBlob(ctx context.Context, addr string, blob core.BlobID) core.Error {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+
+	if _, ok := tc.trash[blob.ID()]; ok {
+		delete(tc.trash, blob.ID())
+		return core.NoError
+	} else if _, ok := tc.blobs[blob.ID()]; ok {
+		return core.ErrInvalidState
+	}
 	return core.ErrNoSuchBlob
 }
 
+// SealBlob seals a write-once blob.
+func (cc *memCuratorTalker) SealBlob(ctx context.Context, addr string, blob core.BlobID) core.Error {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+
+	bi, ok := tc.blobs[blob.ID()]
+	if !ok {
+		return core.ErrNoSuchBlob
+	}
+	if !bi.writeOnce {
+		return core.ErrInvalidArgument
+	}
+	bi.sealed = true
+	tc.addEvent(core.BlobMetadataChanged, blob)
+	return core.NoError
+}
+
+// TruncateBlob drops the tracts of a blob beyond a new size.
+func (cc *memCuratorTalker) TruncateBlob(ctx context.Context, addr string, blob core.BlobID, size int64) core.Error {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+
+	bi, ok := tc.blobs[blob.ID()]
+	if !ok {
+		return core.ErrNoSuchBlob
+	}
+	if size < 0 {
+		return core.ErrInvalidArgument
+	}
+	if bi.sealed {
+		return core.ErrSealed
+	}
+
+	keep := int((size + core.TractLength - 1) / core.TractLength)
+	if keep > len(bi.tracts) {
+		keep = len(bi.tracts)
+	}
+	for _, ti := range bi.tracts[keep:] {
+		if ti.Shared && ti.Tract.Blob == blob && tc.sharer(ti.Tract) != 0 {
+			return core.ErrConflictingState
+		}
+	}
+	lastLength := size - int64(keep-1)*core.TractLength
+	if keep > 0 && lastLength < core.TractLength {
+		last := &bi.tracts[keep-1]
+		if last.Shared {
+			return core.ErrConflictingState
+		}
+		if int64(last.Checksum.Length) > lastLength {
+			last.Checksum = core.TractChecksum{}
+		}
+	}
+
+	bi.tracts = bi.tracts[:keep]
+	if bi.appendOff > size {
+		bi.appendOff = size
+	}
+	tc.addEvent(core.BlobWritten, blob)
+	return core.NoError
+}
+
+// CloneBlob creates a blob that shares the tracts of another.
+func (cc *memCuratorTalker) CloneBlob(ctx context.Context, addr string, blob core.BlobID) (core.BlobID, core.Error) {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+
+	src, ok := tc.blobs[blob.ID()]
+	if !ok {
+		return 0, core.ErrNoSuchBlob
+	}
+	clone := core.BlobIDFromParts(tc.partition, tc.nextBlob)
+	tc.nextBlob++
+
+	bi := &memBlobInfo{repl: src.repl, hint: src.hint, metadata: make(map[string]string), appendOff: src.appendOff, owner: src.owner, acl: src.acl}
+	for k, v := range src.metadata {
+		bi.metadata[k] = v
+	}
+	// Fence writers that have the tracts cached, see curator.fenceTracts.
+	vs, _ := cc.tractservers.(versionSetter)
+	for i := range src.tracts {
+		ti := &src.tracts[i]
+		if !ti.Shared && len(ti.Hosts) > 0 && vs != nil {
+			ti.Version++
+			for _, host := range ti.Hosts {
+				vs.setVersion(host, ti.Tract, ti.Version)
+			}
+		}
+		ti.Shared = true
+	}
+	bi.tracts = append([]core.TractInfo(nil), src.tracts...)
+	tc.blobs[clone.ID()] = bi
+	tc.addEvent(core.BlobCreated, clone)
+
+	return clone, core.NoError
+}
+
+// UnshareTract copies a shared tract for each blob that shares it.
+func (cc *memCuratorTalker) UnshareTract(ctx context.Context, addr string, id core.TractID) core.Error {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+
+	bi, ok := tc.blobs[id.Blob.ID()]
+	if !ok {
+		return core.ErrNoSuchBlob
+	}
+	if int(id.Index) >= len(bi.tracts) {
+		return core.ErrNoSuchTract
+	}
+	for {
+		source := bi.tracts[id.Index]
+		if !source.Shared {
+			return core.NoError
+		}
+
+		// Clones keep the origin's tract ID until they get their own copy.
+		targetID := id.Blob
+		if source.Tract.Blob == id.Blob {
+			if targetID = tc.sharer(source.Tract); targetID == 0 {
+				// The clones are all gone.
+				bi.tracts[id.Index].Shared = false
+				return core.NoError
+			}
+		}
+		target := tc.blobs[targetID.ID()]
+		data, err := cc.tractservers.Read(ctx, source.Hosts[0], source.Tract, source.Version, core.TractLength, 0)
+		if err != core.NoError && err != core.ErrEOF {
+			return err
+		}
+		tsid := tc.nextTSID
+		tc.nextTSID += core.TractserverID(target.repl)
+		dst := target.allocate(core.TractID{Blob: targetID, Index: id.Index}, tsid)
+		for j, host := range dst.Hosts {
+			if err = cc.tractservers.Create(ctx, host, dst.TSIDs[j], dst.Tract, dst.Version, data, 0); err != core.NoError {
+				return err
+			}
+		}
+		dst.Checksum = source.Checksum
+		target.tracts[id.Index] = dst
+
+		// If that was the last clone, the origin's tract isn't shared anymore.
+		if tc.sharer(source.Tract) == 0 {
+			if origin, ok := tc.blobs[source.Tract.Blob.ID()]; ok {
+				origin.tracts[source.Tract.Index].Shared = false
+			}
+		}
+	}
+}
+
+// versionSetter is implemented by fake tractservers that let the fake curator
+// change the versions of their tracts.
+type versionSetter interface {
+	setVersion(addr string, id core.TractID, version int)
+}
+
+// sharer returns a clone that shares the origin's tract 'id', or zero if there
+// are none.
+func (tc *memCurator) sharer(id core.TractID) core.BlobID {
+	for key, bi := range tc.blobs {
+		clone := core.BlobIDFromParts(tc.partition, key)
+		if clone != id.Blob && int(id.Index) < len(bi.tracts) && bi.tracts[id.Index].Tract == id {
+			return clone
+		}
+	}
+	return 0
+}
+
+// CopyTracts extends a blob with copies of other tracts.
+func (cc *memCuratorTalker) CopyTracts(ctx context.Context, addr string, blob core.BlobID, first core.TractKey, src core.BlobID, start, end int) core.Error {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+
+	bi, ok := tc.blobs[blob.ID()]
+	if !ok {
+		return core.ErrNoSuchBlob
+	}
+	if bi.sealed {
+		return core.ErrSealed
+	}
+	if int(first) != len(bi.tracts) {
+		return core.ErrExtendConflict
+	}
+	sbi, ok := tc.blobs[src.ID()]
+	if !ok || src.Partition() != tc.partition {
+		return core.ErrNoSuchBlob
+	}
+	if sbi.writeOnce && !sbi.sealed {
+		return core.ErrNotSealed
+	}
+	if start < 0 || start > end || end > len(sbi.tracts) {
+		return core.ErrNoSuchTract
+	}
+
+	var newTracts []core.TractInfo
+	for i, tract := range sbi.tracts[start:end] {
+		if len(tract.Hosts) == 0 {
+			return core.ErrNotYetImplemented
+		}
+		data, err := cc.tractservers.Read(ctx, tract.Hosts[0], tract.Tract, tract.Version, core.TractLength, 0)
+		if err != core.NoError && err != core.ErrEOF {
+			return err
+		}
+		tsid := tc.nextTSID
+		tc.nextTSID += core.TractserverID(bi.repl)
+		dst := bi.allocate(core.TractID{Blob: blob, Index: first + core.TractKey(i)}, tsid)
+		for j, host := range dst.Hosts {
+			if err = cc.tractservers.Create(ctx, host, dst.TSIDs[j], dst.Tract, dst.Version, data, 0); err != core.NoError {
+				return err
+			}
+		}
+		dst.Checksum = tract.Checksum
+		newTracts = append(newTracts, dst)
+	}
+	if err := bi.ackExtend(blob, newTracts); err != core.NoError {
+		return err
+	}
+	tc.addEvent(core.BlobWritten, blob)
+	return core.NoError
+}
+
+// SetMetadata changes user metadata only.
 func (cc *memCuratorTalker) SetMetadata(ctx context.Context, addr string, blob core.BlobID, md core.BlobInfo) core.Error {
-	return core.ErrNotYetImplemented
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+
+	bi, ok := tc.blobs[blob.ID()]
+	if !ok {
+		return core.ErrNoSuchBlob
+	}
+	for k, v := range md.Metadata {
+		if v == "" {
+			delete(bi.metadata, k)
+		} else {
+			bi.metadata[k] = v
+		}
+	}
+	tc.addEvent(core.BlobMetadataChanged, blob)
+	return core.NoError
+}
+
+// UpdateChecksums changes end-to-end checksums for tracts of a blob.
+func (cc *memCuratorTalker) UpdateChecksums(ctx context.Context, addr string, blob core.BlobID, updates []core.ChecksumUpdate) core.Error {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+
+	bi, ok := tc.blobs[blob.ID()]
+	if !ok {
+		return core.ErrNoSuchBlob
+	}
+	return bi.updateChecksums(updates)
 }
 
 // GetTracts returns the tract location for the given range.
 func (cc *memCuratorTalker) GetTracts(ctx context.Context, addr string, blob core.BlobID, start, end int,
 	forRead, forWrite bool) ([]core.TractInfo, core.Error) {
+	return cc.getTracts(addr, blob, start, end, forRead, forWrite, false)
+}
+
+func (cc *memCuratorTalker) getTracts(addr string, blob core.BlobID, start, end int,
+	forRead, forWrite, allowUnsealed bool) ([]core.TractInfo, core.Error) {
 	cc.lock.Lock()
 	defer cc.lock.Unlock()
 	tc := cc.getCurator(addr)
@@ -140,13 +555,35 @@ func (cc *memCuratorTalker) GetTracts(ctx context.Context, addr string, blob cor
 	if start < 0 || end < 0 {
 		return nil, core.ErrInvalidArgument
 	}
+	if forWrite && bi.sealed {
+		return nil, core.ErrSealed
+	}
+	if forRead && !allowUnsealed && bi.writeOnce && !bi.sealed {
+		return nil, core.ErrNotSealed
+	}
 	clip := func(a int) int {
 		if a > len(bi.tracts) {
 			return len(bi.tracts)
 		}
 		return a
 	}
-	return bi.tracts[clip(start):clip(end)], core.NoError
+	// Return a copy so that later changes aren't visible to the caller.
+	return append([]core.TractInfo(nil), bi.tracts[clip(start):clip(end)]...), core.NoError
+}
+
+// GetTractsWithInfo returns the tract location for the given range and
+// information about the blob.
+func (cc *memCuratorTalker) GetTractsWithInfo(ctx context.Context, addr string, blob core.BlobID, start, end int,
+	forRead, forWrite, allowUnsealed bool) ([]core.TractInfo, *core.BlobInfo, core.Error) {
+	tracts, err := cc.getTracts(addr, blob, start, end, forRead, forWrite, allowUnsealed)
+	if err != core.NoError {
+		return nil, nil, err
+	}
+	info, err := cc.StatBlob(ctx, addr, blob)
+	if err != core.NoError {
+		return nil, nil, err
+	}
+	return tracts, &info, core.NoError
 }
 
 // StatBlob returns the number of tracts in a blob.
@@ -159,7 +596,26 @@ func (cc *memCuratorTalker) StatBlob(ctx context.Context, addr string, blob core
 	if !ok {
 		return core.BlobInfo{}, core.ErrNoSuchBlob
 	}
-	return core.BlobInfo{Repl: bi.repl, NumTracts: len(bi.tracts)}, core.NoError
+	return bi.info(), core.NoError
+}
+
+// info returns the BlobInfo for a blob.
+func (bi *memBlobInfo) info() core.BlobInfo {
+	md := make(map[string]string)
+	for k, v := range bi.metadata {
+		md[k] = v
+	}
+	return core.BlobInfo{
+		Repl:      bi.repl,
+		NumTracts: len(bi.tracts),
+		Hint:      bi.hint,
+		Metadata:  md,
+		WriteOnce: bi.writeOnce,
+		Sealed:    bi.sealed,
+		Deleted:   bi.deleted,
+		Owner:     bi.owner,
+		ACL:       bi.acl,
+	}
 }
 
 // ReportBadTS does nothing.
@@ -185,6 +641,10 @@ func (cc *memCuratorTalker) getCurator(addr string) *memCurator {
 		partition: core.PartitionID(partition),
 		nextBlob:  1,
 		blobs:     make(map[core.BlobKey]*memBlobInfo),
+		trash:     make(map[core.BlobKey]*memBlobInfo),
+	}
+	if tc.partition == core.DefaultNamespacePartition {
+		tc.names = make(map[string]core.BlobID)
 	}
 	cc.curators[addr] = tc
 	return tc
@@ -209,8 +669,176 @@ func (cc *memCuratorTalker) ListBlobs(ctx context.Context, addr string, partitio
 	return
 }
 
-func newMemCuratorTalker() CuratorTalker {
-	return &memCuratorTalker{curators: make(map[string]*memCurator)}
+// ListBlobsWithInfo gets blob ids and info in a given partition that match a filter.
+func (cc *memCuratorTalker) ListBlobsWithInfo(ctx context.Context, addr string, partition core.PartitionID, start core.BlobKey, filter core.BlobFilter) (keys []core.BlobKey, infos []core.BlobInfo, next core.BlobKey, more bool, err core.Error) {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+	if tc.partition != partition {
+		return
+	}
+	var all []core.BlobKey
+	for _, blobs := range []map[core.BlobKey]*memBlobInfo{tc.blobs, tc.trash} {
+		for key := range blobs {
+			if key >= start {
+				all = append(all, key)
+			}
+		}
+	}
+	sort.Sort(bkSlice(all))
+	// look at only three at a time to exercise more logic
+	if len(all) > 3 {
+		next, more = all[3], true
+		all = all[:3]
+	}
+	for _, key := range all {
+		bi, ok := tc.blobs[key]
+		if !ok {
+			bi = tc.trash[key]
+		}
+		if info := bi.info(); filter.Match(info) {
+			keys = append(keys, key)
+			infos = append(infos, info)
+		}
+	}
+	return
+}
+
+// GetEvents returns changes to blobs after 'after'.
+func (cc *memCuratorTalker) GetEvents(ctx context.Context, addr string, partition core.PartitionID, after uint64) (events []core.BlobEvent, next uint64, err core.Error) {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+	if tc.partition != partition || after >= uint64(len(tc.events)) {
+		return nil, after, core.NoError
+	}
+	events = append(events, tc.events[after:]...)
+	// return only three at a time to exercise more logic
+	if len(events) > 3 {
+		events = events[:3]
+	}
+	return events, events[len(events)-1].Index, core.NoError
+}
+
+// LookupName resolves a name in the namespace.
+func (cc *memCuratorTalker) LookupName(ctx context.Context, addr string, name string) (core.BlobID, core.Error) {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+	if tc.names == nil {
+		return 0, core.ErrWrongCurator
+	}
+	blob, ok := tc.names[name]
+	if !ok {
+		return 0, core.ErrNoSuchName
+	}
+	return blob, core.NoError
+}
+
+// Rename moves a name, or a directory, in the namespace.
+func (cc *memCuratorTalker) Rename(ctx context.Context, addr string, from, to string, replace core.BlobID) core.Error {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+	if tc.names == nil {
+		return core.ErrWrongCurator
+	}
+	if strings.HasSuffix(from, core.NameSeparator) {
+		if strings.HasPrefix(to, from) || replace != 0 {
+			return core.ErrInvalidArgument
+		}
+		moved := make(map[string]core.BlobID)
+		for name, blob := range tc.names {
+			if strings.HasPrefix(name, from) {
+				moved[name] = blob
+			}
+		}
+		if len(moved) == 0 {
+			return core.ErrNoSuchName
+		}
+		if tc.nameConflicts(to, "") {
+			return core.ErrAlreadyExists
+		}
+		for name, blob := range moved {
+			delete(tc.names, name)
+			tc.names[to+name[len(from):]] = blob
+		}
+		return core.NoError
+	}
+	blob, ok := tc.names[from]
+	if !ok {
+		return core.ErrNoSuchName
+	}
+	if cur, ok := tc.names[to]; replace != 0 && cur != replace {
+		return core.ErrConflictingState
+	} else if replace == 0 && ok {
+		return core.ErrAlreadyExists
+	}
+	if tc.nameConflicts(to, from) {
+		return core.ErrAlreadyExists
+	}
+	delete(tc.names, from)
+	tc.names[to] = blob
+	return core.NoError
+}
+
+// UnbindName removes a name from the namespace.
+func (cc *memCuratorTalker) UnbindName(ctx context.Context, addr string, name string, blob core.BlobID) core.Error {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+	if tc.names == nil {
+		return core.ErrWrongCurator
+	}
+	cur, ok := tc.names[name]
+	if !ok {
+		return core.ErrNoSuchName
+	}
+	if blob != 0 && cur != blob {
+		return core.ErrConflictingState
+	}
+	delete(tc.names, name)
+	return core.NoError
+}
+
+// ListNames lists one directory of the namespace.
+func (cc *memCuratorTalker) ListNames(ctx context.Context, addr string, prefix, start string) (entries []core.NameEntry, err core.Error) {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+	tc := cc.getCurator(addr)
+	if tc.names == nil {
+		return nil, core.ErrWrongCurator
+	}
+	var names []string
+	for name := range tc.names {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		e := core.NameEntry{Name: name, Blob: tc.names[name]}
+		if i := strings.Index(name[len(prefix):], core.NameSeparator); i >= 0 {
+			e = core.NameEntry{Name: name[:len(prefix)+i+1]}
+		}
+		if e.Name <= start {
+			continue
+		}
+		if n := len(entries); n > 0 && entries[n-1].Name == e.Name {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	// return only three at a time to exercise more logic
+	if len(entries) > 3 {
+		entries = entries[:3]
+	}
+	return
+}
+
+func newMemCuratorTalker(tractservers TractserverTalker) CuratorTalker {
+	return &memCuratorTalker{curators: make(map[string]*memCurator), tractservers: tractservers}
 }
 
 type bkSlice []core.BlobKey
@@ -94,3 +94,13 @@ func (m *memMasterConnection) ListPartitions(ctx context.Context) (partitions []
 func (m *memMasterConnection) GetTractserverInfo(ctx context.Context) (info []core.TractserverInfo, err core.Error) {
 	return nil, core.NoError
 }
+
+// GetQuotas returns no quotas.
+func (m *memMasterConnection) GetQuotas(ctx context.Context) (quotas []core.TenantQuota, err core.Error) {
+	return nil, core.NoError
+}
+
+// SetQuota does nothing.
+func (m *memMasterConnection) SetQuota(ctx context.Context, owner string, limit core.TenantUsage) core.Error {
+	return core.ErrNotYetImplemented
+}
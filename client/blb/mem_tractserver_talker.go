@@ -39,7 +39,7 @@ type memTractserverTalker struct {
 
 // Create creates a new tract on the tractserver and does a write to the newly
 // created tract.
-func (tt *memTractserverTalker) Create(ctx context.Context, addr string, tsid core.TractserverID, id core.TractID, b []byte, off int64) core.Error {
+func (tt *memTractserverTalker) Create(ctx context.Context, addr string, tsid core.TractserverID, id core.TractID, version int, b []byte, off int64) core.Error {
 	tt.lock.Lock()
 	ts := tt.getTractserver(addr)
 	_, ok := ts.versions[id]
@@ -47,9 +47,12 @@ func (tt *memTractserverTalker) Create(ctx context.Context, addr string, tsid co
 		tt.lock.Unlock()
 		return core.ErrAlreadyExists
 	}
-	ts.versions[id] = 1
+	if version == 0 {
+		version = 1
+	}
+	ts.versions[id] = version
 	tt.lock.Unlock()
-	return tt.Write(context.Background(), addr, id, 1, b, off)
+	return tt.Write(context.Background(), addr, id, version, b, off)
 }
 
 // Write writes the given data to a tract.
@@ -128,6 +131,40 @@ func (tt *memTractserverTalker) ReadInto(ctx context.Context, addr string, id co
 	return copy(b, r), err
 }
 
+// ReadV does several reads from tracts on one tractserver.
+func (tt *memTractserverTalker) ReadV(ctx context.Context, addr string, reads []core.ReadVRange) ([][]byte, []core.Error) {
+	bs := make([][]byte, len(reads))
+	errs := make([]core.Error, len(reads))
+	for i, r := range reads {
+		bs[i], errs[i] = tt.Read(ctx, addr, r.ID, r.Version, r.Len, r.Off)
+	}
+	return bs, errs
+}
+
+// Truncate cuts a tract short, or pads it with zeros, to 'size' bytes.
+func (tt *memTractserverTalker) Truncate(ctx context.Context, addr string, id core.TractID, version int, size int64) core.Error {
+	if size < 0 || size > core.TractLength {
+		return core.ErrInvalidArgument
+	}
+
+	tt.lock.Lock()
+	defer tt.lock.Unlock()
+	ts := tt.getTractserver(addr)
+
+	myVersion, ok := ts.versions[id]
+	if !ok {
+		return core.ErrNoSuchTract
+	}
+	if myVersion != version {
+		return core.ErrVersionMismatch
+	}
+
+	data := make([]byte, size)
+	copy(data, ts.data[id])
+	ts.data[id] = data
+	return core.NoError
+}
+
 // StatTract returns the number of bytes in a tract.
 func (tt *memTractserverTalker) StatTract(ctx context.Context, addr string, id core.TractID, version int) (int64, core.Error) {
 	if version < 0 {
@@ -159,6 +196,17 @@ func (tt *memTractserverTalker) SetControlFlags(ctx context.Context, addr string
 
 // getTractserver creates a new fake tractserver with no data initially, that
 // will send a trace of reads and writes to the given function.
+// setVersion changes the version of a tract, like the curator does with
+// SetVersion when cloning a blob.
+func (tt *memTractserverTalker) setVersion(addr string, id core.TractID, version int) {
+	tt.lock.Lock()
+	defer tt.lock.Unlock()
+	ts := tt.getTractserver(addr)
+	if _, ok := ts.versions[id]; ok {
+		ts.versions[id] = version
+	}
+}
+
 func (tt *memTractserverTalker) getTractserver(addr string) *memTractserver {
 	if ts, ok := tt.tractservers[addr]; ok {
 		return ts
@@ -0,0 +1,259 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"context"
+	"strings"
+
+	log "github.com/golang/glog"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+// Blobs can optionally be given names in a hierarchical namespace, e.g.
+// "logs/2016/05/app.log". The namespace is stored by the curator that owns
+// Options.NamespacePartition, and maps each name to a BlobID. Directories are
+// implicit. Named blobs are created by that curator, and deleting a blob
+// removes its names.
+
+// NameEntry is one entry returned by ListPrefix.
+type NameEntry struct {
+	// The full name of the entry. Directories end with "/".
+	Name string
+
+	// The blob that the name refers to, or NilBlobID for directories.
+	Blob BlobID
+}
+
+// IsDir returns true if the entry is a directory.
+func (e NameEntry) IsDir() bool {
+	return strings.HasSuffix(e.Name, core.NameSeparator)
+}
+
+// CreateNamed creates a blob with the given options, like Create, and binds
+// 'name' to it in the same operation. It fails with ErrAlreadyExists if 'name'
+// is already bound, or is a directory, or a parent directory of 'name' is bound
+// to a blob. Since the create and the bind are one operation, a retry after a
+// lost reply can fail with ErrAlreadyExists even though the blob was created.
+func (cli *Client) CreateNamed(name string, opts ...createOpt) (*Blob, error) {
+	if !core.ValidName(name) {
+		return nil, core.ErrInvalidArgument.Error()
+	}
+	return cli.Create(append(opts[:len(opts):len(opts)], func(o *createOptions) { o.name = name })...)
+}
+
+// OpenNamed opens the blob that 'name' is bound to. See Open for the meaning
+// of 'mode'.
+func (cli *Client) OpenNamed(name string, mode string, opts ...openOpt) (*Blob, error) {
+	options := defaultOpenOptions
+	options.ctx = context.Background()
+	for _, o := range opts {
+		o(&options)
+	}
+
+	id, err := cli.LookupName(options.ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return cli.Open(id, mode, opts...)
+}
+
+// LookupName returns the blob that 'name' is bound to.
+func (cli *Client) LookupName(ctx context.Context, name string) (BlobID, error) {
+	if !core.ValidName(name) {
+		return NilBlobID, core.ErrInvalidArgument.Error()
+	}
+
+	var id core.BlobID
+	var berr core.Error
+	cli.retrier.Do(ctx, func(seq int) bool {
+		log.Infof("lookup name %q, attempt #%d", name, seq)
+		id, berr = cli.lookupNameOnce(ctx, name)
+		return !core.IsRetriableError(berr)
+	})
+	return BlobID(id), berr.Error()
+}
+
+// Rename atomically moves the name 'from' to 'to'. It fails if 'to' is already
+// bound to a blob. The blob itself is not changed. If 'from' and 'to' end with
+// "/", every name in the directory 'from' is moved to the directory 'to', which
+// must not exist yet.
+func (cli *Client) Rename(ctx context.Context, from, to string) error {
+	if !core.ValidRename(from, to) {
+		return core.ErrInvalidArgument.Error()
+	}
+
+	var berr core.Error
+	cli.retrier.Do(ctx, func(seq int) bool {
+		log.Infof("rename %q to %q, attempt #%d", from, to, seq)
+		berr = cli.renameOnce(ctx, from, to, 0)
+		return !core.IsRetriableError(berr)
+	})
+	return berr.Error()
+}
+
+// Replace atomically moves the name 'from' to 'to', replacing the binding of
+// 'to' if it has one, and then deletes the blob 'to' was bound to. Readers of
+// 'to' always find one blob or the other. It fails with ErrConflictingState or
+// ErrAlreadyExists if 'to' is bound to another blob while we're replacing it.
+// If the old blob can't be deleted, that's logged rather than returned, since
+// the name has been moved.
+func (cli *Client) Replace(ctx context.Context, from, to string) error {
+	if !core.ValidName(from) || !core.ValidName(to) {
+		return core.ErrInvalidArgument.Error()
+	}
+	old, err := cli.LookupName(ctx, to)
+	if core.ErrNoSuchName.Is(err) {
+		old = 0
+	} else if err != nil {
+		return err
+	}
+
+	var berr core.Error
+	cli.retrier.Do(ctx, func(seq int) bool {
+		log.Infof("rename %q over %q (%s), attempt #%d", from, to, old, seq)
+		berr = cli.renameOnce(ctx, from, to, core.BlobID(old))
+		return !core.IsRetriableError(berr)
+	})
+	if berr != core.NoError || old == 0 {
+		return berr.Error()
+	}
+	if err := cli.Delete(ctx, old); err != nil {
+		log.Errorf("couldn't delete blob %s replaced by %q: %s", old, from, err)
+	}
+	return nil
+}
+
+// DeleteNamed removes 'name' from the namespace and deletes the blob it was
+// bound to.
+func (cli *Client) DeleteNamed(ctx context.Context, name string) error {
+	id, err := cli.LookupName(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	var berr core.Error
+	cli.retrier.Do(ctx, func(seq int) bool {
+		log.Infof("unbind name %q, attempt #%d", name, seq)
+		berr = cli.unbindNameOnce(ctx, name, core.BlobID(id))
+		if berr == core.ErrNoSuchName && seq > 0 {
+			// A previous attempt probably succeeded.
+			berr = core.NoError
+		}
+		return !core.IsRetriableError(berr)
+	})
+	if berr != core.NoError {
+		return berr.Error()
+	}
+	return cli.Delete(ctx, id)
+}
+
+// ListPrefix returns an iterator that lists the entries directly under
+// 'prefix', in batches and in lexicographic order. 'prefix' should be empty to
+// list the top level, or a directory name ending with "/". Names in
+// subdirectories of 'prefix' are returned as a single directory entry.
+// Clients should keep calling the iterator until it returns nil, or an error.
+func (cli *Client) ListPrefix(ctx context.Context, prefix string) NameIterator {
+	ni := &nameIterator{cli: cli, ctx: ctx, prefix: prefix}
+	return ni.next
+}
+
+// NameIterator is a function that returns NameEntries in batches.
+type NameIterator func() ([]NameEntry, error)
+
+type nameIterator struct {
+	cli    *Client
+	ctx    context.Context
+	prefix string
+	start  string
+	done   bool
+}
+
+func (ni *nameIterator) next() (entries []NameEntry, err error) {
+	if ni.done {
+		return nil, nil
+	}
+	if !core.ValidNamePrefix(ni.prefix) {
+		return nil, core.ErrInvalidArgument.Error()
+	}
+
+	var ces []core.NameEntry
+	var berr core.Error
+	ni.cli.retrier.Do(ni.ctx, func(seq int) bool {
+		log.Infof("ListNames(%q, %q), attempt #%d", ni.prefix, ni.start, seq)
+		ces, berr = ni.cli.listNamesOnce(ni.ctx, ni.prefix, ni.start)
+		return !core.IsRetriableError(berr)
+	})
+	if berr != core.NoError {
+		return nil, berr.Error()
+	}
+	if len(ces) == 0 {
+		ni.done = true
+		return nil, nil
+	}
+
+	entries = make([]NameEntry, len(ces))
+	for i, e := range ces {
+		entries[i] = NameEntry{Name: e.Name, Blob: BlobID(e.Blob)}
+	}
+	ni.start = ces[len(ces)-1].Name
+	return entries, nil
+}
+
+func (cli *Client) lookupNameOnce(ctx context.Context, name string) (core.BlobID, core.Error) {
+	addr, lookupWasCached, err := cli.lookup(ctx, cli.namespace)
+	if core.NoError != err {
+		return 0, err
+	}
+	id, err := cli.curators.LookupName(ctx, addr, name)
+	if err != core.NoError && lookupWasCached {
+		// Maybe we're talking to the wrong curator.
+		cli.lookupCache.invalidate(cli.namespace)
+		return cli.lookupNameOnce(ctx, name)
+	}
+	return id, err
+}
+
+func (cli *Client) renameOnce(ctx context.Context, from, to string, replace core.BlobID) core.Error {
+	addr, lookupWasCached, err := cli.lookup(ctx, cli.namespace)
+	if core.NoError != err {
+		return err
+	}
+	err = cli.curators.Rename(ctx, addr, from, to, replace)
+	if err != core.NoError && lookupWasCached {
+		// Maybe we're talking to the wrong curator.
+		cli.lookupCache.invalidate(cli.namespace)
+		return cli.renameOnce(ctx, from, to, replace)
+	}
+	return err
+}
+
+func (cli *Client) unbindNameOnce(ctx context.Context, name string, id core.BlobID) core.Error {
+	addr, lookupWasCached, err := cli.lookup(ctx, cli.namespace)
+	if core.NoError != err {
+		return err
+	}
+	err = cli.curators.UnbindName(ctx, addr, name, id)
+	if err != core.NoError && lookupWasCached {
+		// Maybe we're talking to the wrong curator.
+		cli.lookupCache.invalidate(cli.namespace)
+		return cli.unbindNameOnce(ctx, name, id)
+	}
+	return err
+}
+
+func (cli *Client) listNamesOnce(ctx context.Context, prefix, start string) ([]core.NameEntry, core.Error) {
+	addr, lookupWasCached, err := cli.lookup(ctx, cli.namespace)
+	if core.NoError != err {
+		return nil, err
+	}
+	entries, err := cli.curators.ListNames(ctx, addr, prefix, start)
+	if err != core.NoError && lookupWasCached {
+		// Maybe we're talking to the wrong curator.
+		cli.lookupCache.invalidate(cli.namespace)
+		return cli.listNamesOnce(ctx, prefix, start)
+	}
+	return entries, err
+}
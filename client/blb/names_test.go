@@ -0,0 +1,198 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+// listAll drains a NameIterator and returns the names.
+func listAll(t *testing.T, cli *Client, prefix string) (names []string) {
+	iter := cli.ListPrefix(context.Background(), prefix)
+	for {
+		entries, err := iter()
+		if err != nil {
+			t.Fatalf("ListPrefix: error iterating: %v", err)
+		}
+		if entries == nil {
+			return
+		}
+		for _, e := range entries {
+			if e.IsDir() != (e.Blob == NilBlobID) {
+				t.Errorf("entry %v has inconsistent blob", e)
+			}
+			names = append(names, e.Name)
+		}
+	}
+}
+
+// Test creating and opening blobs by name.
+func TestCreateOpenNamed(t *testing.T) {
+	cli := newClient(nil)
+
+	blob, err := cli.CreateNamed("a/b")
+	if err != nil {
+		t.Fatalf("CreateNamed: %v", err)
+	}
+	p := makeData(1000)
+	checkWrite(t, blob, p)
+
+	if _, err := cli.CreateNamed("a/b"); !core.ErrAlreadyExists.Is(err) {
+		t.Fatalf("expected ErrAlreadyExists, got %v", err)
+	}
+	if _, err := cli.CreateNamed("/bad"); !core.ErrInvalidArgument.Is(err) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+
+	opened, err := cli.OpenNamed("a/b", "r")
+	if err != nil {
+		t.Fatalf("OpenNamed: %v", err)
+	}
+	if opened.ID() != blob.ID() {
+		t.Fatalf("opened %v, expected %v", opened.ID(), blob.ID())
+	}
+	if got := checkRead(t, opened, len(p)); !bytes.Equal(got, p) {
+		t.Errorf("data mismatch")
+	}
+
+	if _, err := cli.OpenNamed("a/c", "r"); !core.ErrNoSuchName.Is(err) {
+		t.Fatalf("expected ErrNoSuchName, got %v", err)
+	}
+}
+
+// Test renaming and deleting named blobs.
+func TestRenameDeleteNamed(t *testing.T) {
+	cli := newClient(nil)
+	ctx := context.Background()
+
+	b1, _ := cli.CreateNamed("x")
+	cli.CreateNamed("y")
+
+	if err := cli.Rename(ctx, "x", "y"); !core.ErrAlreadyExists.Is(err) {
+		t.Fatalf("expected ErrAlreadyExists, got %v", err)
+	}
+	if err := cli.Rename(ctx, "x", "dir/z"); err != nil {
+		t.Fatalf("Rename: %v", err)
+	}
+	if id, err := cli.LookupName(ctx, "dir/z"); err != nil || id != b1.ID() {
+		t.Fatalf("LookupName returned %v, %v", id, err)
+	}
+	if _, err := cli.LookupName(ctx, "x"); !core.ErrNoSuchName.Is(err) {
+		t.Fatalf("expected ErrNoSuchName, got %v", err)
+	}
+
+	if err := cli.DeleteNamed(ctx, "dir/z"); err != nil {
+		t.Fatalf("DeleteNamed: %v", err)
+	}
+	if _, err := cli.LookupName(ctx, "dir/z"); !core.ErrNoSuchName.Is(err) {
+		t.Fatalf("expected ErrNoSuchName, got %v", err)
+	}
+	if _, err := cli.Open(b1.ID(), "r"); err == nil {
+		t.Fatalf("blob should have been deleted")
+	}
+}
+
+// A path can't be both a blob and a directory, and directories can be renamed.
+func TestNameConflictsAndRenameDir(t *testing.T) {
+	cli := newClient(nil)
+	ctx := context.Background()
+
+	b1, _ := cli.CreateNamed("d/1")
+	b2, _ := cli.CreateNamed("d/e/2")
+	if _, err := cli.CreateNamed("d"); !core.ErrAlreadyExists.Is(err) {
+		t.Fatalf("expected ErrAlreadyExists, got %v", err)
+	}
+	if _, err := cli.CreateNamed("d/1/x"); !core.ErrAlreadyExists.Is(err) {
+		t.Fatalf("expected ErrAlreadyExists, got %v", err)
+	}
+	if err := cli.Rename(ctx, "d/", "f"); !core.ErrInvalidArgument.Is(err) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+
+	if err := cli.Rename(ctx, "d/", "f/g/"); err != nil {
+		t.Fatalf("Rename: %v", err)
+	}
+	if id, err := cli.LookupName(ctx, "f/g/1"); err != nil || id != b1.ID() {
+		t.Fatalf("LookupName returned %v, %v", id, err)
+	}
+	if id, err := cli.LookupName(ctx, "f/g/e/2"); err != nil || id != b2.ID() {
+		t.Fatalf("LookupName returned %v, %v", id, err)
+	}
+	if entries := listAll(t, cli, "d/"); len(entries) != 0 {
+		t.Fatalf("old directory still has %v", entries)
+	}
+}
+
+// Deleting a blob removes its names.
+func TestDeleteUnbindsNames(t *testing.T) {
+	cli := newClient(nil)
+	ctx := context.Background()
+
+	b1, _ := cli.CreateNamed("x")
+	if err := cli.Delete(ctx, b1.ID()); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := cli.LookupName(ctx, "x"); !core.ErrNoSuchName.Is(err) {
+		t.Fatalf("expected ErrNoSuchName, got %v", err)
+	}
+	if _, err := cli.CreateNamed("x"); err != nil {
+		t.Fatalf("CreateNamed: %v", err)
+	}
+}
+
+// Test replacing a name with Replace.
+func TestReplace(t *testing.T) {
+	cli := newClient(nil)
+	ctx := context.Background()
+
+	b1, _ := cli.CreateNamed("x")
+	b2, _ := cli.CreateNamed("y")
+
+	// Replacing a name that isn't bound is just a rename.
+	if err := cli.Replace(ctx, "x", "z"); err != nil {
+		t.Fatalf("Replace: %v", err)
+	}
+	if id, err := cli.LookupName(ctx, "z"); err != nil || id != b1.ID() {
+		t.Fatalf("LookupName returned %v, %v", id, err)
+	}
+
+	// The old blob is deleted.
+	if err := cli.Replace(ctx, "y", "z"); err != nil {
+		t.Fatalf("Replace: %v", err)
+	}
+	if id, err := cli.LookupName(ctx, "z"); err != nil || id != b2.ID() {
+		t.Fatalf("LookupName returned %v, %v", id, err)
+	}
+	if _, err := cli.LookupName(ctx, "y"); !core.ErrNoSuchName.Is(err) {
+		t.Fatalf("expected ErrNoSuchName, got %v", err)
+	}
+	if _, err := cli.Open(b1.ID(), "r"); err == nil {
+		t.Fatalf("blob should have been deleted")
+	}
+}
+
+// Test per-directory listings.
+func TestListPrefix(t *testing.T) {
+	cli := newClient(nil)
+	for _, n := range []string{"a", "b/1", "b/2", "b/c/1", "b/c/2", "b/d/1", "b/e", "b/f", "b0", "c"} {
+		if _, err := cli.CreateNamed(n); err != nil {
+			t.Fatalf("CreateNamed(%q): %v", n, err)
+		}
+	}
+
+	check := func(got []string, exp ...string) {
+		if !reflect.DeepEqual(got, exp) {
+			t.Errorf("expected %v, got %v", exp, got)
+		}
+	}
+	check(listAll(t, cli, ""), "a", "b/", "b0", "c")
+	check(listAll(t, cli, "b/"), "b/1", "b/2", "b/c/", "b/d/", "b/e", "b/f")
+	check(listAll(t, cli, "b/c/"), "b/c/1", "b/c/2")
+	check(listAll(t, cli, "nothing/"))
+}
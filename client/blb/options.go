@@ -5,6 +5,7 @@ package blb
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/westerndigitalcorporation/blb/internal/core"
@@ -32,6 +33,71 @@ func ReplFactor(n int) createOpt { return func(o *createOptions) { o.repl = n }
 // WithExpires causes the blob to be created with an expiration time.
 func WithExpires(e time.Time) createOpt { return func(o *createOptions) { o.expires = e } }
 
+// WithMetadata causes the blob to be created with the given user metadata. Keys
+// can't start with "blb.".
+func WithMetadata(md map[string]string) createOpt { return func(o *createOptions) { o.metadata = md } }
+
+// Metadata keys that start with reservedPrefix describe how the data of a
+// compressed or encrypted blob is stored, and are managed by the client.
+const reservedPrefix = "blb."
+
+// hasReserved returns true if 'md' has any reserved keys.
+func hasReserved(md map[string]string) bool {
+	for k := range md {
+		if strings.HasPrefix(k, reservedPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithOwner causes the blob to be created as owned by tenant 'owner'. The
+// blob's space counts against the owner's quota; if creating or extending it
+// would put the owner over their quota, that fails with core.ErrQuotaExceeded.
+// On clusters that require authentication, clients own the blobs they create,
+// and 'owner' must be empty or the client's own identity.
+func WithOwner(owner string) createOpt { return func(o *createOptions) { o.owner = owner } }
+
+// WithACL causes the blob to be created with an ACL: only clients whose tokens
+// authenticate as one of 'readers' or 'writers' may read it, and only
+// 'writers' may change or delete it. Other clients get
+// core.ErrPermissionDenied. ACLs are only checked by clusters that require
+// authentication, and can't be changed once the blob is created.
+//
+// ACLs protect the blob's metadata: curators check them before handing out
+// tract locations, and on every change to the blob or the names bound to it.
+// Tractservers don't check them, so a client that learns a tract ID some other
+// way can still read or write the data directly. Encrypt the blob as well if
+// that matters.
+func WithACL(readers, writers []string) createOpt {
+	return func(o *createOptions) { o.acl = core.ACL{Readers: readers, Writers: writers} }
+}
+
+// WriteOnce causes the blob to be created as write-once: it can't be opened for
+// reading until it's sealed with Blob.Seal or Blob.Close, and it can't be
+// written after that. The curator removes write-once blobs that are abandoned
+// without being sealed.
+func WriteOnce(o *createOptions) { o.writeOnce = true }
+
+// CreateCompressed causes the blob to be compressed with 'codec' by the
+// client. Offsets used with the blob are offsets in the uncompressed data.
+// Compressed blobs can only be written sequentially, and don't support Append,
+// Truncate, or PunchHole. Data written to them is buffered, and isn't
+// visible to other clients until Blob.Flush or Blob.Close. The frame index is
+// kept in blob metadata, which limits them to a few GB, depending on the codec
+// and the other metadata.
+// Client.ReadV and copies done by curators see the compressed data.
+func CreateCompressed(codec Codec) createOpt { return func(o *createOptions) { o.codec = codec } }
+
+// CreateEncrypted causes the blob to be encrypted by the client, with a data
+// key protected by Options.KeyProvider. Encrypted blobs don't support Append,
+// Truncate, or PunchHole, and writes that cover only part of a 64KB block read
+// the block first. Writing past the end fills the gap with encrypted zeros.
+// The length of the data is sealed in blob metadata, so data written to them
+// isn't visible to other clients until Blob.Flush or Blob.Close. Client.ReadV
+// and copies done by curators see the encrypted data.
+func CreateEncrypted(o *createOptions) { o.encrypt = true }
+
 // CreatePriHigh gives high priority to all disk operations related to this blob.
 func CreatePriHigh(o *createOptions) { o.pri = core.Priority_HIGH }
 
@@ -55,6 +121,15 @@ func OpenPriMedium(o *openOptions) { o.pri = core.Priority_MEDIUM }
 // OpenPriLow gives low priority to all disk operations related to this blob.
 func OpenPriLow(o *openOptions) { o.pri = core.Priority_LOW }
 
+// OpenVerifyChecksums causes reads to be checked against the end-to-end
+// checksums that were recorded when the blob was written. Only reads that cover
+// the whole checksummed part of a tract can be checked.
+func OpenVerifyChecksums(o *openOptions) { o.verify = true }
+
+// OpenAllowUnsealed allows opening write-once blobs for reading before they're
+// sealed. The data may still be changing.
+func OpenAllowUnsealed(o *openOptions) { o.allowUnsealed = true }
+
 // OpenContext associates a context with this Open call.
 func OpenContext(ctx context.Context) openOpt { return func(o *openOptions) { o.ctx = ctx } }
 
@@ -62,11 +137,24 @@ func OpenContext(ctx context.Context) openOpt { return func(o *openOptions) { o.
 
 // createOptions contains creation parameters for a blob.
 type createOptions struct {
-	repl    int
-	hint    core.StorageHint
-	expires time.Time
-	pri     core.Priority
-	ctx     context.Context
+	repl      int
+	hint      core.StorageHint
+	expires   time.Time
+	metadata  map[string]string
+	writeOnce bool
+	owner     string
+	acl       core.ACL
+	codec     Codec
+	encrypt   bool
+	pri       core.Priority
+	ctx       context.Context
+
+	// Reserved metadata copied from another blob, which says how the data is
+	// stored.
+	stored map[string]string
+
+	// If set, the blob is bound to this name when it's created.
+	name string
 }
 
 var defaultCreateOptions = createOptions{
@@ -78,8 +166,10 @@ var defaultCreateOptions = createOptions{
 type createOpt func(*createOptions)
 
 type openOptions struct {
-	ctx context.Context
-	pri core.Priority
+	ctx           context.Context
+	pri           core.Priority
+	verify        bool
+	allowUnsealed bool
 }
 
 var defaultOpenOptions = openOptions{
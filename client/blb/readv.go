@@ -0,0 +1,486 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+
+	log "github.com/golang/glog"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/server"
+)
+
+// ReadRange is one range of a blob to read with ReadV.
+type ReadRange struct {
+	Blob BlobID
+	Off  int64
+	Len  int
+}
+
+// ReadResult is the result of reading one ReadRange. As with ReadAt, Err is
+// io.EOF if fewer than Len bytes could be read because the blob ended.
+type ReadResult struct {
+	B   []byte
+	Err error
+}
+
+// readVRange is the state of one range of a ReadV.
+type readVRange struct {
+	id     core.BlobID
+	b      []byte
+	offset int64
+	n      int
+	err    core.Error
+}
+
+// readVPiece is the part of a readVRange that falls in one tract.
+type readVPiece struct {
+	tract   *core.TractInfo
+	curAddr string
+	b       []byte
+	offset  int64
+	result  tractResult
+
+	// For replicated tracts: the order in which to try hosts, how many of them
+	// we've tried so far, and the failures we should report.
+	order  []int
+	next   int
+	failed []readVFailure
+}
+
+type readVFailure struct {
+	host string
+	err  core.Error
+}
+
+// readVBlob holds the ranges of a ReadV in one blob and the tracts they cover.
+type readVBlob struct {
+	id        core.BlobID
+	addr      string
+	start     int
+	tracts    []core.TractInfo
+	padAll    bool
+	wasCached bool
+	ranges    []*readVRange
+	pieces    [][]*readVPiece // pieces of each range, in order
+}
+
+// ReadV reads several ranges, possibly from different blobs, and returns the
+// result of each in order. Reads of the same tractserver are batched into as
+// few RPCs as possible. ReadV reads the blobs' tracts directly, so ranges of
+// compressed or encrypted blobs fail with ErrInvalidArgument.
+func (cli *Client) ReadV(ctx context.Context, ranges []ReadRange) []ReadResult {
+	rs := make([]*readVRange, len(ranges))
+	for i, r := range ranges {
+		rs[i] = &readVRange{id: core.BlobID(r.Blob), offset: r.Off}
+		if r.Len < 0 || r.Off < 0 {
+			rs[i].err = core.ErrInvalidArgument
+		} else {
+			rs[i].b = make([]byte, r.Len)
+		}
+	}
+
+	pending := rs
+	cli.retrier.Do(ctx, func(seq int) bool {
+		log.Infof("readv %d ranges, attempt #%d", len(pending), seq)
+		cli.readV(ctx, pending)
+		var retry []*readVRange
+		for _, r := range pending {
+			if core.IsRetriableError(r.err) {
+				retry = append(retry, r)
+			}
+		}
+		pending = retry
+		return len(pending) == 0
+	})
+
+	results := make([]ReadResult, len(rs))
+	for i, r := range rs {
+		results[i] = ReadResult{B: r.b[:r.n], Err: r.err.Error()}
+	}
+	return results
+}
+
+// readV does one attempt at reading all of 'ranges', and sets their results.
+func (cli *Client) readV(ctx context.Context, ranges []*readVRange) {
+	// Group the ranges by blob.
+	byBlob := make(map[core.BlobID]*readVBlob)
+	var blobs []*readVBlob
+	for _, r := range ranges {
+		r.n, r.err = 0, core.NoError
+		if r.b == nil {
+			r.err = core.ErrInvalidArgument
+			continue
+		} else if len(r.b) == 0 {
+			continue
+		}
+		rb := byBlob[r.id]
+		if rb == nil {
+			rb = &readVBlob{id: r.id}
+			byBlob[r.id] = rb
+			blobs = append(blobs, rb)
+		}
+		rb.ranges = append(rb.ranges, r)
+	}
+
+	// Get the tracts of each blob and split each range into pieces.
+	var pieces []*readVPiece
+	for _, rb := range blobs {
+		if err := cli.readVTracts(ctx, rb); err != core.NoError {
+			for _, r := range rb.ranges {
+				r.err = err
+			}
+			rb.ranges = nil
+			continue
+		}
+		rb.pieces = make([][]*readVPiece, len(rb.ranges))
+		for i, r := range rb.ranges {
+			rb.pieces[i] = cli.readVSplit(rb, r)
+			pieces = append(pieces, rb.pieces[i]...)
+		}
+	}
+
+	cli.readVPieces(ctx, pieces)
+
+	var retry []*readVRange
+	for _, rb := range blobs {
+		if cli.readVFinish(ctx, rb) {
+			retry = append(retry, rb.ranges...)
+		}
+	}
+	if len(retry) > 0 {
+		cli.readV(ctx, retry)
+	}
+}
+
+// readVTracts looks up the tracts covered by the ranges in 'rb'.
+func (cli *Client) readVTracts(ctx context.Context, rb *readVBlob) core.Error {
+	// We want to read tracts in [start, end).
+	start, end := -1, 0
+	for _, r := range rb.ranges {
+		s := int(r.offset / core.TractLength)
+		e := int((r.offset + int64(len(r.b)) + core.TractLength - 1) / core.TractLength)
+		if start < 0 || s < start {
+			start = s
+		}
+		if e > end {
+			end = e
+		}
+	}
+	rb.start = start
+
+	addr, curatorWasCached, err := cli.lookup(ctx, rb.id.Partition())
+	if err != core.NoError {
+		return err
+	}
+	rb.addr = addr
+
+	// As in readAt, we get one extra tract to tell if the last one we read
+	// is the last in the blob.
+	var tracts []core.TractInfo
+	var tractsWereCached bool
+	if cli.useCache() && cli.tractCache.isPlain(rb.id) {
+		tracts, tractsWereCached = cli.tractCache.get(rb.id, start, end+1)
+	}
+	if !tractsWereCached {
+		tracts, err = cli.getPlainTracts(ctx, addr, rb.id, start, end+1)
+	}
+	if err == core.ErrNoSuchTract {
+		// We're past the last tract.
+		return core.NoError
+	} else if err != core.NoError {
+		if curatorWasCached {
+			cli.lookupCache.invalidate(rb.id.Partition())
+			return cli.readVTracts(ctx, rb)
+		}
+		return err
+	}
+
+	rb.wasCached = tractsWereCached
+	if len(tracts) == end+1-start {
+		rb.padAll = true
+		tracts = tracts[:len(tracts)-1]
+	}
+	rb.tracts = tracts
+	return core.NoError
+}
+
+// getPlainTracts gets tracts ['start', 'end') of 'id' from the curator at
+// 'addr', and checks that the blob isn't compressed or encrypted.
+func (cli *Client) getPlainTracts(ctx context.Context, addr string, id core.BlobID, start, end int) ([]core.TractInfo, core.Error) {
+	tracts, info, err := cli.curators.GetTractsWithInfo(ctx, addr, id, start, end, false, false, false)
+	if err != core.NoError && err != core.ErrNoSuchTract {
+		return nil, err
+	}
+	if info == nil {
+		// The curator didn't include the info, or we're past the end.
+		stat, serr := cli.curators.StatBlob(ctx, addr, id)
+		if serr != core.NoError {
+			return nil, serr
+		}
+		info = &stat
+	}
+	if hasReserved(info.Metadata) {
+		return nil, core.ErrInvalidArgument
+	}
+	if err != core.NoError {
+		return nil, err
+	}
+	if cli.useCache() {
+		cli.tractCache.put(id, tracts)
+		cli.tractCache.setPlain(id)
+	}
+	return tracts, core.NoError
+}
+
+// readVSplit returns the pieces of 'r' that fall in tracts of 'rb' that exist.
+func (cli *Client) readVSplit(rb *readVBlob, r *readVRange) (pieces []*readVPiece) {
+	position := 0
+	for position < len(r.b) {
+		idx := int((r.offset+int64(position))/core.TractLength) - rb.start
+		if idx >= len(rb.tracts) {
+			break
+		}
+		tract := &rb.tracts[idx]
+		p := &readVPiece{tract: tract, curAddr: rb.addr}
+		p.b, p.offset = cli.getNextRange(r.b, r.offset, &position)
+		if len(tract.Hosts) > 0 {
+			p.order = rand.Perm(len(tract.Hosts))
+		}
+		pieces = append(pieces, p)
+	}
+	return
+}
+
+// readVTarget is where to read a piece from in the current round.
+type readVTarget struct {
+	host string
+	read core.ReadVRange
+}
+
+// target returns where to read 'p' from next. ok is false if there's nowhere
+// left to try.
+func (p *readVPiece) target() (t readVTarget, ok bool) {
+	tract := p.tract
+	if len(tract.Hosts) > 0 {
+		for ; p.next < len(p.order); p.next++ {
+			n := p.order[p.next]
+			if tract.Hosts[n] == "" {
+				log.V(1).Infof("read %s from tsid %d: no host", tract.Tract, tract.TSIDs[n])
+				continue
+			}
+			t.host = tract.Hosts[n]
+			t.read = core.ReadVRange{ID: tract.Tract, Version: tract.Version, Len: len(p.b), Off: p.offset}
+			return t, true
+		}
+		return t, false
+	} else if tract.RS.Present() && p.next == 0 {
+		t.host = tract.RS.Host
+		t.read = core.ReadVRange{
+			ID:      tract.RS.Chunk.ToTractID(),
+			Version: core.RSChunkVersion,
+			Len:     min(len(p.b), int(tract.RS.Length)),
+			Off:     int64(tract.RS.Offset) + p.offset,
+		}
+		return t, true
+	}
+	return t, false
+}
+
+// readVPieces reads all of 'pieces' and sets their results. In each round, it
+// batches pieces by the host they should be read from next. Pieces of
+// replicated tracts that fail move on to another host for the next round.
+// Pieces of RS tracts that fail are reconstructed.
+func (cli *Client) readVPieces(ctx context.Context, pieces []*readVPiece) {
+	for _, p := range pieces {
+		p.result = tractResult{err: core.ErrAllocHost} // default error if no hosts
+		if len(p.tract.Hosts) == 0 && !p.tract.RS.Present() {
+			p.result.err = core.ErrInvalidState
+		}
+	}
+
+	sem := server.NewSemaphore(ParallelRPCs)
+	pending := pieces
+	for len(pending) > 0 {
+		// Group the pieces by host.
+		byHost := make(map[string][]*readVPiece)
+		targets := make(map[*readVPiece]readVTarget)
+		for _, p := range pending {
+			if t, ok := p.target(); ok {
+				byHost[t.host] = append(byHost[t.host], p)
+				targets[p] = t
+			}
+		}
+
+		var wg sync.WaitGroup
+		var lock sync.Mutex
+		var next []*readVPiece
+		for host, hp := range byHost {
+			for len(hp) > 0 {
+				// Take as many as fit in one request.
+				var batch []*readVPiece
+				var reads []core.ReadVRange
+				total := 0
+				for len(hp) > 0 && (len(batch) == 0 || total+targets[hp[0]].read.Len <= core.MaxReadVLength) {
+					batch = append(batch, hp[0])
+					reads = append(reads, targets[hp[0]].read)
+					total += targets[hp[0]].read.Len
+					hp = hp[1:]
+				}
+
+				wg.Add(1)
+				go func(host string, batch []*readVPiece, reads []core.ReadVRange) {
+					defer wg.Done()
+					sem.Acquire()
+					bs, errs := cli.tractservers.ReadV(ctx, host, reads)
+					sem.Release()
+
+					var retry []*readVPiece
+					for i, p := range batch {
+						var b []byte
+						if bs != nil {
+							b = bs[i]
+						}
+						if !cli.readVPieceDone(ctx, p, host, reads[i], b, errs[i]) {
+							retry = append(retry, p)
+						}
+					}
+					lock.Lock()
+					next = append(next, retry...)
+					lock.Unlock()
+				}(host, batch, reads)
+			}
+		}
+		wg.Wait()
+		pending = next
+	}
+
+	// Report the failures of replicated reads now that we know if we recovered.
+	for _, p := range pieces {
+		couldRecover := p.result.err == core.NoError || p.result.err == core.ErrEOF
+		for _, f := range p.failed {
+			go cli.curators.ReportBadTS(context.Background(), p.curAddr, p.tract.Tract, f.host, "read", f.err, couldRecover)
+		}
+	}
+}
+
+// readVPieceDone handles the result of reading 'p' from 'host'. It returns
+// false if 'p' should be tried again on another host.
+func (cli *Client) readVPieceDone(ctx context.Context, p *readVPiece, host string, read core.ReadVRange, b []byte, err core.Error) bool {
+	tract := p.tract
+	n := copy(p.b, b)
+
+	if len(tract.Hosts) == 0 {
+		// This was an RS tract. finishOneTractRS takes care of failures.
+		p.next++
+		cli.finishOneTractRS(ctx, p.curAddr, &p.result, tract, p.b, read.Off, read.Len, n, err)
+		return true
+	}
+
+	log.V(1).Infof("read %s from tractserver at address %s: %s", tract.Tract, host, err)
+	p.next++
+	if err == core.ErrVersionMismatch {
+		p.result.badVersionHost = host
+	}
+	if err != core.NoError && err != core.ErrEOF {
+		p.failed = append(p.failed, readVFailure{host, err})
+		p.result.err = err
+		return false
+	}
+	for i := n; i < len(p.b); i++ {
+		p.b[i] = 0 // Pad with zeros. See comment in readOneTractReplicated.
+	}
+	p.result = tractResult{len(p.b), n, err, p.result.badVersionHost}
+	return true
+}
+
+// readVFinish figures out the results of the ranges in 'rb' from the results
+// of their pieces. It returns true if the ranges should be read again because
+// the tracts we used were cached and might be out of date.
+func (cli *Client) readVFinish(ctx context.Context, rb *readVBlob) bool {
+	failed := false
+	for i, r := range rb.ranges {
+		r.n, r.err = 0, core.NoError
+		pieces := rb.pieces[i]
+		for _, p := range pieces {
+			res := p.result
+			r.err = res.err
+			if res.err == core.NoError {
+				r.n += res.read
+			} else if res.err == core.ErrEOF {
+				// See readAt for how we handle short reads.
+				if p.tract == &rb.tracts[len(rb.tracts)-1] && !rb.padAll {
+					r.n += res.read
+					break
+				}
+				r.n += res.wanted
+				r.err = core.NoError
+			} else {
+				failed = true
+				break
+			}
+		}
+		if r.err == core.NoError && r.n < len(r.b) {
+			// The range goes past the last tract.
+			r.err = core.ErrEOF
+		}
+	}
+
+	if failed && rb.wasCached {
+		// Maybe we got older cached tracts.
+		cli.tractCache.invalidate(rb.id)
+		return true
+	}
+
+	if verifyFromContext(ctx) {
+		var tracts []core.TractInfo
+		var bufs [][]byte
+		var offsets []int64
+		for _, pieces := range rb.pieces {
+			for _, p := range pieces {
+				if p.result.err == core.NoError || p.result.err == core.ErrEOF {
+					tracts = append(tracts, *p.tract)
+					bufs = append(bufs, p.b)
+					offsets = append(offsets, p.offset)
+				}
+			}
+		}
+		if verr := verifyChecksums(tracts, bufs, offsets); verr != core.NoError {
+			if rb.wasCached {
+				// Maybe the checksums we have are out of date.
+				cli.tractCache.invalidate(rb.id)
+				return true
+			}
+			for _, r := range rb.ranges {
+				r.n, r.err = 0, verr
+			}
+		}
+	}
+
+	// Maybe kick off FixVersion rpcs, as in readAt.
+	var wg sync.WaitGroup
+	for _, pieces := range rb.pieces {
+		for _, p := range pieces {
+			if p.result.badVersionHost == "" {
+				continue
+			}
+			log.Infof("got version mismatch when reading tract %s from host %s, requesting FixVersion",
+				p.tract.Tract, p.result.badVersionHost)
+			if p.result.err == core.ErrVersionMismatch {
+				wg.Add(1)
+				go func(tract core.TractInfo, badHost string) {
+					cli.curators.FixVersion(context.Background(), rb.addr, tract, badHost)
+					wg.Done()
+				}(*p.tract, p.result.badVersionHost)
+			} else {
+				go cli.curators.FixVersion(context.Background(), rb.addr, *p.tract, p.result.badVersionHost)
+			}
+		}
+	}
+	wg.Wait()
+	return false
+}
@@ -10,8 +10,8 @@ import (
 
 	log "github.com/golang/glog"
 	"github.com/westerndigitalcorporation/blb/internal/core"
-	"github.com/westerndigitalcorporation/blb/internal/curator/storageclass"
 	"github.com/westerndigitalcorporation/blb/internal/server"
+	"github.com/westerndigitalcorporation/blb/pkg/lrc"
 	"github.com/westerndigitalcorporation/blb/pkg/rpc"
 )
 
@@ -49,14 +49,13 @@ func (cli *Client) shouldReconstruct(tract *core.TractInfo) bool {
 	if !cli.reconstructState.Enabled {
 		return false
 	}
-	// Make sure it's a class we know about.
-	cls := storageclass.Get(tract.RS.Class)
-	if cls == nil {
+	// The curator tells us how the chunk is coded. Make sure it did, and that
+	// there's some parity.
+	n := tract.RS.Data
+	if n <= 0 || len(tract.RS.OtherHosts) <= n || len(tract.RS.OtherTSIDs) != len(tract.RS.OtherHosts) {
 		return false
 	}
-	// And that we have the right number of hosts.
-	n, m := cls.RSParams()
-	if len(tract.RS.OtherHosts) != n+m {
+	if code := tract.RS.LRC; code.K > 0 && code.K+code.L+code.R != len(tract.RS.OtherHosts) {
 		return false
 	}
 	return true
@@ -73,8 +72,9 @@ func (cli *Client) reconstructOneTract(
 	cli.reconstructState.sem.Acquire()
 	defer cli.reconstructState.sem.Release()
 
-	// We checked this above so this shouldn't fail.
-	n, m := storageclass.Get(tract.RS.Class).RSParams()
+	// We checked these above.
+	n := tract.RS.Data
+	m := len(tract.RS.OtherHosts) - n
 
 	// At this point, we know that we want to read part of an RS chunk, and the
 	// "direct read" has already failed. We need n pieces of data to
@@ -108,6 +108,11 @@ func (cli *Client) reconstructOneTract(
 		return
 	}
 
+	if code := tract.RS.LRC; code.K > 0 {
+		cli.reconstructOneTractLRC(ctx, result, tract, thisB, offset, length, code, targetIdx)
+		return
+	}
+
 	if len(requests) < n {
 		// We don't have enough alive pieces to request.
 		log.Errorf("rs reconstruct %s: not enough pieces %d < %d", tract.Tract, len(requests), n)
@@ -179,6 +184,12 @@ func (cli *Client) reconstructOneTract(
 		return
 	}
 
+	reconstructed(result, tract, thisB, length)
+}
+
+// reconstructed fills in 'result' after the first 'length' bytes of 'thisB' have
+// been reconstructed.
+func reconstructed(result *tractResult, tract *core.TractInfo, thisB []byte, length int) {
 	for i := length; i < len(thisB); i++ {
 		thisB[i] = 0 // Pad with zeros. See comment in readOneTractReplicated.
 	}
@@ -193,3 +204,96 @@ func (cli *Client) reconstructOneTract(
 	}
 	*result = tractResult{len(thisB), length, err, ""}
 }
+
+// reconstructOneTractLRC is reconstructOneTract for locally repairable codes.
+// Instead of reading from all the other pieces, it only reads the ones that it
+// needs, which is usually just the rest of the target's local group.
+func (cli *Client) reconstructOneTractLRC(
+	ctx context.Context,
+	result *tractResult,
+	tract *core.TractInfo,
+	thisB []byte,
+	offset int64,
+	length int,
+	code core.LRCParams,
+	targetIdx int) {
+	enc, e := lrc.New(code.K, code.L, code.R)
+	if e != nil {
+		*result = tractResult{len(thisB), 0, core.ErrInvalidArgument, ""}
+		return
+	}
+
+	lost := []int{targetIdx}
+	for i, host := range tract.RS.OtherHosts {
+		if host == "" && i != targetIdx {
+			lost = append(lost, i)
+		}
+	}
+
+	type piece struct {
+		idx int
+		res []byte
+		err core.Error
+	}
+	data := make([][]byte, enc.Pieces())
+	have := make([]bool, enc.Pieces())
+	lastErr := core.ErrHostNotExist
+
+	// Read the pieces that the code asks for. If any of those fail, add them to
+	// the lost ones and ask again, until we have enough or it gives up.
+	for {
+		read, ok := enc.RepairSet(lost)
+		if !ok {
+			log.Errorf("lrc reconstruct %s: can't reconstruct without %v, last err: %s", tract.Tract, lost, lastErr)
+			*result = tractResult{len(thisB), 0, lastErr, ""}
+			return
+		}
+		var requests []int
+		for _, i := range read {
+			if !have[i] {
+				requests = append(requests, i)
+			}
+		}
+		if len(requests) == 0 {
+			break
+		}
+
+		pieces := make(chan piece, len(requests))
+		for _, i := range requests {
+			go func(i int) {
+				rsTract := tract.RS.BaseChunk.Add(i).ToTractID()
+				p := piece{idx: i}
+				p.res, p.err = cli.tractservers.Read(ctx, tract.RS.OtherHosts[i], rsTract, core.RSChunkVersion, length, offset)
+				if (p.err == core.NoError || p.err == core.ErrEOF) && len(p.res) != length {
+					p.err = core.ErrShortRead
+				}
+				pieces <- p
+			}(i)
+		}
+		for range requests {
+			p := <-pieces
+			if p.err != core.NoError && p.err != core.ErrEOF {
+				lastErr = p.err
+				log.V(1).Infof("lrc reconstruct %s: error %s from %s index %d", tract.Tract, lastErr, tract.RS.OtherHosts[p.idx], p.idx)
+				lost = append(lost, p.idx)
+				continue
+			}
+			log.V(1).Infof("lrc reconstruct %s: read from %s index %d", tract.Tract, tract.RS.OtherHosts[p.idx], p.idx)
+			data[p.idx] = p.res
+			have[p.idx] = true
+			defer rpc.PutBuffer(p.res, true)
+		}
+	}
+
+	// Reconstruct into our destination.
+	data[targetIdx] = thisB[0:0:length]
+	e = enc.Reconstruct(data, []int{targetIdx})
+	out := data[targetIdx]
+	if e != nil || len(out) != length || (length > 0 && &out[0] != &thisB[0]) {
+		log.Errorf("lrc reconstruct error: %s", e)
+		*result = tractResult{len(thisB), 0, core.ErrCorruptData, ""}
+		return
+	}
+
+	reconstructed(result, tract, thisB, length)
+}
@@ -27,8 +27,17 @@ func NewRPCCuratorTalker() CuratorTalker {
 }
 
 // CreateBlob implements CuratorTalker.
-func (r *RPCCuratorTalker) CreateBlob(ctx context.Context, addr string, metadata core.BlobInfo) (core.BlobID, core.Error) {
-	req := core.CreateBlobReq{Repl: metadata.Repl, Hint: metadata.Hint, Expires: metadata.Expires}
+func (r *RPCCuratorTalker) CreateBlob(ctx context.Context, addr string, name string, metadata core.BlobInfo) (core.BlobID, core.Error) {
+	req := core.CreateBlobReq{
+		Repl:      metadata.Repl,
+		Hint:      metadata.Hint,
+		Expires:   metadata.Expires,
+		Metadata:  metadata.Metadata,
+		WriteOnce: metadata.WriteOnce,
+		Owner:     metadata.Owner,
+		ACL:       metadata.ACL,
+		Name:      name,
+	}
 	var reply core.CreateBlobReply
 	if err := r.cc.Send(ctx, addr, core.CreateBlobMethod, req, &reply); err != nil {
 		log.Errorf("RPC-level error creating a blob: %s", err)
@@ -52,9 +61,9 @@ func (r *RPCCuratorTalker) ExtendBlob(ctx context.Context, addr string, blob cor
 }
 
 // AckExtendBlob acks the success of extending 'blob' with the new tracts
-// 'tracts' and term number 'term'.
-func (r *RPCCuratorTalker) AckExtendBlob(ctx context.Context, addr string, blob core.BlobID, tracts []core.TractInfo) core.Error {
-	req := core.AckExtendBlobReq{Blob: blob, Tracts: tracts}
+// 'tracts', and applies the checksum updates 'checksums' with it.
+func (r *RPCCuratorTalker) AckExtendBlob(ctx context.Context, addr string, blob core.BlobID, tracts []core.TractInfo, checksums []core.ChecksumUpdate) core.Error {
+	req := core.AckExtendBlobReq{Blob: blob, Tracts: tracts, Checksums: checksums}
 	var reply core.AckExtendBlobReply
 	if err := r.cc.Send(ctx, addr, core.AckExtendBlobMethod, req, &reply); err != nil {
 		log.Errorf("RPC-level error ack'ing extended tracts %+v for blob %s: %s", tracts, blob, err)
@@ -92,6 +101,101 @@ func (r *RPCCuratorTalker) UndeleteBlob(ctx context.Context, addr string, blob c
 	return reply
 }
 
+// ReserveAppend implements CuratorTalker.
+func (r *RPCCuratorTalker) ReserveAppend(ctx context.Context, addr string, blob core.BlobID, length, minOffset int64) (int64, core.Error) {
+	req := core.ReserveAppendReq{Blob: blob, Length: length, MinOffset: minOffset}
+	var reply core.ReserveAppendReply
+	if err := r.cc.Send(ctx, addr, core.ReserveAppendMethod, req, &reply); err != nil {
+		log.Errorf("RPC-level error reserving append on blob %s: %s", blob, err)
+		return 0, core.ErrRPC
+	}
+	if core.NoError != reply.Err {
+		log.Errorf("curator-level error reserving append on blob %s: %s", blob, reply.Err)
+	}
+	return reply.Offset, reply.Err
+}
+
+// PurgeBlob implements CuratorTalker.
+func (r *RPCCuratorTalker) PurgeBlob(ctx context.Context, addr string, blob core.BlobID) core.Error {
+	var reply core.Error
+	if err := r.cc.Send(ctx, addr, core.PurgeBlobMethod, blob, &reply); err != nil {
+		log.Errorf("RPC-level error purging blob %s: %s", blob, err)
+		return core.ErrRPC
+	}
+	if core.NoError != reply {
+		log.Errorf("curator-level error purging blob %s: %s", blob, reply)
+	}
+	return reply
+}
+
+// SealBlob implements CuratorTalker.
+func (r *RPCCuratorTalker) SealBlob(ctx context.Context, addr string, blob core.BlobID) core.Error {
+	var reply core.Error
+	if err := r.cc.Send(ctx, addr, core.SealBlobMethod, blob, &reply); err != nil {
+		log.Errorf("RPC-level error sealing blob %s: %s", blob, err)
+		return core.ErrRPC
+	}
+	if core.NoError != reply {
+		log.Errorf("curator-level error sealing blob %s: %s", blob, reply)
+	}
+	return reply
+}
+
+// TruncateBlob implements CuratorTalker.
+func (r *RPCCuratorTalker) TruncateBlob(ctx context.Context, addr string, blob core.BlobID, size int64) core.Error {
+	req := core.TruncateBlobReq{Blob: blob, Size: size}
+	var reply core.Error
+	if err := r.cc.Send(ctx, addr, core.TruncateBlobMethod, req, &reply); err != nil {
+		log.Errorf("RPC-level error truncating blob %s: %s", blob, err)
+		return core.ErrRPC
+	}
+	if core.NoError != reply {
+		log.Errorf("curator-level error truncating blob %s: %s", blob, reply)
+	}
+	return reply
+}
+
+// CloneBlob implements CuratorTalker.
+func (r *RPCCuratorTalker) CloneBlob(ctx context.Context, addr string, blob core.BlobID) (core.BlobID, core.Error) {
+	var reply core.CreateBlobReply
+	if err := r.cc.Send(ctx, addr, core.CloneBlobMethod, blob, &reply); err != nil {
+		log.Errorf("RPC-level error cloning blob %s: %s", blob, err)
+		return 0, core.ErrRPC
+	}
+	if core.NoError != reply.Err {
+		log.Errorf("curator-level error cloning blob %s: %s", blob, reply.Err)
+	}
+	return reply.ID, reply.Err
+}
+
+// UnshareTract implements CuratorTalker.
+func (r *RPCCuratorTalker) UnshareTract(ctx context.Context, addr string, id core.TractID) core.Error {
+	req := core.UnshareTractReq{Blob: id.Blob, Index: id.Index}
+	var reply core.Error
+	if err := r.cc.Send(ctx, addr, core.UnshareTractMethod, req, &reply); err != nil {
+		log.Errorf("RPC-level error unsharing tract %s: %s", id, err)
+		return core.ErrRPC
+	}
+	if core.NoError != reply {
+		log.Errorf("curator-level error unsharing tract %s: %s", id, reply)
+	}
+	return reply
+}
+
+// CopyTracts implements CuratorTalker.
+func (r *RPCCuratorTalker) CopyTracts(ctx context.Context, addr string, blob core.BlobID, first core.TractKey, src core.BlobID, start, end int) core.Error {
+	req := core.CopyTractsReq{Dst: blob, First: first, Src: src, Start: start, End: end}
+	var reply core.Error
+	if err := r.cc.Send(ctx, addr, core.CopyTractsMethod, req, &reply); err != nil {
+		log.Errorf("RPC-level error copying tracts to blob %s: %s", blob, err)
+		return core.ErrRPC
+	}
+	if core.NoError != reply {
+		log.Errorf("curator-level error copying tracts to blob %s: %s", blob, reply)
+	}
+	return reply
+}
+
 // SetMetadata implements CuratorTalker.
 func (r *RPCCuratorTalker) SetMetadata(ctx context.Context, addr string, blob core.BlobID, md core.BlobInfo) core.Error {
 	req := core.SetMetadataReq{Blob: blob, Metadata: md}
@@ -106,19 +210,47 @@ func (r *RPCCuratorTalker) SetMetadata(ctx context.Context, addr string, blob co
 	return reply
 }
 
+// UpdateChecksums implements CuratorTalker.
+func (r *RPCCuratorTalker) UpdateChecksums(ctx context.Context, addr string, blob core.BlobID, updates []core.ChecksumUpdate) core.Error {
+	req := core.UpdateChecksumsReq{Blob: blob, Updates: updates}
+	var reply core.Error
+	if err := r.cc.Send(ctx, addr, core.UpdateChecksumsMethod, req, &reply); err != nil {
+		log.Errorf("RPC-level error updating checksums on blob %s: %s", blob, err)
+		return core.ErrRPC
+	}
+	if core.NoError != reply {
+		log.Errorf("curator-level error updating checksums on blob %s: %s", blob, reply)
+	}
+	return reply
+}
+
 // GetTracts implements CuratorTalker.
 func (r *RPCCuratorTalker) GetTracts(ctx context.Context, addr string, blob core.BlobID, start, end int,
 	forRead, forWrite bool) ([]core.TractInfo, core.Error) {
-	req := core.GetTractsReq{
+	reply := r.getTracts(ctx, addr, core.GetTractsReq{
 		Blob: blob, Start: start, End: end,
 		ForRead: forRead, ForWrite: forWrite,
-	}
-	var reply core.GetTractsReply
+	})
+	return reply.Tracts, reply.Err
+}
+
+// GetTractsWithInfo implements CuratorTalker.
+func (r *RPCCuratorTalker) GetTractsWithInfo(ctx context.Context, addr string, blob core.BlobID, start, end int,
+	forRead, forWrite, allowUnsealed bool) ([]core.TractInfo, *core.BlobInfo, core.Error) {
+	reply := r.getTracts(ctx, addr, core.GetTractsReq{
+		Blob: blob, Start: start, End: end,
+		ForRead: forRead, ForWrite: forWrite,
+		WithInfo: true, AllowUnsealed: allowUnsealed,
+	})
+	return reply.Tracts, reply.Info, reply.Err
+}
+
+func (r *RPCCuratorTalker) getTracts(ctx context.Context, addr string, req core.GetTractsReq) (reply core.GetTractsReply) {
 	if err := r.cc.Send(ctx, addr, core.GetTractsMethod, req, &reply); err != nil {
-		log.Errorf("RPC-level error getting tracts [%d, %d) from blob %s: %s", start, end, blob, err)
-		return nil, core.ErrRPC
+		log.Errorf("RPC-level error getting tracts [%d, %d) from blob %s: %s", req.Start, req.End, req.Blob, err)
+		return core.GetTractsReply{Err: core.ErrRPC}
 	}
-	return reply.Tracts, reply.Err
+	return
 }
 
 // StatBlob implements CuratorTalker.
@@ -167,3 +299,73 @@ func (r *RPCCuratorTalker) ListBlobs(ctx context.Context, addr string, partition
 	}
 	return reply.Keys, reply.Err
 }
+
+// ListBlobsWithInfo gets blob ids and info in a given partition that match a filter.
+func (r *RPCCuratorTalker) ListBlobsWithInfo(ctx context.Context, addr string, partition core.PartitionID, start core.BlobKey, filter core.BlobFilter) ([]core.BlobKey, []core.BlobInfo, core.BlobKey, bool, core.Error) {
+	req := core.ListBlobsReq{Partition: partition, Start: start, Filter: &filter}
+	var reply core.ListBlobsReply
+	if err := r.cc.Send(ctx, addr, core.ListBlobsMethod, req, &reply); err != nil {
+		log.Errorf("RPC-level error listing blobs: %s", err)
+		return nil, nil, 0, false, core.ErrRPC
+	}
+	if reply.Err == core.NoError && len(reply.Infos) != len(reply.Keys) {
+		// An old curator that doesn't know about filters.
+		log.Errorf("curator at %s doesn't support filtered listing", addr)
+		return nil, nil, 0, false, core.ErrNotYetImplemented
+	}
+	return reply.Keys, reply.Infos, reply.Next, reply.More, reply.Err
+}
+
+// GetEvents implements CuratorTalker.
+func (r *RPCCuratorTalker) GetEvents(ctx context.Context, addr string, partition core.PartitionID, after uint64) ([]core.BlobEvent, uint64, core.Error) {
+	req := core.GetEventsReq{Partition: partition, After: after}
+	var reply core.GetEventsReply
+	if err := r.cc.Send(ctx, addr, core.GetEventsMethod, req, &reply); err != nil {
+		log.Errorf("RPC-level error getting events: %s", err)
+		return nil, after, core.ErrRPC
+	}
+	return reply.Events, reply.Next, reply.Err
+}
+
+// LookupName implements CuratorTalker.
+func (r *RPCCuratorTalker) LookupName(ctx context.Context, addr string, name string) (core.BlobID, core.Error) {
+	var reply core.LookupNameReply
+	if err := r.cc.Send(ctx, addr, core.LookupNameMethod, name, &reply); err != nil {
+		log.Errorf("RPC-level error looking up name %q: %s", name, err)
+		return 0, core.ErrRPC
+	}
+	return reply.Blob, reply.Err
+}
+
+// Rename implements CuratorTalker.
+func (r *RPCCuratorTalker) Rename(ctx context.Context, addr string, from, to string, replace core.BlobID) core.Error {
+	req := core.RenameReq{From: from, To: to, Replace: replace}
+	var reply core.Error
+	if err := r.cc.Send(ctx, addr, core.RenameMethod, req, &reply); err != nil {
+		log.Errorf("RPC-level error renaming %q to %q: %s", from, to, err)
+		return core.ErrRPC
+	}
+	return reply
+}
+
+// UnbindName implements CuratorTalker.
+func (r *RPCCuratorTalker) UnbindName(ctx context.Context, addr string, name string, blob core.BlobID) core.Error {
+	req := core.UnbindNameReq{Name: name, Blob: blob}
+	var reply core.Error
+	if err := r.cc.Send(ctx, addr, core.UnbindNameMethod, req, &reply); err != nil {
+		log.Errorf("RPC-level error unbinding name %q: %s", name, err)
+		return core.ErrRPC
+	}
+	return reply
+}
+
+// ListNames implements CuratorTalker.
+func (r *RPCCuratorTalker) ListNames(ctx context.Context, addr string, prefix, start string) ([]core.NameEntry, core.Error) {
+	req := core.ListNamesReq{Prefix: prefix, Start: start}
+	var reply core.ListNamesReply
+	if err := r.cc.Send(ctx, addr, core.ListNamesMethod, req, &reply); err != nil {
+		log.Errorf("RPC-level error listing names: %s", err)
+		return nil, core.ErrRPC
+	}
+	return reply.Entries, reply.Err
+}
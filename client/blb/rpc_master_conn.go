@@ -71,3 +71,19 @@ func (r *RPCMasterConnection) GetTractserverInfo(ctx context.Context) (info []co
 	err, _ = r.fc.FailoverRPC(ctx, core.GetTractserverInfoMethod, req, reply)
 	return reply.Info, err
 }
+
+// GetQuotas returns the quotas and usage of blob owners.
+func (r *RPCMasterConnection) GetQuotas(ctx context.Context) (quotas []core.TenantQuota, err core.Error) {
+	req := core.GetQuotasReq{}
+	reply := &core.GetQuotasReply{}
+	err, _ = r.fc.FailoverRPC(ctx, core.GetQuotasMethod, req, reply)
+	return reply.Quotas, err
+}
+
+// SetQuota sets the quota of a blob owner.
+func (r *RPCMasterConnection) SetQuota(ctx context.Context, owner string, limit core.TenantUsage) core.Error {
+	req := core.SetQuotaReq{Owner: owner, Limit: limit}
+	reply := &core.SetQuotaReply{}
+	err, _ := r.fc.FailoverRPC(ctx, core.SetQuotaMethod, req, reply)
+	return err
+}
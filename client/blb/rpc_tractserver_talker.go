@@ -27,9 +27,9 @@ func NewRPCTractserverTalker() TractserverTalker {
 }
 
 // Create creates a new tract on the tractserver and does a write to the newly created tract.
-func (r *RPCTractserverTalker) Create(ctx context.Context, addr string, tsid core.TractserverID, id core.TractID, b []byte, off int64) core.Error {
+func (r *RPCTractserverTalker) Create(ctx context.Context, addr string, tsid core.TractserverID, id core.TractID, version int, b []byte, off int64) core.Error {
 	pri := priorityFromContext(ctx)
-	req := core.CreateTractReq{TSID: tsid, ID: id, Off: off, Pri: pri}
+	req := core.CreateTractReq{TSID: tsid, ID: id, Off: off, Pri: pri, Version: version}
 	req.Set(b, false)
 	var reply core.Error
 	if err := r.cc.Send(ctx, addr, core.CreateTractMethod, &req, &reply); err != nil {
@@ -104,6 +104,64 @@ func (r *RPCTractserverTalker) ReadInto(ctx context.Context, addr string, id cor
 	return len(reply.B), reply.Err
 }
 
+// ReadV does several reads from tracts on one tractserver with one RPC.
+func (r *RPCTractserverTalker) ReadV(ctx context.Context, addr string, reads []core.ReadVRange) ([][]byte, []core.Error) {
+	pri := priorityFromContext(ctx)
+	rpcid := rpc.GenID()
+	req := core.ReadVReq{Reads: reads, Pri: pri, ReqID: rpcid}
+	var reply core.ReadVReply
+	cancel := rpc.CancelAction{Method: core.CancelReqMethod, Req: rpcid}
+	if err := r.cc.SendWithCancel(ctx, addr, core.ReadVMethod, req, &reply, &cancel); err != nil {
+		log.Errorf("ReadV RPC error for %d reads on tractserver @%s: %s", len(reads), addr, err)
+		return nil, sameErrors(len(reads), core.ErrRPC)
+	}
+	if reply.Err != core.NoError {
+		log.Errorf("ReadV error for %d reads on tractserver @%s: %s", len(reads), addr, reply.Err)
+		return nil, sameErrors(len(reads), reply.Err)
+	}
+
+	// Split up the data.
+	total := 0
+	for _, n := range reply.N {
+		total += n
+	}
+	if len(reply.N) != len(reads) || len(reply.Errs) != len(reads) || total != len(reply.B) {
+		log.Errorf("ReadV error for %d reads on tractserver @%s: malformed reply", len(reads), addr)
+		return nil, sameErrors(len(reads), core.ErrInvalidState)
+	}
+	bs := make([][]byte, len(reads))
+	pos := 0
+	for i, n := range reply.N {
+		bs[i] = reply.B[pos : pos+n : pos+n]
+		pos += n
+	}
+	return bs, reply.Errs
+}
+
+// sameErrors returns a slice of n copies of err.
+func sameErrors(n int, err core.Error) []core.Error {
+	errs := make([]core.Error, n)
+	for i := range errs {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Truncate cuts a tract short, or pads it with zeros, to 'size' bytes.
+func (r *RPCTractserverTalker) Truncate(ctx context.Context, addr string, id core.TractID, version int, size int64) core.Error {
+	pri := priorityFromContext(ctx)
+	req := core.TruncateReq{ID: id, Version: version, Size: size, Pri: pri}
+	var reply core.Error
+	if err := r.cc.Send(ctx, addr, core.TruncateMethod, req, &reply); err != nil {
+		log.Errorf("Truncate RPC error for tract (id: %s, version: %d) on tractserver @%s: %s", id, version, addr, err)
+		return core.ErrRPC
+	}
+	if reply != core.NoError {
+		log.Errorf("Truncate error for tract (id: %s, version: %d) on tractserver @%s: %s", id, version, addr, reply)
+	}
+	return reply
+}
+
 // StatTract returns the number of bytes in a tract.
 func (r *RPCTractserverTalker) StatTract(ctx context.Context, addr string, id core.TractID, version int) (int64, core.Error) {
 	pri := priorityFromContext(ctx)
@@ -0,0 +1,357 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/server"
+)
+
+const (
+	defaultStreamReadahead   = 4
+	defaultStreamWriteBehind = 4
+)
+
+// StreamReader wraps an existing Blob for sequential reading. It keeps several
+// tract-sized reads in flight ahead of the reader, so that reading doesn't
+// stall at tract boundaries. It implements the io.ReadSeeker interface.
+type StreamReader struct {
+	b *Blob
+
+	// How many reads to keep in flight.
+	depth int
+
+	// Offset of the next byte to return from Read.
+	offset int64
+
+	// Offset of the next read to start.
+	next int64
+
+	// Whether a read has hit the end of the blob, so there's no point in
+	// starting more.
+	atEnd bool
+
+	// Reads in flight, in order, and the data left over from the last
+	// finished one.
+	pending []*streamChunk
+	cur     []byte
+	curErr  error
+
+	// Cancels the reads in flight.
+	cancel context.CancelFunc
+	ctx    context.Context
+}
+
+// streamChunk is one read done by a StreamReader.
+type streamChunk struct {
+	done chan struct{}
+	b    []byte
+	err  error
+}
+
+// NewStreamReader wraps an existing blob for sequential reading. The number of
+// reads in flight is set by Options.StreamReadahead.
+func NewStreamReader(b *Blob) *StreamReader {
+	r := &StreamReader{b: b, depth: b.cli.streamReadahead, offset: b.offset, next: b.offset}
+	r.ctx, r.cancel = context.WithCancel(b.ctx)
+	return r
+}
+
+// Read reads up to 'len(p)' bytes into 'p'. It returns the number of bytes
+// read and any error encountered.
+func (r *StreamReader) Read(p []byte) (int, error) {
+	if len(r.cur) == 0 {
+		if r.curErr != nil {
+			return 0, r.curErr
+		}
+		r.fill()
+		if len(r.pending) == 0 {
+			return 0, io.EOF
+		}
+		c := r.pending[0]
+		r.pending = r.pending[1:]
+		<-c.done
+		r.cur, r.curErr = c.b, c.err
+		if r.curErr != nil {
+			// Anything after this is either past the end or likely to fail
+			// too. Seek starts over.
+			r.discard()
+		}
+	}
+	n := copy(p, r.cur)
+	r.cur = r.cur[n:]
+	r.offset += int64(n)
+	if len(r.cur) == 0 && r.curErr != nil {
+		return n, r.curErr
+	}
+	return n, nil
+}
+
+// fill starts reads until 'depth' are in flight.
+func (r *StreamReader) fill() {
+	for len(r.pending) < r.depth && !r.atEnd {
+		off := r.next
+		length := core.TractLength - int(off%core.TractLength)
+		r.next += int64(length)
+		c := &streamChunk{done: make(chan struct{})}
+		r.pending = append(r.pending, c)
+
+		// Use a copy of the blob with our context, so that we can cancel.
+		b := *r.b
+		b.ctx = r.ctx
+		go func() {
+			buf := make([]byte, length)
+			n, err := b.ReadAt(buf, off)
+			c.b, c.err = buf[:n], err
+			close(c.done)
+		}()
+	}
+}
+
+// discard cancels all reads in flight.
+func (r *StreamReader) discard() {
+	r.cancel()
+	r.ctx, r.cancel = context.WithCancel(r.b.ctx)
+	r.pending = nil
+	r.atEnd = true
+}
+
+// Seek sets the offset for the next Read, as Blob.Seek does. All reads in
+// flight are discarded.
+func (r *StreamReader) Seek(offset int64, whence int) (int64, error) {
+	var newOffset int64
+	switch whence {
+	case os.SEEK_SET:
+		newOffset = offset
+	case os.SEEK_CUR:
+		newOffset = r.offset + offset
+	case os.SEEK_END:
+		end, err := r.b.ByteLength()
+		if err != nil {
+			return -1, err
+		}
+		newOffset = end + offset
+	default:
+		return 0, fmt.Errorf("invalid argument %d, must be in [0, 2]", whence)
+	}
+	if newOffset < 0 {
+		return 0, fmt.Errorf("cannot seek to a negative offset: %d", newOffset)
+	}
+	r.discard()
+	r.offset, r.next, r.atEnd = newOffset, newOffset, false
+	r.cur, r.curErr = nil, nil
+	return newOffset, nil
+}
+
+// Close cancels all reads in flight. It doesn't close the underlying blob.
+func (r *StreamReader) Close() error {
+	r.discard()
+	r.cancel()
+	return nil
+}
+
+// ByteLength returns the length of the blob in bytes.
+func (r *StreamReader) ByteLength() (int64, error) {
+	return r.b.ByteLength()
+}
+
+// Stat returns returns stat(2)-ish info about the blob.
+func (r *StreamReader) Stat() (core.BlobInfo, error) {
+	return r.b.Stat()
+}
+
+// ID returns the BlobID of the blob.
+func (r *StreamReader) ID() BlobID {
+	return r.b.ID()
+}
+
+// StreamWriter wraps an existing Blob for sequential writing. It buffers data
+// until it has a batch of full tracts, extends the blob for all of them at
+// once, and writes them in parallel. Errors from writes are returned from a
+// later Write, Flush, or Close. It implements the io.WriteCloser interface.
+type StreamWriter struct {
+	b *Blob
+
+	// Data that hasn't been sent yet, and where it goes.
+	buf    []byte
+	offset int64
+
+	// Tracts of data waiting for the rest of their batch.
+	pending []streamWrite
+
+	// Bounds the number of tracts being written, and so the memory we use.
+	sem server.Semaphore
+	wg  sync.WaitGroup
+
+	// The end of the furthest write we've started.
+	end int64
+
+	// The first error from any write.
+	lock sync.Mutex
+	err  error
+}
+
+// streamWrite is a write that a StreamWriter hasn't started yet.
+type streamWrite struct {
+	buf []byte
+	off int64
+}
+
+// NewStreamWriter wraps an existing blob for sequential writing, starting at
+// the blob's current offset. The number of tracts written in parallel is set
+// by Options.StreamWriteBehind.
+func NewStreamWriter(b *Blob) *StreamWriter {
+	return &StreamWriter{
+		b:      b,
+		offset: b.offset,
+		sem:    server.NewSemaphore(b.cli.streamWriteBehind),
+	}
+}
+
+// Write buffers 'p' to be written to the blob. It returns len(p) unless an
+// earlier write failed.
+func (w *StreamWriter) Write(p []byte) (int, error) {
+	if err := w.getErr(); err != nil {
+		return 0, err
+	}
+	n := len(p)
+	for len(p) > 0 {
+		// Fill up to the next tract boundary.
+		room := core.TractLength - int((w.offset+int64(len(w.buf)))%core.TractLength)
+		take := min(room, len(p))
+		w.buf = append(w.buf, p[:take]...)
+		p = p[take:]
+		if take == room {
+			if err := w.send(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// send adds the buffered data to the batch, and starts writing the batch if
+// it's full.
+func (w *StreamWriter) send() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	buf, off := w.buf, w.offset
+	w.buf, w.offset = nil, off+int64(len(buf))
+
+	// Compressed blobs are written a frame at a time, in order, and encrypted
+	// blobs may need to fill out their last block, so write these in order.
+	if w.b.comp != nil || w.b.enc != nil {
+		if _, err := w.b.WriteAt(buf, off); err != nil {
+			w.setErr(err)
+			return err
+		}
+		return nil
+	}
+
+	w.pending = append(w.pending, streamWrite{buf: buf, off: off})
+	if len(w.pending) < w.b.cli.streamWriteBehind {
+		return nil
+	}
+	return w.sendPending()
+}
+
+// sendPending starts writing the batch of pending tracts.
+func (w *StreamWriter) sendPending() error {
+	if len(w.pending) == 0 {
+		return nil
+	}
+	pending := w.pending
+	w.pending = nil
+
+	// Create the tracts first, since writes that create tracts in parallel
+	// would conflict. The writes are contiguous, so one call covers them all.
+	last := pending[len(pending)-1]
+	if end := last.off + int64(len(last.buf)); end > w.end {
+		var err core.Error
+		w.b.cli.retrier.Do(w.b.ctx, func(seq int) bool {
+			err = w.b.cli.extendTo(w.b.ctx, w.b.id, end)
+			return !core.IsRetriableError(err)
+		})
+		if err != core.NoError {
+			w.setErr(err.Error())
+			return err.Error()
+		}
+		w.end = end
+	}
+
+	for _, p := range pending {
+		w.sem.Acquire()
+		w.wg.Add(1)
+		// Write through a copy of the blob, so that we don't race on its state.
+		b := *w.b
+		go func(p streamWrite) {
+			defer w.wg.Done()
+			defer w.sem.Release()
+			if _, err := b.WriteAt(p.buf, p.off); err != nil {
+				w.setErr(err)
+			}
+		}(p)
+	}
+	return nil
+}
+
+func (w *StreamWriter) getErr() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.err
+}
+
+func (w *StreamWriter) setErr(err error) {
+	w.lock.Lock()
+	if w.err == nil {
+		w.err = err
+	}
+	w.lock.Unlock()
+}
+
+// Flush writes all buffered data and waits for all writes to finish. It
+// returns the first error from any write.
+func (w *StreamWriter) Flush() error {
+	if w.getErr() == nil && w.send() == nil {
+		w.sendPending()
+	}
+	w.wg.Wait()
+	if err := w.getErr(); err != nil {
+		return err
+	}
+	if err := w.b.Flush(); err != nil {
+		w.setErr(err)
+		return err
+	}
+	w.b.offset = w.offset
+	if w.b.appendMinKnown && w.end > w.b.appendMin {
+		w.b.appendMin = w.end
+	}
+	return nil
+}
+
+// Close flushes the writer and then closes the underlying blob.
+func (w *StreamWriter) Close() error {
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return w.b.Close()
+}
+
+// Stat returns returns stat(2)-ish info about the blob.
+func (w *StreamWriter) Stat() (core.BlobInfo, error) {
+	return w.b.Stat()
+}
+
+// ID returns the BlobID of the blob.
+func (w *StreamWriter) ID() BlobID {
+	return w.b.ID()
+}
@@ -14,6 +14,13 @@ import (
 
 type tractInfoSlice []core.TractInfo
 
+// tractCacheEntry holds the cached tracts of one blob.
+type tractCacheEntry struct {
+	tracts tractInfoSlice
+	// Is the blob known to be stored as is, i.e. not compressed or encrypted?
+	plain bool
+}
+
 type tractCache struct {
 	lock  sync.Mutex
 	cache *lru.Cache
@@ -30,10 +37,11 @@ func (lc *tractCache) put(blob core.BlobID, tracts []core.TractInfo) {
 	v, ok := lc.cache.Get(blob)
 	if !ok {
 		// Nothing there yet, just drop them in.
-		lc.cache.Add(blob, tractInfoSlice(tracts))
+		lc.cache.Add(blob, &tractCacheEntry{tracts: tracts})
 	} else {
 		// Merge in using a map.
-		cache := v.(tractInfoSlice)
+		entry := v.(*tractCacheEntry)
+		cache := entry.tracts
 		cacheMap := make(map[core.TractKey]core.TractInfo, len(cache)+len(tracts))
 		for _, ti := range cache {
 			cacheMap[ti.Tract.Index] = ti
@@ -48,10 +56,29 @@ func (lc *tractCache) put(blob core.BlobID, tracts []core.TractInfo) {
 		}
 		sort.Sort(newCache)
 
-		lc.cache.Add(blob, newCache)
+		entry.tracts = newCache
+	}
+}
+
+// setPlain records that 'blob' isn't compressed or encrypted, if any of its
+// tracts are cached.
+func (lc *tractCache) setPlain(blob core.BlobID) {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+	if v, ok := lc.cache.Get(blob); ok {
+		v.(*tractCacheEntry).plain = true
 	}
 }
 
+// isPlain returns true if 'blob' is cached and known to not be compressed or
+// encrypted.
+func (lc *tractCache) isPlain(blob core.BlobID) bool {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+	v, ok := lc.cache.Get(blob)
+	return ok && v.(*tractCacheEntry).plain
+}
+
 func (lc *tractCache) get(blob core.BlobID, start, end int) (out []core.TractInfo, ok bool) {
 	lc.lock.Lock()
 	defer lc.lock.Unlock()
@@ -65,7 +92,7 @@ func (lc *tractCache) get(blob core.BlobID, start, end int) (out []core.TractInf
 	// We have some tracts cached, but maybe not these.
 
 	// Find potential start.
-	cache := v.(tractInfoSlice)
+	cache := v.(*tractCacheEntry).tracts
 	idx := sort.Search(len(cache), func(i int) bool {
 		return int(cache[i].Tract.Index) >= start
 	})
@@ -10,9 +10,9 @@ import (
 
 // TractserverTalker manages connections to tractservers.
 type TractserverTalker interface {
-	// Create creates a new tract on the tractserver and does a write to the
-	// newly created tract.
-	Create(ctx context.Context, addr string, tsid core.TractserverID, id core.TractID, b []byte, off int64) core.Error
+	// Create creates a new tract on the tractserver at 'version' and does a
+	// write to the newly created tract.
+	Create(ctx context.Context, addr string, tsid core.TractserverID, id core.TractID, version int, b []byte, off int64) core.Error
 
 	// Write does a write to a tract on this tractserver.
 	Write(ctx context.Context, addr string, id core.TractID, version int, b []byte, off int64) core.Error
@@ -24,6 +24,13 @@ type TractserverTalker interface {
 	// It returns the number of bytes read, as in io.Reader's Read.
 	ReadInto(ctx context.Context, addr string, id core.TractID, version int, b []byte, off int64) (int, core.Error)
 
+	// ReadV does several reads from tracts on one tractserver. It returns the
+	// data and result of each read, in order.
+	ReadV(ctx context.Context, addr string, reads []core.ReadVRange) ([][]byte, []core.Error)
+
+	// Truncate cuts a tract short, or pads it with zeros, to 'size' bytes.
+	Truncate(ctx context.Context, addr string, id core.TractID, version int, size int64) core.Error
+
 	// StatTract returns the number of bytes in a tract.
 	StatTract(ctx context.Context, addr string, id core.TractID, version int) (int64, core.Error)
 
@@ -0,0 +1,222 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package blb
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/golang/glog"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/server"
+)
+
+// Truncation:
+//
+// The length of a blob is the length of all of its tracts but the last, plus
+// the length of the last one. Truncating a blob cuts the new last tract short
+// on all of its replicas first, and then asks the curator to drop the tracts
+// after it. The curator has the tractservers delete those right away, since
+// tracts past the end of a blob are otherwise never garbage collected. Doing
+// the tractservers first means that a truncation that fails halfway can simply
+// be retried.
+//
+// Punching a hole cuts the tracts that lie entirely inside the hole down to
+// nothing, which frees their space on the tractservers. They stay in the blob,
+// and read as zeros like any other short tract that isn't the last one. The
+// parts of the hole in other tracts are overwritten with zeros.
+
+// truncate changes the length of blob 'id' to 'size'.
+func (cli *Client) truncate(ctx context.Context, id core.BlobID, size int64) core.Error {
+	if size < 0 {
+		return core.ErrInvalidArgument
+	}
+
+	curatorAddr, info, err := cli.statBlob(ctx, id)
+	if err != core.NoError {
+		return err
+	}
+	if info.Class != core.StorageClass_REPLICATED {
+		return core.ErrReadOnlyStorageClass
+	}
+
+	length, err := cli.byteLength(ctx, id)
+	if err != core.NoError {
+		return err
+	}
+	if size >= length {
+		if size > length {
+			// Tractservers pad tracts with zeros when writing past the end.
+			_, err = cli.writeAt(ctx, id, []byte{0}, size-1)
+		}
+		return err
+	}
+
+	// Whatever we have cached for the blob is about to be out of date.
+	defer cli.tractCache.invalidate(id)
+
+	keep := int((size + core.TractLength - 1) / core.TractLength)
+	lastLength := size - int64(keep-1)*core.TractLength
+	first := keep
+	if keep > 0 && lastLength < core.TractLength {
+		first = keep - 1
+	}
+	tracts, err := cli.curators.GetTracts(ctx, curatorAddr, id, first, info.NumTracts, false, true)
+	if err != core.NoError {
+		return err
+	}
+
+	// Tracts that are shared with clones have to be copied for them before we
+	// can drop or change them. Dropping a tract a clone shares with its origin
+	// just stops sharing it.
+	var shared []core.TractInfo
+	for i, tract := range tracts {
+		if tract.Shared && (tract.Tract.Blob == id || (first < keep && i == 0)) {
+			shared = append(shared, tract)
+		}
+	}
+	if unshared, err := cli.unshareTracts(ctx, curatorAddr, id, shared); err != core.NoError {
+		return err
+	} else if unshared {
+		return cli.truncate(ctx, id, size)
+	}
+
+	if first < keep {
+		last := tracts[0]
+		if last.Checksum.Length > int(lastLength) {
+			update := core.ChecksumUpdate{Index: last.Tract.Index, Old: last.Checksum}
+			if err = cli.updateChecksums(ctx, curatorAddr, id, tracts[:1], []core.ChecksumUpdate{update}); err != core.NoError {
+				return err
+			}
+		}
+		if err = cli.truncateTracts(ctx, curatorAddr, tracts[:1], lastLength); err != core.NoError {
+			return err
+		}
+	}
+	return cli.curators.TruncateBlob(ctx, curatorAddr, id, size)
+}
+
+// punchHole frees the space used by the 'length' bytes of blob 'id' at 'off',
+// which read as zeros afterwards.
+func (cli *Client) punchHole(ctx context.Context, id core.BlobID, off, length int64) core.Error {
+	if off < 0 || length < 0 {
+		return core.ErrInvalidArgument
+	}
+
+	curatorAddr, info, err := cli.statBlob(ctx, id)
+	if err != core.NoError {
+		return err
+	}
+	blobLength, err := cli.byteLength(ctx, id)
+	if err != core.NoError {
+		return err
+	}
+	end := off + length
+	if end > blobLength {
+		end = blobLength
+	}
+	if off >= end {
+		return core.NoError
+	}
+
+	// Tracts [start, stop) are entirely inside the hole. The last tract of
+	// the blob has to keep its length, so it's never one of them.
+	start := int((off + core.TractLength - 1) / core.TractLength)
+	stop := int(end / core.TractLength)
+	if stop > info.NumTracts-1 {
+		stop = info.NumTracts - 1
+	}
+	if start >= stop {
+		return cli.writeZeros(ctx, id, off, end)
+	}
+
+	if err = cli.writeZeros(ctx, id, off, int64(start)*core.TractLength); err != core.NoError {
+		return err
+	}
+	if err = cli.writeZeros(ctx, id, int64(stop)*core.TractLength, end); err != core.NoError {
+		return err
+	}
+
+	defer cli.tractCache.invalidate(id)
+	tracts, err := cli.curators.GetTracts(ctx, curatorAddr, id, start, stop, false, true)
+	if err != core.NoError {
+		return err
+	}
+	if unshared, err := cli.unshareTracts(ctx, curatorAddr, id, tracts); err != core.NoError {
+		return err
+	} else if unshared {
+		return cli.punchHole(ctx, id, off, length)
+	}
+
+	var updates []core.ChecksumUpdate
+	for _, tract := range tracts {
+		if tract.Checksum.Present() {
+			updates = append(updates, core.ChecksumUpdate{Index: tract.Tract.Index, Old: tract.Checksum})
+		}
+	}
+	if err = cli.updateChecksums(ctx, curatorAddr, id, tracts, updates); err != core.NoError {
+		return err
+	}
+	return cli.truncateTracts(ctx, curatorAddr, tracts, 0)
+}
+
+// writeZeros overwrites the bytes [off, end) of blob 'id' with zeros, a tract
+// at a time.
+func (cli *Client) writeZeros(ctx context.Context, id core.BlobID, off, end int64) core.Error {
+	if off >= end {
+		return core.NoError
+	}
+	zeros := make([]byte, min(int(end-off), core.TractLength))
+	for off < end {
+		n := min(int(end-off), len(zeros))
+		if _, err := cli.writeAt(ctx, id, zeros[:n], off); err != core.NoError {
+			return err
+		}
+		off += int64(n)
+	}
+	return core.NoError
+}
+
+// truncateTracts cuts all replicas of 'tracts' down to 'size' bytes.
+func (cli *Client) truncateTracts(ctx context.Context, curatorAddr string, tracts []core.TractInfo, size int64) core.Error {
+	for _, tract := range tracts {
+		for j, host := range tract.Hosts {
+			if host == "" {
+				log.Errorf("missing host for tsid %d truncating tract %s", tract.TSIDs[j], tract.Tract)
+				return core.ErrHostNotExist
+			}
+		}
+	}
+
+	sem := server.NewSemaphore(ParallelRPCs)
+	var wg sync.WaitGroup
+	results := make([][]core.Error, len(tracts))
+	for i, tract := range tracts {
+		results[i] = make([]core.Error, len(tract.Hosts))
+		for j, host := range tract.Hosts {
+			wg.Add(1)
+			go func(result *core.Error, tract core.TractInfo, host string) {
+				defer wg.Done()
+				sem.Acquire()
+				defer sem.Release()
+				*result = cli.tractservers.Truncate(ctx, host, tract.Tract, tract.Version, size)
+				log.V(1).Infof("truncate %s on %s to %d: %s", tract.Tract, host, size, *result)
+			}(&results[i][j], tract, host)
+		}
+	}
+	wg.Wait()
+
+	for i, tract := range tracts {
+		for j, err := range results[i] {
+			if err == core.ErrVersionMismatch {
+				cli.curators.FixVersion(context.Background(), curatorAddr, tract, tract.Hosts[j])
+			}
+			if err != core.NoError {
+				return err
+			}
+		}
+	}
+	return core.NoError
+}
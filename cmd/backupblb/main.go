@@ -9,6 +9,7 @@ import (
 
 	log "github.com/golang/glog"
 	"github.com/westerndigitalcorporation/blb/internal/backupblb"
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
 	"github.com/westerndigitalcorporation/blb/platform/clustersniff"
 )
 
@@ -16,8 +17,17 @@ func main() {
 	cfg := backupblb.DefaultConfig
 	flag.StringVar(&cfg.Cluster, "cluster", "", "cluster to back up")
 	flag.StringVar(&cfg.BaseDir, "base", "", "base directory to back up to")
+	tokenFile := flag.String("token", "", "file with the token to present, if the cluster requires authentication")
 	flag.Parse()
 
+	if *tokenFile != "" {
+		token, err := rpc.ReadToken(*tokenFile)
+		if err != nil {
+			log.Fatalf("Couldn't read token: %s", err)
+		}
+		rpc.SetToken(token)
+	}
+
 	if cfg.Cluster == "" {
 		cfg.Cluster = clustersniff.Cluster()
 	}
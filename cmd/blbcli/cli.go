@@ -11,6 +11,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/westerndigitalcorporation/blb/internal/cluster"
 	"github.com/westerndigitalcorporation/blb/internal/core"
 	"github.com/westerndigitalcorporation/blb/internal/fuse"
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
 	test "github.com/westerndigitalcorporation/blb/pkg/testutil"
 )
 
@@ -112,6 +115,10 @@ func newBlbCli() *blbCli {
 			Usage: "Buffer size for reads and writes without explicit length",
 			Value: 8 << 20,
 		},
+		cli.StringFlag{
+			Name:  "token",
+			Usage: "File with the token to present, if the cluster requires authentication",
+		},
 	}
 
 	blobflag := cli.StringFlag{
@@ -139,6 +146,10 @@ func newBlbCli() *blbCli {
 		Name:  "verbose, v",
 		Usage: "set this flag to add additional verbosity",
 	}
+	attrflag := cli.StringSliceFlag{
+		Name:  "attr, a",
+		Usage: "user metadata as key=value (may be repeated; with setmd, an empty value removes the key)",
+	}
 
 	// flags used by blb cluster.
 	masterFlag := cli.IntFlag{
@@ -185,6 +196,23 @@ func newBlbCli() *blbCli {
 			Usage:   "Creates a new blob.",
 			Flags: []cli.Flag{
 				replflag,
+				attrflag,
+				cli.BoolFlag{
+					Name:  "writeonce",
+					Usage: "create a write-once blob that must be sealed before reading",
+				},
+				cli.StringFlag{
+					Name:  "owner",
+					Usage: "tenant that owns the blob, for quotas",
+				},
+				cli.StringSliceFlag{
+					Name:  "reader",
+					Usage: "name that may read the blob (may be repeated)",
+				},
+				cli.StringSliceFlag{
+					Name:  "writer",
+					Usage: "name that may read and change the blob (may be repeated)",
+				},
 			},
 			Action: b.cmdCreate,
 		},
@@ -216,6 +244,108 @@ func newBlbCli() *blbCli {
 			},
 			Action: b.cmdUnRm,
 		},
+		{
+			Name:  "trash",
+			Usage: "Manages deleted blobs that haven't been purged yet.",
+			Subcommands: []cli.Command{
+				{
+					Name:   "ls",
+					Usage:  "Lists deleted blobs.",
+					Action: b.cmdTrashList,
+				},
+				{
+					Name:  "purge",
+					Usage: "Removes a deleted blob for good.",
+					Flags: []cli.Flag{
+						blobflag,
+					},
+					Action: b.cmdTrashPurge,
+				},
+				{
+					Name:  "restore",
+					Usage: "Un-deletes a blob.",
+					Flags: []cli.Flag{
+						blobflag,
+					},
+					Action: b.cmdUnRm,
+				},
+			},
+		},
+		{
+			Name:  "quota",
+			Usage: "Manages per-owner quotas.",
+			Subcommands: []cli.Command{
+				{
+					Name:   "ls",
+					Usage:  "Lists quotas and usage of each owner.",
+					Action: b.cmdQuotaList,
+				},
+				{
+					Name:  "set",
+					Usage: "Sets the quota of an owner. Zero means no limit.",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "owner",
+							Usage: "owner to set the quota of",
+						},
+						cli.Int64Flag{
+							Name:  "bytes",
+							Usage: "limit on the bytes of tracts used by the owner's blobs",
+						},
+						cli.Int64Flag{
+							Name:  "blobs",
+							Usage: "limit on the number of blobs of the owner",
+						},
+					},
+					Action: b.cmdQuotaSet,
+				},
+			},
+		},
+		{
+			Name:  "seal",
+			Usage: "Seals a write-once blob.",
+			Flags: []cli.Flag{
+				blobflag,
+			},
+			Action: b.cmdSeal,
+		},
+		{
+			Name:  "clone",
+			Usage: "Creates a copy-on-write clone of a blob.",
+			Flags: []cli.Flag{
+				blobflag,
+			},
+			Action: b.cmdClone,
+		},
+		{
+			Name:  "copy",
+			Usage: "Copies a blob to a new blob without reading it through the client.",
+			Flags: []cli.Flag{
+				blobflag,
+				replflag,
+			},
+			Action: b.cmdCopy,
+		},
+		{
+			Name:  "append-aligned",
+			Usage: "Copies other blobs to the end of a blob, each starting on a tract boundary.",
+			Flags: []cli.Flag{
+				blobflag,
+				cli.StringSliceFlag{
+					Name:  "src, s",
+					Usage: "blob to copy (may be repeated)",
+				},
+			},
+			Action: b.cmdAppendAligned,
+		},
+		{
+			Name:  "verify",
+			Usage: "Checks a blob's data against its end-to-end checksums.",
+			Flags: []cli.Flag{
+				blobflag,
+			},
+			Action: b.cmdVerify,
+		},
 		{
 			Name:    "setmd",
 			Aliases: []string{"setmetadata"},
@@ -238,6 +368,7 @@ func newBlbCli() *blbCli {
 					Name:  "expires",
 					Usage: "expiry time (auto-delete after this time)",
 				},
+				attrflag,
 			},
 			Action: b.cmdSetMd,
 		},
@@ -305,6 +436,31 @@ or a full path to a disk root.
 			},
 			Action: b.cmdWrite,
 		},
+		{
+			Name:  "truncate",
+			Usage: "Truncates or extends a blob.",
+			Flags: []cli.Flag{
+				blobflag,
+				cli.IntFlag{
+					Name:  "size, s",
+					Usage: "new length of the blob",
+				},
+			},
+			Action: b.cmdTruncate,
+		},
+		{
+			Name:  "punch",
+			Usage: "Zeroes a range of a blob and frees the space it used.",
+			Flags: []cli.Flag{
+				blobflag,
+				offsetflag,
+				cli.IntFlag{
+					Name:  "length, l",
+					Usage: "length of the range",
+				},
+			},
+			Action: b.cmdPunch,
+		},
 		{
 			Name:  "mount",
 			Usage: "Mounts blb as a filesystem using fuse.",
@@ -471,6 +627,15 @@ func (b *blbCli) getClient(c *cli.Context) *blb.Client {
 // This function will be called before any subcommand gets started so some setup
 // can be done here.
 func (b *blbCli) beforeSubcommandRun(c *cli.Context) error {
+	if path := c.GlobalString("token"); path != "" {
+		token, err := rpc.ReadToken(path)
+		if err != nil {
+			log.Errorf("Couldn't read token: %s", err)
+			return err
+		}
+		rpc.SetToken(token)
+	}
+
 	// See if users have some setup commands to run before any subcommand starts.
 	commands := c.GlobalStringSlice("setup")
 	if len(commands) != 0 {
@@ -497,7 +662,19 @@ func (b *blbCli) cmdInfo(c *cli.Context) {
 func (b *blbCli) cmdCreate(c *cli.Context) {
 	client := b.getClient(c)
 	repl := c.Int("repl")
-	blob, err := client.Create(blb.ReplFactor(repl))
+	attrs, err := parseAttrs(c.StringSlice("attr"))
+	if err != nil {
+		log.Errorf("%s", err)
+		return
+	}
+	owner := blb.WithOwner(c.String("owner"))
+	acl := blb.WithACL(c.StringSlice("reader"), c.StringSlice("writer"))
+	var blob *blb.Blob
+	if c.Bool("writeonce") {
+		blob, err = client.Create(blb.ReplFactor(repl), blb.WithMetadata(attrs), owner, acl, blb.WriteOnce)
+	} else {
+		blob, err = client.Create(blb.ReplFactor(repl), blb.WithMetadata(attrs), owner, acl)
+	}
 	if err != nil {
 		log.Errorf("Couldn't create blob: %s", err)
 		return
@@ -539,6 +716,23 @@ func (b *blbCli) cmdStat(c *cli.Context) {
 	log.Infof("     %16s MTime=%s ATime=%s", "", mt, at)
 	log.Infof("     %16s Expires=%s", "", et)
 	log.Infof("     %16s StorageHint=%s StorageClass=%s", "", info.Hint, info.Class)
+	if info.WriteOnce {
+		log.Infof("     %16s WriteOnce Sealed=%t", "", info.Sealed)
+	}
+	if info.Owner != "" {
+		log.Infof("     %16s Owner=%s", "", info.Owner)
+	}
+	if !info.ACL.IsEmpty() {
+		log.Infof("     %16s Readers=%s Writers=%s", "", strings.Join(info.ACL.Readers, ","), strings.Join(info.ACL.Writers, ","))
+	}
+	keys := make([]string, 0, len(info.Metadata))
+	for k := range info.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		log.Infof("     %16s %s=%q", "", k, info.Metadata[k])
+	}
 
 	if c.Bool("verbose") {
 		tracts, gtErr := client.GetTracts(context.Background(), blobid, 0, info.NumTracts)
@@ -585,6 +779,211 @@ func (b *blbCli) cmdUnRm(c *cli.Context) {
 	}
 }
 
+// cmdTrashList implements the "trash ls" subcommand.
+func (b *blbCli) cmdTrashList(c *cli.Context) {
+	client := b.getClient(c)
+	next := client.ListDeleted(context.Background())
+	for {
+		blobs, err := next()
+		if err != nil {
+			log.Errorf("Error: %s", err)
+			break
+		}
+		if blobs == nil {
+			break
+		}
+		for _, blob := range blobs {
+			pt := "---"
+			if !blob.Info.PurgeAt.IsZero() {
+				pt = blob.Info.PurgeAt.Format(time.RFC3339)
+			}
+			log.Infof("%s Deleted=%s PurgeAt=%s", blob.ID, blob.Info.Deleted.Format(time.RFC3339), pt)
+		}
+	}
+}
+
+// cmdTrashPurge implements the "trash purge" subcommand.
+func (b *blbCli) cmdTrashPurge(c *cli.Context) {
+	client := b.getClient(c)
+	blobid, err := blb.ParseBlobID(c.String("blob"))
+	if err != nil {
+		log.Errorf("Failed to parse blobID: %v", err)
+		return
+	}
+	if err := client.Purge(context.Background(), blobid); err != nil {
+		log.Errorf("Error purging blob %s: %s", blobid, err)
+		return
+	}
+	log.Infof("Blob %s purged", blobid)
+}
+
+// cmdQuotaList implements the "quota ls" subcommand.
+func (b *blbCli) cmdQuotaList(c *cli.Context) {
+	mc := blb.NewRPCMasterConnection(b.getCluster(c))
+	quotas, err := mc.GetQuotas(context.Background())
+	if err != core.NoError {
+		log.Errorf("Error: %s", err)
+		return
+	}
+	fmt.Printf("%-20s  %14s / %14s  %10s / %10s\n", "owner", "bytes", "limit", "blobs", "limit")
+	for _, q := range quotas {
+		fmt.Printf("%-20s  %14d / %14s  %10d / %10s\n", q.Owner,
+			q.Used.Bytes, quotaLimit(q.Limit.Bytes), q.Used.Blobs, quotaLimit(q.Limit.Blobs))
+	}
+}
+
+func quotaLimit(limit int64) string {
+	if limit == 0 {
+		return "---"
+	}
+	return strconv.FormatInt(limit, 10)
+}
+
+// cmdQuotaSet implements the "quota set" subcommand.
+func (b *blbCli) cmdQuotaSet(c *cli.Context) {
+	owner := c.String("owner")
+	if owner == "" {
+		log.Errorf("Must specify --owner")
+		return
+	}
+	limit := core.TenantUsage{Bytes: c.Int64("bytes"), Blobs: c.Int64("blobs")}
+	mc := blb.NewRPCMasterConnection(b.getCluster(c))
+	if err := mc.SetQuota(context.Background(), owner, limit); err != core.NoError {
+		log.Errorf("Error setting quota of %s: %s", owner, err)
+		return
+	}
+	log.Infof("Quota of %s set to %+v", owner, limit)
+}
+
+// cmdSeal implements the "seal" subcommand.
+func (b *blbCli) cmdSeal(c *cli.Context) {
+	client := b.getClient(c)
+	blobid, err := blb.ParseBlobID(c.String("blob"))
+	if err != nil {
+		log.Errorf("Failed to parse blobID: %v", err)
+		return
+	}
+	if err := client.Seal(context.Background(), blobid); err != nil {
+		log.Errorf("Error sealing blob %s: %s", blobid, err)
+		return
+	}
+	log.Infof("Blob %s sealed", blobid)
+}
+
+// cmdClone implements the "clone" subcommand.
+func (b *blbCli) cmdClone(c *cli.Context) {
+	client := b.getClient(c)
+	blobid, err := blb.ParseBlobID(c.String("blob"))
+	if err != nil {
+		log.Errorf("Failed to parse blobID: %v", err)
+		return
+	}
+	clone, err := client.Clone(context.Background(), blobid)
+	if err != nil {
+		log.Errorf("Error cloning blob %s: %s", blobid, err)
+		return
+	}
+	log.Infof("Blob %s cloned to %s", blobid, clone)
+}
+
+// cmdCopy implements the "copy" subcommand.
+func (b *blbCli) cmdCopy(c *cli.Context) {
+	client := b.getClient(c)
+	blobid, err := blb.ParseBlobID(c.String("blob"))
+	if err != nil {
+		log.Errorf("Failed to parse blobID: %v", err)
+		return
+	}
+	copied, err := client.Copy(context.Background(), blobid, blb.ReplFactor(c.Int("repl")))
+	if err != nil {
+		log.Errorf("Error copying blob %s: %s", blobid, err)
+		return
+	}
+	log.Infof("Blob %s copied to %s", blobid, copied)
+}
+
+// cmdAppendAligned implements the "append-aligned" subcommand.
+func (b *blbCli) cmdAppendAligned(c *cli.Context) {
+	client := b.getClient(c)
+	blobid, err := blb.ParseBlobID(c.String("blob"))
+	if err != nil {
+		log.Errorf("Failed to parse blobID: %v", err)
+		return
+	}
+	var srcs []blb.BlobID
+	for _, s := range c.StringSlice("src") {
+		src, err := blb.ParseBlobID(s)
+		if err != nil {
+			log.Errorf("Failed to parse blobID: %v", err)
+			return
+		}
+		srcs = append(srcs, src)
+	}
+	offsets, err := client.AppendAligned(context.Background(), blobid, srcs...)
+	if err != nil {
+		log.Errorf("Error appending to blob %s: %s", blobid, err)
+		return
+	}
+	for i, src := range srcs {
+		fmt.Printf("%s\t%d\n", src, offsets[i])
+	}
+}
+
+// cmdTruncate implements the "truncate" subcommand.
+func (b *blbCli) cmdTruncate(c *cli.Context) {
+	client := b.getClient(c)
+	blobid, err := blb.ParseBlobID(c.String("blob"))
+	if err != nil {
+		log.Errorf("Failed to parse blobID: %v", err)
+		return
+	}
+	blob, err := client.Open(blobid, "w")
+	if err != nil {
+		log.Errorf("Couldn't open blob: %v", err)
+		return
+	}
+	if err := blob.Truncate(int64(c.Int("size"))); err != nil {
+		log.Errorf("Error truncating blob %s: %s", blobid, err)
+		return
+	}
+	log.Infof("Blob %s truncated to %d bytes", blobid, c.Int("size"))
+}
+
+// cmdPunch implements the "punch" subcommand.
+func (b *blbCli) cmdPunch(c *cli.Context) {
+	client := b.getClient(c)
+	blobid, err := blb.ParseBlobID(c.String("blob"))
+	if err != nil {
+		log.Errorf("Failed to parse blobID: %v", err)
+		return
+	}
+	blob, err := client.Open(blobid, "w")
+	if err != nil {
+		log.Errorf("Couldn't open blob: %v", err)
+		return
+	}
+	if err := blob.PunchHole(int64(c.Int("offset")), int64(c.Int("length"))); err != nil {
+		log.Errorf("Error punching hole in blob %s: %s", blobid, err)
+		return
+	}
+	log.Infof("Punched hole of %d bytes at offset %d in blob %s", c.Int("length"), c.Int("offset"), blobid)
+}
+
+// cmdVerify implements the "verify" subcommand.
+func (b *blbCli) cmdVerify(c *cli.Context) {
+	client := b.getClient(c)
+	blobid, err := blb.ParseBlobID(c.String("blob"))
+	if err != nil {
+		log.Errorf("Failed to parse blobID: %v", err)
+		return
+	}
+	if err := client.VerifyBlob(context.Background(), blobid); err != nil {
+		log.Errorf("Error verifying blob %s: %s", blobid, err)
+		return
+	}
+	fmt.Printf("Blob %s OK\n", blobid)
+}
+
 // parses a time as a string, in RFC3339 or UnixDate format.
 func parseTime(v string) (t time.Time, e error) {
 	if t, e = time.Parse(time.RFC3339, v); e == nil {
@@ -593,6 +992,22 @@ func parseTime(v string) (t time.Time, e error) {
 	return time.Parse(time.UnixDate, v)
 }
 
+// parses user metadata given as key=value strings.
+func parseAttrs(vals []string) (map[string]string, error) {
+	if len(vals) == 0 {
+		return nil, nil
+	}
+	attrs := make(map[string]string)
+	for _, kv := range vals {
+		i := strings.Index(kv, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("couldn't parse attribute %q, expected key=value", kv)
+		}
+		attrs[kv[:i]] = kv[i+1:]
+	}
+	return attrs, nil
+}
+
 // cmdSetMd implements the "setmd" subcommand.
 func (b *blbCli) cmdSetMd(c *cli.Context) {
 	client := b.getClient(c)
@@ -634,6 +1049,11 @@ func (b *blbCli) cmdSetMd(c *cli.Context) {
 		}
 	}
 
+	if md.Metadata, err = parseAttrs(c.StringSlice("attr")); err != nil {
+		log.Errorf("%s", err)
+		return
+	}
+
 	blbErr := client.SetMetadata(context.Background(), blobid, md)
 	if blbErr == nil {
 		log.Infof("Blob %s updated", blobid)
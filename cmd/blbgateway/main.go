@@ -0,0 +1,44 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"flag"
+	"net/http"
+
+	log "github.com/golang/glog"
+
+	client "github.com/westerndigitalcorporation/blb/client/blb"
+	"github.com/westerndigitalcorporation/blb/internal/gateway"
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
+	"github.com/westerndigitalcorporation/blb/platform/clustersniff"
+)
+
+func main() {
+	cfg := gateway.DefaultConfig
+	flag.StringVar(&cfg.Cluster, "cluster", "", "cluster to serve")
+	flag.StringVar(&cfg.Addr, "addr", cfg.Addr, "address to serve S3 requests on")
+	flag.IntVar(&cfg.MaxKeys, "max_keys", cfg.MaxKeys, "most keys returned by one listing")
+	flag.DurationVar(&cfg.UploadExpiry, "upload_expiry", cfg.UploadExpiry, "how long multipart uploads may take before they expire")
+	// All S3 requests are made with this one identity, so blob ACLs don't
+	// separate the gateway's users.
+	tokenFile := flag.String("token", "", "file with the token to present, if the cluster requires authentication")
+	flag.Parse()
+
+	if *tokenFile != "" {
+		token, err := rpc.ReadToken(*tokenFile)
+		if err != nil {
+			log.Fatalf("Couldn't read token: %s", err)
+		}
+		rpc.SetToken(token)
+	}
+
+	if cfg.Cluster == "" {
+		cfg.Cluster = clustersniff.Cluster()
+	}
+
+	cli := client.NewClient(client.Options{Cluster: cfg.Cluster, Instance: "gateway"})
+	log.Infof("serving S3 requests for %s on %s", cfg.Cluster, cfg.Addr)
+	log.Fatal(http.ListenAndServe(cfg.Addr, gateway.NewGateway(cfg, cli)))
+}
@@ -12,6 +12,7 @@ import (
 	log "github.com/golang/glog"
 	"github.com/westerndigitalcorporation/blb/internal/curator"
 	"github.com/westerndigitalcorporation/blb/internal/curator/durable"
+	"github.com/westerndigitalcorporation/blb/internal/server"
 	"github.com/westerndigitalcorporation/blb/pkg/failures"
 	"github.com/westerndigitalcorporation/blb/pkg/raft/raft"
 	"github.com/westerndigitalcorporation/blb/pkg/raft/raftfs"
@@ -138,6 +139,10 @@ func main() {
 		log.Fatalf("Failed to validate configurations: %v", err)
 	}
 
+	if err := server.SetupAuth(curatorCfg.TokensFile, curatorCfg.TokenFile); err != nil {
+		log.Fatalf("Failed to set up authentication: %s", err)
+	}
+
 	// Initialize failure injection service.
 	if curatorCfg.UseFailure {
 		log.Infof("enabling failure service")
@@ -12,6 +12,7 @@ import (
 
 	"github.com/westerndigitalcorporation/blb/internal/master"
 	"github.com/westerndigitalcorporation/blb/internal/master/durable"
+	"github.com/westerndigitalcorporation/blb/internal/server"
 	"github.com/westerndigitalcorporation/blb/pkg/failures"
 	"github.com/westerndigitalcorporation/blb/pkg/raft/raft"
 	"github.com/westerndigitalcorporation/blb/pkg/raft/raftfs"
@@ -124,6 +125,10 @@ func init() {
 }
 
 func main() {
+	if err := server.SetupAuth(masterCfg.TokensFile, masterCfg.TokenFile); err != nil {
+		log.Fatalf("Failed to set up authentication: %s", err)
+	}
+
 	// Initialize failure injection service.
 	if masterCfg.UseFailure {
 		log.Infof("enabling failure service")
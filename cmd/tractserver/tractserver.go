@@ -10,6 +10,7 @@ import (
 
 	log "github.com/golang/glog"
 
+	"github.com/westerndigitalcorporation/blb/internal/server"
 	"github.com/westerndigitalcorporation/blb/internal/tractserver"
 	"github.com/westerndigitalcorporation/blb/pkg/failures"
 	"github.com/westerndigitalcorporation/blb/platform/clustersniff"
@@ -91,6 +92,10 @@ func main() {
 		log.Fatalf("Failed to validate configurations: %v", err)
 	}
 
+	if err := server.SetupAuth(cfg.TokensFile, cfg.TokenFile); err != nil {
+		log.Fatalf("Failed to set up authentication: %s", err)
+	}
+
 	// Initialize failure injection service.
 	if cfg.UseFailure {
 		log.Infof("enabling failure service")
@@ -20,6 +20,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/westerndigitalcorporation/blb/internal/server"
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
 )
 
 const (
@@ -177,6 +178,7 @@ func (b *BackupManager) fetchBackup(service string, state *GroupState, wg *sync.
 		if state.LastEtag != "" {
 			req.Header.Set("If-None-Match", state.LastEtag)
 		}
+		rpc.SetAuthHeader(req)
 		resp, err := b.cli.Do(req)
 		if err != nil {
 			log.Errorf("http error from %s %s: %s", service, addr, err)
@@ -24,6 +24,7 @@ import (
 	"github.com/westerndigitalcorporation/blb/internal/curator"
 	"github.com/westerndigitalcorporation/blb/internal/tractserver"
 	"github.com/westerndigitalcorporation/blb/pkg/failures"
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
 	test "github.com/westerndigitalcorporation/blb/pkg/testutil"
 )
 
@@ -407,6 +408,7 @@ func (t *TractServer) addDisk(root string) {
 	if err != nil {
 		log.Fatalf("NewRequest: %s", err)
 	}
+	rpc.SetAuthHeader(req)
 
 	cli := &http.Client{Transport: &http.Transport{
 		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
@@ -0,0 +1,19151 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: internal/core/blbpb/blb.proto
+
+package blbpb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/types"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type BlobID struct {
+	Value                uint64   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlobID) Reset()         { *m = BlobID{} }
+func (m *BlobID) String() string { return proto.CompactTextString(m) }
+func (*BlobID) ProtoMessage()    {}
+func (*BlobID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{0}
+}
+func (m *BlobID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BlobID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobID.Merge(dst, src)
+}
+func (m *BlobID) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobID) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobID proto.InternalMessageInfo
+
+func (m *BlobID) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type Error struct {
+	Value                int64    `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Error) Reset()         { *m = Error{} }
+func (m *Error) String() string { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()    {}
+func (*Error) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{1}
+}
+func (m *Error) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Error.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Error) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Error.Merge(dst, src)
+}
+func (m *Error) XXX_Size() int {
+	return m.Size()
+}
+func (m *Error) XXX_DiscardUnknown() {
+	xxx_messageInfo_Error.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Error proto.InternalMessageInfo
+
+func (m *Error) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type TractID struct {
+	Blob                 uint64   `protobuf:"varint,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	Index                uint32   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TractID) Reset()         { *m = TractID{} }
+func (m *TractID) String() string { return proto.CompactTextString(m) }
+func (*TractID) ProtoMessage()    {}
+func (*TractID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{2}
+}
+func (m *TractID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TractID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TractID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TractID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TractID.Merge(dst, src)
+}
+func (m *TractID) XXX_Size() int {
+	return m.Size()
+}
+func (m *TractID) XXX_DiscardUnknown() {
+	xxx_messageInfo_TractID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TractID proto.InternalMessageInfo
+
+func (m *TractID) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+func (m *TractID) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type RSChunkID struct {
+	Partition            uint32   `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Id                   uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RSChunkID) Reset()         { *m = RSChunkID{} }
+func (m *RSChunkID) String() string { return proto.CompactTextString(m) }
+func (*RSChunkID) ProtoMessage()    {}
+func (*RSChunkID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{3}
+}
+func (m *RSChunkID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RSChunkID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RSChunkID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RSChunkID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RSChunkID.Merge(dst, src)
+}
+func (m *RSChunkID) XXX_Size() int {
+	return m.Size()
+}
+func (m *RSChunkID) XXX_DiscardUnknown() {
+	xxx_messageInfo_RSChunkID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RSChunkID proto.InternalMessageInfo
+
+func (m *RSChunkID) GetPartition() uint32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *RSChunkID) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type TractChecksum struct {
+	Crc                  uint32   `protobuf:"varint,1,opt,name=crc,proto3" json:"crc,omitempty"`
+	Length               int64    `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TractChecksum) Reset()         { *m = TractChecksum{} }
+func (m *TractChecksum) String() string { return proto.CompactTextString(m) }
+func (*TractChecksum) ProtoMessage()    {}
+func (*TractChecksum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{4}
+}
+func (m *TractChecksum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TractChecksum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TractChecksum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TractChecksum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TractChecksum.Merge(dst, src)
+}
+func (m *TractChecksum) XXX_Size() int {
+	return m.Size()
+}
+func (m *TractChecksum) XXX_DiscardUnknown() {
+	xxx_messageInfo_TractChecksum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TractChecksum proto.InternalMessageInfo
+
+func (m *TractChecksum) GetCrc() uint32 {
+	if m != nil {
+		return m.Crc
+	}
+	return 0
+}
+
+func (m *TractChecksum) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+type TractInfo struct {
+	Tract   *TractID `protobuf:"bytes,1,opt,name=tract" json:"tract,omitempty"`
+	Version int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// For the REPLICATED storage class.
+	Hosts []string `protobuf:"bytes,3,rep,name=hosts" json:"hosts,omitempty"`
+	Tsids []uint32 `protobuf:"varint,4,rep,packed,name=tsids" json:"tsids,omitempty"`
+	// For RS classes.
+	Rs                   *TractPointer  `protobuf:"bytes,5,opt,name=rs" json:"rs,omitempty"`
+	Checksum             *TractChecksum `protobuf:"bytes,6,opt,name=checksum" json:"checksum,omitempty"`
+	Shared               bool           `protobuf:"varint,7,opt,name=shared,proto3" json:"shared,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TractInfo) Reset()         { *m = TractInfo{} }
+func (m *TractInfo) String() string { return proto.CompactTextString(m) }
+func (*TractInfo) ProtoMessage()    {}
+func (*TractInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{5}
+}
+func (m *TractInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TractInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TractInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TractInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TractInfo.Merge(dst, src)
+}
+func (m *TractInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TractInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TractInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TractInfo proto.InternalMessageInfo
+
+func (m *TractInfo) GetTract() *TractID {
+	if m != nil {
+		return m.Tract
+	}
+	return nil
+}
+
+func (m *TractInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TractInfo) GetHosts() []string {
+	if m != nil {
+		return m.Hosts
+	}
+	return nil
+}
+
+func (m *TractInfo) GetTsids() []uint32 {
+	if m != nil {
+		return m.Tsids
+	}
+	return nil
+}
+
+func (m *TractInfo) GetRs() *TractPointer {
+	if m != nil {
+		return m.Rs
+	}
+	return nil
+}
+
+func (m *TractInfo) GetChecksum() *TractChecksum {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *TractInfo) GetShared() bool {
+	if m != nil {
+		return m.Shared
+	}
+	return false
+}
+
+type TractPointer struct {
+	Chunk  *RSChunkID `protobuf:"bytes,1,opt,name=chunk" json:"chunk,omitempty"`
+	Host   string     `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Tsid   uint32     `protobuf:"varint,3,opt,name=tsid,proto3" json:"tsid,omitempty"`
+	Offset uint32     `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length uint32     `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+	// For reconstructing the tract from the other pieces of its chunk.
+	Class                int32      `protobuf:"varint,6,opt,name=class,proto3" json:"class,omitempty"`
+	BaseChunk            *RSChunkID `protobuf:"bytes,7,opt,name=base_chunk,json=baseChunk" json:"base_chunk,omitempty"`
+	OtherHosts           []string   `protobuf:"bytes,8,rep,name=other_hosts,json=otherHosts" json:"other_hosts,omitempty"`
+	OtherTsids           []uint32   `protobuf:"varint,9,rep,packed,name=other_tsids,json=otherTsids" json:"other_tsids,omitempty"`
+	Data                 int64      `protobuf:"varint,10,opt,name=data,proto3" json:"data,omitempty"`
+	Lrc                  *LRCParams `protobuf:"bytes,11,opt,name=lrc" json:"lrc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *TractPointer) Reset()         { *m = TractPointer{} }
+func (m *TractPointer) String() string { return proto.CompactTextString(m) }
+func (*TractPointer) ProtoMessage()    {}
+func (*TractPointer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{6}
+}
+func (m *TractPointer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TractPointer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TractPointer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TractPointer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TractPointer.Merge(dst, src)
+}
+func (m *TractPointer) XXX_Size() int {
+	return m.Size()
+}
+func (m *TractPointer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TractPointer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TractPointer proto.InternalMessageInfo
+
+func (m *TractPointer) GetChunk() *RSChunkID {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (m *TractPointer) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *TractPointer) GetTsid() uint32 {
+	if m != nil {
+		return m.Tsid
+	}
+	return 0
+}
+
+func (m *TractPointer) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *TractPointer) GetLength() uint32 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *TractPointer) GetClass() int32 {
+	if m != nil {
+		return m.Class
+	}
+	return 0
+}
+
+func (m *TractPointer) GetBaseChunk() *RSChunkID {
+	if m != nil {
+		return m.BaseChunk
+	}
+	return nil
+}
+
+func (m *TractPointer) GetOtherHosts() []string {
+	if m != nil {
+		return m.OtherHosts
+	}
+	return nil
+}
+
+func (m *TractPointer) GetOtherTsids() []uint32 {
+	if m != nil {
+		return m.OtherTsids
+	}
+	return nil
+}
+
+func (m *TractPointer) GetData() int64 {
+	if m != nil {
+		return m.Data
+	}
+	return 0
+}
+
+func (m *TractPointer) GetLrc() *LRCParams {
+	if m != nil {
+		return m.Lrc
+	}
+	return nil
+}
+
+type LRCParams struct {
+	K                    int64    `protobuf:"varint,1,opt,name=k,proto3" json:"k,omitempty"`
+	L                    int64    `protobuf:"varint,2,opt,name=l,proto3" json:"l,omitempty"`
+	R                    int64    `protobuf:"varint,3,opt,name=r,proto3" json:"r,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LRCParams) Reset()         { *m = LRCParams{} }
+func (m *LRCParams) String() string { return proto.CompactTextString(m) }
+func (*LRCParams) ProtoMessage()    {}
+func (*LRCParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{7}
+}
+func (m *LRCParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LRCParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LRCParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *LRCParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LRCParams.Merge(dst, src)
+}
+func (m *LRCParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LRCParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LRCParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LRCParams proto.InternalMessageInfo
+
+func (m *LRCParams) GetK() int64 {
+	if m != nil {
+		return m.K
+	}
+	return 0
+}
+
+func (m *LRCParams) GetL() int64 {
+	if m != nil {
+		return m.L
+	}
+	return 0
+}
+
+func (m *LRCParams) GetR() int64 {
+	if m != nil {
+		return m.R
+	}
+	return 0
+}
+
+type ACL struct {
+	Readers              []string `protobuf:"bytes,1,rep,name=readers" json:"readers,omitempty"`
+	Writers              []string `protobuf:"bytes,2,rep,name=writers" json:"writers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ACL) Reset()         { *m = ACL{} }
+func (m *ACL) String() string { return proto.CompactTextString(m) }
+func (*ACL) ProtoMessage()    {}
+func (*ACL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{8}
+}
+func (m *ACL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ACL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ACL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ACL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ACL.Merge(dst, src)
+}
+func (m *ACL) XXX_Size() int {
+	return m.Size()
+}
+func (m *ACL) XXX_DiscardUnknown() {
+	xxx_messageInfo_ACL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ACL proto.InternalMessageInfo
+
+func (m *ACL) GetReaders() []string {
+	if m != nil {
+		return m.Readers
+	}
+	return nil
+}
+
+func (m *ACL) GetWriters() []string {
+	if m != nil {
+		return m.Writers
+	}
+	return nil
+}
+
+type BlobInfo struct {
+	Repl                 int64             `protobuf:"varint,1,opt,name=repl,proto3" json:"repl,omitempty"`
+	NumTracts            int64             `protobuf:"varint,2,opt,name=num_tracts,json=numTracts,proto3" json:"num_tracts,omitempty"`
+	Mtime                int64             `protobuf:"varint,3,opt,name=mtime,proto3" json:"mtime,omitempty"`
+	Atime                int64             `protobuf:"varint,4,opt,name=atime,proto3" json:"atime,omitempty"`
+	Class                int32             `protobuf:"varint,5,opt,name=class,proto3" json:"class,omitempty"`
+	Hint                 int32             `protobuf:"varint,6,opt,name=hint,proto3" json:"hint,omitempty"`
+	Expires              int64             `protobuf:"varint,7,opt,name=expires,proto3" json:"expires,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,8,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	WriteOnce            bool              `protobuf:"varint,9,opt,name=write_once,json=writeOnce,proto3" json:"write_once,omitempty"`
+	Sealed               bool              `protobuf:"varint,10,opt,name=sealed,proto3" json:"sealed,omitempty"`
+	Deleted              int64             `protobuf:"varint,11,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	PurgeAt              int64             `protobuf:"varint,12,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	Owner                string            `protobuf:"bytes,13,opt,name=owner,proto3" json:"owner,omitempty"`
+	Acl                  *ACL              `protobuf:"bytes,14,opt,name=acl" json:"acl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BlobInfo) Reset()         { *m = BlobInfo{} }
+func (m *BlobInfo) String() string { return proto.CompactTextString(m) }
+func (*BlobInfo) ProtoMessage()    {}
+func (*BlobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{9}
+}
+func (m *BlobInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BlobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobInfo.Merge(dst, src)
+}
+func (m *BlobInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobInfo proto.InternalMessageInfo
+
+func (m *BlobInfo) GetRepl() int64 {
+	if m != nil {
+		return m.Repl
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetNumTracts() int64 {
+	if m != nil {
+		return m.NumTracts
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetMtime() int64 {
+	if m != nil {
+		return m.Mtime
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetAtime() int64 {
+	if m != nil {
+		return m.Atime
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetClass() int32 {
+	if m != nil {
+		return m.Class
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetHint() int32 {
+	if m != nil {
+		return m.Hint
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *BlobInfo) GetWriteOnce() bool {
+	if m != nil {
+		return m.WriteOnce
+	}
+	return false
+}
+
+func (m *BlobInfo) GetSealed() bool {
+	if m != nil {
+		return m.Sealed
+	}
+	return false
+}
+
+func (m *BlobInfo) GetDeleted() int64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetPurgeAt() int64 {
+	if m != nil {
+		return m.PurgeAt
+	}
+	return 0
+}
+
+func (m *BlobInfo) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *BlobInfo) GetAcl() *ACL {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+type BlobFilter struct {
+	Classes              []int32  `protobuf:"varint,1,rep,packed,name=classes" json:"classes,omitempty"`
+	Hints                []int32  `protobuf:"varint,2,rep,packed,name=hints" json:"hints,omitempty"`
+	MtimeAfter           int64    `protobuf:"varint,3,opt,name=mtime_after,json=mtimeAfter,proto3" json:"mtime_after,omitempty"`
+	MtimeBefore          int64    `protobuf:"varint,4,opt,name=mtime_before,json=mtimeBefore,proto3" json:"mtime_before,omitempty"`
+	AtimeAfter           int64    `protobuf:"varint,5,opt,name=atime_after,json=atimeAfter,proto3" json:"atime_after,omitempty"`
+	AtimeBefore          int64    `protobuf:"varint,6,opt,name=atime_before,json=atimeBefore,proto3" json:"atime_before,omitempty"`
+	ExpiresBefore        int64    `protobuf:"varint,7,opt,name=expires_before,json=expiresBefore,proto3" json:"expires_before,omitempty"`
+	Deleted              bool     `protobuf:"varint,8,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlobFilter) Reset()         { *m = BlobFilter{} }
+func (m *BlobFilter) String() string { return proto.CompactTextString(m) }
+func (*BlobFilter) ProtoMessage()    {}
+func (*BlobFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{10}
+}
+func (m *BlobFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BlobFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobFilter.Merge(dst, src)
+}
+func (m *BlobFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobFilter proto.InternalMessageInfo
+
+func (m *BlobFilter) GetClasses() []int32 {
+	if m != nil {
+		return m.Classes
+	}
+	return nil
+}
+
+func (m *BlobFilter) GetHints() []int32 {
+	if m != nil {
+		return m.Hints
+	}
+	return nil
+}
+
+func (m *BlobFilter) GetMtimeAfter() int64 {
+	if m != nil {
+		return m.MtimeAfter
+	}
+	return 0
+}
+
+func (m *BlobFilter) GetMtimeBefore() int64 {
+	if m != nil {
+		return m.MtimeBefore
+	}
+	return 0
+}
+
+func (m *BlobFilter) GetAtimeAfter() int64 {
+	if m != nil {
+		return m.AtimeAfter
+	}
+	return 0
+}
+
+func (m *BlobFilter) GetAtimeBefore() int64 {
+	if m != nil {
+		return m.AtimeBefore
+	}
+	return 0
+}
+
+func (m *BlobFilter) GetExpiresBefore() int64 {
+	if m != nil {
+		return m.ExpiresBefore
+	}
+	return 0
+}
+
+func (m *BlobFilter) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+type BlobEvent struct {
+	Type                 uint32   `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Blob                 uint64   `protobuf:"varint,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	Class                int32    `protobuf:"varint,3,opt,name=class,proto3" json:"class,omitempty"`
+	Index                uint64   `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlobEvent) Reset()         { *m = BlobEvent{} }
+func (m *BlobEvent) String() string { return proto.CompactTextString(m) }
+func (*BlobEvent) ProtoMessage()    {}
+func (*BlobEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{11}
+}
+func (m *BlobEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BlobEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobEvent.Merge(dst, src)
+}
+func (m *BlobEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobEvent proto.InternalMessageInfo
+
+func (m *BlobEvent) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *BlobEvent) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+func (m *BlobEvent) GetClass() int32 {
+	if m != nil {
+		return m.Class
+	}
+	return 0
+}
+
+func (m *BlobEvent) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ChecksumUpdate struct {
+	Index                uint32         `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Old                  *TractChecksum `protobuf:"bytes,2,opt,name=old" json:"old,omitempty"`
+	New                  *TractChecksum `protobuf:"bytes,3,opt,name=new" json:"new,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChecksumUpdate) Reset()         { *m = ChecksumUpdate{} }
+func (m *ChecksumUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChecksumUpdate) ProtoMessage()    {}
+func (*ChecksumUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{12}
+}
+func (m *ChecksumUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChecksumUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChecksumUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ChecksumUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChecksumUpdate.Merge(dst, src)
+}
+func (m *ChecksumUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChecksumUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChecksumUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChecksumUpdate proto.InternalMessageInfo
+
+func (m *ChecksumUpdate) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ChecksumUpdate) GetOld() *TractChecksum {
+	if m != nil {
+		return m.Old
+	}
+	return nil
+}
+
+func (m *ChecksumUpdate) GetNew() *TractChecksum {
+	if m != nil {
+		return m.New
+	}
+	return nil
+}
+
+type NameEntry struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Blob                 uint64   `protobuf:"varint,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NameEntry) Reset()         { *m = NameEntry{} }
+func (m *NameEntry) String() string { return proto.CompactTextString(m) }
+func (*NameEntry) ProtoMessage()    {}
+func (*NameEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{13}
+}
+func (m *NameEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *NameEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameEntry.Merge(dst, src)
+}
+func (m *NameEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameEntry proto.InternalMessageInfo
+
+func (m *NameEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NameEntry) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+type TenantUsage struct {
+	Bytes                int64    `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Blobs                int64    `protobuf:"varint,2,opt,name=blobs,proto3" json:"blobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TenantUsage) Reset()         { *m = TenantUsage{} }
+func (m *TenantUsage) String() string { return proto.CompactTextString(m) }
+func (*TenantUsage) ProtoMessage()    {}
+func (*TenantUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{14}
+}
+func (m *TenantUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TenantUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TenantUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TenantUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TenantUsage.Merge(dst, src)
+}
+func (m *TenantUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TenantUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TenantUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TenantUsage proto.InternalMessageInfo
+
+func (m *TenantUsage) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *TenantUsage) GetBlobs() int64 {
+	if m != nil {
+		return m.Blobs
+	}
+	return 0
+}
+
+type TenantQuota struct {
+	Owner                string       `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Limit                *TenantUsage `protobuf:"bytes,2,opt,name=limit" json:"limit,omitempty"`
+	Used                 *TenantUsage `protobuf:"bytes,3,opt,name=used" json:"used,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TenantQuota) Reset()         { *m = TenantQuota{} }
+func (m *TenantQuota) String() string { return proto.CompactTextString(m) }
+func (*TenantQuota) ProtoMessage()    {}
+func (*TenantQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{15}
+}
+func (m *TenantQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TenantQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TenantQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TenantQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TenantQuota.Merge(dst, src)
+}
+func (m *TenantQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *TenantQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_TenantQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TenantQuota proto.InternalMessageInfo
+
+func (m *TenantQuota) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TenantQuota) GetLimit() *TenantUsage {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *TenantQuota) GetUsed() *TenantUsage {
+	if m != nil {
+		return m.Used
+	}
+	return nil
+}
+
+type DiskControlFlags struct {
+	StopAllocating       bool     `protobuf:"varint,1,opt,name=stop_allocating,json=stopAllocating,proto3" json:"stop_allocating,omitempty"`
+	Drain                int64    `protobuf:"varint,2,opt,name=drain,proto3" json:"drain,omitempty"`
+	DrainLocal           int64    `protobuf:"varint,3,opt,name=drain_local,json=drainLocal,proto3" json:"drain_local,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiskControlFlags) Reset()         { *m = DiskControlFlags{} }
+func (m *DiskControlFlags) String() string { return proto.CompactTextString(m) }
+func (*DiskControlFlags) ProtoMessage()    {}
+func (*DiskControlFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{16}
+}
+func (m *DiskControlFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiskControlFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiskControlFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DiskControlFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskControlFlags.Merge(dst, src)
+}
+func (m *DiskControlFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiskControlFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskControlFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskControlFlags proto.InternalMessageInfo
+
+func (m *DiskControlFlags) GetStopAllocating() bool {
+	if m != nil {
+		return m.StopAllocating
+	}
+	return false
+}
+
+func (m *DiskControlFlags) GetDrain() int64 {
+	if m != nil {
+		return m.Drain
+	}
+	return 0
+}
+
+func (m *DiskControlFlags) GetDrainLocal() int64 {
+	if m != nil {
+		return m.DrainLocal
+	}
+	return 0
+}
+
+type DiskStatus struct {
+	Root                 string            `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Full                 bool              `protobuf:"varint,2,opt,name=full,proto3" json:"full,omitempty"`
+	Healthy              bool              `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	QueueLen             int64             `protobuf:"varint,4,opt,name=queue_len,json=queueLen,proto3" json:"queue_len,omitempty"`
+	AvgWaitMs            int64             `protobuf:"varint,5,opt,name=avg_wait_ms,json=avgWaitMs,proto3" json:"avg_wait_ms,omitempty"`
+	Flags                *DiskControlFlags `protobuf:"bytes,6,opt,name=flags" json:"flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DiskStatus) Reset()         { *m = DiskStatus{} }
+func (m *DiskStatus) String() string { return proto.CompactTextString(m) }
+func (*DiskStatus) ProtoMessage()    {}
+func (*DiskStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{17}
+}
+func (m *DiskStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiskStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiskStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DiskStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskStatus.Merge(dst, src)
+}
+func (m *DiskStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiskStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskStatus proto.InternalMessageInfo
+
+func (m *DiskStatus) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *DiskStatus) GetFull() bool {
+	if m != nil {
+		return m.Full
+	}
+	return false
+}
+
+func (m *DiskStatus) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *DiskStatus) GetQueueLen() int64 {
+	if m != nil {
+		return m.QueueLen
+	}
+	return 0
+}
+
+func (m *DiskStatus) GetAvgWaitMs() int64 {
+	if m != nil {
+		return m.AvgWaitMs
+	}
+	return 0
+}
+
+func (m *DiskStatus) GetFlags() *DiskControlFlags {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+type FsStatus struct {
+	Status               *DiskStatus       `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
+	Ops                  map[string]string `protobuf:"bytes,2,rep,name=ops" json:"ops,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NumTracts            int64             `protobuf:"varint,3,opt,name=num_tracts,json=numTracts,proto3" json:"num_tracts,omitempty"`
+	NumDeletedTracts     int64             `protobuf:"varint,4,opt,name=num_deleted_tracts,json=numDeletedTracts,proto3" json:"num_deleted_tracts,omitempty"`
+	NumUnknownFiles      int64             `protobuf:"varint,5,opt,name=num_unknown_files,json=numUnknownFiles,proto3" json:"num_unknown_files,omitempty"`
+	AvailSpace           uint64            `protobuf:"varint,6,opt,name=avail_space,json=availSpace,proto3" json:"avail_space,omitempty"`
+	TotalSpace           uint64            `protobuf:"varint,7,opt,name=total_space,json=totalSpace,proto3" json:"total_space,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *FsStatus) Reset()         { *m = FsStatus{} }
+func (m *FsStatus) String() string { return proto.CompactTextString(m) }
+func (*FsStatus) ProtoMessage()    {}
+func (*FsStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{18}
+}
+func (m *FsStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FsStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FsStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *FsStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FsStatus.Merge(dst, src)
+}
+func (m *FsStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *FsStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_FsStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FsStatus proto.InternalMessageInfo
+
+func (m *FsStatus) GetStatus() *DiskStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *FsStatus) GetOps() map[string]string {
+	if m != nil {
+		return m.Ops
+	}
+	return nil
+}
+
+func (m *FsStatus) GetNumTracts() int64 {
+	if m != nil {
+		return m.NumTracts
+	}
+	return 0
+}
+
+func (m *FsStatus) GetNumDeletedTracts() int64 {
+	if m != nil {
+		return m.NumDeletedTracts
+	}
+	return 0
+}
+
+func (m *FsStatus) GetNumUnknownFiles() int64 {
+	if m != nil {
+		return m.NumUnknownFiles
+	}
+	return 0
+}
+
+func (m *FsStatus) GetAvailSpace() uint64 {
+	if m != nil {
+		return m.AvailSpace
+	}
+	return 0
+}
+
+func (m *FsStatus) GetTotalSpace() uint64 {
+	if m != nil {
+		return m.TotalSpace
+	}
+	return 0
+}
+
+type TractserverInfo struct {
+	Id                   uint32      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Addr                 string      `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Disks                []*FsStatus `protobuf:"bytes,3,rep,name=disks" json:"disks,omitempty"`
+	LastHeartbeat        int64       `protobuf:"varint,4,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TractserverInfo) Reset()         { *m = TractserverInfo{} }
+func (m *TractserverInfo) String() string { return proto.CompactTextString(m) }
+func (*TractserverInfo) ProtoMessage()    {}
+func (*TractserverInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{19}
+}
+func (m *TractserverInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TractserverInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TractserverInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TractserverInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TractserverInfo.Merge(dst, src)
+}
+func (m *TractserverInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TractserverInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TractserverInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TractserverInfo proto.InternalMessageInfo
+
+func (m *TractserverInfo) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TractserverInfo) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TractserverInfo) GetDisks() []*FsStatus {
+	if m != nil {
+		return m.Disks
+	}
+	return nil
+}
+
+func (m *TractserverInfo) GetLastHeartbeat() int64 {
+	if m != nil {
+		return m.LastHeartbeat
+	}
+	return 0
+}
+
+type LookupCuratorReq struct {
+	Blob                 uint64   `protobuf:"varint,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupCuratorReq) Reset()         { *m = LookupCuratorReq{} }
+func (m *LookupCuratorReq) String() string { return proto.CompactTextString(m) }
+func (*LookupCuratorReq) ProtoMessage()    {}
+func (*LookupCuratorReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{20}
+}
+func (m *LookupCuratorReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupCuratorReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupCuratorReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *LookupCuratorReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupCuratorReq.Merge(dst, src)
+}
+func (m *LookupCuratorReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LookupCuratorReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupCuratorReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupCuratorReq proto.InternalMessageInfo
+
+func (m *LookupCuratorReq) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+type LookupCuratorReply struct {
+	Replicas             []string `protobuf:"bytes,1,rep,name=replicas" json:"replicas,omitempty"`
+	Err                  int64    `protobuf:"varint,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupCuratorReply) Reset()         { *m = LookupCuratorReply{} }
+func (m *LookupCuratorReply) String() string { return proto.CompactTextString(m) }
+func (*LookupCuratorReply) ProtoMessage()    {}
+func (*LookupCuratorReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{21}
+}
+func (m *LookupCuratorReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupCuratorReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupCuratorReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *LookupCuratorReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupCuratorReply.Merge(dst, src)
+}
+func (m *LookupCuratorReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *LookupCuratorReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupCuratorReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupCuratorReply proto.InternalMessageInfo
+
+func (m *LookupCuratorReply) GetReplicas() []string {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+func (m *LookupCuratorReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type LookupPartitionReq struct {
+	Partition            uint32   `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPartitionReq) Reset()         { *m = LookupPartitionReq{} }
+func (m *LookupPartitionReq) String() string { return proto.CompactTextString(m) }
+func (*LookupPartitionReq) ProtoMessage()    {}
+func (*LookupPartitionReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{22}
+}
+func (m *LookupPartitionReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupPartitionReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupPartitionReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *LookupPartitionReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPartitionReq.Merge(dst, src)
+}
+func (m *LookupPartitionReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LookupPartitionReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPartitionReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPartitionReq proto.InternalMessageInfo
+
+func (m *LookupPartitionReq) GetPartition() uint32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+type LookupPartitionReply struct {
+	Replicas             []string `protobuf:"bytes,1,rep,name=replicas" json:"replicas,omitempty"`
+	Err                  int64    `protobuf:"varint,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPartitionReply) Reset()         { *m = LookupPartitionReply{} }
+func (m *LookupPartitionReply) String() string { return proto.CompactTextString(m) }
+func (*LookupPartitionReply) ProtoMessage()    {}
+func (*LookupPartitionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{23}
+}
+func (m *LookupPartitionReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupPartitionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupPartitionReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *LookupPartitionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPartitionReply.Merge(dst, src)
+}
+func (m *LookupPartitionReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *LookupPartitionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPartitionReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPartitionReply proto.InternalMessageInfo
+
+func (m *LookupPartitionReply) GetReplicas() []string {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+func (m *LookupPartitionReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type MasterCreateBlobReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MasterCreateBlobReq) Reset()         { *m = MasterCreateBlobReq{} }
+func (m *MasterCreateBlobReq) String() string { return proto.CompactTextString(m) }
+func (*MasterCreateBlobReq) ProtoMessage()    {}
+func (*MasterCreateBlobReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{24}
+}
+func (m *MasterCreateBlobReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MasterCreateBlobReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MasterCreateBlobReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *MasterCreateBlobReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MasterCreateBlobReq.Merge(dst, src)
+}
+func (m *MasterCreateBlobReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *MasterCreateBlobReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MasterCreateBlobReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MasterCreateBlobReq proto.InternalMessageInfo
+
+type ListPartitionsReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPartitionsReq) Reset()         { *m = ListPartitionsReq{} }
+func (m *ListPartitionsReq) String() string { return proto.CompactTextString(m) }
+func (*ListPartitionsReq) ProtoMessage()    {}
+func (*ListPartitionsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{25}
+}
+func (m *ListPartitionsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPartitionsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPartitionsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListPartitionsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPartitionsReq.Merge(dst, src)
+}
+func (m *ListPartitionsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPartitionsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPartitionsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPartitionsReq proto.InternalMessageInfo
+
+type ListPartitionsReply struct {
+	Partitions           []uint32 `protobuf:"varint,1,rep,packed,name=partitions" json:"partitions,omitempty"`
+	Err                  int64    `protobuf:"varint,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPartitionsReply) Reset()         { *m = ListPartitionsReply{} }
+func (m *ListPartitionsReply) String() string { return proto.CompactTextString(m) }
+func (*ListPartitionsReply) ProtoMessage()    {}
+func (*ListPartitionsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{26}
+}
+func (m *ListPartitionsReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPartitionsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPartitionsReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListPartitionsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPartitionsReply.Merge(dst, src)
+}
+func (m *ListPartitionsReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPartitionsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPartitionsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPartitionsReply proto.InternalMessageInfo
+
+func (m *ListPartitionsReply) GetPartitions() []uint32 {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *ListPartitionsReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type GetTractserverInfoReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTractserverInfoReq) Reset()         { *m = GetTractserverInfoReq{} }
+func (m *GetTractserverInfoReq) String() string { return proto.CompactTextString(m) }
+func (*GetTractserverInfoReq) ProtoMessage()    {}
+func (*GetTractserverInfoReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{27}
+}
+func (m *GetTractserverInfoReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTractserverInfoReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTractserverInfoReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetTractserverInfoReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTractserverInfoReq.Merge(dst, src)
+}
+func (m *GetTractserverInfoReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTractserverInfoReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTractserverInfoReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTractserverInfoReq proto.InternalMessageInfo
+
+type GetTractserverInfoReply struct {
+	Info                 []*TractserverInfo `protobuf:"bytes,1,rep,name=info" json:"info,omitempty"`
+	Err                  int64              `protobuf:"varint,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetTractserverInfoReply) Reset()         { *m = GetTractserverInfoReply{} }
+func (m *GetTractserverInfoReply) String() string { return proto.CompactTextString(m) }
+func (*GetTractserverInfoReply) ProtoMessage()    {}
+func (*GetTractserverInfoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{28}
+}
+func (m *GetTractserverInfoReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTractserverInfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTractserverInfoReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetTractserverInfoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTractserverInfoReply.Merge(dst, src)
+}
+func (m *GetTractserverInfoReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTractserverInfoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTractserverInfoReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTractserverInfoReply proto.InternalMessageInfo
+
+func (m *GetTractserverInfoReply) GetInfo() []*TractserverInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *GetTractserverInfoReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type GetQuotasReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetQuotasReq) Reset()         { *m = GetQuotasReq{} }
+func (m *GetQuotasReq) String() string { return proto.CompactTextString(m) }
+func (*GetQuotasReq) ProtoMessage()    {}
+func (*GetQuotasReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{29}
+}
+func (m *GetQuotasReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetQuotasReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetQuotasReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetQuotasReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuotasReq.Merge(dst, src)
+}
+func (m *GetQuotasReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetQuotasReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuotasReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuotasReq proto.InternalMessageInfo
+
+type GetQuotasReply struct {
+	Quotas               []*TenantQuota `protobuf:"bytes,1,rep,name=quotas" json:"quotas,omitempty"`
+	Err                  int64          `protobuf:"varint,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetQuotasReply) Reset()         { *m = GetQuotasReply{} }
+func (m *GetQuotasReply) String() string { return proto.CompactTextString(m) }
+func (*GetQuotasReply) ProtoMessage()    {}
+func (*GetQuotasReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{30}
+}
+func (m *GetQuotasReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetQuotasReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetQuotasReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetQuotasReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuotasReply.Merge(dst, src)
+}
+func (m *GetQuotasReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetQuotasReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuotasReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuotasReply proto.InternalMessageInfo
+
+func (m *GetQuotasReply) GetQuotas() []*TenantQuota {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+func (m *GetQuotasReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type SetQuotaReq struct {
+	Owner                string       `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Limit                *TenantUsage `protobuf:"bytes,2,opt,name=limit" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SetQuotaReq) Reset()         { *m = SetQuotaReq{} }
+func (m *SetQuotaReq) String() string { return proto.CompactTextString(m) }
+func (*SetQuotaReq) ProtoMessage()    {}
+func (*SetQuotaReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{31}
+}
+func (m *SetQuotaReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetQuotaReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetQuotaReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SetQuotaReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetQuotaReq.Merge(dst, src)
+}
+func (m *SetQuotaReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetQuotaReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetQuotaReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetQuotaReq proto.InternalMessageInfo
+
+func (m *SetQuotaReq) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *SetQuotaReq) GetLimit() *TenantUsage {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+type SetQuotaReply struct {
+	Err                  int64    `protobuf:"varint,1,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetQuotaReply) Reset()         { *m = SetQuotaReply{} }
+func (m *SetQuotaReply) String() string { return proto.CompactTextString(m) }
+func (*SetQuotaReply) ProtoMessage()    {}
+func (*SetQuotaReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{32}
+}
+func (m *SetQuotaReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetQuotaReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetQuotaReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SetQuotaReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetQuotaReply.Merge(dst, src)
+}
+func (m *SetQuotaReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetQuotaReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetQuotaReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetQuotaReply proto.InternalMessageInfo
+
+func (m *SetQuotaReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type CreateBlobReq struct {
+	Repl                 int64             `protobuf:"varint,1,opt,name=repl,proto3" json:"repl,omitempty"`
+	Hint                 int32             `protobuf:"varint,2,opt,name=hint,proto3" json:"hint,omitempty"`
+	Expires              int64             `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	WriteOnce            bool              `protobuf:"varint,5,opt,name=write_once,json=writeOnce,proto3" json:"write_once,omitempty"`
+	Owner                string            `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	Acl                  *ACL              `protobuf:"bytes,7,opt,name=acl" json:"acl,omitempty"`
+	Name                 string            `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateBlobReq) Reset()         { *m = CreateBlobReq{} }
+func (m *CreateBlobReq) String() string { return proto.CompactTextString(m) }
+func (*CreateBlobReq) ProtoMessage()    {}
+func (*CreateBlobReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{33}
+}
+func (m *CreateBlobReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateBlobReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateBlobReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *CreateBlobReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBlobReq.Merge(dst, src)
+}
+func (m *CreateBlobReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateBlobReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBlobReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateBlobReq proto.InternalMessageInfo
+
+func (m *CreateBlobReq) GetRepl() int64 {
+	if m != nil {
+		return m.Repl
+	}
+	return 0
+}
+
+func (m *CreateBlobReq) GetHint() int32 {
+	if m != nil {
+		return m.Hint
+	}
+	return 0
+}
+
+func (m *CreateBlobReq) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+func (m *CreateBlobReq) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateBlobReq) GetWriteOnce() bool {
+	if m != nil {
+		return m.WriteOnce
+	}
+	return false
+}
+
+func (m *CreateBlobReq) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *CreateBlobReq) GetAcl() *ACL {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+func (m *CreateBlobReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CreateBlobReply struct {
+	Err                  int64    `protobuf:"varint,1,opt,name=err,proto3" json:"err,omitempty"`
+	Id                   uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateBlobReply) Reset()         { *m = CreateBlobReply{} }
+func (m *CreateBlobReply) String() string { return proto.CompactTextString(m) }
+func (*CreateBlobReply) ProtoMessage()    {}
+func (*CreateBlobReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{34}
+}
+func (m *CreateBlobReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateBlobReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateBlobReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *CreateBlobReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBlobReply.Merge(dst, src)
+}
+func (m *CreateBlobReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateBlobReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBlobReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateBlobReply proto.InternalMessageInfo
+
+func (m *CreateBlobReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+func (m *CreateBlobReply) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ExtendBlobReq struct {
+	Blob                 uint64   `protobuf:"varint,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	NumTracts            int64    `protobuf:"varint,2,opt,name=num_tracts,json=numTracts,proto3" json:"num_tracts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtendBlobReq) Reset()         { *m = ExtendBlobReq{} }
+func (m *ExtendBlobReq) String() string { return proto.CompactTextString(m) }
+func (*ExtendBlobReq) ProtoMessage()    {}
+func (*ExtendBlobReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{35}
+}
+func (m *ExtendBlobReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendBlobReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendBlobReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ExtendBlobReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendBlobReq.Merge(dst, src)
+}
+func (m *ExtendBlobReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendBlobReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendBlobReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendBlobReq proto.InternalMessageInfo
+
+func (m *ExtendBlobReq) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+func (m *ExtendBlobReq) GetNumTracts() int64 {
+	if m != nil {
+		return m.NumTracts
+	}
+	return 0
+}
+
+type ExtendBlobReply struct {
+	Err                  int64        `protobuf:"varint,1,opt,name=err,proto3" json:"err,omitempty"`
+	NewTracts            []*TractInfo `protobuf:"bytes,2,rep,name=new_tracts,json=newTracts" json:"new_tracts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ExtendBlobReply) Reset()         { *m = ExtendBlobReply{} }
+func (m *ExtendBlobReply) String() string { return proto.CompactTextString(m) }
+func (*ExtendBlobReply) ProtoMessage()    {}
+func (*ExtendBlobReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{36}
+}
+func (m *ExtendBlobReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendBlobReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendBlobReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ExtendBlobReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendBlobReply.Merge(dst, src)
+}
+func (m *ExtendBlobReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendBlobReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendBlobReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendBlobReply proto.InternalMessageInfo
+
+func (m *ExtendBlobReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+func (m *ExtendBlobReply) GetNewTracts() []*TractInfo {
+	if m != nil {
+		return m.NewTracts
+	}
+	return nil
+}
+
+type AckExtendBlobReq struct {
+	Blob                 uint64            `protobuf:"varint,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	Tracts               []*TractInfo      `protobuf:"bytes,2,rep,name=tracts" json:"tracts,omitempty"`
+	Checksums            []*ChecksumUpdate `protobuf:"bytes,3,rep,name=checksums" json:"checksums,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AckExtendBlobReq) Reset()         { *m = AckExtendBlobReq{} }
+func (m *AckExtendBlobReq) String() string { return proto.CompactTextString(m) }
+func (*AckExtendBlobReq) ProtoMessage()    {}
+func (*AckExtendBlobReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{37}
+}
+func (m *AckExtendBlobReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AckExtendBlobReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AckExtendBlobReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *AckExtendBlobReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AckExtendBlobReq.Merge(dst, src)
+}
+func (m *AckExtendBlobReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *AckExtendBlobReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AckExtendBlobReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AckExtendBlobReq proto.InternalMessageInfo
+
+func (m *AckExtendBlobReq) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+func (m *AckExtendBlobReq) GetTracts() []*TractInfo {
+	if m != nil {
+		return m.Tracts
+	}
+	return nil
+}
+
+func (m *AckExtendBlobReq) GetChecksums() []*ChecksumUpdate {
+	if m != nil {
+		return m.Checksums
+	}
+	return nil
+}
+
+type AckExtendBlobReply struct {
+	NumTracts            int64    `protobuf:"varint,1,opt,name=num_tracts,json=numTracts,proto3" json:"num_tracts,omitempty"`
+	Err                  int64    `protobuf:"varint,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AckExtendBlobReply) Reset()         { *m = AckExtendBlobReply{} }
+func (m *AckExtendBlobReply) String() string { return proto.CompactTextString(m) }
+func (*AckExtendBlobReply) ProtoMessage()    {}
+func (*AckExtendBlobReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{38}
+}
+func (m *AckExtendBlobReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AckExtendBlobReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AckExtendBlobReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *AckExtendBlobReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AckExtendBlobReply.Merge(dst, src)
+}
+func (m *AckExtendBlobReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *AckExtendBlobReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AckExtendBlobReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AckExtendBlobReply proto.InternalMessageInfo
+
+func (m *AckExtendBlobReply) GetNumTracts() int64 {
+	if m != nil {
+		return m.NumTracts
+	}
+	return 0
+}
+
+func (m *AckExtendBlobReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type SetMetadataReq struct {
+	Blob                 uint64    `protobuf:"varint,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	Metadata             *BlobInfo `protobuf:"bytes,2,opt,name=metadata" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SetMetadataReq) Reset()         { *m = SetMetadataReq{} }
+func (m *SetMetadataReq) String() string { return proto.CompactTextString(m) }
+func (*SetMetadataReq) ProtoMessage()    {}
+func (*SetMetadataReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{39}
+}
+func (m *SetMetadataReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetMetadataReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetMetadataReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SetMetadataReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMetadataReq.Merge(dst, src)
+}
+func (m *SetMetadataReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetMetadataReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMetadataReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMetadataReq proto.InternalMessageInfo
+
+func (m *SetMetadataReq) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+func (m *SetMetadataReq) GetMetadata() *BlobInfo {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type UpdateChecksumsReq struct {
+	Blob                 uint64            `protobuf:"varint,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	Updates              []*ChecksumUpdate `protobuf:"bytes,2,rep,name=updates" json:"updates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateChecksumsReq) Reset()         { *m = UpdateChecksumsReq{} }
+func (m *UpdateChecksumsReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateChecksumsReq) ProtoMessage()    {}
+func (*UpdateChecksumsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{40}
+}
+func (m *UpdateChecksumsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateChecksumsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateChecksumsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *UpdateChecksumsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateChecksumsReq.Merge(dst, src)
+}
+func (m *UpdateChecksumsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateChecksumsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateChecksumsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateChecksumsReq proto.InternalMessageInfo
+
+func (m *UpdateChecksumsReq) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+func (m *UpdateChecksumsReq) GetUpdates() []*ChecksumUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+type ReserveAppendReq struct {
+	Blob                 uint64   `protobuf:"varint,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	Length               int64    `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	MinOffset            int64    `protobuf:"varint,3,opt,name=min_offset,json=minOffset,proto3" json:"min_offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveAppendReq) Reset()         { *m = ReserveAppendReq{} }
+func (m *ReserveAppendReq) String() string { return proto.CompactTextString(m) }
+func (*ReserveAppendReq) ProtoMessage()    {}
+func (*ReserveAppendReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{41}
+}
+func (m *ReserveAppendReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveAppendReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveAppendReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReserveAppendReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveAppendReq.Merge(dst, src)
+}
+func (m *ReserveAppendReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveAppendReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveAppendReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveAppendReq proto.InternalMessageInfo
+
+func (m *ReserveAppendReq) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+func (m *ReserveAppendReq) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *ReserveAppendReq) GetMinOffset() int64 {
+	if m != nil {
+		return m.MinOffset
+	}
+	return 0
+}
+
+type ReserveAppendReply struct {
+	Offset               int64    `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Err                  int64    `protobuf:"varint,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveAppendReply) Reset()         { *m = ReserveAppendReply{} }
+func (m *ReserveAppendReply) String() string { return proto.CompactTextString(m) }
+func (*ReserveAppendReply) ProtoMessage()    {}
+func (*ReserveAppendReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{42}
+}
+func (m *ReserveAppendReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveAppendReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveAppendReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReserveAppendReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveAppendReply.Merge(dst, src)
+}
+func (m *ReserveAppendReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveAppendReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveAppendReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveAppendReply proto.InternalMessageInfo
+
+func (m *ReserveAppendReply) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ReserveAppendReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type TruncateBlobReq struct {
+	Blob                 uint64   `protobuf:"varint,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	Size_                int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TruncateBlobReq) Reset()         { *m = TruncateBlobReq{} }
+func (m *TruncateBlobReq) String() string { return proto.CompactTextString(m) }
+func (*TruncateBlobReq) ProtoMessage()    {}
+func (*TruncateBlobReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{43}
+}
+func (m *TruncateBlobReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TruncateBlobReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TruncateBlobReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TruncateBlobReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateBlobReq.Merge(dst, src)
+}
+func (m *TruncateBlobReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *TruncateBlobReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateBlobReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateBlobReq proto.InternalMessageInfo
+
+func (m *TruncateBlobReq) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+func (m *TruncateBlobReq) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+type UnshareTractReq struct {
+	Blob                 uint64   `protobuf:"varint,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	Index                uint32   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnshareTractReq) Reset()         { *m = UnshareTractReq{} }
+func (m *UnshareTractReq) String() string { return proto.CompactTextString(m) }
+func (*UnshareTractReq) ProtoMessage()    {}
+func (*UnshareTractReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{44}
+}
+func (m *UnshareTractReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnshareTractReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnshareTractReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *UnshareTractReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnshareTractReq.Merge(dst, src)
+}
+func (m *UnshareTractReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnshareTractReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnshareTractReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnshareTractReq proto.InternalMessageInfo
+
+func (m *UnshareTractReq) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+func (m *UnshareTractReq) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type CopyTractsReq struct {
+	Dst                  uint64   `protobuf:"varint,1,opt,name=dst,proto3" json:"dst,omitempty"`
+	First                uint32   `protobuf:"varint,2,opt,name=first,proto3" json:"first,omitempty"`
+	Src                  uint64   `protobuf:"varint,3,opt,name=src,proto3" json:"src,omitempty"`
+	Start                int64    `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64    `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CopyTractsReq) Reset()         { *m = CopyTractsReq{} }
+func (m *CopyTractsReq) String() string { return proto.CompactTextString(m) }
+func (*CopyTractsReq) ProtoMessage()    {}
+func (*CopyTractsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{45}
+}
+func (m *CopyTractsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CopyTractsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CopyTractsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *CopyTractsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyTractsReq.Merge(dst, src)
+}
+func (m *CopyTractsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *CopyTractsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyTractsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopyTractsReq proto.InternalMessageInfo
+
+func (m *CopyTractsReq) GetDst() uint64 {
+	if m != nil {
+		return m.Dst
+	}
+	return 0
+}
+
+func (m *CopyTractsReq) GetFirst() uint32 {
+	if m != nil {
+		return m.First
+	}
+	return 0
+}
+
+func (m *CopyTractsReq) GetSrc() uint64 {
+	if m != nil {
+		return m.Src
+	}
+	return 0
+}
+
+func (m *CopyTractsReq) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *CopyTractsReq) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type GetTractsReq struct {
+	Blob                 uint64   `protobuf:"varint,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	Start                int64    `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64    `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	ForRead              bool     `protobuf:"varint,4,opt,name=for_read,json=forRead,proto3" json:"for_read,omitempty"`
+	ForWrite             bool     `protobuf:"varint,5,opt,name=for_write,json=forWrite,proto3" json:"for_write,omitempty"`
+	WithInfo             bool     `protobuf:"varint,6,opt,name=with_info,json=withInfo,proto3" json:"with_info,omitempty"`
+	AllowUnsealed        bool     `protobuf:"varint,7,opt,name=allow_unsealed,json=allowUnsealed,proto3" json:"allow_unsealed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTractsReq) Reset()         { *m = GetTractsReq{} }
+func (m *GetTractsReq) String() string { return proto.CompactTextString(m) }
+func (*GetTractsReq) ProtoMessage()    {}
+func (*GetTractsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{46}
+}
+func (m *GetTractsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTractsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTractsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetTractsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTractsReq.Merge(dst, src)
+}
+func (m *GetTractsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTractsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTractsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTractsReq proto.InternalMessageInfo
+
+func (m *GetTractsReq) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+func (m *GetTractsReq) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *GetTractsReq) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *GetTractsReq) GetForRead() bool {
+	if m != nil {
+		return m.ForRead
+	}
+	return false
+}
+
+func (m *GetTractsReq) GetForWrite() bool {
+	if m != nil {
+		return m.ForWrite
+	}
+	return false
+}
+
+func (m *GetTractsReq) GetWithInfo() bool {
+	if m != nil {
+		return m.WithInfo
+	}
+	return false
+}
+
+func (m *GetTractsReq) GetAllowUnsealed() bool {
+	if m != nil {
+		return m.AllowUnsealed
+	}
+	return false
+}
+
+type GetTractsReply struct {
+	Tracts []*TractInfo `protobuf:"bytes,1,rep,name=tracts" json:"tracts,omitempty"`
+	Err    int64        `protobuf:"varint,2,opt,name=err,proto3" json:"err,omitempty"`
+	// Only set if with_info was.
+	Info                 *BlobInfo `protobuf:"bytes,3,opt,name=info" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetTractsReply) Reset()         { *m = GetTractsReply{} }
+func (m *GetTractsReply) String() string { return proto.CompactTextString(m) }
+func (*GetTractsReply) ProtoMessage()    {}
+func (*GetTractsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{47}
+}
+func (m *GetTractsReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTractsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTractsReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetTractsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTractsReply.Merge(dst, src)
+}
+func (m *GetTractsReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTractsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTractsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTractsReply proto.InternalMessageInfo
+
+func (m *GetTractsReply) GetTracts() []*TractInfo {
+	if m != nil {
+		return m.Tracts
+	}
+	return nil
+}
+
+func (m *GetTractsReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+func (m *GetTractsReply) GetInfo() *BlobInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type StatBlobReply struct {
+	Err                  int64     `protobuf:"varint,1,opt,name=err,proto3" json:"err,omitempty"`
+	Info                 *BlobInfo `protobuf:"bytes,2,opt,name=info" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *StatBlobReply) Reset()         { *m = StatBlobReply{} }
+func (m *StatBlobReply) String() string { return proto.CompactTextString(m) }
+func (*StatBlobReply) ProtoMessage()    {}
+func (*StatBlobReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{48}
+}
+func (m *StatBlobReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatBlobReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatBlobReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *StatBlobReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatBlobReply.Merge(dst, src)
+}
+func (m *StatBlobReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatBlobReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatBlobReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatBlobReply proto.InternalMessageInfo
+
+func (m *StatBlobReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+func (m *StatBlobReply) GetInfo() *BlobInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ReportBadTSReq struct {
+	Id                   *TractID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Bad                  string   `protobuf:"bytes,2,opt,name=bad,proto3" json:"bad,omitempty"`
+	Operation            string   `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	GotError             int64    `protobuf:"varint,4,opt,name=got_error,json=gotError,proto3" json:"got_error,omitempty"`
+	CouldRecover         bool     `protobuf:"varint,5,opt,name=could_recover,json=couldRecover,proto3" json:"could_recover,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportBadTSReq) Reset()         { *m = ReportBadTSReq{} }
+func (m *ReportBadTSReq) String() string { return proto.CompactTextString(m) }
+func (*ReportBadTSReq) ProtoMessage()    {}
+func (*ReportBadTSReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{49}
+}
+func (m *ReportBadTSReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportBadTSReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportBadTSReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReportBadTSReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportBadTSReq.Merge(dst, src)
+}
+func (m *ReportBadTSReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportBadTSReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportBadTSReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportBadTSReq proto.InternalMessageInfo
+
+func (m *ReportBadTSReq) GetId() *TractID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ReportBadTSReq) GetBad() string {
+	if m != nil {
+		return m.Bad
+	}
+	return ""
+}
+
+func (m *ReportBadTSReq) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *ReportBadTSReq) GetGotError() int64 {
+	if m != nil {
+		return m.GotError
+	}
+	return 0
+}
+
+func (m *ReportBadTSReq) GetCouldRecover() bool {
+	if m != nil {
+		return m.CouldRecover
+	}
+	return false
+}
+
+type FixVersionReq struct {
+	Info                 *TractInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Bad                  string     `protobuf:"bytes,2,opt,name=bad,proto3" json:"bad,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *FixVersionReq) Reset()         { *m = FixVersionReq{} }
+func (m *FixVersionReq) String() string { return proto.CompactTextString(m) }
+func (*FixVersionReq) ProtoMessage()    {}
+func (*FixVersionReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{50}
+}
+func (m *FixVersionReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FixVersionReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FixVersionReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *FixVersionReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixVersionReq.Merge(dst, src)
+}
+func (m *FixVersionReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *FixVersionReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixVersionReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixVersionReq proto.InternalMessageInfo
+
+func (m *FixVersionReq) GetInfo() *TractInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *FixVersionReq) GetBad() string {
+	if m != nil {
+		return m.Bad
+	}
+	return ""
+}
+
+type ListBlobsReq struct {
+	Partition uint32 `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Start     uint32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	// If not set, all blobs that aren't deleted are listed.
+	Filter               *BlobFilter `protobuf:"bytes,3,opt,name=filter" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListBlobsReq) Reset()         { *m = ListBlobsReq{} }
+func (m *ListBlobsReq) String() string { return proto.CompactTextString(m) }
+func (*ListBlobsReq) ProtoMessage()    {}
+func (*ListBlobsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{51}
+}
+func (m *ListBlobsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListBlobsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListBlobsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListBlobsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlobsReq.Merge(dst, src)
+}
+func (m *ListBlobsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListBlobsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlobsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBlobsReq proto.InternalMessageInfo
+
+func (m *ListBlobsReq) GetPartition() uint32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *ListBlobsReq) GetStart() uint32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ListBlobsReq) GetFilter() *BlobFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type ListBlobsReply struct {
+	Keys                 []uint32    `protobuf:"varint,1,rep,packed,name=keys" json:"keys,omitempty"`
+	Err                  int64       `protobuf:"varint,2,opt,name=err,proto3" json:"err,omitempty"`
+	Infos                []*BlobInfo `protobuf:"bytes,3,rep,name=infos" json:"infos,omitempty"`
+	Next                 uint32      `protobuf:"varint,4,opt,name=next,proto3" json:"next,omitempty"`
+	More                 bool        `protobuf:"varint,5,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListBlobsReply) Reset()         { *m = ListBlobsReply{} }
+func (m *ListBlobsReply) String() string { return proto.CompactTextString(m) }
+func (*ListBlobsReply) ProtoMessage()    {}
+func (*ListBlobsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{52}
+}
+func (m *ListBlobsReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListBlobsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListBlobsReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListBlobsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlobsReply.Merge(dst, src)
+}
+func (m *ListBlobsReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListBlobsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlobsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBlobsReply proto.InternalMessageInfo
+
+func (m *ListBlobsReply) GetKeys() []uint32 {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ListBlobsReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+func (m *ListBlobsReply) GetInfos() []*BlobInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *ListBlobsReply) GetNext() uint32 {
+	if m != nil {
+		return m.Next
+	}
+	return 0
+}
+
+func (m *ListBlobsReply) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type GetEventsReq struct {
+	Partition            uint32   `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	After                uint64   `protobuf:"varint,2,opt,name=after,proto3" json:"after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEventsReq) Reset()         { *m = GetEventsReq{} }
+func (m *GetEventsReq) String() string { return proto.CompactTextString(m) }
+func (*GetEventsReq) ProtoMessage()    {}
+func (*GetEventsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{53}
+}
+func (m *GetEventsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEventsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEventsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetEventsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsReq.Merge(dst, src)
+}
+func (m *GetEventsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEventsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEventsReq proto.InternalMessageInfo
+
+func (m *GetEventsReq) GetPartition() uint32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *GetEventsReq) GetAfter() uint64 {
+	if m != nil {
+		return m.After
+	}
+	return 0
+}
+
+type GetEventsReply struct {
+	Events               []*BlobEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	Next                 uint64       `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+	Err                  int64        `protobuf:"varint,3,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetEventsReply) Reset()         { *m = GetEventsReply{} }
+func (m *GetEventsReply) String() string { return proto.CompactTextString(m) }
+func (*GetEventsReply) ProtoMessage()    {}
+func (*GetEventsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{54}
+}
+func (m *GetEventsReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEventsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEventsReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetEventsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsReply.Merge(dst, src)
+}
+func (m *GetEventsReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEventsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEventsReply proto.InternalMessageInfo
+
+func (m *GetEventsReply) GetEvents() []*BlobEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *GetEventsReply) GetNext() uint64 {
+	if m != nil {
+		return m.Next
+	}
+	return 0
+}
+
+func (m *GetEventsReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type LookupNameReply struct {
+	Blob                 uint64   `protobuf:"varint,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	Err                  int64    `protobuf:"varint,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupNameReply) Reset()         { *m = LookupNameReply{} }
+func (m *LookupNameReply) String() string { return proto.CompactTextString(m) }
+func (*LookupNameReply) ProtoMessage()    {}
+func (*LookupNameReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{55}
+}
+func (m *LookupNameReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupNameReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupNameReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *LookupNameReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupNameReply.Merge(dst, src)
+}
+func (m *LookupNameReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *LookupNameReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupNameReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupNameReply proto.InternalMessageInfo
+
+func (m *LookupNameReply) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+func (m *LookupNameReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type RenameReq struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Replace              uint64   `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenameReq) Reset()         { *m = RenameReq{} }
+func (m *RenameReq) String() string { return proto.CompactTextString(m) }
+func (*RenameReq) ProtoMessage()    {}
+func (*RenameReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{56}
+}
+func (m *RenameReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenameReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenameReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RenameReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameReq.Merge(dst, src)
+}
+func (m *RenameReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenameReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameReq proto.InternalMessageInfo
+
+func (m *RenameReq) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *RenameReq) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *RenameReq) GetReplace() uint64 {
+	if m != nil {
+		return m.Replace
+	}
+	return 0
+}
+
+type UnbindNameReq struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Blob                 uint64   `protobuf:"varint,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnbindNameReq) Reset()         { *m = UnbindNameReq{} }
+func (m *UnbindNameReq) String() string { return proto.CompactTextString(m) }
+func (*UnbindNameReq) ProtoMessage()    {}
+func (*UnbindNameReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{57}
+}
+func (m *UnbindNameReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbindNameReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbindNameReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *UnbindNameReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbindNameReq.Merge(dst, src)
+}
+func (m *UnbindNameReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbindNameReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbindNameReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbindNameReq proto.InternalMessageInfo
+
+func (m *UnbindNameReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UnbindNameReq) GetBlob() uint64 {
+	if m != nil {
+		return m.Blob
+	}
+	return 0
+}
+
+type ListNamesReq struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Start                string   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListNamesReq) Reset()         { *m = ListNamesReq{} }
+func (m *ListNamesReq) String() string { return proto.CompactTextString(m) }
+func (*ListNamesReq) ProtoMessage()    {}
+func (*ListNamesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{58}
+}
+func (m *ListNamesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListNamesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListNamesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListNamesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNamesReq.Merge(dst, src)
+}
+func (m *ListNamesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListNamesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNamesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNamesReq proto.InternalMessageInfo
+
+func (m *ListNamesReq) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ListNamesReq) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+type ListNamesReply struct {
+	Entries              []*NameEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	Err                  int64        `protobuf:"varint,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListNamesReply) Reset()         { *m = ListNamesReply{} }
+func (m *ListNamesReply) String() string { return proto.CompactTextString(m) }
+func (*ListNamesReply) ProtoMessage()    {}
+func (*ListNamesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{59}
+}
+func (m *ListNamesReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListNamesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListNamesReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListNamesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNamesReply.Merge(dst, src)
+}
+func (m *ListNamesReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListNamesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNamesReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNamesReply proto.InternalMessageInfo
+
+func (m *ListNamesReply) GetEntries() []*NameEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *ListNamesReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type CreateTractReq struct {
+	Tsid                 uint32   `protobuf:"varint,1,opt,name=tsid,proto3" json:"tsid,omitempty"`
+	Id                   *TractID `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	Off                  int64    `protobuf:"varint,3,opt,name=off,proto3" json:"off,omitempty"`
+	Pri                  int32    `protobuf:"varint,4,opt,name=pri,proto3" json:"pri,omitempty"`
+	Version              int64    `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTractReq) Reset()         { *m = CreateTractReq{} }
+func (m *CreateTractReq) String() string { return proto.CompactTextString(m) }
+func (*CreateTractReq) ProtoMessage()    {}
+func (*CreateTractReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{60}
+}
+func (m *CreateTractReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateTractReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateTractReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *CreateTractReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTractReq.Merge(dst, src)
+}
+func (m *CreateTractReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateTractReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTractReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTractReq proto.InternalMessageInfo
+
+func (m *CreateTractReq) GetTsid() uint32 {
+	if m != nil {
+		return m.Tsid
+	}
+	return 0
+}
+
+func (m *CreateTractReq) GetId() *TractID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *CreateTractReq) GetOff() int64 {
+	if m != nil {
+		return m.Off
+	}
+	return 0
+}
+
+func (m *CreateTractReq) GetPri() int32 {
+	if m != nil {
+		return m.Pri
+	}
+	return 0
+}
+
+func (m *CreateTractReq) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type WriteReq struct {
+	Id                   *TractID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Off                  int64    `protobuf:"varint,3,opt,name=off,proto3" json:"off,omitempty"`
+	Pri                  int32    `protobuf:"varint,4,opt,name=pri,proto3" json:"pri,omitempty"`
+	ReqId                string   `protobuf:"bytes,5,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WriteReq) Reset()         { *m = WriteReq{} }
+func (m *WriteReq) String() string { return proto.CompactTextString(m) }
+func (*WriteReq) ProtoMessage()    {}
+func (*WriteReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{61}
+}
+func (m *WriteReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *WriteReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteReq.Merge(dst, src)
+}
+func (m *WriteReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteReq proto.InternalMessageInfo
+
+func (m *WriteReq) GetId() *TractID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *WriteReq) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *WriteReq) GetOff() int64 {
+	if m != nil {
+		return m.Off
+	}
+	return 0
+}
+
+func (m *WriteReq) GetPri() int32 {
+	if m != nil {
+		return m.Pri
+	}
+	return 0
+}
+
+func (m *WriteReq) GetReqId() string {
+	if m != nil {
+		return m.ReqId
+	}
+	return ""
+}
+
+type ReadReq struct {
+	Id                   *TractID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Len                  int64    `protobuf:"varint,3,opt,name=len,proto3" json:"len,omitempty"`
+	Off                  int64    `protobuf:"varint,4,opt,name=off,proto3" json:"off,omitempty"`
+	Pri                  int32    `protobuf:"varint,5,opt,name=pri,proto3" json:"pri,omitempty"`
+	ReqId                string   `protobuf:"bytes,6,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadReq) Reset()         { *m = ReadReq{} }
+func (m *ReadReq) String() string { return proto.CompactTextString(m) }
+func (*ReadReq) ProtoMessage()    {}
+func (*ReadReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{62}
+}
+func (m *ReadReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReadReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadReq.Merge(dst, src)
+}
+func (m *ReadReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadReq proto.InternalMessageInfo
+
+func (m *ReadReq) GetId() *TractID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ReadReq) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ReadReq) GetLen() int64 {
+	if m != nil {
+		return m.Len
+	}
+	return 0
+}
+
+func (m *ReadReq) GetOff() int64 {
+	if m != nil {
+		return m.Off
+	}
+	return 0
+}
+
+func (m *ReadReq) GetPri() int32 {
+	if m != nil {
+		return m.Pri
+	}
+	return 0
+}
+
+func (m *ReadReq) GetReqId() string {
+	if m != nil {
+		return m.ReqId
+	}
+	return ""
+}
+
+type ReadReply struct {
+	Err                  int64    `protobuf:"varint,1,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadReply) Reset()         { *m = ReadReply{} }
+func (m *ReadReply) String() string { return proto.CompactTextString(m) }
+func (*ReadReply) ProtoMessage()    {}
+func (*ReadReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{63}
+}
+func (m *ReadReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReadReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadReply.Merge(dst, src)
+}
+func (m *ReadReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadReply proto.InternalMessageInfo
+
+func (m *ReadReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type ReadVRange struct {
+	Id                   *TractID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Len                  int64    `protobuf:"varint,3,opt,name=len,proto3" json:"len,omitempty"`
+	Off                  int64    `protobuf:"varint,4,opt,name=off,proto3" json:"off,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadVRange) Reset()         { *m = ReadVRange{} }
+func (m *ReadVRange) String() string { return proto.CompactTextString(m) }
+func (*ReadVRange) ProtoMessage()    {}
+func (*ReadVRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{64}
+}
+func (m *ReadVRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadVRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadVRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReadVRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadVRange.Merge(dst, src)
+}
+func (m *ReadVRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadVRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadVRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadVRange proto.InternalMessageInfo
+
+func (m *ReadVRange) GetId() *TractID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ReadVRange) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ReadVRange) GetLen() int64 {
+	if m != nil {
+		return m.Len
+	}
+	return 0
+}
+
+func (m *ReadVRange) GetOff() int64 {
+	if m != nil {
+		return m.Off
+	}
+	return 0
+}
+
+type ReadVReq struct {
+	Reads                []*ReadVRange `protobuf:"bytes,1,rep,name=reads" json:"reads,omitempty"`
+	Pri                  int32         `protobuf:"varint,2,opt,name=pri,proto3" json:"pri,omitempty"`
+	ReqId                string        `protobuf:"bytes,3,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReadVReq) Reset()         { *m = ReadVReq{} }
+func (m *ReadVReq) String() string { return proto.CompactTextString(m) }
+func (*ReadVReq) ProtoMessage()    {}
+func (*ReadVReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{65}
+}
+func (m *ReadVReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadVReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadVReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReadVReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadVReq.Merge(dst, src)
+}
+func (m *ReadVReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadVReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadVReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadVReq proto.InternalMessageInfo
+
+func (m *ReadVReq) GetReads() []*ReadVRange {
+	if m != nil {
+		return m.Reads
+	}
+	return nil
+}
+
+func (m *ReadVReq) GetPri() int32 {
+	if m != nil {
+		return m.Pri
+	}
+	return 0
+}
+
+func (m *ReadVReq) GetReqId() string {
+	if m != nil {
+		return m.ReqId
+	}
+	return ""
+}
+
+type ReadVReply struct {
+	Err int64 `protobuf:"varint,1,opt,name=err,proto3" json:"err,omitempty"`
+	// The number of bytes read, and the error, for each range.
+	N                    []int64  `protobuf:"varint,2,rep,packed,name=n" json:"n,omitempty"`
+	Errs                 []int64  `protobuf:"varint,3,rep,packed,name=errs" json:"errs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadVReply) Reset()         { *m = ReadVReply{} }
+func (m *ReadVReply) String() string { return proto.CompactTextString(m) }
+func (*ReadVReply) ProtoMessage()    {}
+func (*ReadVReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{66}
+}
+func (m *ReadVReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadVReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReadVReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadVReply.Merge(dst, src)
+}
+func (m *ReadVReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadVReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadVReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadVReply proto.InternalMessageInfo
+
+func (m *ReadVReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+func (m *ReadVReply) GetN() []int64 {
+	if m != nil {
+		return m.N
+	}
+	return nil
+}
+
+func (m *ReadVReply) GetErrs() []int64 {
+	if m != nil {
+		return m.Errs
+	}
+	return nil
+}
+
+type TruncateReq struct {
+	Id                   *TractID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Size_                int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Pri                  int32    `protobuf:"varint,4,opt,name=pri,proto3" json:"pri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TruncateReq) Reset()         { *m = TruncateReq{} }
+func (m *TruncateReq) String() string { return proto.CompactTextString(m) }
+func (*TruncateReq) ProtoMessage()    {}
+func (*TruncateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{67}
+}
+func (m *TruncateReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TruncateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TruncateReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TruncateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateReq.Merge(dst, src)
+}
+func (m *TruncateReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *TruncateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateReq proto.InternalMessageInfo
+
+func (m *TruncateReq) GetId() *TractID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *TruncateReq) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TruncateReq) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *TruncateReq) GetPri() int32 {
+	if m != nil {
+		return m.Pri
+	}
+	return 0
+}
+
+type StatTractReq struct {
+	Id                   *TractID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Pri                  int32    `protobuf:"varint,3,opt,name=pri,proto3" json:"pri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatTractReq) Reset()         { *m = StatTractReq{} }
+func (m *StatTractReq) String() string { return proto.CompactTextString(m) }
+func (*StatTractReq) ProtoMessage()    {}
+func (*StatTractReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{68}
+}
+func (m *StatTractReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatTractReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatTractReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *StatTractReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatTractReq.Merge(dst, src)
+}
+func (m *StatTractReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatTractReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatTractReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatTractReq proto.InternalMessageInfo
+
+func (m *StatTractReq) GetId() *TractID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *StatTractReq) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *StatTractReq) GetPri() int32 {
+	if m != nil {
+		return m.Pri
+	}
+	return 0
+}
+
+type StatTractReply struct {
+	Err                  int64    `protobuf:"varint,1,opt,name=err,proto3" json:"err,omitempty"`
+	Size_                int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	ModStamp             uint64   `protobuf:"varint,3,opt,name=mod_stamp,json=modStamp,proto3" json:"mod_stamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatTractReply) Reset()         { *m = StatTractReply{} }
+func (m *StatTractReply) String() string { return proto.CompactTextString(m) }
+func (*StatTractReply) ProtoMessage()    {}
+func (*StatTractReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{69}
+}
+func (m *StatTractReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatTractReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatTractReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *StatTractReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatTractReply.Merge(dst, src)
+}
+func (m *StatTractReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatTractReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatTractReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatTractReply proto.InternalMessageInfo
+
+func (m *StatTractReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+func (m *StatTractReply) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *StatTractReply) GetModStamp() uint64 {
+	if m != nil {
+		return m.ModStamp
+	}
+	return 0
+}
+
+type GetDiskInfoReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDiskInfoReq) Reset()         { *m = GetDiskInfoReq{} }
+func (m *GetDiskInfoReq) String() string { return proto.CompactTextString(m) }
+func (*GetDiskInfoReq) ProtoMessage()    {}
+func (*GetDiskInfoReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{70}
+}
+func (m *GetDiskInfoReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDiskInfoReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDiskInfoReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetDiskInfoReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDiskInfoReq.Merge(dst, src)
+}
+func (m *GetDiskInfoReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDiskInfoReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDiskInfoReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDiskInfoReq proto.InternalMessageInfo
+
+type GetDiskInfoReply struct {
+	Disks                []*FsStatus `protobuf:"bytes,1,rep,name=disks" json:"disks,omitempty"`
+	Err                  int64       `protobuf:"varint,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetDiskInfoReply) Reset()         { *m = GetDiskInfoReply{} }
+func (m *GetDiskInfoReply) String() string { return proto.CompactTextString(m) }
+func (*GetDiskInfoReply) ProtoMessage()    {}
+func (*GetDiskInfoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{71}
+}
+func (m *GetDiskInfoReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDiskInfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDiskInfoReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetDiskInfoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDiskInfoReply.Merge(dst, src)
+}
+func (m *GetDiskInfoReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDiskInfoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDiskInfoReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDiskInfoReply proto.InternalMessageInfo
+
+func (m *GetDiskInfoReply) GetDisks() []*FsStatus {
+	if m != nil {
+		return m.Disks
+	}
+	return nil
+}
+
+func (m *GetDiskInfoReply) GetErr() int64 {
+	if m != nil {
+		return m.Err
+	}
+	return 0
+}
+
+type SetControlFlagsReq struct {
+	Root                 string            `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Flags                *DiskControlFlags `protobuf:"bytes,2,opt,name=flags" json:"flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetControlFlagsReq) Reset()         { *m = SetControlFlagsReq{} }
+func (m *SetControlFlagsReq) String() string { return proto.CompactTextString(m) }
+func (*SetControlFlagsReq) ProtoMessage()    {}
+func (*SetControlFlagsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blb_31e5e9d8fa52afcb, []int{72}
+}
+func (m *SetControlFlagsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetControlFlagsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetControlFlagsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SetControlFlagsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetControlFlagsReq.Merge(dst, src)
+}
+func (m *SetControlFlagsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetControlFlagsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetControlFlagsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetControlFlagsReq proto.InternalMessageInfo
+
+func (m *SetControlFlagsReq) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *SetControlFlagsReq) GetFlags() *DiskControlFlags {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BlobID)(nil), "blb.BlobID")
+	proto.RegisterType((*Error)(nil), "blb.Error")
+	proto.RegisterType((*TractID)(nil), "blb.TractID")
+	proto.RegisterType((*RSChunkID)(nil), "blb.RSChunkID")
+	proto.RegisterType((*TractChecksum)(nil), "blb.TractChecksum")
+	proto.RegisterType((*TractInfo)(nil), "blb.TractInfo")
+	proto.RegisterType((*TractPointer)(nil), "blb.TractPointer")
+	proto.RegisterType((*LRCParams)(nil), "blb.LRCParams")
+	proto.RegisterType((*ACL)(nil), "blb.ACL")
+	proto.RegisterType((*BlobInfo)(nil), "blb.BlobInfo")
+	proto.RegisterMapType((map[string]string)(nil), "blb.BlobInfo.MetadataEntry")
+	proto.RegisterType((*BlobFilter)(nil), "blb.BlobFilter")
+	proto.RegisterType((*BlobEvent)(nil), "blb.BlobEvent")
+	proto.RegisterType((*ChecksumUpdate)(nil), "blb.ChecksumUpdate")
+	proto.RegisterType((*NameEntry)(nil), "blb.NameEntry")
+	proto.RegisterType((*TenantUsage)(nil), "blb.TenantUsage")
+	proto.RegisterType((*TenantQuota)(nil), "blb.TenantQuota")
+	proto.RegisterType((*DiskControlFlags)(nil), "blb.DiskControlFlags")
+	proto.RegisterType((*DiskStatus)(nil), "blb.DiskStatus")
+	proto.RegisterType((*FsStatus)(nil), "blb.FsStatus")
+	proto.RegisterMapType((map[string]string)(nil), "blb.FsStatus.OpsEntry")
+	proto.RegisterType((*TractserverInfo)(nil), "blb.TractserverInfo")
+	proto.RegisterType((*LookupCuratorReq)(nil), "blb.LookupCuratorReq")
+	proto.RegisterType((*LookupCuratorReply)(nil), "blb.LookupCuratorReply")
+	proto.RegisterType((*LookupPartitionReq)(nil), "blb.LookupPartitionReq")
+	proto.RegisterType((*LookupPartitionReply)(nil), "blb.LookupPartitionReply")
+	proto.RegisterType((*MasterCreateBlobReq)(nil), "blb.MasterCreateBlobReq")
+	proto.RegisterType((*ListPartitionsReq)(nil), "blb.ListPartitionsReq")
+	proto.RegisterType((*ListPartitionsReply)(nil), "blb.ListPartitionsReply")
+	proto.RegisterType((*GetTractserverInfoReq)(nil), "blb.GetTractserverInfoReq")
+	proto.RegisterType((*GetTractserverInfoReply)(nil), "blb.GetTractserverInfoReply")
+	proto.RegisterType((*GetQuotasReq)(nil), "blb.GetQuotasReq")
+	proto.RegisterType((*GetQuotasReply)(nil), "blb.GetQuotasReply")
+	proto.RegisterType((*SetQuotaReq)(nil), "blb.SetQuotaReq")
+	proto.RegisterType((*SetQuotaReply)(nil), "blb.SetQuotaReply")
+	proto.RegisterType((*CreateBlobReq)(nil), "blb.CreateBlobReq")
+	proto.RegisterMapType((map[string]string)(nil), "blb.CreateBlobReq.MetadataEntry")
+	proto.RegisterType((*CreateBlobReply)(nil), "blb.CreateBlobReply")
+	proto.RegisterType((*ExtendBlobReq)(nil), "blb.ExtendBlobReq")
+	proto.RegisterType((*ExtendBlobReply)(nil), "blb.ExtendBlobReply")
+	proto.RegisterType((*AckExtendBlobReq)(nil), "blb.AckExtendBlobReq")
+	proto.RegisterType((*AckExtendBlobReply)(nil), "blb.AckExtendBlobReply")
+	proto.RegisterType((*SetMetadataReq)(nil), "blb.SetMetadataReq")
+	proto.RegisterType((*UpdateChecksumsReq)(nil), "blb.UpdateChecksumsReq")
+	proto.RegisterType((*ReserveAppendReq)(nil), "blb.ReserveAppendReq")
+	proto.RegisterType((*ReserveAppendReply)(nil), "blb.ReserveAppendReply")
+	proto.RegisterType((*TruncateBlobReq)(nil), "blb.TruncateBlobReq")
+	proto.RegisterType((*UnshareTractReq)(nil), "blb.UnshareTractReq")
+	proto.RegisterType((*CopyTractsReq)(nil), "blb.CopyTractsReq")
+	proto.RegisterType((*GetTractsReq)(nil), "blb.GetTractsReq")
+	proto.RegisterType((*GetTractsReply)(nil), "blb.GetTractsReply")
+	proto.RegisterType((*StatBlobReply)(nil), "blb.StatBlobReply")
+	proto.RegisterType((*ReportBadTSReq)(nil), "blb.ReportBadTSReq")
+	proto.RegisterType((*FixVersionReq)(nil), "blb.FixVersionReq")
+	proto.RegisterType((*ListBlobsReq)(nil), "blb.ListBlobsReq")
+	proto.RegisterType((*ListBlobsReply)(nil), "blb.ListBlobsReply")
+	proto.RegisterType((*GetEventsReq)(nil), "blb.GetEventsReq")
+	proto.RegisterType((*GetEventsReply)(nil), "blb.GetEventsReply")
+	proto.RegisterType((*LookupNameReply)(nil), "blb.LookupNameReply")
+	proto.RegisterType((*RenameReq)(nil), "blb.RenameReq")
+	proto.RegisterType((*UnbindNameReq)(nil), "blb.UnbindNameReq")
+	proto.RegisterType((*ListNamesReq)(nil), "blb.ListNamesReq")
+	proto.RegisterType((*ListNamesReply)(nil), "blb.ListNamesReply")
+	proto.RegisterType((*CreateTractReq)(nil), "blb.CreateTractReq")
+	proto.RegisterType((*WriteReq)(nil), "blb.WriteReq")
+	proto.RegisterType((*ReadReq)(nil), "blb.ReadReq")
+	proto.RegisterType((*ReadReply)(nil), "blb.ReadReply")
+	proto.RegisterType((*ReadVRange)(nil), "blb.ReadVRange")
+	proto.RegisterType((*ReadVReq)(nil), "blb.ReadVReq")
+	proto.RegisterType((*ReadVReply)(nil), "blb.ReadVReply")
+	proto.RegisterType((*TruncateReq)(nil), "blb.TruncateReq")
+	proto.RegisterType((*StatTractReq)(nil), "blb.StatTractReq")
+	proto.RegisterType((*StatTractReply)(nil), "blb.StatTractReply")
+	proto.RegisterType((*GetDiskInfoReq)(nil), "blb.GetDiskInfoReq")
+	proto.RegisterType((*GetDiskInfoReply)(nil), "blb.GetDiskInfoReply")
+	proto.RegisterType((*SetControlFlagsReq)(nil), "blb.SetControlFlagsReq")
+}
+func (m *BlobID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Value))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Error) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Error) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Value))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TractID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TractID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RSChunkID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RSChunkID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Partition))
+	}
+	if m.Id != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TractChecksum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TractChecksum) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Crc != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Crc))
+	}
+	if m.Length != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Length))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TractInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TractInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Tract != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Tract.Size()))
+		n1, err := m.Tract.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Version))
+	}
+	if len(m.Hosts) > 0 {
+		for _, s := range m.Hosts {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Tsids) > 0 {
+		dAtA3 := make([]byte, len(m.Tsids)*10)
+		var j2 int
+		for _, num := range m.Tsids {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(j2))
+		i += copy(dAtA[i:], dAtA3[:j2])
+	}
+	if m.Rs != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Rs.Size()))
+		n4, err := m.Rs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.Checksum != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Checksum.Size()))
+		n5, err := m.Checksum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Shared {
+		dAtA[i] = 0x38
+		i++
+		if m.Shared {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TractPointer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TractPointer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Chunk != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Chunk.Size()))
+		n6, err := m.Chunk.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.Host) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Host)))
+		i += copy(dAtA[i:], m.Host)
+	}
+	if m.Tsid != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Tsid))
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Length))
+	}
+	if m.Class != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Class))
+	}
+	if m.BaseChunk != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.BaseChunk.Size()))
+		n7, err := m.BaseChunk.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.OtherHosts) > 0 {
+		for _, s := range m.OtherHosts {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.OtherTsids) > 0 {
+		dAtA9 := make([]byte, len(m.OtherTsids)*10)
+		var j8 int
+		for _, num := range m.OtherTsids {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(j8))
+		i += copy(dAtA[i:], dAtA9[:j8])
+	}
+	if m.Data != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Data))
+	}
+	if m.Lrc != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Lrc.Size()))
+		n10, err := m.Lrc.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *LRCParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LRCParams) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.K != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.K))
+	}
+	if m.L != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.L))
+	}
+	if m.R != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.R))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ACL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ACL) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Readers) > 0 {
+		for _, s := range m.Readers {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Writers) > 0 {
+		for _, s := range m.Writers {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BlobInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repl != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Repl))
+	}
+	if m.NumTracts != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.NumTracts))
+	}
+	if m.Mtime != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Mtime))
+	}
+	if m.Atime != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Atime))
+	}
+	if m.Class != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Class))
+	}
+	if m.Hint != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Hint))
+	}
+	if m.Expires != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Expires))
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x42
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovBlb(uint64(len(k))) + 1 + len(v) + sovBlb(uint64(len(v)))
+			i = encodeVarintBlb(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.WriteOnce {
+		dAtA[i] = 0x48
+		i++
+		if m.WriteOnce {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Sealed {
+		dAtA[i] = 0x50
+		i++
+		if m.Sealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Deleted != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Deleted))
+	}
+	if m.PurgeAt != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.PurgeAt))
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if m.Acl != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Acl.Size()))
+		n11, err := m.Acl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BlobFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobFilter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Classes) > 0 {
+		dAtA13 := make([]byte, len(m.Classes)*10)
+		var j12 int
+		for _, num1 := range m.Classes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(j12))
+		i += copy(dAtA[i:], dAtA13[:j12])
+	}
+	if len(m.Hints) > 0 {
+		dAtA15 := make([]byte, len(m.Hints)*10)
+		var j14 int
+		for _, num1 := range m.Hints {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(j14))
+		i += copy(dAtA[i:], dAtA15[:j14])
+	}
+	if m.MtimeAfter != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.MtimeAfter))
+	}
+	if m.MtimeBefore != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.MtimeBefore))
+	}
+	if m.AtimeAfter != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.AtimeAfter))
+	}
+	if m.AtimeBefore != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.AtimeBefore))
+	}
+	if m.ExpiresBefore != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.ExpiresBefore))
+	}
+	if m.Deleted {
+		dAtA[i] = 0x40
+		i++
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BlobEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Type))
+	}
+	if m.Blob != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if m.Class != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Class))
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ChecksumUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChecksumUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Index))
+	}
+	if m.Old != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Old.Size()))
+		n16, err := m.Old.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.New != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.New.Size()))
+		n17, err := m.New.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *NameEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Blob != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TenantUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TenantUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Bytes))
+	}
+	if m.Blobs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blobs))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TenantQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TenantQuota) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if m.Limit != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Limit.Size()))
+		n18, err := m.Limit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.Used != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Used.Size()))
+		n19, err := m.Used.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DiskControlFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiskControlFlags) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StopAllocating {
+		dAtA[i] = 0x8
+		i++
+		if m.StopAllocating {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Drain != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Drain))
+	}
+	if m.DrainLocal != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.DrainLocal))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DiskStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiskStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Root)))
+		i += copy(dAtA[i:], m.Root)
+	}
+	if m.Full {
+		dAtA[i] = 0x10
+		i++
+		if m.Full {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Healthy {
+		dAtA[i] = 0x18
+		i++
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.QueueLen != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.QueueLen))
+	}
+	if m.AvgWaitMs != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.AvgWaitMs))
+	}
+	if m.Flags != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Flags.Size()))
+		n20, err := m.Flags.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *FsStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FsStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Status.Size()))
+		n21, err := m.Status.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if len(m.Ops) > 0 {
+		for k, _ := range m.Ops {
+			dAtA[i] = 0x12
+			i++
+			v := m.Ops[k]
+			mapSize := 1 + len(k) + sovBlb(uint64(len(k))) + 1 + len(v) + sovBlb(uint64(len(v)))
+			i = encodeVarintBlb(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.NumTracts != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.NumTracts))
+	}
+	if m.NumDeletedTracts != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.NumDeletedTracts))
+	}
+	if m.NumUnknownFiles != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.NumUnknownFiles))
+	}
+	if m.AvailSpace != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.AvailSpace))
+	}
+	if m.TotalSpace != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.TotalSpace))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TractserverInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TractserverInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Id))
+	}
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if len(m.Disks) > 0 {
+		for _, msg := range m.Disks {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.LastHeartbeat != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.LastHeartbeat))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *LookupCuratorReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LookupCuratorReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *LookupCuratorReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LookupCuratorReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Replicas) > 0 {
+		for _, s := range m.Replicas {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *LookupPartitionReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LookupPartitionReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Partition))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *LookupPartitionReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LookupPartitionReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Replicas) > 0 {
+		for _, s := range m.Replicas {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MasterCreateBlobReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MasterCreateBlobReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListPartitionsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPartitionsReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListPartitionsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPartitionsReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Partitions) > 0 {
+		dAtA23 := make([]byte, len(m.Partitions)*10)
+		var j22 int
+		for _, num := range m.Partitions {
+			for num >= 1<<7 {
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j22++
+			}
+			dAtA23[j22] = uint8(num)
+			j22++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(j22))
+		i += copy(dAtA[i:], dAtA23[:j22])
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetTractserverInfoReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTractserverInfoReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetTractserverInfoReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTractserverInfoReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		for _, msg := range m.Info {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetQuotasReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetQuotasReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetQuotasReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetQuotasReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for _, msg := range m.Quotas {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SetQuotaReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetQuotaReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if m.Limit != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Limit.Size()))
+		n24, err := m.Limit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SetQuotaReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetQuotaReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Err != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CreateBlobReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateBlobReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repl != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Repl))
+	}
+	if m.Hint != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Hint))
+	}
+	if m.Expires != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Expires))
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x22
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovBlb(uint64(len(k))) + 1 + len(v) + sovBlb(uint64(len(v)))
+			i = encodeVarintBlb(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.WriteOnce {
+		dAtA[i] = 0x28
+		i++
+		if m.WriteOnce {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if m.Acl != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Acl.Size()))
+		n25, err := m.Acl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CreateBlobReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateBlobReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Err != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.Id != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ExtendBlobReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendBlobReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if m.NumTracts != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.NumTracts))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ExtendBlobReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendBlobReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Err != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if len(m.NewTracts) > 0 {
+		for _, msg := range m.NewTracts {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AckExtendBlobReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AckExtendBlobReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if len(m.Tracts) > 0 {
+		for _, msg := range m.Tracts {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Checksums) > 0 {
+		for _, msg := range m.Checksums {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AckExtendBlobReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AckExtendBlobReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NumTracts != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.NumTracts))
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SetMetadataReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetMetadataReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if m.Metadata != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Metadata.Size()))
+		n26, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *UpdateChecksumsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateChecksumsReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if len(m.Updates) > 0 {
+		for _, msg := range m.Updates {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReserveAppendReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveAppendReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if m.Length != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Length))
+	}
+	if m.MinOffset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.MinOffset))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReserveAppendReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveAppendReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Offset))
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TruncateBlobReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncateBlobReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Size_))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *UnshareTractReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnshareTractReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CopyTractsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyTractsReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Dst != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Dst))
+	}
+	if m.First != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.First))
+	}
+	if m.Src != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Src))
+	}
+	if m.Start != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Start))
+	}
+	if m.End != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.End))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetTractsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTractsReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if m.Start != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Start))
+	}
+	if m.End != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.End))
+	}
+	if m.ForRead {
+		dAtA[i] = 0x20
+		i++
+		if m.ForRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ForWrite {
+		dAtA[i] = 0x28
+		i++
+		if m.ForWrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.WithInfo {
+		dAtA[i] = 0x30
+		i++
+		if m.WithInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.AllowUnsealed {
+		dAtA[i] = 0x38
+		i++
+		if m.AllowUnsealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetTractsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTractsReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tracts) > 0 {
+		for _, msg := range m.Tracts {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.Info != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Info.Size()))
+		n27, err := m.Info.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StatBlobReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatBlobReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Err != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.Info != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Info.Size()))
+		n28, err := m.Info.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReportBadTSReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportBadTSReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Id.Size()))
+		n29, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if len(m.Bad) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Bad)))
+		i += copy(dAtA[i:], m.Bad)
+	}
+	if len(m.Operation) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Operation)))
+		i += copy(dAtA[i:], m.Operation)
+	}
+	if m.GotError != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.GotError))
+	}
+	if m.CouldRecover {
+		dAtA[i] = 0x28
+		i++
+		if m.CouldRecover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *FixVersionReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixVersionReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Info.Size()))
+		n30, err := m.Info.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if len(m.Bad) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Bad)))
+		i += copy(dAtA[i:], m.Bad)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListBlobsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBlobsReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Partition))
+	}
+	if m.Start != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Start))
+	}
+	if m.Filter != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Filter.Size()))
+		n31, err := m.Filter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListBlobsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBlobsReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		dAtA33 := make([]byte, len(m.Keys)*10)
+		var j32 int
+		for _, num := range m.Keys {
+			for num >= 1<<7 {
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j32++
+			}
+			dAtA33[j32] = uint8(num)
+			j32++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(j32))
+		i += copy(dAtA[i:], dAtA33[:j32])
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if len(m.Infos) > 0 {
+		for _, msg := range m.Infos {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Next != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Next))
+	}
+	if m.More {
+		dAtA[i] = 0x28
+		i++
+		if m.More {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetEventsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEventsReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Partition))
+	}
+	if m.After != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.After))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetEventsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEventsReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, msg := range m.Events {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Next != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Next))
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *LookupNameReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LookupNameReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RenameReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenameReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.From)))
+		i += copy(dAtA[i:], m.From)
+	}
+	if len(m.To) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.To)))
+		i += copy(dAtA[i:], m.To)
+	}
+	if m.Replace != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Replace))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *UnbindNameReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbindNameReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Blob != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Blob))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListNamesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListNamesReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefix) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
+	}
+	if len(m.Start) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Start)))
+		i += copy(dAtA[i:], m.Start)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListNamesReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListNamesReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CreateTractReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTractReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Tsid != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Tsid))
+	}
+	if m.Id != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Id.Size()))
+		n34, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	if m.Off != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Off))
+	}
+	if m.Pri != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Pri))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Version))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *WriteReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Id.Size()))
+		n35, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Version))
+	}
+	if m.Off != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Off))
+	}
+	if m.Pri != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Pri))
+	}
+	if len(m.ReqId) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.ReqId)))
+		i += copy(dAtA[i:], m.ReqId)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReadReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Id.Size()))
+		n36, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Version))
+	}
+	if m.Len != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Len))
+	}
+	if m.Off != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Off))
+	}
+	if m.Pri != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Pri))
+	}
+	if len(m.ReqId) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.ReqId)))
+		i += copy(dAtA[i:], m.ReqId)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReadReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Err != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReadVRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadVRange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Id.Size()))
+		n37, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Version))
+	}
+	if m.Len != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Len))
+	}
+	if m.Off != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Off))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReadVReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadVReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reads) > 0 {
+		for _, msg := range m.Reads {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Pri != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Pri))
+	}
+	if len(m.ReqId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.ReqId)))
+		i += copy(dAtA[i:], m.ReqId)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReadVReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadVReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Err != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if len(m.N) > 0 {
+		dAtA39 := make([]byte, len(m.N)*10)
+		var j38 int
+		for _, num1 := range m.N {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA39[j38] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j38++
+			}
+			dAtA39[j38] = uint8(num)
+			j38++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(j38))
+		i += copy(dAtA[i:], dAtA39[:j38])
+	}
+	if len(m.Errs) > 0 {
+		dAtA41 := make([]byte, len(m.Errs)*10)
+		var j40 int
+		for _, num1 := range m.Errs {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j40++
+			}
+			dAtA41[j40] = uint8(num)
+			j40++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(j40))
+		i += copy(dAtA[i:], dAtA41[:j40])
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TruncateReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncateReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Id.Size()))
+		n42, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Version))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Size_))
+	}
+	if m.Pri != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Pri))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StatTractReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatTractReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Id.Size()))
+		n43, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Version))
+	}
+	if m.Pri != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Pri))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StatTractReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatTractReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Err != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Size_))
+	}
+	if m.ModStamp != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.ModStamp))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetDiskInfoReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDiskInfoReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetDiskInfoReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDiskInfoReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Disks) > 0 {
+		for _, msg := range m.Disks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBlb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Err != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SetControlFlagsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetControlFlagsReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(len(m.Root)))
+		i += copy(dAtA[i:], m.Root)
+	}
+	if m.Flags != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBlb(dAtA, i, uint64(m.Flags.Size()))
+		n44, err := m.Flags.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintBlb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *BlobID) Size() (n int) {
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovBlb(uint64(m.Value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Error) Size() (n int) {
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovBlb(uint64(m.Value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TractID) Size() (n int) {
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBlb(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RSChunkID) Size() (n int) {
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		n += 1 + sovBlb(uint64(m.Partition))
+	}
+	if m.Id != 0 {
+		n += 1 + sovBlb(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TractChecksum) Size() (n int) {
+	var l int
+	_ = l
+	if m.Crc != 0 {
+		n += 1 + sovBlb(uint64(m.Crc))
+	}
+	if m.Length != 0 {
+		n += 1 + sovBlb(uint64(m.Length))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TractInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Tract != nil {
+		l = m.Tract.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovBlb(uint64(m.Version))
+	}
+	if len(m.Hosts) > 0 {
+		for _, s := range m.Hosts {
+			l = len(s)
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if len(m.Tsids) > 0 {
+		l = 0
+		for _, e := range m.Tsids {
+			l += sovBlb(uint64(e))
+		}
+		n += 1 + sovBlb(uint64(l)) + l
+	}
+	if m.Rs != nil {
+		l = m.Rs.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Checksum != nil {
+		l = m.Checksum.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Shared {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TractPointer) Size() (n int) {
+	var l int
+	_ = l
+	if m.Chunk != nil {
+		l = m.Chunk.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Tsid != 0 {
+		n += 1 + sovBlb(uint64(m.Tsid))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovBlb(uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		n += 1 + sovBlb(uint64(m.Length))
+	}
+	if m.Class != 0 {
+		n += 1 + sovBlb(uint64(m.Class))
+	}
+	if m.BaseChunk != nil {
+		l = m.BaseChunk.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if len(m.OtherHosts) > 0 {
+		for _, s := range m.OtherHosts {
+			l = len(s)
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if len(m.OtherTsids) > 0 {
+		l = 0
+		for _, e := range m.OtherTsids {
+			l += sovBlb(uint64(e))
+		}
+		n += 1 + sovBlb(uint64(l)) + l
+	}
+	if m.Data != 0 {
+		n += 1 + sovBlb(uint64(m.Data))
+	}
+	if m.Lrc != nil {
+		l = m.Lrc.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LRCParams) Size() (n int) {
+	var l int
+	_ = l
+	if m.K != 0 {
+		n += 1 + sovBlb(uint64(m.K))
+	}
+	if m.L != 0 {
+		n += 1 + sovBlb(uint64(m.L))
+	}
+	if m.R != 0 {
+		n += 1 + sovBlb(uint64(m.R))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ACL) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Readers) > 0 {
+		for _, s := range m.Readers {
+			l = len(s)
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if len(m.Writers) > 0 {
+		for _, s := range m.Writers {
+			l = len(s)
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlobInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repl != 0 {
+		n += 1 + sovBlb(uint64(m.Repl))
+	}
+	if m.NumTracts != 0 {
+		n += 1 + sovBlb(uint64(m.NumTracts))
+	}
+	if m.Mtime != 0 {
+		n += 1 + sovBlb(uint64(m.Mtime))
+	}
+	if m.Atime != 0 {
+		n += 1 + sovBlb(uint64(m.Atime))
+	}
+	if m.Class != 0 {
+		n += 1 + sovBlb(uint64(m.Class))
+	}
+	if m.Hint != 0 {
+		n += 1 + sovBlb(uint64(m.Hint))
+	}
+	if m.Expires != 0 {
+		n += 1 + sovBlb(uint64(m.Expires))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovBlb(uint64(len(k))) + 1 + len(v) + sovBlb(uint64(len(v)))
+			n += mapEntrySize + 1 + sovBlb(uint64(mapEntrySize))
+		}
+	}
+	if m.WriteOnce {
+		n += 2
+	}
+	if m.Sealed {
+		n += 2
+	}
+	if m.Deleted != 0 {
+		n += 1 + sovBlb(uint64(m.Deleted))
+	}
+	if m.PurgeAt != 0 {
+		n += 1 + sovBlb(uint64(m.PurgeAt))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlobFilter) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Classes) > 0 {
+		l = 0
+		for _, e := range m.Classes {
+			l += sovBlb(uint64(e))
+		}
+		n += 1 + sovBlb(uint64(l)) + l
+	}
+	if len(m.Hints) > 0 {
+		l = 0
+		for _, e := range m.Hints {
+			l += sovBlb(uint64(e))
+		}
+		n += 1 + sovBlb(uint64(l)) + l
+	}
+	if m.MtimeAfter != 0 {
+		n += 1 + sovBlb(uint64(m.MtimeAfter))
+	}
+	if m.MtimeBefore != 0 {
+		n += 1 + sovBlb(uint64(m.MtimeBefore))
+	}
+	if m.AtimeAfter != 0 {
+		n += 1 + sovBlb(uint64(m.AtimeAfter))
+	}
+	if m.AtimeBefore != 0 {
+		n += 1 + sovBlb(uint64(m.AtimeBefore))
+	}
+	if m.ExpiresBefore != 0 {
+		n += 1 + sovBlb(uint64(m.ExpiresBefore))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlobEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovBlb(uint64(m.Type))
+	}
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if m.Class != 0 {
+		n += 1 + sovBlb(uint64(m.Class))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBlb(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChecksumUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBlb(uint64(m.Index))
+	}
+	if m.Old != nil {
+		l = m.Old.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.New != nil {
+		l = m.New.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NameEntry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TenantUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		n += 1 + sovBlb(uint64(m.Bytes))
+	}
+	if m.Blobs != 0 {
+		n += 1 + sovBlb(uint64(m.Blobs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TenantQuota) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Used != nil {
+		l = m.Used.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiskControlFlags) Size() (n int) {
+	var l int
+	_ = l
+	if m.StopAllocating {
+		n += 2
+	}
+	if m.Drain != 0 {
+		n += 1 + sovBlb(uint64(m.Drain))
+	}
+	if m.DrainLocal != 0 {
+		n += 1 + sovBlb(uint64(m.DrainLocal))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiskStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Full {
+		n += 2
+	}
+	if m.Healthy {
+		n += 2
+	}
+	if m.QueueLen != 0 {
+		n += 1 + sovBlb(uint64(m.QueueLen))
+	}
+	if m.AvgWaitMs != 0 {
+		n += 1 + sovBlb(uint64(m.AvgWaitMs))
+	}
+	if m.Flags != nil {
+		l = m.Flags.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FsStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if len(m.Ops) > 0 {
+		for k, v := range m.Ops {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovBlb(uint64(len(k))) + 1 + len(v) + sovBlb(uint64(len(v)))
+			n += mapEntrySize + 1 + sovBlb(uint64(mapEntrySize))
+		}
+	}
+	if m.NumTracts != 0 {
+		n += 1 + sovBlb(uint64(m.NumTracts))
+	}
+	if m.NumDeletedTracts != 0 {
+		n += 1 + sovBlb(uint64(m.NumDeletedTracts))
+	}
+	if m.NumUnknownFiles != 0 {
+		n += 1 + sovBlb(uint64(m.NumUnknownFiles))
+	}
+	if m.AvailSpace != 0 {
+		n += 1 + sovBlb(uint64(m.AvailSpace))
+	}
+	if m.TotalSpace != 0 {
+		n += 1 + sovBlb(uint64(m.TotalSpace))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TractserverInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBlb(uint64(m.Id))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if len(m.Disks) > 0 {
+		for _, e := range m.Disks {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.LastHeartbeat != 0 {
+		n += 1 + sovBlb(uint64(m.LastHeartbeat))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LookupCuratorReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LookupCuratorReply) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Replicas) > 0 {
+		for _, s := range m.Replicas {
+			l = len(s)
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LookupPartitionReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		n += 1 + sovBlb(uint64(m.Partition))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LookupPartitionReply) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Replicas) > 0 {
+		for _, s := range m.Replicas {
+			l = len(s)
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MasterCreateBlobReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListPartitionsReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListPartitionsReply) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Partitions) > 0 {
+		l = 0
+		for _, e := range m.Partitions {
+			l += sovBlb(uint64(e))
+		}
+		n += 1 + sovBlb(uint64(l)) + l
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTractserverInfoReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTractserverInfoReply) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		for _, e := range m.Info {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetQuotasReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetQuotasReply) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for _, e := range m.Quotas {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetQuotaReq) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetQuotaReply) Size() (n int) {
+	var l int
+	_ = l
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateBlobReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repl != 0 {
+		n += 1 + sovBlb(uint64(m.Repl))
+	}
+	if m.Hint != 0 {
+		n += 1 + sovBlb(uint64(m.Hint))
+	}
+	if m.Expires != 0 {
+		n += 1 + sovBlb(uint64(m.Expires))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovBlb(uint64(len(k))) + 1 + len(v) + sovBlb(uint64(len(v)))
+			n += mapEntrySize + 1 + sovBlb(uint64(mapEntrySize))
+		}
+	}
+	if m.WriteOnce {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateBlobReply) Size() (n int) {
+	var l int
+	_ = l
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.Id != 0 {
+		n += 1 + sovBlb(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExtendBlobReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if m.NumTracts != 0 {
+		n += 1 + sovBlb(uint64(m.NumTracts))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExtendBlobReply) Size() (n int) {
+	var l int
+	_ = l
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if len(m.NewTracts) > 0 {
+		for _, e := range m.NewTracts {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AckExtendBlobReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if len(m.Tracts) > 0 {
+		for _, e := range m.Tracts {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if len(m.Checksums) > 0 {
+		for _, e := range m.Checksums {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AckExtendBlobReply) Size() (n int) {
+	var l int
+	_ = l
+	if m.NumTracts != 0 {
+		n += 1 + sovBlb(uint64(m.NumTracts))
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetMetadataReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateChecksumsReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReserveAppendReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if m.Length != 0 {
+		n += 1 + sovBlb(uint64(m.Length))
+	}
+	if m.MinOffset != 0 {
+		n += 1 + sovBlb(uint64(m.MinOffset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReserveAppendReply) Size() (n int) {
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovBlb(uint64(m.Offset))
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TruncateBlobReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovBlb(uint64(m.Size_))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnshareTractReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBlb(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CopyTractsReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Dst != 0 {
+		n += 1 + sovBlb(uint64(m.Dst))
+	}
+	if m.First != 0 {
+		n += 1 + sovBlb(uint64(m.First))
+	}
+	if m.Src != 0 {
+		n += 1 + sovBlb(uint64(m.Src))
+	}
+	if m.Start != 0 {
+		n += 1 + sovBlb(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovBlb(uint64(m.End))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTractsReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if m.Start != 0 {
+		n += 1 + sovBlb(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovBlb(uint64(m.End))
+	}
+	if m.ForRead {
+		n += 2
+	}
+	if m.ForWrite {
+		n += 2
+	}
+	if m.WithInfo {
+		n += 2
+	}
+	if m.AllowUnsealed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTractsReply) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Tracts) > 0 {
+		for _, e := range m.Tracts {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatBlobReply) Size() (n int) {
+	var l int
+	_ = l
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportBadTSReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	l = len(m.Bad)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.GotError != 0 {
+		n += 1 + sovBlb(uint64(m.GotError))
+	}
+	if m.CouldRecover {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FixVersionReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	l = len(m.Bad)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBlobsReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		n += 1 + sovBlb(uint64(m.Partition))
+	}
+	if m.Start != 0 {
+		n += 1 + sovBlb(uint64(m.Start))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBlobsReply) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		l = 0
+		for _, e := range m.Keys {
+			l += sovBlb(uint64(e))
+		}
+		n += 1 + sovBlb(uint64(l)) + l
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if len(m.Infos) > 0 {
+		for _, e := range m.Infos {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.Next != 0 {
+		n += 1 + sovBlb(uint64(m.Next))
+	}
+	if m.More {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetEventsReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		n += 1 + sovBlb(uint64(m.Partition))
+	}
+	if m.After != 0 {
+		n += 1 + sovBlb(uint64(m.After))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetEventsReply) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.Next != 0 {
+		n += 1 + sovBlb(uint64(m.Next))
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LookupNameReply) Size() (n int) {
+	var l int
+	_ = l
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenameReq) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Replace != 0 {
+		n += 1 + sovBlb(uint64(m.Replace))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnbindNameReq) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Blob != 0 {
+		n += 1 + sovBlb(uint64(m.Blob))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListNamesReq) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListNamesReply) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateTractReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Tsid != 0 {
+		n += 1 + sovBlb(uint64(m.Tsid))
+	}
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Off != 0 {
+		n += 1 + sovBlb(uint64(m.Off))
+	}
+	if m.Pri != 0 {
+		n += 1 + sovBlb(uint64(m.Pri))
+	}
+	if m.Version != 0 {
+		n += 1 + sovBlb(uint64(m.Version))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WriteReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovBlb(uint64(m.Version))
+	}
+	if m.Off != 0 {
+		n += 1 + sovBlb(uint64(m.Off))
+	}
+	if m.Pri != 0 {
+		n += 1 + sovBlb(uint64(m.Pri))
+	}
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovBlb(uint64(m.Version))
+	}
+	if m.Len != 0 {
+		n += 1 + sovBlb(uint64(m.Len))
+	}
+	if m.Off != 0 {
+		n += 1 + sovBlb(uint64(m.Off))
+	}
+	if m.Pri != 0 {
+		n += 1 + sovBlb(uint64(m.Pri))
+	}
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadReply) Size() (n int) {
+	var l int
+	_ = l
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadVRange) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovBlb(uint64(m.Version))
+	}
+	if m.Len != 0 {
+		n += 1 + sovBlb(uint64(m.Len))
+	}
+	if m.Off != 0 {
+		n += 1 + sovBlb(uint64(m.Off))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadVReq) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Reads) > 0 {
+		for _, e := range m.Reads {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.Pri != 0 {
+		n += 1 + sovBlb(uint64(m.Pri))
+	}
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadVReply) Size() (n int) {
+	var l int
+	_ = l
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if len(m.N) > 0 {
+		l = 0
+		for _, e := range m.N {
+			l += sovBlb(uint64(e))
+		}
+		n += 1 + sovBlb(uint64(l)) + l
+	}
+	if len(m.Errs) > 0 {
+		l = 0
+		for _, e := range m.Errs {
+			l += sovBlb(uint64(e))
+		}
+		n += 1 + sovBlb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TruncateReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovBlb(uint64(m.Version))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovBlb(uint64(m.Size_))
+	}
+	if m.Pri != 0 {
+		n += 1 + sovBlb(uint64(m.Pri))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatTractReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovBlb(uint64(m.Version))
+	}
+	if m.Pri != 0 {
+		n += 1 + sovBlb(uint64(m.Pri))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatTractReply) Size() (n int) {
+	var l int
+	_ = l
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovBlb(uint64(m.Size_))
+	}
+	if m.ModStamp != 0 {
+		n += 1 + sovBlb(uint64(m.ModStamp))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDiskInfoReq) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDiskInfoReply) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Disks) > 0 {
+		for _, e := range m.Disks {
+			l = e.Size()
+			n += 1 + l + sovBlb(uint64(l))
+		}
+	}
+	if m.Err != 0 {
+		n += 1 + sovBlb(uint64(m.Err))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetControlFlagsReq) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.Flags != nil {
+		l = m.Flags.Size()
+		n += 1 + l + sovBlb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBlb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozBlb(x uint64) (n int) {
+	return sovBlb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlobID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Error) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TractID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TractID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TractID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RSChunkID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RSChunkID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RSChunkID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TractChecksum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TractChecksum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TractChecksum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Crc", wireType)
+			}
+			m.Crc = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Crc |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TractInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TractInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tract == nil {
+				m.Tract = &TractID{}
+			}
+			if err := m.Tract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hosts = append(m.Hosts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Tsids = append(m.Tsids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBlb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Tsids = append(m.Tsids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tsids", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rs == nil {
+				m.Rs = &TractPointer{}
+			}
+			if err := m.Rs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checksum == nil {
+				m.Checksum = &TractChecksum{}
+			}
+			if err := m.Checksum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shared", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Shared = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TractPointer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TractPointer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TractPointer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Chunk == nil {
+				m.Chunk = &RSChunkID{}
+			}
+			if err := m.Chunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tsid", wireType)
+			}
+			m.Tsid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tsid |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			m.Class = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Class |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseChunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseChunk == nil {
+				m.BaseChunk = &RSChunkID{}
+			}
+			if err := m.BaseChunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherHosts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherHosts = append(m.OtherHosts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OtherTsids = append(m.OtherTsids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBlb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OtherTsids = append(m.OtherTsids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherTsids", wireType)
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			m.Data = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Data |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lrc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lrc == nil {
+				m.Lrc = &LRCParams{}
+			}
+			if err := m.Lrc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LRCParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LRCParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LRCParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
+			}
+			m.K = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.K |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L", wireType)
+			}
+			m.L = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.L |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			m.R = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.R |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ACL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ACL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ACL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Readers = append(m.Readers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Writers = append(m.Writers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repl", wireType)
+			}
+			m.Repl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Repl |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTracts", wireType)
+			}
+			m.NumTracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTracts |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtime", wireType)
+			}
+			m.Mtime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mtime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atime", wireType)
+			}
+			m.Atime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Atime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			m.Class = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Class |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hint", wireType)
+			}
+			m.Hint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hint |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			m.Expires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expires |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthBlb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthBlb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBlb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthBlb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteOnce", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WriteOnce = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sealed = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			m.Deleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deleted |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurgeAt", wireType)
+			}
+			m.PurgeAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurgeAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acl == nil {
+				m.Acl = &ACL{}
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Classes = append(m.Classes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBlb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Classes = append(m.Classes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Hints = append(m.Hints, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBlb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Hints = append(m.Hints, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hints", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MtimeAfter", wireType)
+			}
+			m.MtimeAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MtimeAfter |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MtimeBefore", wireType)
+			}
+			m.MtimeBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MtimeBefore |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtimeAfter", wireType)
+			}
+			m.AtimeAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtimeAfter |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtimeBefore", wireType)
+			}
+			m.AtimeBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtimeBefore |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresBefore", wireType)
+			}
+			m.ExpiresBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresBefore |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			m.Class = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Class |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChecksumUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChecksumUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChecksumUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Old == nil {
+				m.Old = &TractChecksum{}
+			}
+			if err := m.Old.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.New == nil {
+				m.New = &TractChecksum{}
+			}
+			if err := m.New.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TenantUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TenantUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TenantUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			m.Blobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blobs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TenantQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TenantQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TenantQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &TenantUsage{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Used == nil {
+				m.Used = &TenantUsage{}
+			}
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiskControlFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiskControlFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiskControlFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopAllocating", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StopAllocating = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drain", wireType)
+			}
+			m.Drain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Drain |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainLocal", wireType)
+			}
+			m.DrainLocal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrainLocal |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiskStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiskStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiskStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Full = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueLen", wireType)
+			}
+			m.QueueLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueLen |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgWaitMs", wireType)
+			}
+			m.AvgWaitMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvgWaitMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flags == nil {
+				m.Flags = &DiskControlFlags{}
+			}
+			if err := m.Flags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FsStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FsStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FsStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &DiskStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ops == nil {
+				m.Ops = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthBlb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthBlb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBlb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthBlb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Ops[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTracts", wireType)
+			}
+			m.NumTracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTracts |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumDeletedTracts", wireType)
+			}
+			m.NumDeletedTracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumDeletedTracts |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUnknownFiles", wireType)
+			}
+			m.NumUnknownFiles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumUnknownFiles |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailSpace", wireType)
+			}
+			m.AvailSpace = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvailSpace |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSpace", wireType)
+			}
+			m.TotalSpace = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSpace |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TractserverInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TractserverInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TractserverInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disks = append(m.Disks, &FsStatus{})
+			if err := m.Disks[len(m.Disks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeat", wireType)
+			}
+			m.LastHeartbeat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeartbeat |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LookupCuratorReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupCuratorReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupCuratorReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LookupCuratorReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupCuratorReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupCuratorReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LookupPartitionReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupPartitionReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupPartitionReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LookupPartitionReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupPartitionReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupPartitionReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MasterCreateBlobReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MasterCreateBlobReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MasterCreateBlobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPartitionsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPartitionsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPartitionsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPartitionsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPartitionsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPartitionsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Partitions = append(m.Partitions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBlb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Partitions = append(m.Partitions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTractserverInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTractserverInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTractserverInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTractserverInfoReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTractserverInfoReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTractserverInfoReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, &TractserverInfo{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetQuotasReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetQuotasReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetQuotasReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetQuotasReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetQuotasReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetQuotasReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotas = append(m.Quotas, &TenantQuota{})
+			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetQuotaReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetQuotaReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetQuotaReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &TenantUsage{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetQuotaReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetQuotaReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetQuotaReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateBlobReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateBlobReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateBlobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repl", wireType)
+			}
+			m.Repl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Repl |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hint", wireType)
+			}
+			m.Hint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hint |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			m.Expires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expires |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthBlb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthBlb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBlb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthBlb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteOnce", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WriteOnce = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acl == nil {
+				m.Acl = &ACL{}
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateBlobReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateBlobReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateBlobReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtendBlobReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendBlobReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendBlobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTracts", wireType)
+			}
+			m.NumTracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTracts |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtendBlobReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendBlobReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendBlobReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewTracts = append(m.NewTracts, &TractInfo{})
+			if err := m.NewTracts[len(m.NewTracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AckExtendBlobReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AckExtendBlobReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AckExtendBlobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tracts = append(m.Tracts, &TractInfo{})
+			if err := m.Tracts[len(m.Tracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, &ChecksumUpdate{})
+			if err := m.Checksums[len(m.Checksums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AckExtendBlobReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AckExtendBlobReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AckExtendBlobReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTracts", wireType)
+			}
+			m.NumTracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTracts |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetMetadataReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetMetadataReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetMetadataReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &BlobInfo{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateChecksumsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateChecksumsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateChecksumsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, &ChecksumUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveAppendReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveAppendReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveAppendReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOffset", wireType)
+			}
+			m.MinOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOffset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveAppendReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveAppendReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveAppendReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TruncateBlobReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TruncateBlobReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TruncateBlobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnshareTractReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnshareTractReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnshareTractReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CopyTractsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CopyTractsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CopyTractsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
+			}
+			m.Dst = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dst |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			m.First = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.First |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
+			}
+			m.Src = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Src |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTractsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTractsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTractsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForRead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForRead = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForWrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForWrite = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithInfo = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnsealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnsealed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTractsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTractsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTractsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tracts = append(m.Tracts, &TractInfo{})
+			if err := m.Tracts[len(m.Tracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &BlobInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatBlobReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatBlobReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatBlobReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &BlobInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportBadTSReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportBadTSReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportBadTSReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &TractID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bad", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bad = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GotError", wireType)
+			}
+			m.GotError = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GotError |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CouldRecover", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CouldRecover = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FixVersionReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FixVersionReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FixVersionReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &TractInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bad", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bad = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBlobsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBlobsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBlobsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &BlobFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBlobsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBlobsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBlobsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Keys = append(m.Keys, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBlb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Keys = append(m.Keys, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infos = append(m.Infos, &BlobInfo{})
+			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			m.Next = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Next |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field More", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.More = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEventsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEventsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEventsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			m.After = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.After |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEventsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEventsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEventsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &BlobEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			m.Next = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Next |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LookupNameReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupNameReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupNameReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenameReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenameReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenameReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
+			}
+			m.Replace = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replace |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbindNameReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbindNameReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbindNameReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			m.Blob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blob |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListNamesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListNamesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListNamesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListNamesReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListNamesReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListNamesReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &NameEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTractReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTractReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTractReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tsid", wireType)
+			}
+			m.Tsid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tsid |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &TractID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Off", wireType)
+			}
+			m.Off = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Off |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pri", wireType)
+			}
+			m.Pri = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pri |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &TractID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Off", wireType)
+			}
+			m.Off = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Off |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pri", wireType)
+			}
+			m.Pri = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pri |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &TractID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
+			}
+			m.Len = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Len |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Off", wireType)
+			}
+			m.Off = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Off |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pri", wireType)
+			}
+			m.Pri = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pri |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadVRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadVRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadVRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &TractID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
+			}
+			m.Len = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Len |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Off", wireType)
+			}
+			m.Off = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Off |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadVReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadVReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadVReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reads = append(m.Reads, &ReadVRange{})
+			if err := m.Reads[len(m.Reads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pri", wireType)
+			}
+			m.Pri = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pri |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadVReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadVReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadVReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.N = append(m.N, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBlb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.N = append(m.N, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Errs = append(m.Errs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBlb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Errs = append(m.Errs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TruncateReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TruncateReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TruncateReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &TractID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pri", wireType)
+			}
+			m.Pri = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pri |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatTractReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatTractReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatTractReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &TractID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pri", wireType)
+			}
+			m.Pri = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pri |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatTractReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatTractReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatTractReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModStamp", wireType)
+			}
+			m.ModStamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModStamp |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDiskInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDiskInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDiskInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDiskInfoReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDiskInfoReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDiskInfoReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disks = append(m.Disks, &FsStatus{})
+			if err := m.Disks[len(m.Disks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			m.Err = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Err |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetControlFlagsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetControlFlagsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetControlFlagsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flags == nil {
+				m.Flags = &DiskControlFlags{}
+			}
+			if err := m.Flags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlb(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlb
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlb
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthBlb
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowBlb
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipBlb(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthBlb = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlb   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("internal/core/blbpb/blb.proto", fileDescriptor_blb_31e5e9d8fa52afcb) }
+
+var fileDescriptor_blb_31e5e9d8fa52afcb = []byte{
+	// 3237 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x5d, 0x6f, 0x1c, 0xc7,
+	0x91, 0x98, 0x9d, 0xdd, 0xe5, 0x6e, 0x2d, 0x77, 0x49, 0x35, 0x25, 0x6b, 0xbd, 0x96, 0x74, 0xd2,
+	0x48, 0xb2, 0x74, 0x67, 0x9b, 0xf2, 0x51, 0x38, 0xf9, 0x74, 0x36, 0xce, 0x16, 0x49, 0xc9, 0x16,
+	0x8e, 0xb6, 0x74, 0x43, 0xd1, 0x0e, 0x0c, 0x24, 0x8b, 0xde, 0x9d, 0x5e, 0x72, 0xc0, 0xd9, 0x99,
+	0x65, 0xcf, 0x2c, 0x29, 0x06, 0x08, 0x10, 0x23, 0xc8, 0x6b, 0x7e, 0x43, 0xfe, 0x42, 0x9e, 0x92,
+	0xb7, 0x20, 0x2f, 0x41, 0xf2, 0xe6, 0x1f, 0x90, 0x07, 0xc3, 0xbf, 0x24, 0xa8, 0xea, 0xee, 0xf9,
+	0xda, 0x21, 0x25, 0x43, 0xc8, 0x0b, 0xd1, 0x55, 0x5d, 0x5d, 0x5d, 0x5f, 0x53, 0x5d, 0x55, 0x5c,
+	0xb8, 0xea, 0x87, 0x89, 0x90, 0x21, 0x0f, 0xee, 0x8d, 0x23, 0x29, 0xee, 0x8d, 0x82, 0xd1, 0x6c,
+	0x84, 0x7f, 0xd7, 0x67, 0x32, 0x4a, 0x22, 0x66, 0x8f, 0x82, 0xd1, 0xe0, 0xda, 0x7e, 0x14, 0xed,
+	0x07, 0xe2, 0x1e, 0xa1, 0x46, 0xf3, 0xc9, 0xbd, 0x13, 0xc9, 0x67, 0x33, 0x21, 0x63, 0x45, 0xe4,
+	0x5c, 0x83, 0xe6, 0x66, 0x10, 0x8d, 0x9e, 0x6e, 0xb3, 0x8b, 0xd0, 0x38, 0xe6, 0xc1, 0x5c, 0xf4,
+	0xad, 0xeb, 0xd6, 0xdd, 0xba, 0xab, 0x00, 0xe7, 0x2a, 0x34, 0x1e, 0x4b, 0x19, 0xc9, 0xe2, 0xb6,
+	0x6d, 0xb6, 0xef, 0xc3, 0xd2, 0x0b, 0xc9, 0xc7, 0xc9, 0xd3, 0x6d, 0xc6, 0xa0, 0x3e, 0x0a, 0xa2,
+	0x91, 0x3e, 0x4e, 0x6b, 0x3c, 0xe4, 0x87, 0x9e, 0x78, 0xd9, 0xaf, 0x5d, 0xb7, 0xee, 0x76, 0x5d,
+	0x05, 0x38, 0x0f, 0xa1, 0xed, 0xee, 0x6e, 0x1d, 0xcc, 0xc3, 0xc3, 0xa7, 0xdb, 0xec, 0x0a, 0xb4,
+	0x67, 0x5c, 0x26, 0x7e, 0xe2, 0x47, 0x21, 0x9d, 0xed, 0xba, 0x19, 0x82, 0xf5, 0xa0, 0xe6, 0x7b,
+	0x74, 0xba, 0xee, 0xd6, 0x7c, 0xcf, 0x79, 0x08, 0x5d, 0xba, 0x6f, 0xeb, 0x40, 0x8c, 0x0f, 0xe3,
+	0xf9, 0x94, 0xad, 0x82, 0x3d, 0x96, 0x63, 0x7d, 0x10, 0x97, 0xec, 0x2d, 0x68, 0x06, 0x22, 0xdc,
+	0x4f, 0x0e, 0xe8, 0x98, 0xed, 0x6a, 0xc8, 0xf9, 0xc1, 0x82, 0xb6, 0x92, 0x35, 0x9c, 0x44, 0xcc,
+	0x81, 0x46, 0x82, 0x00, 0x9d, 0xec, 0x6c, 0x2c, 0xaf, 0xa3, 0xdd, 0xb4, 0x2a, 0xae, 0xda, 0x62,
+	0x7d, 0x58, 0x3a, 0x16, 0x32, 0x46, 0xc1, 0x14, 0x2b, 0x03, 0xa2, 0x5e, 0x07, 0x51, 0x9c, 0xc4,
+	0x7d, 0xfb, 0xba, 0x7d, 0xb7, 0xed, 0x2a, 0x00, 0xb1, 0x49, 0xec, 0x7b, 0x71, 0xbf, 0x7e, 0xdd,
+	0x46, 0x6d, 0x09, 0x60, 0x37, 0xa0, 0x26, 0xe3, 0x7e, 0x83, 0xae, 0xb9, 0x90, 0x5d, 0xf3, 0x3c,
+	0x22, 0xef, 0xb9, 0x35, 0x19, 0xb3, 0x75, 0x68, 0x8d, 0xb5, 0x42, 0xfd, 0x26, 0x11, 0xb2, 0x8c,
+	0xd0, 0xa8, 0xea, 0xa6, 0x34, 0xa8, 0x62, 0x7c, 0xc0, 0xa5, 0xf0, 0xfa, 0x4b, 0xd7, 0xad, 0xbb,
+	0x2d, 0x57, 0x43, 0xce, 0xdf, 0x6b, 0xb0, 0x9c, 0x67, 0xce, 0x6e, 0x41, 0x63, 0x8c, 0x76, 0xd6,
+	0x5a, 0xf6, 0x88, 0x6b, 0x6a, 0x7b, 0x57, 0x6d, 0xa2, 0xe7, 0x50, 0x01, 0x52, 0xb2, 0xed, 0xd2,
+	0x1a, 0x71, 0x28, 0x7e, 0xdf, 0x26, 0xc3, 0xd2, 0x1a, 0xaf, 0x8d, 0x26, 0x93, 0x58, 0x24, 0xfd,
+	0x3a, 0x61, 0x35, 0x94, 0xb3, 0x78, 0x43, 0xe1, 0x15, 0x84, 0xf6, 0x18, 0x07, 0x3c, 0x8e, 0x49,
+	0xa7, 0x86, 0xab, 0x00, 0xf6, 0x01, 0xc0, 0x88, 0xc7, 0x62, 0xa8, 0x04, 0x5b, 0xaa, 0x14, 0xac,
+	0x8d, 0x14, 0x04, 0xb0, 0x7f, 0x83, 0x4e, 0x94, 0x1c, 0x08, 0x39, 0x54, 0x06, 0x6f, 0x91, 0xc1,
+	0x81, 0x50, 0x5f, 0x90, 0xd5, 0x53, 0x02, 0x65, 0xfb, 0x36, 0xd9, 0x5e, 0x11, 0xbc, 0x20, 0x07,
+	0x30, 0xa8, 0x7b, 0x3c, 0xe1, 0x7d, 0x20, 0x1f, 0xd2, 0x9a, 0x5d, 0x07, 0x3b, 0x90, 0xe3, 0x7e,
+	0x27, 0x77, 0xfb, 0x8e, 0xbb, 0xf5, 0x9c, 0x4b, 0x3e, 0x8d, 0x5d, 0xdc, 0x72, 0xfe, 0x0b, 0xda,
+	0x29, 0x86, 0x2d, 0x83, 0x75, 0xa8, 0x03, 0xdf, 0x3a, 0x44, 0x28, 0xd0, 0x11, 0x61, 0x05, 0x08,
+	0x49, 0x32, 0x93, 0xed, 0x5a, 0xd2, 0x79, 0x08, 0xf6, 0xa3, 0xad, 0x1d, 0x0c, 0x1d, 0x29, 0xb8,
+	0x27, 0x64, 0xdc, 0xb7, 0x48, 0x62, 0x03, 0xe2, 0xce, 0x89, 0xf4, 0x13, 0xdc, 0xa9, 0xa9, 0x1d,
+	0x0d, 0x3a, 0x7f, 0xb1, 0xa1, 0x45, 0xdf, 0x22, 0xc6, 0x27, 0x83, 0xba, 0x14, 0xb3, 0x40, 0x5f,
+	0x4a, 0x6b, 0x76, 0x15, 0x20, 0x9c, 0x4f, 0x87, 0x14, 0x9c, 0xb1, 0x16, 0xa0, 0x1d, 0xce, 0xa7,
+	0xe4, 0x72, 0x0a, 0xbf, 0x69, 0xe2, 0x4f, 0x85, 0x16, 0x46, 0x01, 0x88, 0xe5, 0x84, 0xad, 0x2b,
+	0x2c, 0x37, 0x58, 0xe5, 0x9a, 0x46, 0xde, 0x35, 0x18, 0x08, 0x7e, 0x98, 0x68, 0x7f, 0xd1, 0x1a,
+	0xe5, 0x15, 0x2f, 0x67, 0xbe, 0x14, 0x31, 0xf9, 0xca, 0x76, 0x0d, 0xc8, 0x3e, 0x82, 0xd6, 0x54,
+	0x24, 0x9c, 0x6c, 0x8b, 0x6e, 0xe9, 0x6c, 0xbc, 0x43, 0x86, 0x34, 0x3a, 0xac, 0x7f, 0xa9, 0x77,
+	0x1f, 0x87, 0x89, 0x3c, 0x75, 0x53, 0x62, 0xd4, 0x83, 0x74, 0x1e, 0x46, 0xe1, 0x58, 0xf4, 0xdb,
+	0x14, 0xc2, 0x6d, 0xc2, 0x3c, 0x0b, 0xc7, 0x82, 0xa2, 0x5b, 0xf0, 0x40, 0x78, 0x7d, 0xd0, 0xd1,
+	0x4d, 0x10, 0x4a, 0xe2, 0x89, 0x40, 0x24, 0xc2, 0x23, 0xbf, 0xd9, 0xae, 0x01, 0xd9, 0xdb, 0xd0,
+	0x9a, 0xcd, 0xe5, 0xbe, 0x18, 0xf2, 0xa4, 0xbf, 0xac, 0xb6, 0x08, 0x7e, 0x94, 0xa0, 0xa2, 0xd1,
+	0x49, 0x28, 0x64, 0xbf, 0x4b, 0xc1, 0xad, 0x00, 0x36, 0x00, 0x9b, 0x8f, 0x83, 0x7e, 0x8f, 0xdc,
+	0xdf, 0x22, 0xa9, 0x1f, 0x6d, 0xed, 0xb8, 0x88, 0x1c, 0x7c, 0x0c, 0xdd, 0x82, 0xe0, 0x98, 0x62,
+	0x0e, 0xc5, 0x29, 0x79, 0xa2, 0xed, 0xe2, 0x32, 0xcb, 0x85, 0xea, 0x8b, 0x51, 0xc0, 0xff, 0xd4,
+	0xfe, 0xdb, 0x72, 0x7e, 0x5b, 0x03, 0x40, 0xfd, 0x9f, 0xf8, 0x01, 0x7e, 0x7f, 0x7d, 0x58, 0x22,
+	0xcb, 0x0a, 0x15, 0x06, 0x0d, 0xd7, 0x80, 0x94, 0x41, 0xfc, 0x30, 0x51, 0x41, 0xd0, 0x70, 0x15,
+	0x80, 0xb1, 0x4c, 0x5e, 0x1b, 0xf2, 0x49, 0x22, 0x4c, 0x54, 0x01, 0xa1, 0x1e, 0x21, 0x86, 0xdd,
+	0x80, 0x65, 0x45, 0x30, 0x12, 0x93, 0x48, 0x1a, 0xa7, 0xaa, 0x43, 0x9b, 0x84, 0x42, 0x1e, 0x3c,
+	0xc7, 0xa3, 0xa1, 0x78, 0xf0, 0x02, 0x0f, 0x9e, 0xe7, 0xd1, 0x54, 0x3c, 0x78, 0x8e, 0xc7, 0x6d,
+	0xe8, 0x69, 0x2f, 0x1b, 0x22, 0xe5, 0xfb, 0xae, 0xc6, 0x6a, 0xb2, 0x9c, 0x47, 0x5a, 0xe4, 0x2a,
+	0x03, 0x3a, 0x43, 0x68, 0xa3, 0x19, 0x1e, 0x1f, 0x8b, 0x50, 0xe5, 0x92, 0xd3, 0x99, 0xd0, 0x49,
+	0x9a, 0xd6, 0xe9, 0x6b, 0x51, 0x2b, 0xbe, 0x16, 0x2a, 0x28, 0xed, 0x7c, 0x50, 0xa6, 0x6f, 0x48,
+	0x5d, 0xbd, 0x4b, 0x04, 0x38, 0x12, 0x7a, 0x26, 0x31, 0xee, 0xcd, 0x3c, 0x9e, 0x88, 0x8c, 0xce,
+	0xca, 0xbd, 0x35, 0xec, 0x16, 0xd8, 0x51, 0xa0, 0x5e, 0x90, 0xea, 0xac, 0x8a, 0xdb, 0x48, 0x15,
+	0x8a, 0x93, 0xbe, 0x7d, 0x36, 0x55, 0x28, 0x4e, 0x9c, 0xfb, 0xd0, 0xfe, 0x8a, 0x4f, 0x85, 0x8a,
+	0x0a, 0x06, 0xf5, 0x90, 0x4f, 0x85, 0x0e, 0x0b, 0x5a, 0x57, 0x29, 0xe5, 0x3c, 0x84, 0xce, 0x0b,
+	0x11, 0xf2, 0x30, 0xd9, 0x8b, 0xf9, 0x3e, 0x49, 0x39, 0x3a, 0x4d, 0x28, 0x1e, 0xe8, 0x73, 0x24,
+	0x80, 0xb0, 0x41, 0x34, 0x32, 0x1f, 0xb5, 0x02, 0x9c, 0x23, 0x73, 0xf4, 0xff, 0xe7, 0x51, 0xc2,
+	0xb3, 0x50, 0xb6, 0xf2, 0xa1, 0xfc, 0x2e, 0x34, 0x02, 0x7f, 0xea, 0x27, 0x5a, 0xc5, 0x55, 0x25,
+	0x7c, 0x76, 0xa3, 0xab, 0xb6, 0xd9, 0x2d, 0xa8, 0xcf, 0x63, 0xe1, 0xf5, 0xed, 0x33, 0xc8, 0x68,
+	0xd7, 0x91, 0xb0, 0xba, 0xed, 0xc7, 0x87, 0x5b, 0x51, 0x98, 0xc8, 0x28, 0x78, 0x12, 0xf0, 0xfd,
+	0x98, 0xdd, 0x81, 0x95, 0x38, 0x89, 0x66, 0x43, 0x1e, 0x04, 0xd1, 0x98, 0x27, 0x7e, 0xb8, 0x4f,
+	0x12, 0xb4, 0xdc, 0x1e, 0xa2, 0x1f, 0xa5, 0x58, 0x14, 0xd0, 0x93, 0xdc, 0x37, 0xaf, 0xa5, 0x02,
+	0x30, 0x1e, 0x69, 0x31, 0x44, 0xba, 0xc0, 0xc4, 0x34, 0xa1, 0x76, 0x10, 0xe3, 0xfc, 0xc9, 0x02,
+	0xc0, 0x4b, 0x77, 0x13, 0x9e, 0xcc, 0x29, 0x09, 0xc9, 0x28, 0x4a, 0x8c, 0x61, 0x71, 0x8d, 0xb8,
+	0xc9, 0x3c, 0x50, 0x49, 0xb7, 0xe5, 0xd2, 0x1a, 0x83, 0xef, 0x40, 0xf0, 0x20, 0x39, 0x38, 0x25,
+	0x9e, 0x2d, 0xd7, 0x80, 0xec, 0x1d, 0x68, 0x1f, 0xcd, 0xc5, 0x5c, 0x0c, 0x03, 0x11, 0xea, 0x2f,
+	0xa4, 0x45, 0x88, 0x1d, 0x11, 0xb2, 0x6b, 0xd0, 0xe1, 0xc7, 0xfb, 0xc3, 0x13, 0xee, 0x27, 0xc3,
+	0x69, 0xac, 0x3f, 0x8f, 0x36, 0x3f, 0xde, 0xff, 0x86, 0xfb, 0xc9, 0x97, 0x31, 0x7b, 0x0f, 0x1a,
+	0x13, 0x54, 0x5b, 0x3f, 0xc4, 0x97, 0xc8, 0x50, 0x65, 0x9b, 0xb8, 0x8a, 0xc6, 0xf9, 0xbe, 0x06,
+	0xad, 0x27, 0xb1, 0x16, 0xfc, 0x0e, 0x34, 0x63, 0x5a, 0xe9, 0xd7, 0x76, 0x25, 0x3d, 0xaa, 0x08,
+	0x5c, 0xbd, 0xcd, 0xee, 0x82, 0x1d, 0xcd, 0xd4, 0x97, 0xdf, 0xd9, 0x78, 0x8b, 0xa8, 0x0c, 0x93,
+	0xf5, 0x67, 0xb3, 0x58, 0xa5, 0x4b, 0x24, 0x29, 0x65, 0x7c, 0xbb, 0x9c, 0xf1, 0xdf, 0x07, 0x86,
+	0xdb, 0xfa, 0xa3, 0x33, 0x64, 0x4a, 0xe3, 0xd5, 0x70, 0x3e, 0xdd, 0x56, 0x1b, 0x9a, 0xfa, 0x3f,
+	0xe0, 0x02, 0x52, 0xcf, 0xc3, 0xc3, 0x30, 0x3a, 0x09, 0x87, 0x13, 0x3f, 0x10, 0x46, 0xff, 0x95,
+	0x70, 0x3e, 0xdd, 0x53, 0xf8, 0x27, 0x88, 0xa6, 0x24, 0x72, 0xcc, 0xfd, 0x60, 0x18, 0xcf, 0xf8,
+	0x58, 0xa5, 0x88, 0xba, 0x0b, 0x84, 0xda, 0x45, 0x0c, 0x12, 0x24, 0x51, 0xc2, 0x0d, 0xc1, 0x92,
+	0x22, 0x20, 0x14, 0x11, 0x0c, 0x1e, 0x40, 0xcb, 0xe8, 0xf2, 0x93, 0x32, 0xe8, 0x77, 0x16, 0xac,
+	0x28, 0x81, 0x85, 0x3c, 0x16, 0x92, 0x1e, 0x43, 0x55, 0x05, 0xaa, 0xef, 0xba, 0xe6, 0x7b, 0x18,
+	0x0e, 0xdc, 0xf3, 0xa4, 0x3e, 0x4c, 0x6b, 0x76, 0x13, 0x1a, 0x9e, 0x1f, 0x1f, 0xaa, 0x92, 0xac,
+	0xb3, 0xd1, 0x2d, 0x98, 0xd5, 0x55, 0x7b, 0x98, 0xd7, 0x02, 0x1e, 0x27, 0xc3, 0x03, 0xc1, 0x65,
+	0x32, 0x12, 0x3c, 0xd1, 0xc6, 0xea, 0x22, 0xf6, 0x0b, 0x83, 0x74, 0xde, 0x85, 0xd5, 0x9d, 0x28,
+	0x3a, 0x9c, 0xcf, 0xb6, 0xe6, 0x92, 0x27, 0x91, 0x74, 0xc5, 0x51, 0x55, 0x79, 0xeb, 0x6c, 0x02,
+	0x2b, 0xd1, 0xcd, 0x82, 0x53, 0x36, 0x80, 0x16, 0x3e, 0xd7, 0xfe, 0x98, 0x9b, 0xc7, 0x3f, 0x85,
+	0xd1, 0x12, 0x42, 0x4a, 0xfd, 0x81, 0xe0, 0xd2, 0xd9, 0x30, 0x3c, 0x9e, 0x9b, 0xa2, 0x17, 0x6f,
+	0x3b, 0xb7, 0x2a, 0x76, 0xb6, 0xe1, 0xe2, 0xc2, 0x99, 0x9f, 0x7e, 0xf3, 0x25, 0x58, 0xfb, 0x92,
+	0xc7, 0x89, 0x90, 0x5b, 0x52, 0xf0, 0x44, 0x60, 0xbe, 0x76, 0xc5, 0x91, 0xb3, 0x06, 0x17, 0x76,
+	0xfc, 0x38, 0x49, 0x59, 0xc7, 0x88, 0xfc, 0x1c, 0xd6, 0xca, 0x48, 0xbc, 0xf0, 0x1a, 0x40, 0x2a,
+	0x95, 0xba, 0xb2, 0xeb, 0xe6, 0x30, 0x15, 0x97, 0x5e, 0x86, 0x4b, 0x9f, 0x8b, 0xa4, 0xe4, 0x60,
+	0xbc, 0x61, 0x0f, 0x2e, 0x57, 0x6d, 0xe0, 0x2d, 0x77, 0xa1, 0xee, 0x87, 0x93, 0x88, 0xf8, 0x77,
+	0x36, 0x2e, 0x66, 0xe9, 0x39, 0x47, 0x48, 0x14, 0x15, 0xf7, 0xf5, 0x60, 0xf9, 0x73, 0xa1, 0x12,
+	0x28, 0x29, 0xb2, 0x03, 0xbd, 0x1c, 0xac, 0xb8, 0x37, 0x8f, 0x08, 0xd4, 0xfc, 0xf3, 0xa9, 0x91,
+	0xe8, 0x5c, 0xbd, 0x5f, 0xc1, 0xfd, 0xff, 0xa0, 0xb3, 0xab, 0xb9, 0xa1, 0xd7, 0xde, 0x28, 0x43,
+	0x3b, 0x37, 0xa0, 0x9b, 0x31, 0x43, 0xc9, 0xf4, 0x7d, 0x56, 0x76, 0xdf, 0x1f, 0x6a, 0xd0, 0x2d,
+	0x78, 0xab, 0xb2, 0x4e, 0x34, 0x65, 0x5c, 0xad, 0xba, 0x8c, 0xb3, 0x8b, 0x65, 0xdc, 0x27, 0xb9,
+	0x32, 0xae, 0x4e, 0x16, 0xb8, 0x4e, 0x12, 0x16, 0xee, 0x79, 0xcd, 0x5a, 0xae, 0x51, 0xae, 0xe5,
+	0x52, 0x8b, 0x34, 0x2b, 0xca, 0xaf, 0xa5, 0x8a, 0xf2, 0x2b, 0x7d, 0x57, 0x5b, 0xd9, 0xbb, 0xfa,
+	0x66, 0x25, 0xd9, 0x7d, 0x58, 0xc9, 0xab, 0x52, 0x69, 0xd8, 0x85, 0x3e, 0x73, 0x13, 0xba, 0x8f,
+	0x5f, 0x26, 0x22, 0xf4, 0x72, 0x76, 0x5e, 0xe8, 0x6e, 0xcf, 0xaf, 0xc7, 0x1d, 0x17, 0x56, 0xf2,
+	0x3c, 0xaa, 0x2f, 0xfe, 0x00, 0x20, 0x14, 0x27, 0x19, 0x0f, 0x3b, 0xed, 0x47, 0xd2, 0x5e, 0xd5,
+	0x6d, 0x87, 0xe2, 0x44, 0xf3, 0xfc, 0xce, 0x82, 0xd5, 0x47, 0xe3, 0xc3, 0x57, 0xcb, 0xf6, 0x2e,
+	0x34, 0xcf, 0xe5, 0xa9, 0x77, 0xd9, 0x7f, 0x42, 0xdb, 0xb4, 0x95, 0x26, 0x75, 0xae, 0x29, 0xf7,
+	0x17, 0xaa, 0x2b, 0x37, 0xa3, 0x72, 0x1e, 0x03, 0x2b, 0x89, 0x80, 0xaa, 0x15, 0x8d, 0x61, 0x95,
+	0x9f, 0xaa, 0xc5, 0x6f, 0xe7, 0x19, 0xf4, 0x76, 0x45, 0x62, 0xfc, 0x7a, 0x96, 0x1e, 0xff, 0x9e,
+	0x8b, 0x4e, 0xf5, 0xfd, 0x74, 0x0b, 0x4d, 0x46, 0x16, 0x8a, 0xce, 0x37, 0xc0, 0x94, 0xb0, 0x46,
+	0xf4, 0xf8, 0x2c, 0xa6, 0x1f, 0xc0, 0xd2, 0x9c, 0x28, 0x8d, 0x75, 0x2a, 0x55, 0x36, 0x34, 0xce,
+	0xcf, 0x61, 0xd5, 0x15, 0x94, 0x6b, 0x1e, 0xcd, 0x66, 0x22, 0xf4, 0xce, 0x62, 0x7b, 0xc6, 0xe4,
+	0x01, 0x4d, 0x33, 0xf5, 0xc3, 0xa1, 0xee, 0x9d, 0xf5, 0x2b, 0x3e, 0xf5, 0xc3, 0x67, 0x84, 0x70,
+	0xfe, 0x17, 0x58, 0x89, 0x3d, 0xda, 0x33, 0x6b, 0xb6, 0x95, 0x2d, 0x35, 0x54, 0x61, 0xc8, 0x87,
+	0xf8, 0x60, 0xce, 0xc3, 0x71, 0x31, 0x2b, 0x2c, 0x48, 0xc7, 0xa0, 0x1e, 0xfb, 0xbf, 0x14, 0xfa,
+	0x24, 0xad, 0x9d, 0x8f, 0x61, 0x65, 0x2f, 0xa4, 0xe1, 0x01, 0xb9, 0xe9, 0xac, 0xa3, 0xd5, 0x63,
+	0x9c, 0x23, 0xe8, 0x6e, 0x45, 0xb3, 0x53, 0xe5, 0x60, 0x3c, 0xba, 0x0a, 0xb6, 0x17, 0x27, 0xfa,
+	0x24, 0x2e, 0xf1, 0xe0, 0xc4, 0x97, 0x7a, 0xb4, 0xd0, 0x75, 0x15, 0x80, 0x74, 0xb1, 0x1c, 0x93,
+	0x21, 0xea, 0x2e, 0x2e, 0x91, 0x2e, 0x4e, 0xb8, 0x34, 0xcf, 0xb1, 0x02, 0x48, 0xd5, 0xd0, 0xd3,
+	0x25, 0x0a, 0x2e, 0x9d, 0xbf, 0x5a, 0x94, 0xce, 0xb3, 0x2b, 0xcf, 0x90, 0x56, 0x31, 0xab, 0x55,
+	0x30, 0xb3, 0x53, 0x66, 0xd8, 0x35, 0x4e, 0x22, 0x39, 0xc4, 0xc6, 0x9c, 0xee, 0x6d, 0xb9, 0x4b,
+	0x13, 0x7c, 0xc3, 0xb9, 0x87, 0x15, 0x24, 0x6e, 0x51, 0x1e, 0xd3, 0x49, 0x0d, 0x69, 0xbf, 0x41,
+	0x18, 0x37, 0x4f, 0xfc, 0xe4, 0x60, 0x48, 0x6f, 0x52, 0x53, 0x6d, 0x22, 0x82, 0x4a, 0x95, 0xdb,
+	0xd0, 0xc3, 0x3a, 0xf9, 0x64, 0x38, 0x0f, 0x75, 0x13, 0xab, 0x46, 0x34, 0x5d, 0xc2, 0xee, 0x69,
+	0xa4, 0x33, 0x85, 0x5e, 0x4e, 0x0f, 0xf4, 0x77, 0xf6, 0xc1, 0x5a, 0xe7, 0x7e, 0xb0, 0x0b, 0xfe,
+	0x67, 0x37, 0xf4, 0xf3, 0x68, 0x57, 0x7d, 0x1e, 0xb4, 0xe5, 0x6c, 0x43, 0x17, 0x0b, 0xa1, 0xf3,
+	0x12, 0x91, 0xe1, 0x52, 0x3b, 0x9b, 0xcb, 0xef, 0x2d, 0xe8, 0xb9, 0x62, 0x16, 0xc9, 0x64, 0x93,
+	0x7b, 0x2f, 0x76, 0x55, 0x9d, 0x62, 0x2a, 0xb3, 0xf2, 0x0c, 0x0d, 0xeb, 0xb4, 0x55, 0xb0, 0x47,
+	0xdc, 0xd3, 0x29, 0x19, 0x97, 0x58, 0xd7, 0x44, 0x33, 0x21, 0x39, 0xd5, 0x35, 0x36, 0xe1, 0x33,
+	0x04, 0x5a, 0x76, 0x3f, 0x4a, 0x86, 0x42, 0xca, 0x48, 0x9a, 0xc2, 0x7d, 0x3f, 0x4a, 0xd4, 0x00,
+	0xf2, 0x26, 0x74, 0xc7, 0xd1, 0x3c, 0xf0, 0x86, 0x52, 0x8c, 0xa3, 0x63, 0xdd, 0xd9, 0xb6, 0xdc,
+	0x65, 0x42, 0xba, 0x0a, 0xe7, 0x3c, 0x86, 0xee, 0x13, 0xff, 0xe5, 0xd7, 0x6a, 0x4c, 0x87, 0x02,
+	0x3a, 0x69, 0xed, 0x60, 0x55, 0x18, 0x35, 0xad, 0x1a, 0x8a, 0x62, 0x3a, 0x87, 0xb0, 0x8c, 0xe5,
+	0x0e, 0xea, 0x1f, 0xbf, 0xb2, 0x1c, 0x2b, 0x06, 0x5c, 0xd7, 0x04, 0xdc, 0x1d, 0x68, 0x4e, 0x68,
+	0x0a, 0xd0, 0xb7, 0x73, 0xed, 0x40, 0x36, 0x1c, 0x70, 0xf5, 0xb6, 0xf3, 0x1b, 0x0b, 0x7a, 0xb9,
+	0xdb, 0xd0, 0x3d, 0x0c, 0xea, 0x87, 0xe2, 0xd4, 0x54, 0x54, 0xb4, 0xae, 0x70, 0xfc, 0x4d, 0xfc,
+	0x2c, 0x27, 0x51, 0xb1, 0xe4, 0x4d, 0x7d, 0xa6, 0xf6, 0xe8, 0x3d, 0x15, 0x2f, 0xcd, 0xc8, 0x8e,
+	0xd6, 0x88, 0x9b, 0x62, 0x53, 0xaf, 0x2c, 0x48, 0x6b, 0x67, 0x93, 0xbe, 0x2c, 0x6a, 0xd8, 0x5f,
+	0x4f, 0x65, 0x35, 0x5e, 0x50, 0x4f, 0xa6, 0x02, 0x9c, 0x5f, 0x40, 0x2f, 0xc7, 0x43, 0x47, 0xb5,
+	0x20, 0xb0, 0x10, 0xd5, 0xe9, 0x68, 0xc0, 0xd5, 0xbb, 0xa9, 0x94, 0xba, 0x73, 0x26, 0x29, 0xb5,
+	0xc2, 0x76, 0x96, 0xe9, 0x3e, 0x82, 0x15, 0x55, 0xf7, 0x62, 0x1b, 0x9e, 0x5a, 0x6a, 0x21, 0x01,
+	0x2c, 0xa6, 0xc8, 0xa7, 0xd0, 0x76, 0x45, 0x48, 0x87, 0x28, 0x67, 0x4c, 0x64, 0x34, 0x35, 0x0d,
+	0x26, 0xae, 0xf1, 0xfd, 0x4f, 0x22, 0x1d, 0x01, 0xb5, 0x24, 0x52, 0xf3, 0xbb, 0x59, 0x80, 0xad,
+	0x8d, 0x4a, 0x53, 0x06, 0x74, 0x3e, 0x82, 0xee, 0x5e, 0x38, 0xf2, 0x43, 0xef, 0xab, 0x8c, 0xdd,
+	0x6b, 0x0d, 0x02, 0x3e, 0x51, 0x31, 0x85, 0xc7, 0xc8, 0xc0, 0x6f, 0x41, 0x73, 0x26, 0xc5, 0xc4,
+	0x7f, 0xa9, 0x4f, 0x6a, 0xa8, 0x18, 0x4d, 0x6d, 0x1d, 0x4d, 0x58, 0xb7, 0xe6, 0x4e, 0xab, 0xba,
+	0x75, 0x49, 0x84, 0x89, 0xf4, 0x45, 0xd1, 0xb6, 0xe9, 0x84, 0xc2, 0x35, 0xdb, 0x15, 0xf6, 0xf8,
+	0xb5, 0x05, 0x3d, 0x55, 0x14, 0xe5, 0xf3, 0x3e, 0x0d, 0x7c, 0xad, 0xdc, 0xc0, 0xf7, 0x4a, 0x5a,
+	0x15, 0x9d, 0xf1, 0x75, 0x47, 0x93, 0x89, 0xf1, 0x4f, 0x34, 0x99, 0x20, 0x66, 0x26, 0x7d, 0x0a,
+	0xb5, 0x86, 0x8b, 0xcb, 0xfc, 0x08, 0xbd, 0x51, 0x18, 0xa1, 0x3b, 0xbf, 0x82, 0x16, 0xa5, 0xd3,
+	0x57, 0x67, 0x91, 0xb3, 0xc7, 0xf0, 0xaf, 0x23, 0xc1, 0x25, 0x68, 0x4a, 0x71, 0x34, 0xf4, 0xd5,
+	0x3b, 0xd2, 0x76, 0x1b, 0x52, 0x1c, 0x3d, 0xf5, 0x9c, 0xdf, 0x59, 0xb0, 0x84, 0xa9, 0xfe, 0x0d,
+	0xaf, 0xc7, 0x09, 0x83, 0xbe, 0x3e, 0x10, 0xa9, 0x40, 0xf5, 0x05, 0x81, 0x1a, 0x55, 0x02, 0x35,
+	0xf3, 0x02, 0x5d, 0x85, 0xb6, 0x92, 0xa7, 0xba, 0xf2, 0x3f, 0x00, 0xc0, 0xed, 0xaf, 0x5d, 0x1e,
+	0xee, 0x8b, 0x7f, 0xa5, 0xc4, 0xce, 0xb7, 0xd0, 0x52, 0x37, 0x89, 0x23, 0x76, 0x1b, 0x1a, 0xf8,
+	0x3c, 0x9a, 0x08, 0x53, 0x29, 0x2c, 0x93, 0xc3, 0x55, 0xbb, 0x46, 0xc9, 0x5a, 0x95, 0x92, 0x76,
+	0x5e, 0xc9, 0xcf, 0x8c, 0x16, 0x67, 0x3c, 0x42, 0xcb, 0x60, 0x85, 0x54, 0x92, 0xd9, 0xae, 0x15,
+	0x62, 0x48, 0x0a, 0x29, 0x55, 0x7a, 0xb3, 0x5d, 0x5a, 0x3b, 0x87, 0xd0, 0x31, 0xc5, 0xce, 0x9b,
+	0xb8, 0xce, 0x14, 0x43, 0x76, 0x56, 0x0c, 0x2d, 0xc6, 0x8e, 0xf3, 0x33, 0x58, 0xc6, 0x67, 0x33,
+	0xfd, 0x46, 0xde, 0xc0, 0xec, 0xc8, 0xd9, 0xce, 0x38, 0xef, 0x42, 0x2f, 0xc7, 0xb9, 0xda, 0x18,
+	0x15, 0x05, 0x1b, 0xbe, 0x90, 0xd3, 0xc8, 0x1b, 0xc6, 0x09, 0x9f, 0xce, 0x74, 0x66, 0x6a, 0x4d,
+	0x23, 0x6f, 0x17, 0x61, 0x67, 0x95, 0xd2, 0x2f, 0x0e, 0x9c, 0x4c, 0x53, 0xfd, 0x14, 0x56, 0x0b,
+	0x18, 0xbc, 0x28, 0x1d, 0x94, 0x58, 0xe7, 0x0c, 0x4a, 0x16, 0x53, 0xc6, 0x1e, 0xb0, 0x5d, 0x91,
+	0x14, 0x86, 0x60, 0xe2, 0xa8, 0x72, 0x58, 0x97, 0x4e, 0xd0, 0x6a, 0xaf, 0x9e, 0xa0, 0x6d, 0xfc,
+	0xc3, 0x86, 0x55, 0x35, 0x85, 0xd8, 0x95, 0xc7, 0x5f, 0xf0, 0xd0, 0x0b, 0x84, 0x64, 0x9f, 0x42,
+	0xb7, 0x30, 0x57, 0x61, 0x8a, 0x47, 0x79, 0x26, 0x33, 0xb8, 0x5c, 0x85, 0x46, 0x1d, 0x1f, 0x9b,
+	0x87, 0x22, 0x1d, 0x58, 0xb0, 0x3c, 0x6d, 0x7e, 0xd4, 0x32, 0x78, 0xbb, 0x7a, 0x43, 0xb1, 0x59,
+	0x2d, 0x4f, 0x48, 0x58, 0x9f, 0xc8, 0x2b, 0x06, 0x27, 0x67, 0x4b, 0xb3, 0xa9, 0x72, 0xf7, 0xf3,
+	0x6c, 0x2e, 0xa2, 0x86, 0x7e, 0x0b, 0x63, 0x96, 0x41, 0xbf, 0x12, 0x8f, 0x3c, 0xbe, 0x02, 0xb6,
+	0x38, 0x1e, 0x61, 0x03, 0xa2, 0xaf, 0x1c, 0xa8, 0x0c, 0xae, 0x9c, 0xb9, 0x87, 0xfc, 0xee, 0x43,
+	0x3b, 0x9d, 0x83, 0xb0, 0x0b, 0x86, 0x34, 0x9d, 0x93, 0x0c, 0xd6, 0xca, 0x28, 0x3c, 0xf4, 0x21,
+	0xb4, 0xcc, 0x84, 0x82, 0xa9, 0x31, 0x46, 0x6e, 0xfa, 0x31, 0x60, 0x25, 0xcc, 0x2c, 0x38, 0xdd,
+	0xf8, 0x73, 0x1b, 0x2e, 0x68, 0x5b, 0xe4, 0xfc, 0xfb, 0x00, 0x20, 0x67, 0x51, 0xb6, 0x38, 0x6e,
+	0x18, 0x5c, 0x5c, 0xc0, 0xe1, 0xfd, 0x0f, 0x00, 0xb2, 0xb6, 0x53, 0x9f, 0x2b, 0xb4, 0xc2, 0x83,
+	0x8b, 0x0b, 0x38, 0x3c, 0xf7, 0x29, 0x74, 0x0b, 0x1d, 0xab, 0x8e, 0xa7, 0x72, 0x23, 0x3d, 0xb8,
+	0x5c, 0x85, 0x46, 0x06, 0xb7, 0x01, 0xd4, 0x2c, 0x95, 0x4e, 0x77, 0xb2, 0x42, 0x6b, 0x7b, 0x00,
+	0xea, 0x46, 0x2a, 0x51, 0xef, 0xc0, 0xf2, 0x5e, 0xe8, 0xbd, 0x06, 0xe1, 0x2d, 0x68, 0x3f, 0xc7,
+	0x7f, 0x50, 0x9d, 0x4f, 0x75, 0x13, 0xcd, 0xcd, 0x83, 0xf3, 0x89, 0xd6, 0xa1, 0xbd, 0x15, 0x44,
+	0x61, 0x05, 0xab, 0x6a, 0x1b, 0xae, 0x43, 0x27, 0xd7, 0x76, 0xb3, 0x35, 0xe3, 0xb4, 0x5c, 0x23,
+	0x5e, 0xe0, 0xff, 0x00, 0x56, 0x4a, 0x5d, 0xb5, 0xfe, 0x94, 0x16, 0x7b, 0xed, 0xc2, 0xb9, 0x4f,
+	0xa1, 0x5b, 0xe8, 0x6a, 0xb5, 0xcd, 0xcb, 0x8d, 0xf4, 0xe0, 0x72, 0x15, 0x5a, 0x05, 0xdb, 0x72,
+	0xbe, 0xad, 0x65, 0x66, 0xee, 0x57, 0xe8, 0x74, 0x0b, 0x57, 0x7e, 0x08, 0xcb, 0xf9, 0x6e, 0x56,
+	0x9f, 0x28, 0x35, 0xb8, 0x85, 0x13, 0xef, 0x03, 0x64, 0x2d, 0xac, 0x09, 0xc4, 0x7c, 0x4f, 0x5b,
+	0xa0, 0x56, 0xdf, 0x8c, 0x26, 0xbe, 0x50, 0xfc, 0xbc, 0x0a, 0xdf, 0x4c, 0xbe, 0xaf, 0x7b, 0x0f,
+	0x5a, 0xa6, 0xf5, 0x2a, 0xba, 0x47, 0x7f, 0x2e, 0x85, 0xb6, 0x6c, 0x1d, 0x3a, 0xb9, 0x06, 0x4b,
+	0x3b, 0xa7, 0xd8, 0x72, 0x95, 0xe5, 0xcf, 0xda, 0x1d, 0x2d, 0x7f, 0xa1, 0xff, 0x29, 0xcb, 0x9f,
+	0xf6, 0x19, 0x5a, 0xfe, 0x7c, 0x97, 0x33, 0x58, 0x2b, 0xa3, 0xb2, 0x44, 0xa1, 0x6a, 0xfa, 0x4c,
+	0xe9, 0xb4, 0x4f, 0x18, 0xac, 0x95, 0x51, 0x78, 0xe8, 0x33, 0x80, 0xac, 0x50, 0x67, 0x57, 0xd6,
+	0xd5, 0x8f, 0x50, 0xd6, 0xcd, 0x8f, 0x50, 0xd6, 0x77, 0x13, 0xe9, 0x87, 0xfb, 0x5f, 0xe3, 0x8c,
+	0x4e, 0x87, 0x69, 0xb9, 0xae, 0xbf, 0x05, 0x4d, 0x55, 0xb1, 0x33, 0xfd, 0xdb, 0x00, 0x53, 0xbe,
+	0x97, 0xf5, 0xcf, 0x8a, 0x71, 0xad, 0x7f, 0xa1, 0x3a, 0xaf, 0xd2, 0x1f, 0xb7, 0xf2, 0xfa, 0x9b,
+	0x8a, 0x7c, 0xb0, 0x56, 0x46, 0x61, 0x06, 0xfb, 0xa3, 0x0d, 0xcb, 0x2f, 0x76, 0x73, 0xc9, 0x6b,
+	0x1d, 0x3a, 0xb9, 0xd2, 0x59, 0xfb, 0xa8, 0x58, 0x4c, 0x17, 0x6e, 0x75, 0xa0, 0xa1, 0xe6, 0x06,
+	0xea, 0xa5, 0x35, 0x45, 0x6f, 0x29, 0x1f, 0xd4, 0x69, 0xee, 0xb0, 0x9c, 0x16, 0x58, 0x48, 0xd1,
+	0xcb, 0x41, 0x68, 0x93, 0x3b, 0xd0, 0xa0, 0xea, 0x49, 0x73, 0x32, 0x55, 0xda, 0x20, 0x5f, 0x96,
+	0xe9, 0xd6, 0xa0, 0x65, 0xbe, 0x13, 0x9d, 0xa7, 0x73, 0x35, 0x53, 0xd9, 0x24, 0x69, 0x1d, 0xa2,
+	0x4d, 0x92, 0xaf, 0x78, 0x06, 0x6b, 0x65, 0x14, 0xb2, 0x7f, 0x08, 0x9d, 0x5c, 0x55, 0xc1, 0xd2,
+	0x08, 0xc8, 0x55, 0x1e, 0x83, 0x4b, 0x8b, 0x48, 0x95, 0xc1, 0x57, 0x4a, 0x55, 0x84, 0xce, 0x26,
+	0x8b, 0xb5, 0x45, 0x41, 0xce, 0x0d, 0x68, 0x6e, 0xf1, 0x70, 0x2c, 0x82, 0x57, 0x04, 0x53, 0xee,
+	0xcc, 0xe6, 0xda, 0xdf, 0x7e, 0xbc, 0x66, 0x7d, 0xff, 0xe3, 0x35, 0xeb, 0x87, 0x1f, 0xaf, 0x59,
+	0xdf, 0x36, 0xe8, 0xe7, 0x51, 0xa3, 0x26, 0x1d, 0xbb, 0xff, 0xcf, 0x01, 0x00, 0x2d, 0x47, 0xb1,
+	0x4d, 0x3c, 0x25, 0x00, 0x00,
+}
@@ -0,0 +1,6 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+//go:generate ../../../tools/genproto
+
+package blbpb
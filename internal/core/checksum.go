@@ -0,0 +1,36 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package core
+
+import "hash/crc32"
+
+var crc32Table = crc32.MakeTable(crc32.Castagnoli)
+
+// TractChecksum is an end-to-end checksum of a prefix of a tract's data. It's
+// computed by the client that wrote the data and stored by the curator, so it
+// can be used to check data independently of the checksums that tractservers
+// keep on disk.
+type TractChecksum struct {
+	// CRC32 (Castagnoli) of the first Length bytes of the tract.
+	CRC uint32
+
+	// The number of bytes covered. Zero means the tract has no checksum.
+	Length int
+}
+
+// Present returns true if the checksum covers any data.
+func (c TractChecksum) Present() bool {
+	return c.Length > 0
+}
+
+// Extend returns the checksum of the data covered by c followed by b.
+func (c TractChecksum) Extend(b []byte) TractChecksum {
+	return TractChecksum{CRC: crc32.Update(c.CRC, crc32Table, b), Length: c.Length + len(b)}
+}
+
+// Verify returns true if the prefix of b covered by c matches it. b must start
+// at the beginning of the tract and must be at least c.Length bytes long.
+func (c TractChecksum) Verify(b []byte) bool {
+	return len(b) >= c.Length && crc32.Checksum(b[:c.Length], crc32Table) == c.CRC
+}
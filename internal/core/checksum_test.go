@@ -0,0 +1,42 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package core
+
+import "testing"
+
+// Test that extending a checksum piece by piece matches checksumming all at once.
+func TestTractChecksum(t *testing.T) {
+	data := []byte("the quick brown fox jumps over the lazy dog")
+
+	var c TractChecksum
+	if c.Present() {
+		t.Errorf("zero checksum should not be present")
+	}
+	for b := data; len(b) > 0; {
+		n := 10
+		if n > len(b) {
+			n = len(b)
+		}
+		c, b = c.Extend(b[:n]), b[n:]
+	}
+	var all TractChecksum
+	if all = all.Extend(data); c != all {
+		t.Errorf("incremental checksum %+v doesn't match %+v", c, all)
+	}
+
+	if !c.Verify(data) {
+		t.Errorf("checksum should match data")
+	}
+	if !c.Verify(append(data, "trailing"...)) {
+		t.Errorf("data past the checksummed prefix should be ignored")
+	}
+	if c.Verify(data[:len(data)-1]) {
+		t.Errorf("short data should not match")
+	}
+	bad := append([]byte(nil), data...)
+	bad[3]++
+	if c.Verify(bad) {
+		t.Errorf("corrupted data should not match")
+	}
+}
@@ -40,6 +40,9 @@ const (
 	StorageClass_RS_8_3  StorageClass = 2
 	StorageClass_RS_10_3 StorageClass = 3
 	StorageClass_RS_12_5 StorageClass = 4
+	// Locally repairable code K_L_R, with K data in L local groups that each
+	// have a parity piece, and R global parity.
+	StorageClass_LRC_12_2_2 StorageClass = 5
 )
 
 var StorageClass_name = map[int32]string{
@@ -48,6 +51,7 @@ var StorageClass_name = map[int32]string{
 	2: "RS_8_3",
 	3: "RS_10_3",
 	4: "RS_12_5",
+	5: "LRC_12_2_2",
 }
 var StorageClass_value = map[string]int32{
 	"REPLICATED": 0,
@@ -55,6 +59,7 @@ var StorageClass_value = map[string]int32{
 	"RS_8_3":     2,
 	"RS_10_3":    3,
 	"RS_12_5":    4,
+	"LRC_12_2_2": 5,
 }
 
 func (x StorageClass) Enum() *StorageClass {
@@ -169,19 +174,20 @@ func init() {
 func init() { proto.RegisterFile("internal/core/core.proto", fileDescriptorCore) }
 
 var fileDescriptorCore = []byte{
-	// 222 bytes of a gzipped FileDescriptorProto
+	// 231 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc8, 0xcc, 0x2b, 0x49,
 	0x2d, 0xca, 0x4b, 0xcc, 0xd1, 0x4f, 0xce, 0x2f, 0x4a, 0x05, 0x13, 0x7a, 0x05, 0x45, 0xf9, 0x25,
-	0xf9, 0x42, 0x2c, 0x20, 0xb6, 0x56, 0x00, 0x17, 0x4f, 0x70, 0x49, 0x7e, 0x51, 0x62, 0x7a, 0xaa,
+	0xf9, 0x42, 0x2c, 0x20, 0xb6, 0x56, 0x02, 0x17, 0x4f, 0x70, 0x49, 0x7e, 0x51, 0x62, 0x7a, 0xaa,
 	0x73, 0x4e, 0x62, 0x71, 0xb1, 0x10, 0x1f, 0x17, 0x57, 0x90, 0x6b, 0x80, 0x8f, 0xa7, 0xb3, 0x63,
 	0x88, 0xab, 0x8b, 0x00, 0x83, 0x10, 0x17, 0x17, 0x5b, 0x50, 0x70, 0xbc, 0x59, 0xbc, 0xb1, 0x00,
 	0x23, 0x94, 0x6d, 0x11, 0x6f, 0x2c, 0xc0, 0x24, 0xc4, 0xcd, 0xc5, 0x1e, 0x14, 0x1c, 0x6f, 0x68,
-	0x10, 0x6f, 0x2c, 0xc0, 0x0c, 0xe3, 0x18, 0xc5, 0x9b, 0x0a, 0xb0, 0x68, 0x99, 0x73, 0x71, 0x43,
-	0x4d, 0xf4, 0xc8, 0xcc, 0x2b, 0x01, 0xc9, 0xb9, 0xb8, 0xba, 0x39, 0x86, 0xfa, 0x84, 0x08, 0x30,
-	0x08, 0xb1, 0x73, 0x31, 0x7b, 0xf8, 0x87, 0x08, 0x30, 0x0a, 0x71, 0x70, 0xb1, 0x84, 0x3b, 0x06,
-	0xf9, 0x0a, 0x30, 0x81, 0x58, 0xce, 0xfe, 0x3e, 0x2e, 0x02, 0xcc, 0x5a, 0x16, 0x5c, 0x1c, 0x01,
-	0x45, 0x99, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x42, 0xbc, 0x5c, 0x9c, 0x21, 0xc1, 0x28, 0xfa, 0x7c,
-	0xfc, 0xc3, 0x21, 0x4e, 0xf0, 0x75, 0x75, 0xf1, 0x0c, 0x85, 0xea, 0xf4, 0xf0, 0x74, 0xf7, 0x10,
-	0x60, 0x76, 0xe2, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x81, 0x22, 0x27, 0x92, 0xf3, 0x00, 0x00, 0x00,
+	0x10, 0x6f, 0x2c, 0xc0, 0x0c, 0xe3, 0x18, 0xc5, 0x9b, 0x0a, 0xb0, 0x80, 0x4c, 0xf0, 0x09, 0x72,
+	0x06, 0xf1, 0x8c, 0xe2, 0x8d, 0x04, 0x58, 0xb5, 0xcc, 0xb9, 0xb8, 0xa1, 0x36, 0x78, 0x64, 0xe6,
+	0x95, 0x80, 0xd4, 0xba, 0xb8, 0xba, 0x39, 0x86, 0xfa, 0x84, 0x08, 0x30, 0x08, 0xb1, 0x73, 0x31,
+	0x7b, 0xf8, 0x87, 0x08, 0x30, 0x0a, 0x71, 0x70, 0xb1, 0x84, 0x3b, 0x06, 0xf9, 0x0a, 0x30, 0x81,
+	0x58, 0xce, 0xfe, 0x3e, 0x2e, 0x02, 0xcc, 0x5a, 0x16, 0x5c, 0x1c, 0x01, 0x45, 0x99, 0xf9, 0x45,
+	0x99, 0x25, 0x95, 0x42, 0xbc, 0x5c, 0x9c, 0x21, 0xc1, 0x28, 0xfa, 0x7c, 0xfc, 0xc3, 0x21, 0x4e,
+	0xf2, 0x75, 0x75, 0xf1, 0x0c, 0x85, 0xea, 0xf4, 0xf0, 0x74, 0xf7, 0x10, 0x60, 0x76, 0xe2, 0x39,
+	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x01, 0x03, 0x00, 0xd0,
+	0xde, 0x4b, 0x34, 0x03, 0x01, 0x00, 0x00,
 }
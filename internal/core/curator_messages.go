@@ -68,6 +68,23 @@ type CreateBlobReq struct {
 
 	// Expiry time.
 	Expires time.Time
+
+	// Initial user metadata.
+	Metadata map[string]string
+
+	// Should the blob be write-once? See BlobInfo.WriteOnce.
+	WriteOnce bool
+
+	// Who owns the blob? See BlobInfo.Owner.
+	Owner string
+
+	// Who may use the blob? See BlobInfo.ACL.
+	ACL ACL
+
+	// If set, the new blob is bound to this name in the namespace, atomically
+	// with its creation. The request must then go to the curator that owns
+	// the namespace, and fails with ErrAlreadyExists if the name is taken.
+	Name string
 }
 
 // CreateBlobReply is a reply to a CreateBlobReq sent from the curator to the client.
@@ -110,6 +127,10 @@ type AckExtendBlobReq struct {
 
 	// Successfully created tracts.
 	Tracts []TractInfo
+
+	// End-to-end checksum updates for the new tracts, or existing ones, that
+	// are applied along with the extension.
+	Checksums []ChecksumUpdate
 }
 
 // AckExtendBlobReply is the reply message for AckExtendBlobReq.
@@ -120,6 +141,43 @@ type AckExtendBlobReply struct {
 	Err Error
 }
 
+// ReserveAppendMethod is the method name for client to curator request to
+// reserve space at the end of a blob for an append.
+const ReserveAppendMethod = "CuratorSrvHandler.ReserveAppend"
+
+// ReserveAppendReq asks the curator to reserve Length bytes at the end of a
+// blob. Each reservation gets a distinct range, so concurrent appenders don't
+// overwrite each other.
+type ReserveAppendReq struct {
+	Blob   BlobID
+	Length int64
+
+	// The client's idea of the current length of the blob. Appends never go
+	// before this offset, which matters if the blob was also written with
+	// regular writes.
+	MinOffset int64
+}
+
+// ReserveAppendReply is the reply to a ReserveAppendReq.
+type ReserveAppendReply struct {
+	// Where the client should write its data.
+	Offset int64
+
+	Err Error
+}
+
+// TruncateBlobMethod is the method name for client to curator request to drop
+// the tracts of a blob beyond a new size. Request is TruncateBlobReq, reply is
+// Error.
+const TruncateBlobMethod = "CuratorSrvHandler.TruncateBlob"
+
+// TruncateBlobReq asks the curator to truncate a blob to Size bytes. The client
+// must have already truncated the new last tract on its tractservers.
+type TruncateBlobReq struct {
+	Blob BlobID
+	Size int64
+}
+
 // DeleteBlobMethod is the method name for client to curator delete blob. Request is BlobID, reply is Error.
 const DeleteBlobMethod = "CuratorSrvHandler.DeleteBlob"
 
@@ -127,6 +185,51 @@ const DeleteBlobMethod = "CuratorSrvHandler.DeleteBlob"
 // Request is BlobID, reply is Error.
 const UndeleteBlobMethod = "CuratorSrvHandler.UndeleteBlob"
 
+// PurgeBlobMethod is the method name for client to curator request to remove a
+// deleted blob for good, without waiting for it to be purged. Request is
+// BlobID, reply is Error.
+const PurgeBlobMethod = "CuratorSrvHandler.PurgeBlob"
+
+// SealBlobMethod is the method name for client to curator request to seal a
+// write-once blob. Request is BlobID, reply is Error.
+const SealBlobMethod = "CuratorSrvHandler.SealBlob"
+
+// CloneBlobMethod is the method name for client to curator request to clone a
+// blob. Request is BlobID, reply is CreateBlobReply.
+const CloneBlobMethod = "CuratorSrvHandler.CloneBlob"
+
+// UnshareTractMethod is the method name for client to curator request to
+// give every blob sharing a tract its own copy, so that the tract can be
+// written. The copies are made by tractservers. Request is UnshareTractReq,
+// reply is Error.
+const UnshareTractMethod = "CuratorSrvHandler.UnshareTract"
+
+// UnshareTractReq identifies a tract of a blob that the client wants to write.
+type UnshareTractReq struct {
+	Blob  BlobID
+	Index TractKey
+}
+
+// CopyTractsMethod is the method name for client to curator request to copy
+// tracts to the end of a blob. Request is CopyTractsReq, reply is Error.
+const CopyTractsMethod = "CuratorSrvHandler.CopyTracts"
+
+// CopyTractsReq asks the curator to have tractservers copy tracts [Start, End)
+// of blob 'Src' into new tracts at the end of blob 'Dst'. The curator looks up
+// the source tracts itself, so both blobs have to be on the same curator.
+type CopyTractsReq struct {
+	Dst BlobID
+
+	// The first new tract. If the blob doesn't end here, the request fails
+	// with ErrExtendConflict.
+	First TractKey
+
+	// The tracts to copy.
+	Src   BlobID
+	Start int
+	End   int
+}
+
 // SetMetadataMethod is the method name for client to curator request to change
 // blob metadata. Request is SetMetadataReq, reply is Error.
 const SetMetadataMethod = "CuratorSrvHandler.SetMetadata"
@@ -135,7 +238,9 @@ const SetMetadataMethod = "CuratorSrvHandler.SetMetadata"
 type SetMetadataReq struct {
 	Blob BlobID
 
-	// Only changing Hint, MTime, ATime, Expires is supported. Other fields are ignored.
+	// Only changing Hint, MTime, ATime, Expires, and Metadata is supported.
+	// Other fields are ignored. Keys in Metadata with empty values are removed,
+	// others are added or replaced.
 	Metadata BlobInfo
 }
 
@@ -152,6 +257,14 @@ type GetTractsReq struct {
 
 	// Is the client intending to open this blob for reading or writing (or both)?
 	ForRead, ForWrite bool
+
+	// Should the reply include the blob's info? This saves a separate
+	// StatBlob when opening a blob.
+	WithInfo bool
+
+	// Reading a write-once blob before it's sealed fails with ErrNotSealed,
+	// unless this is set.
+	AllowUnsealed bool
 }
 
 // GetTractsReply is the reply to a GetTractsReq.
@@ -166,6 +279,31 @@ type GetTractsReply struct {
 
 	// Was there any error encountered?  If so, the rest of the fields should be ignored.
 	Err Error
+
+	// The blob's info, if GetTractsReq.WithInfo was set. Curators that
+	// don't support WithInfo leave this nil.
+	Info *BlobInfo
+}
+
+// UpdateChecksumsMethod is the method name for client to curator request to
+// update end-to-end tract checksums. Request is UpdateChecksumsReq, reply is
+// Error.
+const UpdateChecksumsMethod = "CuratorSrvHandler.UpdateChecksums"
+
+// ChecksumUpdate changes the checksum of one tract from Old to New. If the
+// current checksum isn't Old, someone else changed the tract concurrently, and
+// the checksum is cleared instead.
+type ChecksumUpdate struct {
+	Index TractKey
+	Old   TractChecksum
+	New   TractChecksum
+}
+
+// UpdateChecksumsReq asks the curator to update checksums for some tracts in
+// a blob.
+type UpdateChecksumsReq struct {
+	Blob    BlobID
+	Updates []ChecksumUpdate
 }
 
 // StatBlobMethod is the method name for client to curator stat blob. Request is BlobID.
@@ -224,10 +362,94 @@ const ListBlobsMethod = "CuratorSrvHandler.ListBlobs"
 type ListBlobsReq struct {
 	Partition PartitionID
 	Start     BlobKey
+
+	// If set, only blobs that match are returned, along with their info.
+	Filter *BlobFilter
 }
 
 // ListBlobsReply is the result of a ListBlobs call.
 type ListBlobsReply struct {
 	Keys []BlobKey
 	Err  Error
+
+	// These are only set for requests with a Filter. Infos holds the info for
+	// each key. The curator may stop early even if nothing matched; if More
+	// is true, there may be more matching blobs starting at Next.
+	Infos []BlobInfo
+	Next  BlobKey
+	More  bool
+}
+
+// GetEventsMethod is the method name for clients to ask the curator for
+// recent changes to blobs in a partition.
+const GetEventsMethod = "CuratorSrvHandler.GetEvents"
+
+// GetEventsReq is the client request for events in a partition.
+type GetEventsReq struct {
+	Partition PartitionID
+
+	// Only events with a greater index are returned. Zero means start from
+	// the oldest event that the curator still has.
+	After uint64
+}
+
+// GetEventsReply is the result of a GetEvents call. The curator may stop
+// early; the next request should use Next as its After.
+type GetEventsReply struct {
+	Events []BlobEvent
+	Next   uint64
+	Err    Error
+}
+
+// LookupNameMethod is the method name for clients to resolve a name to a blob.
+// Request is the name as a string.
+const LookupNameMethod = "CuratorSrvHandler.LookupName"
+
+// LookupNameReply is the reply to a LookupName call.
+type LookupNameReply struct {
+	Blob BlobID
+	Err  Error
+}
+
+// RenameMethod is the method name for clients to rename a blob.
+const RenameMethod = "CuratorSrvHandler.Rename"
+
+// RenameReq asks the namespace curator to atomically move the binding of From
+// to To. Reply is Error. If Replace is non-zero, To must be bound to that blob
+// (or it fails with ErrConflictingState), and the binding is replaced.
+// Otherwise it fails with ErrAlreadyExists if To is bound. If From and To end
+// with NameSeparator, every name in the directory From is moved; To must not
+// exist yet.
+type RenameReq struct {
+	From    string
+	To      string
+	Replace BlobID
+}
+
+// UnbindNameMethod is the method name for clients to remove a name.
+const UnbindNameMethod = "CuratorSrvHandler.UnbindName"
+
+// UnbindNameReq asks the namespace curator to remove a name. Reply is Error. If
+// Blob is non-zero, the name is only removed if it's bound to that blob.
+type UnbindNameReq struct {
+	Name string
+	Blob BlobID
+}
+
+// ListNamesMethod is the method name for clients to list the namespace.
+const ListNamesMethod = "CuratorSrvHandler.ListNames"
+
+// ListNamesReq asks for the entries directly under Prefix whose names are
+// greater than Start. Names in subdirectories of Prefix are collapsed into a
+// single directory entry.
+type ListNamesReq struct {
+	Prefix string
+	Start  string
+}
+
+// ListNamesReply is the result of a ListNames call. The server may choose how
+// many entries to return at once; an empty list means there are no more.
+type ListNamesReply struct {
+	Entries []NameEntry
+	Err     Error
 }
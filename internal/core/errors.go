@@ -201,6 +201,45 @@ const (
 	// ErrDrainDisk is a fake error that should be treated the same as
 	// ErrCorruptData or ErrIO.
 	ErrDrainDisk
+
+	// ErrNoSuchName is returned if a blob name is not bound in the namespace.
+	ErrNoSuchName
+
+	// ErrWrongCurator is returned if a curator is asked to do something for a
+	// partition it doesn't own.
+	ErrWrongCurator
+
+	// ErrMetadataTooLarge is returned if the user metadata of a blob would
+	// exceed MaxMetadataSize.
+	ErrMetadataTooLarge
+
+	// ErrChecksumMismatch is returned if tract data doesn't match the
+	// end-to-end checksum stored by the curator.
+	ErrChecksumMismatch
+
+	// ErrNotSealed is returned when opening a write-once blob for reading
+	// before it has been sealed.
+	ErrNotSealed
+
+	// ErrSealed is returned when trying to modify a write-once blob after it
+	// has been sealed.
+	ErrSealed
+
+	// ErrEventsTrimmed is returned when asking for blob events that are older
+	// than the curator keeps.
+	ErrEventsTrimmed
+
+	// ErrKeyUnavailable is returned by the client when the key for an
+	// encrypted blob can't be obtained from its key provider.
+	ErrKeyUnavailable
+
+	// ErrQuotaExceeded is returned when creating or extending a blob would
+	// put its owner over their quota.
+	ErrQuotaExceeded
+
+	// ErrPermissionDenied is returned when the caller isn't allowed to use a
+	// blob by its ACL.
+	ErrPermissionDenied
 )
 
 var description = map[Error]string{
@@ -272,6 +311,16 @@ var description = map[Error]string{
 	ErrConflictingState:     "conflicting state in transaction",
 	ErrReadOnlyMode:         "raft read-only mode",
 	ErrDrainDisk:            "fake error to drain disk",
+	ErrNoSuchName:           "name does not exist",
+	ErrWrongCurator:         "curator does not own the requested partition",
+	ErrMetadataTooLarge:     "blob metadata is too large",
+	ErrChecksumMismatch:     "tract data does not match its end-to-end checksum",
+	ErrNotSealed:            "blob has not been sealed",
+	ErrSealed:               "blob is sealed",
+	ErrEventsTrimmed:        "blob events are no longer available",
+	ErrKeyUnavailable:       "encryption key is unavailable",
+	ErrQuotaExceeded:        "owner is over their quota",
+	ErrPermissionDenied:     "permission denied",
 }
 
 // String returns a human readable error message.
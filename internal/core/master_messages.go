@@ -63,6 +63,9 @@ type CuratorHeartbeatReq struct {
 
 	// Where can we reach the curator?
 	Addr string
+
+	// How much space do the blobs of each owner use on this curator?
+	Usage map[string]TenantUsage
 }
 
 // CuratorHeartbeatReply is the reply to a curator heartbeat. This message
@@ -71,6 +74,12 @@ type CuratorHeartbeatReply struct {
 	// What partitions does the curator own?
 	Partitions []PartitionID
 
+	// The quotas of all owners that have one.
+	Quotas map[string]TenantUsage
+
+	// How much space do the blobs of each owner use on the other curators?
+	OtherUsage map[string]TenantUsage
+
 	// lib.NoError is everything went OK, otherwise an error representing
 	// what went wrong.
 	Err Error
@@ -253,3 +262,31 @@ type GetTractserverInfoReply struct {
 	Info []TractserverInfo
 	Err  Error
 }
+
+// GetQuotasMethod is the method name to get the quotas and usage of owners.
+const GetQuotasMethod = "MasterSrvHandler.GetQuotas"
+
+// GetQuotasReq requests the quotas and usage of all owners from the master.
+type GetQuotasReq struct {
+}
+
+// GetQuotasReply has the quotas and usage of all owners that have either,
+// sorted by owner.
+type GetQuotasReply struct {
+	Quotas []TenantQuota
+	Err    Error
+}
+
+// SetQuotaMethod is the method name to set the quota of an owner.
+const SetQuotaMethod = "MasterSrvHandler.SetQuota"
+
+// SetQuotaReq sets the quota of an owner. A zero Limit removes the quota.
+type SetQuotaReq struct {
+	Owner string
+	Limit TenantUsage
+}
+
+// SetQuotaReply is the reply to a SetQuotaReq.
+type SetQuotaReply struct {
+	Err Error
+}
@@ -3,7 +3,10 @@
 
 package core
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // This file contains common structs that are used as parts of other messages.
 
@@ -28,6 +31,16 @@ type TractInfo struct {
 
 	// Pointer to the tract encoded in an RS chunk.
 	RS TractPointer
+
+	// ==== for all classes:
+
+	// End-to-end checksum of the tract data, if known.
+	Checksum TractChecksum
+
+	// Is the tract shared between a blob and its clones? Shared tracts must be
+	// unshared before they're written. Tract is the ID of the shared copy,
+	// which may belong to a different blob.
+	Shared bool
 }
 
 // BlobInfo is information about a blob, analogous to os.FileInfo.
@@ -49,6 +62,246 @@ type BlobInfo struct {
 
 	// Time after which this blob can be automatically deleted by the system.
 	Expires time.Time
+
+	// User-defined key/value metadata. See ValidMetadataKey and
+	// MaxMetadataSize for restrictions.
+	Metadata map[string]string
+
+	// Was the blob created to be sealed on close? If so, it can't be read
+	// until it's sealed, and can't be written after.
+	WriteOnce bool
+
+	// Has a write-once blob been sealed?
+	Sealed bool
+
+	// When was the blob deleted? This is zero unless the blob is deleted but
+	// can still be undeleted.
+	Deleted time.Time
+
+	// When will the blob be removed for good, because it was deleted or it
+	// expired? This is zero if neither applies.
+	PurgeAt time.Time
+
+	// Which tenant owns the blob? Its space counts against the owner's quota.
+	// Empty if the blob has no owner.
+	Owner string
+
+	// Who may use the blob. It's set when the blob is created.
+	ACL ACL
+}
+
+// ACL says who may use a blob, by the names that their tokens authenticate
+// as. Blobs with an empty ACL can be used by any client. Peers with the
+// control or admin role can use any blob.
+type ACL struct {
+	// Readers may stat, read, and clone the blob.
+	Readers []string
+
+	// Writers may also write, extend, seal, truncate, delete, and undelete
+	// the blob, and change its metadata.
+	Writers []string
+}
+
+// IsEmpty returns true if 'a' allows any client.
+func (a ACL) IsEmpty() bool {
+	return len(a.Readers) == 0 && len(a.Writers) == 0
+}
+
+// Allows returns true if 'a' lets 'name' read the blob or, if 'write' is true,
+// change it.
+func (a ACL) Allows(name string, write bool) bool {
+	if a.IsEmpty() {
+		return true
+	}
+	for _, w := range a.Writers {
+		if w == name {
+			return true
+		}
+	}
+	if !write {
+		for _, r := range a.Readers {
+			if r == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TenantUsage is an amount of space used by the blobs of one owner, including
+// deleted blobs that haven't been purged yet. Bytes is the raw space of the
+// tracts allocated to the blobs: TractLength for each tract, times the
+// replication factor, or times the overhead of the blob's erasure code. As a
+// quota, it's the most the owner may use; a zero field means that there's no
+// limit.
+type TenantUsage struct {
+	Bytes int64
+	Blobs int64
+}
+
+// Add returns the sum of 'u' and 'v'.
+func (u TenantUsage) Add(v TenantUsage) TenantUsage {
+	return TenantUsage{Bytes: u.Bytes + v.Bytes, Blobs: u.Blobs + v.Blobs}
+}
+
+// Exceeds returns true if 'u' is over the quota 'limit'.
+func (u TenantUsage) Exceeds(limit TenantUsage) bool {
+	return (limit.Bytes > 0 && u.Bytes > limit.Bytes) || (limit.Blobs > 0 && u.Blobs > limit.Blobs)
+}
+
+// TenantQuota is the quota and usage of one owner.
+type TenantQuota struct {
+	Owner string
+	Limit TenantUsage
+	Used  TenantUsage
+}
+
+// BlobFilter selects blobs when listing them. The zero value selects all blobs
+// that aren't deleted.
+type BlobFilter struct {
+	// If not empty, only blobs with one of these storage classes match.
+	Classes []StorageClass
+
+	// If not empty, only blobs with one of these hints match.
+	Hints []StorageHint
+
+	// If not zero, only blobs with an mtime or atime in [After, Before) match.
+	MTimeAfter  time.Time
+	MTimeBefore time.Time
+	ATimeAfter  time.Time
+	ATimeBefore time.Time
+
+	// If not zero, only blobs that expire before this time match. Blobs that
+	// don't expire never match.
+	ExpiresBefore time.Time
+
+	// If true, only blobs that are deleted but can still be undeleted match,
+	// instead of live blobs.
+	Deleted bool
+}
+
+// Match returns true if a blob with 'info' is selected by 'f'.
+func (f *BlobFilter) Match(info BlobInfo) bool {
+	if f.Deleted != !info.Deleted.IsZero() {
+		return false
+	}
+	if len(f.Classes) > 0 {
+		found := false
+		for _, c := range f.Classes {
+			found = found || c == info.Class
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Hints) > 0 {
+		found := false
+		for _, h := range f.Hints {
+			found = found || h == info.Hint
+		}
+		if !found {
+			return false
+		}
+	}
+	if !inTimeRange(info.MTime, f.MTimeAfter, f.MTimeBefore) || !inTimeRange(info.ATime, f.ATimeAfter, f.ATimeBefore) {
+		return false
+	}
+	if !f.ExpiresBefore.IsZero() && (info.Expires.IsZero() || !info.Expires.Before(f.ExpiresBefore)) {
+		return false
+	}
+	return true
+}
+
+// inTimeRange returns true if 't' is in [after, before). Zero bounds are open.
+func inTimeRange(t, after, before time.Time) bool {
+	return (after.IsZero() || !t.Before(after)) && (before.IsZero() || t.Before(before))
+}
+
+// BlobEventType says what happened to a blob in a BlobEvent.
+type BlobEventType uint8
+
+const (
+	// BlobCreated means the blob was created, possibly as a clone.
+	BlobCreated BlobEventType = iota + 1
+
+	// BlobWritten means the blob was extended, truncated, or written to.
+	BlobWritten
+
+	// BlobMetadataChanged means the blob's metadata was changed or the blob
+	// was sealed.
+	BlobMetadataChanged
+
+	// BlobDeleted means the blob was deleted.
+	BlobDeleted
+
+	// BlobUndeleted means the blob was undeleted.
+	BlobUndeleted
+
+	// BlobClassChanged means the blob's storage class was changed.
+	BlobClassChanged
+)
+
+var blobEventNames = map[BlobEventType]string{
+	BlobCreated:         "created",
+	BlobWritten:         "written",
+	BlobMetadataChanged: "metadata",
+	BlobDeleted:         "deleted",
+	BlobUndeleted:       "undeleted",
+	BlobClassChanged:    "class",
+}
+
+// String returns a short name for the event type.
+func (t BlobEventType) String() string {
+	if s, ok := blobEventNames[t]; ok {
+		return s
+	}
+	return fmt.Sprintf("BlobEventType(%d)", t)
+}
+
+// BlobEvent describes one change to a blob.
+type BlobEvent struct {
+	Type BlobEventType
+	Blob BlobID
+
+	// The new storage class, for BlobClassChanged.
+	Class StorageClass
+
+	// The raft index of the curator command that made the change. Events in
+	// the same partition are ordered by index.
+	Index uint64
+}
+
+const (
+	// MaxMetadataKeyLength is the maximum length of a user metadata key.
+	MaxMetadataKeyLength = 128
+
+	// MaxMetadataSize is the maximum total size, in bytes, of all keys and
+	// values in the user metadata of one blob.
+	MaxMetadataSize = 4096
+)
+
+// ValidMetadataKey returns true if 'key' can be used as a user metadata key.
+// Keys must be non-empty, and consist of printable ASCII characters other than
+// space and '='.
+func ValidMetadataKey(key string) bool {
+	if len(key) == 0 || len(key) > MaxMetadataKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if c := key[i]; c <= ' ' || c > '~' || c == '=' {
+			return false
+		}
+	}
+	return true
+}
+
+// MetadataSize returns the size of user metadata, for comparing against
+// MaxMetadataSize.
+func MetadataSize(md map[string]string) (n int) {
+	for k, v := range md {
+		n += len(k) + len(v)
+	}
+	return
 }
 
 // TractPointer is a reference to a tract embedded in an RS chunk.
@@ -67,6 +320,11 @@ type TractPointer struct {
 	BaseChunk  RSChunkID
 	OtherHosts []string
 	OtherTSIDs []TractserverID
+
+	// The number of data pieces in the chunk, and the parameters of its code if
+	// it's locally repairable, so that clients don't need to know the class.
+	Data int
+	LRC  LRCParams
 }
 
 // Present returns true if this TractPointer is not the zero value.
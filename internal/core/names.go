@@ -0,0 +1,80 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package core
+
+import "strings"
+
+/*
+
+Blob names form a hierarchical namespace layered over BlobIDs. A name is a
+sequence of non-empty components separated by NameSeparator, e.g.
+"logs/2016/05/app.log". Directories are implicit: "logs/2016/" exists as long
+as some name starts with it. A name can't be bound inside another, so a path is
+either a blob or a directory.
+
+The whole namespace is stored in the curator that owns one partition, so that
+operations that touch several names (e.g. renaming a directory, or checking
+that a name doesn't conflict with a directory) can be applied atomically in a
+single Raft group. Named blobs are created on that curator too, in the same
+Raft command that binds the name, and deleting a blob removes its names.
+
+The partition is configured on curators and clients, and defaults to
+DefaultNamespacePartition. Clusters whose namespace outgrows one curator group
+can give it a partition on a curator group of its own.
+
+*/
+
+const (
+	// DefaultNamespacePartition is the partition whose curator stores the
+	// namespace, unless curators and clients are configured otherwise.
+	DefaultNamespacePartition PartitionID = 1
+
+	// NameSeparator separates the components of a blob name.
+	NameSeparator = "/"
+
+	// MaxNameLength is the maximum length of a blob name in bytes.
+	MaxNameLength = 1024
+)
+
+// NameEntry is one entry of a namespace listing.
+type NameEntry struct {
+	// The full name of the entry. Directories end with NameSeparator.
+	Name string
+
+	// The blob the name refers to. Zero for directories.
+	Blob BlobID
+}
+
+// IsDir returns true if this entry is an implicit directory.
+func (e NameEntry) IsDir() bool {
+	return strings.HasSuffix(e.Name, NameSeparator)
+}
+
+// ValidName returns true if 'name' can be bound to a blob.
+func ValidName(name string) bool {
+	if name == "" || len(name) > MaxNameLength {
+		return false
+	}
+	for _, c := range strings.Split(name, NameSeparator) {
+		if c == "" || c == "." || c == ".." || strings.IndexByte(c, 0) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidRename returns true if 'from' can be renamed to 'to'. Both must be valid
+// names, or both valid names followed by a separator, to move a directory.
+func ValidRename(from, to string) bool {
+	if strings.HasSuffix(from, NameSeparator) != strings.HasSuffix(to, NameSeparator) {
+		return false
+	}
+	return ValidName(strings.TrimSuffix(from, NameSeparator)) && ValidName(strings.TrimSuffix(to, NameSeparator))
+}
+
+// ValidNamePrefix returns true if 'prefix' can be used to list names. Prefixes
+// are either empty or a valid name optionally followed by a separator.
+func ValidNamePrefix(prefix string) bool {
+	return prefix == "" || ValidName(strings.TrimSuffix(prefix, NameSeparator))
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test that name validation accepts and rejects the right names.
+func TestValidName(t *testing.T) {
+	good := []string{"a", "a/b", "logs/2016/05/app.log", "a.b/..c", strings.Repeat("x", MaxNameLength)}
+	bad := []string{"", "/a", "a/", "a//b", "./a", "a/../b", "a\x00b", strings.Repeat("x", MaxNameLength+1)}
+	for _, n := range good {
+		if !ValidName(n) {
+			t.Errorf("%q should be valid", n)
+		}
+	}
+	for _, n := range bad {
+		if ValidName(n) {
+			t.Errorf("%q should be invalid", n)
+		}
+	}
+
+	for _, p := range []string{"", "a", "a/", "a/b/"} {
+		if !ValidNamePrefix(p) {
+			t.Errorf("prefix %q should be valid", p)
+		}
+	}
+	for _, p := range []string{"/", "a//", "/a/"} {
+		if ValidNamePrefix(p) {
+			t.Errorf("prefix %q should be invalid", p)
+		}
+	}
+}
@@ -97,6 +97,17 @@ type PullTractReq struct {
 
 	// And what is the version?
 	Version int
+
+	// If set, the tractserver pulls the tract (SrcID, SrcVersion) instead, and
+	// stores the data as (ID, Version). This is how tracts are copied between
+	// blobs.
+	SrcID      TractID
+	SrcVersion int
+
+	// If SrcID is an RS chunk piece, only SrcLength bytes starting at
+	// SrcOffset are copied. This is how tracts are copied out of RS chunks.
+	SrcOffset int
+	SrcLength int
 }
 
 // GetTSIDMethod is the method name for the GetTSID method. Request is struct{},
@@ -115,11 +126,18 @@ const PackTractsMethod = "TSCtlHandler.PackTracts"
 
 // PackTractSpec describes one tract to pack.
 type PackTractSpec struct {
-	ID      TractID  // tract id
-	From    []TSAddr // host(s) that it can be read from
-	Version int      // expected version
-	Offset  int      // destination offset in packed tract
-	Length  int      // expected length
+	ID       TractID       // tract id
+	From     []TSAddr      // host(s) that it can be read from
+	Version  int           // expected version
+	Offset   int           // destination offset in packed tract
+	Length   int           // expected length
+	Checksum TractChecksum // end-to-end checksum, if known
+
+	// If the tract is stored in an RS chunk, it's read from the data piece
+	// Piece instead, starting at PieceOffset. From has the host of the piece,
+	// and Version is unused.
+	Piece       RSChunkID
+	PieceOffset int
 }
 
 // PackTractsReq is a request to the TS to pack mulitple regular data tracts
@@ -135,6 +153,16 @@ type PackTractsReq struct {
 // RSEncodeMethod is the method name for RSEncode. Request is RSEncodeReq, reply is Error.
 const RSEncodeMethod = "TSCtlHandler.RSEncode"
 
+// LRCParams are the parameters of a locally repairable code: K data pieces, split
+// into L local groups that each have a parity piece, and R global parity
+// pieces. The pieces of a chunk are ordered data, local parity, then global
+// parity. See pkg/lrc.
+type LRCParams struct {
+	K int
+	L int
+	R int
+}
+
 // RSEncodeReq is a request to perform erasure coding or reconstruction on a chunk.
 // For Reed-Solomon with N data and M parity pieces, there should be exactly N Srcs
 // and M Dests. This tractserver will read the N pieces from other
@@ -150,6 +178,7 @@ type RSEncodeReq struct {
 	Srcs     []TSAddr      // Where to read the N data pieces from.
 	Dests    []TSAddr      // Where to write the M parity pieces to.
 	IndexMap []int         // Data indexes for reconstruction (see below).
+	LRC      LRCParams     // For locally repairable codes, zero for Reed-Solomon.
 }
 
 // To simplify the implementation, we use the same RPC for coding and reconstruction.
@@ -169,6 +198,12 @@ type RSEncodeReq struct {
 // Srcs[2] has piece 3, etc.; Dests[0] should get piece 1, Dests[1] should get piece 5,
 // and don't write anything to Dests[2]. (But note that len(Dests) must still be 3! Fill
 // it with zero values.)
+//
+// For locally repairable codes, LRC is set. Coding is the same, with K Srcs and
+// L+R Dests. For reconstruction, Srcs only has to cover what's needed to
+// rebuild the lost pieces, which is just the rest of their local group if
+// they're alone in it. There's no padding: IndexMap has an entry for each of
+// Srcs and Dests.
 
 // ---
 
@@ -179,12 +214,16 @@ const CreateTractMethod = "TSSrvHandler.CreateTract"
 
 // CreateTractReq is sent from a client to a tractserver to ask the tractserver
 // to create a tract with id 'ID' and writes the bytes to it.
+//
+// The tract starts at 'Version', which is the version the curator gave out
+// when the blob was extended. Zero means the usual initial version.
 type CreateTractReq struct {
-	TSID TractserverID
-	ID   TractID
-	B    []byte
-	Off  int64
-	Pri  Priority
+	TSID    TractserverID
+	ID      TractID
+	B       []byte
+	Off     int64
+	Pri     Priority
+	Version int
 
 	// Local-only flag to indicate whether B is exclusively owned.
 	bExclusive bool
@@ -238,6 +277,56 @@ type ReadReply struct {
 	bExclusive bool
 }
 
+// ReadVMethod is the method name for client to tractserver vectored read.
+const ReadVMethod = "TSSrvHandler.ReadV"
+
+// MaxReadVLength is the most data that can be read with one ReadVReq.
+const MaxReadVLength = TractLength
+
+// ReadVRange is one range to read in a ReadVReq.
+type ReadVRange struct {
+	ID      TractID
+	Version int
+	Len     int
+	Off     int64
+}
+
+// ReadVReq is the request for reading several ranges of tracts at once.
+type ReadVReq struct {
+	Reads []ReadVRange
+	Pri   Priority
+
+	// ID for cancellation.
+	ReqID string
+}
+
+// ReadVReply is the reply for ReadVReq. The data read for all ranges is
+// concatenated in B, and N and Errs have the length and result of each read.
+type ReadVReply struct {
+	// Set if the request as a whole failed.
+	Err Error
+
+	N    []int
+	Errs []Error
+	B    []byte
+
+	// Local-only flag to indicate whether B is exclusively owned.
+	bExclusive bool
+}
+
+// TruncateMethod is the method name for client to tractserver truncate tract.
+// Reply is Error.
+const TruncateMethod = "TSSrvHandler.Truncate"
+
+// TruncateReq is sent from the client to a tractserver to change the length of
+// a tract. Growing a tract pads it with zeros.
+type TruncateReq struct {
+	ID      TractID
+	Version int
+	Size    int64
+	Pri     Priority
+}
+
 // StatTractMethod is the method name for client to tractserver stat tract.
 const StatTractMethod = "TSSrvHandler.StatTract"
 
@@ -301,10 +390,13 @@ func (w *WriteReq) Get() ([]byte, bool)        { b := w.B; w.B = nil; return b,
 func (w *WriteReq) Set(b []byte, e bool)       { w.B, w.bExclusive = b, e }
 func (r *ReadReply) Get() ([]byte, bool)       { b := r.B; r.B = nil; return b, r.bExclusive }
 func (r *ReadReply) Set(b []byte, e bool)      { r.B, r.bExclusive = b, e }
+func (r *ReadVReply) Get() ([]byte, bool)      { b := r.B; r.B = nil; return b, r.bExclusive }
+func (r *ReadVReply) Set(b []byte, e bool)     { r.B, r.bExclusive = b, e }
 
 var (
 	// Assert that these implement rpc.BulkData.
 	_ rpc.BulkData = (*CreateTractReq)(nil)
 	_ rpc.BulkData = (*WriteReq)(nil)
 	_ rpc.BulkData = (*ReadReply)(nil)
+	_ rpc.BulkData = (*ReadVReply)(nil)
 )
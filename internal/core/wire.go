@@ -0,0 +1,1146 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package core
+
+import (
+	"strings"
+	"time"
+
+	"github.com/westerndigitalcorporation/blb/internal/core/blbpb"
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
+)
+
+// WireServices lists the client-facing RPC methods. Besides gob, these can be
+// called with protocol buffers (see rpc.DialProto), so clients don't have to
+// be written in Go.
+//
+// The protocol buffer messages are defined in blbpb/blb.proto. The arguments
+// and replies of these methods implement rpc.WireMessage by converting to and
+// from them below. A new field in a message needs a new field in blb.proto,
+// and a line in each conversion.
+var WireServices = wireServices([]wireMethod{
+	// Master.
+	{LookupCuratorMethod, LookupCuratorReq{}, LookupCuratorReply{}},
+	{LookupPartitionMethod, LookupPartitionReq{}, LookupPartitionReply{}},
+	{MasterCreateBlobMethod, MasterCreateBlobReq{}, LookupCuratorReply{}},
+	{ListPartitionsMethod, ListPartitionsReq{}, ListPartitionsReply{}},
+	{GetTractserverInfoMethod, GetTractserverInfoReq{}, GetTractserverInfoReply{}},
+	{GetQuotasMethod, GetQuotasReq{}, GetQuotasReply{}},
+	{SetQuotaMethod, SetQuotaReq{}, SetQuotaReply{}},
+
+	// Curator.
+	{CreateBlobMethod, CreateBlobReq{}, CreateBlobReply{}},
+	{ExtendBlobMethod, ExtendBlobReq{}, ExtendBlobReply{}},
+	{AckExtendBlobMethod, AckExtendBlobReq{}, AckExtendBlobReply{}},
+	{DeleteBlobMethod, BlobID(0), NoError},
+	{UndeleteBlobMethod, BlobID(0), NoError},
+	{PurgeBlobMethod, BlobID(0), NoError},
+	{SealBlobMethod, BlobID(0), NoError},
+	{CloneBlobMethod, BlobID(0), CreateBlobReply{}},
+	{SetMetadataMethod, SetMetadataReq{}, NoError},
+	{UpdateChecksumsMethod, UpdateChecksumsReq{}, NoError},
+	{ReserveAppendMethod, ReserveAppendReq{}, ReserveAppendReply{}},
+	{TruncateBlobMethod, TruncateBlobReq{}, NoError},
+	{UnshareTractMethod, UnshareTractReq{}, NoError},
+	{CopyTractsMethod, CopyTractsReq{}, NoError},
+	{GetTractsMethod, GetTractsReq{}, GetTractsReply{}},
+	{StatBlobMethod, BlobID(0), StatBlobReply{}},
+	{ReportBadTSMethod, ReportBadTSReq{}, NoError},
+	{FixVersionMethod, FixVersionReq{}, NoError},
+	{ListBlobsMethod, ListBlobsReq{}, ListBlobsReply{}},
+	{GetEventsMethod, GetEventsReq{}, GetEventsReply{}},
+	{LookupNameMethod, "", LookupNameReply{}},
+	{RenameMethod, RenameReq{}, NoError},
+	{UnbindNameMethod, UnbindNameReq{}, NoError},
+	{ListNamesMethod, ListNamesReq{}, ListNamesReply{}},
+
+	// Tractserver.
+	{CreateTractMethod, CreateTractReq{}, NoError},
+	{WriteMethod, WriteReq{}, NoError},
+	{ReadMethod, ReadReq{}, ReadReply{}},
+	{ReadVMethod, ReadVReq{}, ReadVReply{}},
+	{TruncateMethod, TruncateReq{}, NoError},
+	{StatTractMethod, StatTractReq{}, StatTractReply{}},
+	{GetDiskInfoMethod, GetDiskInfoReq{}, GetDiskInfoReply{}},
+	{SetControlFlagsMethod, SetControlFlagsReq{}, NoError},
+	{CancelReqMethod, "", NoError},
+})
+
+type wireMethod struct {
+	method     string
+	req, reply interface{}
+}
+
+// wireServices groups methods by service.
+func wireServices(methods []wireMethod) (services []rpc.WireService) {
+	for _, m := range methods {
+		parts := strings.SplitN(m.method, ".", 2)
+		if len(services) == 0 || services[len(services)-1].Name != parts[0] {
+			services = append(services, rpc.WireService{Name: parts[0]})
+		}
+		svc := &services[len(services)-1]
+		svc.Methods = append(svc.Methods, rpc.WireMethod{Name: parts[1], Req: m.req, Reply: m.reply})
+	}
+	return
+}
+
+// Times are sent as nanoseconds since the epoch, with 0 for the zero time.
+
+func timeToWire(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+func timeFromWire(ns int64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ns)
+}
+
+// Conversions of the types that messages are made of. The ...FromWire
+// functions accept nil, since a message that's missing from the wire is
+// decoded as nil.
+
+func (id TractID) toWire() *blbpb.TractID {
+	return &blbpb.TractID{Blob: uint64(id.Blob), Index: uint32(id.Index)}
+}
+
+func tractIDFromWire(m *blbpb.TractID) TractID {
+	return TractID{Blob: BlobID(m.GetBlob()), Index: TractKey(m.GetIndex())}
+}
+
+func (id RSChunkID) toWire() *blbpb.RSChunkID {
+	return &blbpb.RSChunkID{Partition: uint32(id.Partition), Id: id.ID}
+}
+
+func rsChunkIDFromWire(m *blbpb.RSChunkID) RSChunkID {
+	return RSChunkID{Partition: PartitionID(m.GetPartition()), ID: m.GetId()}
+}
+
+func (c TractChecksum) toWire() *blbpb.TractChecksum {
+	return &blbpb.TractChecksum{Crc: c.CRC, Length: int64(c.Length)}
+}
+
+func tractChecksumFromWire(m *blbpb.TractChecksum) TractChecksum {
+	return TractChecksum{CRC: m.GetCrc(), Length: int(m.GetLength())}
+}
+
+func (lrc LRCParams) toWire() *blbpb.LRCParams {
+	return &blbpb.LRCParams{K: int64(lrc.K), L: int64(lrc.L), R: int64(lrc.R)}
+}
+
+func lrcParamsFromWire(m *blbpb.LRCParams) LRCParams {
+	return LRCParams{K: int(m.GetK()), L: int(m.GetL()), R: int(m.GetR())}
+}
+
+func (tp TractPointer) toWire() *blbpb.TractPointer {
+	return &blbpb.TractPointer{
+		Chunk:      tp.Chunk.toWire(),
+		Host:       tp.Host,
+		Tsid:       uint32(tp.TSID),
+		Offset:     tp.Offset,
+		Length:     tp.Length,
+		Class:      int32(tp.Class),
+		BaseChunk:  tp.BaseChunk.toWire(),
+		OtherHosts: tp.OtherHosts,
+		OtherTsids: tsidsToWire(tp.OtherTSIDs),
+		Data:       int64(tp.Data),
+		Lrc:        tp.LRC.toWire(),
+	}
+}
+
+func tractPointerFromWire(m *blbpb.TractPointer) TractPointer {
+	return TractPointer{
+		Chunk:      rsChunkIDFromWire(m.GetChunk()),
+		Host:       m.GetHost(),
+		TSID:       TractserverID(m.GetTsid()),
+		Offset:     m.GetOffset(),
+		Length:     m.GetLength(),
+		Class:      StorageClass(m.GetClass()),
+		BaseChunk:  rsChunkIDFromWire(m.GetBaseChunk()),
+		OtherHosts: m.GetOtherHosts(),
+		OtherTSIDs: tsidsFromWire(m.GetOtherTsids()),
+		Data:       int(m.GetData()),
+		LRC:        lrcParamsFromWire(m.GetLrc()),
+	}
+}
+
+func (t TractInfo) toWire() *blbpb.TractInfo {
+	return &blbpb.TractInfo{
+		Tract:    t.Tract.toWire(),
+		Version:  int64(t.Version),
+		Hosts:    t.Hosts,
+		Tsids:    tsidsToWire(t.TSIDs),
+		Rs:       t.RS.toWire(),
+		Checksum: t.Checksum.toWire(),
+		Shared:   t.Shared,
+	}
+}
+
+func tractInfoFromWire(m *blbpb.TractInfo) TractInfo {
+	return TractInfo{
+		Tract:    tractIDFromWire(m.GetTract()),
+		Version:  int(m.GetVersion()),
+		Hosts:    m.GetHosts(),
+		TSIDs:    tsidsFromWire(m.GetTsids()),
+		RS:       tractPointerFromWire(m.GetRs()),
+		Checksum: tractChecksumFromWire(m.GetChecksum()),
+		Shared:   m.GetShared(),
+	}
+}
+
+func tractInfosToWire(ts []TractInfo) []*blbpb.TractInfo {
+	if len(ts) == 0 {
+		return nil
+	}
+	ms := make([]*blbpb.TractInfo, len(ts))
+	for i, t := range ts {
+		ms[i] = t.toWire()
+	}
+	return ms
+}
+
+func tractInfosFromWire(ms []*blbpb.TractInfo) []TractInfo {
+	if len(ms) == 0 {
+		return nil
+	}
+	ts := make([]TractInfo, len(ms))
+	for i, m := range ms {
+		ts[i] = tractInfoFromWire(m)
+	}
+	return ts
+}
+
+func tsidsToWire(ids []TractserverID) []uint32 {
+	if len(ids) == 0 {
+		return nil
+	}
+	ms := make([]uint32, len(ids))
+	for i, id := range ids {
+		ms[i] = uint32(id)
+	}
+	return ms
+}
+
+func tsidsFromWire(ms []uint32) []TractserverID {
+	if len(ms) == 0 {
+		return nil
+	}
+	ids := make([]TractserverID, len(ms))
+	for i, m := range ms {
+		ids[i] = TractserverID(m)
+	}
+	return ids
+}
+
+func (a ACL) toWire() *blbpb.ACL {
+	return &blbpb.ACL{Readers: a.Readers, Writers: a.Writers}
+}
+
+func aclFromWire(m *blbpb.ACL) ACL {
+	return ACL{Readers: m.GetReaders(), Writers: m.GetWriters()}
+}
+
+func (b BlobInfo) toWire() *blbpb.BlobInfo {
+	return &blbpb.BlobInfo{
+		Repl:      int64(b.Repl),
+		NumTracts: int64(b.NumTracts),
+		Mtime:     timeToWire(b.MTime),
+		Atime:     timeToWire(b.ATime),
+		Class:     int32(b.Class),
+		Hint:      int32(b.Hint),
+		Expires:   timeToWire(b.Expires),
+		Metadata:  b.Metadata,
+		WriteOnce: b.WriteOnce,
+		Sealed:    b.Sealed,
+		Deleted:   timeToWire(b.Deleted),
+		PurgeAt:   timeToWire(b.PurgeAt),
+		Owner:     b.Owner,
+		Acl:       b.ACL.toWire(),
+	}
+}
+
+func blobInfoFromWire(m *blbpb.BlobInfo) BlobInfo {
+	return BlobInfo{
+		Repl:      int(m.GetRepl()),
+		NumTracts: int(m.GetNumTracts()),
+		MTime:     timeFromWire(m.GetMtime()),
+		ATime:     timeFromWire(m.GetAtime()),
+		Class:     StorageClass(m.GetClass()),
+		Hint:      StorageHint(m.GetHint()),
+		Expires:   timeFromWire(m.GetExpires()),
+		Metadata:  m.GetMetadata(),
+		WriteOnce: m.GetWriteOnce(),
+		Sealed:    m.GetSealed(),
+		Deleted:   timeFromWire(m.GetDeleted()),
+		PurgeAt:   timeFromWire(m.GetPurgeAt()),
+		Owner:     m.GetOwner(),
+		ACL:       aclFromWire(m.GetAcl()),
+	}
+}
+
+func (f BlobFilter) toWire() *blbpb.BlobFilter {
+	m := &blbpb.BlobFilter{
+		MtimeAfter:    timeToWire(f.MTimeAfter),
+		MtimeBefore:   timeToWire(f.MTimeBefore),
+		AtimeAfter:    timeToWire(f.ATimeAfter),
+		AtimeBefore:   timeToWire(f.ATimeBefore),
+		ExpiresBefore: timeToWire(f.ExpiresBefore),
+		Deleted:       f.Deleted,
+	}
+	for _, c := range f.Classes {
+		m.Classes = append(m.Classes, int32(c))
+	}
+	for _, h := range f.Hints {
+		m.Hints = append(m.Hints, int32(h))
+	}
+	return m
+}
+
+func blobFilterFromWire(m *blbpb.BlobFilter) BlobFilter {
+	f := BlobFilter{
+		MTimeAfter:    timeFromWire(m.GetMtimeAfter()),
+		MTimeBefore:   timeFromWire(m.GetMtimeBefore()),
+		ATimeAfter:    timeFromWire(m.GetAtimeAfter()),
+		ATimeBefore:   timeFromWire(m.GetAtimeBefore()),
+		ExpiresBefore: timeFromWire(m.GetExpiresBefore()),
+		Deleted:       m.GetDeleted(),
+	}
+	for _, c := range m.GetClasses() {
+		f.Classes = append(f.Classes, StorageClass(c))
+	}
+	for _, h := range m.GetHints() {
+		f.Hints = append(f.Hints, StorageHint(h))
+	}
+	return f
+}
+
+func (u ChecksumUpdate) toWire() *blbpb.ChecksumUpdate {
+	return &blbpb.ChecksumUpdate{Index: uint32(u.Index), Old: u.Old.toWire(), New: u.New.toWire()}
+}
+
+func checksumUpdatesToWire(us []ChecksumUpdate) []*blbpb.ChecksumUpdate {
+	if len(us) == 0 {
+		return nil
+	}
+	ms := make([]*blbpb.ChecksumUpdate, len(us))
+	for i, u := range us {
+		ms[i] = u.toWire()
+	}
+	return ms
+}
+
+func checksumUpdatesFromWire(ms []*blbpb.ChecksumUpdate) []ChecksumUpdate {
+	if len(ms) == 0 {
+		return nil
+	}
+	us := make([]ChecksumUpdate, len(ms))
+	for i, m := range ms {
+		us[i] = ChecksumUpdate{
+			Index: TractKey(m.GetIndex()),
+			Old:   tractChecksumFromWire(m.GetOld()),
+			New:   tractChecksumFromWire(m.GetNew()),
+		}
+	}
+	return us
+}
+
+func (u TenantUsage) toWire() *blbpb.TenantUsage {
+	return &blbpb.TenantUsage{Bytes: u.Bytes, Blobs: u.Blobs}
+}
+
+func tenantUsageFromWire(m *blbpb.TenantUsage) TenantUsage {
+	return TenantUsage{Bytes: m.GetBytes(), Blobs: m.GetBlobs()}
+}
+
+func (f DiskControlFlags) toWire() *blbpb.DiskControlFlags {
+	return &blbpb.DiskControlFlags{StopAllocating: f.StopAllocating, Drain: int64(f.Drain), DrainLocal: int64(f.DrainLocal)}
+}
+
+func diskControlFlagsFromWire(m *blbpb.DiskControlFlags) DiskControlFlags {
+	return DiskControlFlags{StopAllocating: m.GetStopAllocating(), Drain: int(m.GetDrain()), DrainLocal: int(m.GetDrainLocal())}
+}
+
+func fsStatusesToWire(ss []FsStatus) []*blbpb.FsStatus {
+	if len(ss) == 0 {
+		return nil
+	}
+	ms := make([]*blbpb.FsStatus, len(ss))
+	for i, s := range ss {
+		ms[i] = &blbpb.FsStatus{
+			Status: &blbpb.DiskStatus{
+				Root:      s.Status.Root,
+				Full:      s.Status.Full,
+				Healthy:   s.Status.Healthy,
+				QueueLen:  int64(s.Status.QueueLen),
+				AvgWaitMs: int64(s.Status.AvgWaitMs),
+				Flags:     s.Status.Flags.toWire(),
+			},
+			Ops:              s.Ops,
+			NumTracts:        int64(s.NumTracts),
+			NumDeletedTracts: int64(s.NumDeletedTracts),
+			NumUnknownFiles:  int64(s.NumUnknownFiles),
+			AvailSpace:       s.AvailSpace,
+			TotalSpace:       s.TotalSpace,
+		}
+	}
+	return ms
+}
+
+func fsStatusesFromWire(ms []*blbpb.FsStatus) []FsStatus {
+	if len(ms) == 0 {
+		return nil
+	}
+	ss := make([]FsStatus, len(ms))
+	for i, m := range ms {
+		ss[i] = FsStatus{
+			Status: DiskStatus{
+				Root:      m.GetStatus().GetRoot(),
+				Full:      m.GetStatus().GetFull(),
+				Healthy:   m.GetStatus().GetHealthy(),
+				QueueLen:  int(m.GetStatus().GetQueueLen()),
+				AvgWaitMs: int(m.GetStatus().GetAvgWaitMs()),
+				Flags:     diskControlFlagsFromWire(m.GetStatus().GetFlags()),
+			},
+			Ops:              m.GetOps(),
+			NumTracts:        int(m.GetNumTracts()),
+			NumDeletedTracts: int(m.GetNumDeletedTracts()),
+			NumUnknownFiles:  int(m.GetNumUnknownFiles()),
+			AvailSpace:       m.GetAvailSpace(),
+			TotalSpace:       m.GetTotalSpace(),
+		}
+	}
+	return ss
+}
+
+// The following implement rpc.WireMessage for the arguments and replies in
+// WireServices:
+
+func (id *BlobID) MarshalWire() ([]byte, error) { return (&blbpb.BlobID{Value: uint64(*id)}).Marshal() }
+func (id *BlobID) UnmarshalWire(b []byte) error {
+	var m blbpb.BlobID
+	err := m.Unmarshal(b)
+	*id = BlobID(m.Value)
+	return err
+}
+
+func (e *Error) MarshalWire() ([]byte, error) { return (&blbpb.Error{Value: int64(*e)}).Marshal() }
+func (e *Error) UnmarshalWire(b []byte) error {
+	var m blbpb.Error
+	err := m.Unmarshal(b)
+	*e = Error(m.Value)
+	return err
+}
+
+// Master.
+
+func (r *LookupCuratorReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.LookupCuratorReq{Blob: uint64(r.Blob)}).Marshal()
+}
+func (r *LookupCuratorReq) UnmarshalWire(b []byte) error {
+	var m blbpb.LookupCuratorReq
+	err := m.Unmarshal(b)
+	*r = LookupCuratorReq{Blob: BlobID(m.Blob)}
+	return err
+}
+
+func (r *LookupCuratorReply) MarshalWire() ([]byte, error) {
+	return (&blbpb.LookupCuratorReply{Replicas: r.Replicas, Err: int64(r.Err)}).Marshal()
+}
+func (r *LookupCuratorReply) UnmarshalWire(b []byte) error {
+	var m blbpb.LookupCuratorReply
+	err := m.Unmarshal(b)
+	*r = LookupCuratorReply{Replicas: m.Replicas, Err: Error(m.Err)}
+	return err
+}
+
+func (r *LookupPartitionReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.LookupPartitionReq{Partition: uint32(r.Partition)}).Marshal()
+}
+func (r *LookupPartitionReq) UnmarshalWire(b []byte) error {
+	var m blbpb.LookupPartitionReq
+	err := m.Unmarshal(b)
+	*r = LookupPartitionReq{Partition: PartitionID(m.Partition)}
+	return err
+}
+
+func (r *LookupPartitionReply) MarshalWire() ([]byte, error) {
+	return (&blbpb.LookupPartitionReply{Replicas: r.Replicas, Err: int64(r.Err)}).Marshal()
+}
+func (r *LookupPartitionReply) UnmarshalWire(b []byte) error {
+	var m blbpb.LookupPartitionReply
+	err := m.Unmarshal(b)
+	*r = LookupPartitionReply{Replicas: m.Replicas, Err: Error(m.Err)}
+	return err
+}
+
+func (r *MasterCreateBlobReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.MasterCreateBlobReq{}).Marshal()
+}
+func (r *MasterCreateBlobReq) UnmarshalWire(b []byte) error {
+	return (&blbpb.MasterCreateBlobReq{}).Unmarshal(b)
+}
+
+func (r *ListPartitionsReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.ListPartitionsReq{}).Marshal()
+}
+func (r *ListPartitionsReq) UnmarshalWire(b []byte) error {
+	return (&blbpb.ListPartitionsReq{}).Unmarshal(b)
+}
+
+func (r *ListPartitionsReply) MarshalWire() ([]byte, error) {
+	m := &blbpb.ListPartitionsReply{Err: int64(r.Err)}
+	for _, p := range r.Partitions {
+		m.Partitions = append(m.Partitions, uint32(p))
+	}
+	return m.Marshal()
+}
+func (r *ListPartitionsReply) UnmarshalWire(b []byte) error {
+	var m blbpb.ListPartitionsReply
+	err := m.Unmarshal(b)
+	*r = ListPartitionsReply{Err: Error(m.Err)}
+	for _, p := range m.Partitions {
+		r.Partitions = append(r.Partitions, PartitionID(p))
+	}
+	return err
+}
+
+func (r *GetTractserverInfoReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.GetTractserverInfoReq{}).Marshal()
+}
+func (r *GetTractserverInfoReq) UnmarshalWire(b []byte) error {
+	return (&blbpb.GetTractserverInfoReq{}).Unmarshal(b)
+}
+
+func (r *GetTractserverInfoReply) MarshalWire() ([]byte, error) {
+	m := &blbpb.GetTractserverInfoReply{Err: int64(r.Err)}
+	for _, info := range r.Info {
+		m.Info = append(m.Info, &blbpb.TractserverInfo{
+			Id:            uint32(info.ID),
+			Addr:          info.Addr,
+			Disks:         fsStatusesToWire(info.Disks),
+			LastHeartbeat: timeToWire(info.LastHeartbeat),
+		})
+	}
+	return m.Marshal()
+}
+func (r *GetTractserverInfoReply) UnmarshalWire(b []byte) error {
+	var m blbpb.GetTractserverInfoReply
+	err := m.Unmarshal(b)
+	*r = GetTractserverInfoReply{Err: Error(m.Err)}
+	for _, info := range m.Info {
+		r.Info = append(r.Info, TractserverInfo{
+			ID:            TractserverID(info.Id),
+			Addr:          info.Addr,
+			Disks:         fsStatusesFromWire(info.Disks),
+			LastHeartbeat: timeFromWire(info.LastHeartbeat),
+		})
+	}
+	return err
+}
+
+func (r *GetQuotasReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.GetQuotasReq{}).Marshal()
+}
+func (r *GetQuotasReq) UnmarshalWire(b []byte) error {
+	return (&blbpb.GetQuotasReq{}).Unmarshal(b)
+}
+
+func (r *GetQuotasReply) MarshalWire() ([]byte, error) {
+	m := &blbpb.GetQuotasReply{Err: int64(r.Err)}
+	for _, q := range r.Quotas {
+		m.Quotas = append(m.Quotas, &blbpb.TenantQuota{Owner: q.Owner, Limit: q.Limit.toWire(), Used: q.Used.toWire()})
+	}
+	return m.Marshal()
+}
+func (r *GetQuotasReply) UnmarshalWire(b []byte) error {
+	var m blbpb.GetQuotasReply
+	err := m.Unmarshal(b)
+	*r = GetQuotasReply{Err: Error(m.Err)}
+	for _, q := range m.Quotas {
+		r.Quotas = append(r.Quotas, TenantQuota{Owner: q.Owner, Limit: tenantUsageFromWire(q.Limit), Used: tenantUsageFromWire(q.Used)})
+	}
+	return err
+}
+
+func (r *SetQuotaReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.SetQuotaReq{Owner: r.Owner, Limit: r.Limit.toWire()}).Marshal()
+}
+func (r *SetQuotaReq) UnmarshalWire(b []byte) error {
+	var m blbpb.SetQuotaReq
+	err := m.Unmarshal(b)
+	*r = SetQuotaReq{Owner: m.Owner, Limit: tenantUsageFromWire(m.Limit)}
+	return err
+}
+
+func (r *SetQuotaReply) MarshalWire() ([]byte, error) {
+	return (&blbpb.SetQuotaReply{Err: int64(r.Err)}).Marshal()
+}
+func (r *SetQuotaReply) UnmarshalWire(b []byte) error {
+	var m blbpb.SetQuotaReply
+	err := m.Unmarshal(b)
+	*r = SetQuotaReply{Err: Error(m.Err)}
+	return err
+}
+
+// Curator.
+
+func (r *CreateBlobReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.CreateBlobReq{
+		Repl:      int64(r.Repl),
+		Hint:      int32(r.Hint),
+		Expires:   timeToWire(r.Expires),
+		Metadata:  r.Metadata,
+		WriteOnce: r.WriteOnce,
+		Owner:     r.Owner,
+		Acl:       r.ACL.toWire(),
+		Name:      r.Name,
+	}).Marshal()
+}
+func (r *CreateBlobReq) UnmarshalWire(b []byte) error {
+	var m blbpb.CreateBlobReq
+	err := m.Unmarshal(b)
+	*r = CreateBlobReq{
+		Repl:      int(m.Repl),
+		Hint:      StorageHint(m.Hint),
+		Expires:   timeFromWire(m.Expires),
+		Metadata:  m.Metadata,
+		WriteOnce: m.WriteOnce,
+		Owner:     m.Owner,
+		ACL:       aclFromWire(m.Acl),
+		Name:      m.Name,
+	}
+	return err
+}
+
+func (r *CreateBlobReply) MarshalWire() ([]byte, error) {
+	return (&blbpb.CreateBlobReply{Err: int64(r.Err), Id: uint64(r.ID)}).Marshal()
+}
+func (r *CreateBlobReply) UnmarshalWire(b []byte) error {
+	var m blbpb.CreateBlobReply
+	err := m.Unmarshal(b)
+	*r = CreateBlobReply{Err: Error(m.Err), ID: BlobID(m.Id)}
+	return err
+}
+
+func (r *ExtendBlobReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.ExtendBlobReq{Blob: uint64(r.Blob), NumTracts: int64(r.NumTracts)}).Marshal()
+}
+func (r *ExtendBlobReq) UnmarshalWire(b []byte) error {
+	var m blbpb.ExtendBlobReq
+	err := m.Unmarshal(b)
+	*r = ExtendBlobReq{Blob: BlobID(m.Blob), NumTracts: int(m.NumTracts)}
+	return err
+}
+
+func (r *ExtendBlobReply) MarshalWire() ([]byte, error) {
+	return (&blbpb.ExtendBlobReply{Err: int64(r.Err), NewTracts: tractInfosToWire(r.NewTracts)}).Marshal()
+}
+func (r *ExtendBlobReply) UnmarshalWire(b []byte) error {
+	var m blbpb.ExtendBlobReply
+	err := m.Unmarshal(b)
+	*r = ExtendBlobReply{Err: Error(m.Err), NewTracts: tractInfosFromWire(m.NewTracts)}
+	return err
+}
+
+func (r *AckExtendBlobReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.AckExtendBlobReq{
+		Blob:      uint64(r.Blob),
+		Tracts:    tractInfosToWire(r.Tracts),
+		Checksums: checksumUpdatesToWire(r.Checksums),
+	}).Marshal()
+}
+func (r *AckExtendBlobReq) UnmarshalWire(b []byte) error {
+	var m blbpb.AckExtendBlobReq
+	err := m.Unmarshal(b)
+	*r = AckExtendBlobReq{
+		Blob:      BlobID(m.Blob),
+		Tracts:    tractInfosFromWire(m.Tracts),
+		Checksums: checksumUpdatesFromWire(m.Checksums),
+	}
+	return err
+}
+
+func (r *AckExtendBlobReply) MarshalWire() ([]byte, error) {
+	return (&blbpb.AckExtendBlobReply{NumTracts: int64(r.NumTracts), Err: int64(r.Err)}).Marshal()
+}
+func (r *AckExtendBlobReply) UnmarshalWire(b []byte) error {
+	var m blbpb.AckExtendBlobReply
+	err := m.Unmarshal(b)
+	*r = AckExtendBlobReply{NumTracts: int(m.NumTracts), Err: Error(m.Err)}
+	return err
+}
+
+func (r *SetMetadataReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.SetMetadataReq{Blob: uint64(r.Blob), Metadata: r.Metadata.toWire()}).Marshal()
+}
+func (r *SetMetadataReq) UnmarshalWire(b []byte) error {
+	var m blbpb.SetMetadataReq
+	err := m.Unmarshal(b)
+	*r = SetMetadataReq{Blob: BlobID(m.Blob), Metadata: blobInfoFromWire(m.Metadata)}
+	return err
+}
+
+func (r *UpdateChecksumsReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.UpdateChecksumsReq{Blob: uint64(r.Blob), Updates: checksumUpdatesToWire(r.Updates)}).Marshal()
+}
+func (r *UpdateChecksumsReq) UnmarshalWire(b []byte) error {
+	var m blbpb.UpdateChecksumsReq
+	err := m.Unmarshal(b)
+	*r = UpdateChecksumsReq{Blob: BlobID(m.Blob), Updates: checksumUpdatesFromWire(m.Updates)}
+	return err
+}
+
+func (r *ReserveAppendReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.ReserveAppendReq{Blob: uint64(r.Blob), Length: r.Length, MinOffset: r.MinOffset}).Marshal()
+}
+func (r *ReserveAppendReq) UnmarshalWire(b []byte) error {
+	var m blbpb.ReserveAppendReq
+	err := m.Unmarshal(b)
+	*r = ReserveAppendReq{Blob: BlobID(m.Blob), Length: m.Length, MinOffset: m.MinOffset}
+	return err
+}
+
+func (r *ReserveAppendReply) MarshalWire() ([]byte, error) {
+	return (&blbpb.ReserveAppendReply{Offset: r.Offset, Err: int64(r.Err)}).Marshal()
+}
+func (r *ReserveAppendReply) UnmarshalWire(b []byte) error {
+	var m blbpb.ReserveAppendReply
+	err := m.Unmarshal(b)
+	*r = ReserveAppendReply{Offset: m.Offset, Err: Error(m.Err)}
+	return err
+}
+
+func (r *TruncateBlobReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.TruncateBlobReq{Blob: uint64(r.Blob), Size_: r.Size}).Marshal()
+}
+func (r *TruncateBlobReq) UnmarshalWire(b []byte) error {
+	var m blbpb.TruncateBlobReq
+	err := m.Unmarshal(b)
+	*r = TruncateBlobReq{Blob: BlobID(m.Blob), Size: m.Size_}
+	return err
+}
+
+func (r *UnshareTractReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.UnshareTractReq{Blob: uint64(r.Blob), Index: uint32(r.Index)}).Marshal()
+}
+func (r *UnshareTractReq) UnmarshalWire(b []byte) error {
+	var m blbpb.UnshareTractReq
+	err := m.Unmarshal(b)
+	*r = UnshareTractReq{Blob: BlobID(m.Blob), Index: TractKey(m.Index)}
+	return err
+}
+
+func (r *CopyTractsReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.CopyTractsReq{
+		Dst:   uint64(r.Dst),
+		First: uint32(r.First),
+		Src:   uint64(r.Src),
+		Start: int64(r.Start),
+		End:   int64(r.End),
+	}).Marshal()
+}
+func (r *CopyTractsReq) UnmarshalWire(b []byte) error {
+	var m blbpb.CopyTractsReq
+	err := m.Unmarshal(b)
+	*r = CopyTractsReq{
+		Dst:   BlobID(m.Dst),
+		First: TractKey(m.First),
+		Src:   BlobID(m.Src),
+		Start: int(m.Start),
+		End:   int(m.End),
+	}
+	return err
+}
+
+func (r *GetTractsReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.GetTractsReq{
+		Blob:          uint64(r.Blob),
+		Start:         int64(r.Start),
+		End:           int64(r.End),
+		ForRead:       r.ForRead,
+		ForWrite:      r.ForWrite,
+		WithInfo:      r.WithInfo,
+		AllowUnsealed: r.AllowUnsealed,
+	}).Marshal()
+}
+func (r *GetTractsReq) UnmarshalWire(b []byte) error {
+	var m blbpb.GetTractsReq
+	err := m.Unmarshal(b)
+	*r = GetTractsReq{
+		Blob:          BlobID(m.Blob),
+		Start:         int(m.Start),
+		End:           int(m.End),
+		ForRead:       m.ForRead,
+		ForWrite:      m.ForWrite,
+		WithInfo:      m.WithInfo,
+		AllowUnsealed: m.AllowUnsealed,
+	}
+	return err
+}
+
+func (r *GetTractsReply) MarshalWire() ([]byte, error) {
+	m := &blbpb.GetTractsReply{Tracts: tractInfosToWire(r.Tracts), Err: int64(r.Err)}
+	if r.Info != nil {
+		m.Info = r.Info.toWire()
+	}
+	return m.Marshal()
+}
+func (r *GetTractsReply) UnmarshalWire(b []byte) error {
+	var m blbpb.GetTractsReply
+	err := m.Unmarshal(b)
+	*r = GetTractsReply{Tracts: tractInfosFromWire(m.Tracts), Err: Error(m.Err)}
+	if m.Info != nil {
+		info := blobInfoFromWire(m.Info)
+		r.Info = &info
+	}
+	return err
+}
+
+func (r *StatBlobReply) MarshalWire() ([]byte, error) {
+	return (&blbpb.StatBlobReply{Err: int64(r.Err), Info: r.Info.toWire()}).Marshal()
+}
+func (r *StatBlobReply) UnmarshalWire(b []byte) error {
+	var m blbpb.StatBlobReply
+	err := m.Unmarshal(b)
+	*r = StatBlobReply{Err: Error(m.Err), Info: blobInfoFromWire(m.Info)}
+	return err
+}
+
+func (r *ReportBadTSReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.ReportBadTSReq{
+		Id:           r.ID.toWire(),
+		Bad:          r.Bad,
+		Operation:    r.Operation,
+		GotError:     int64(r.GotError),
+		CouldRecover: r.CouldRecover,
+	}).Marshal()
+}
+func (r *ReportBadTSReq) UnmarshalWire(b []byte) error {
+	var m blbpb.ReportBadTSReq
+	err := m.Unmarshal(b)
+	*r = ReportBadTSReq{
+		ID:           tractIDFromWire(m.Id),
+		Bad:          m.Bad,
+		Operation:    m.Operation,
+		GotError:     Error(m.GotError),
+		CouldRecover: m.CouldRecover,
+	}
+	return err
+}
+
+func (r *FixVersionReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.FixVersionReq{Info: r.Info.toWire(), Bad: r.Bad}).Marshal()
+}
+func (r *FixVersionReq) UnmarshalWire(b []byte) error {
+	var m blbpb.FixVersionReq
+	err := m.Unmarshal(b)
+	*r = FixVersionReq{Info: tractInfoFromWire(m.Info), Bad: m.Bad}
+	return err
+}
+
+func (r *ListBlobsReq) MarshalWire() ([]byte, error) {
+	m := &blbpb.ListBlobsReq{Partition: uint32(r.Partition), Start: uint32(r.Start)}
+	if r.Filter != nil {
+		m.Filter = r.Filter.toWire()
+	}
+	return m.Marshal()
+}
+func (r *ListBlobsReq) UnmarshalWire(b []byte) error {
+	var m blbpb.ListBlobsReq
+	err := m.Unmarshal(b)
+	*r = ListBlobsReq{Partition: PartitionID(m.Partition), Start: BlobKey(m.Start)}
+	if m.Filter != nil {
+		filter := blobFilterFromWire(m.Filter)
+		r.Filter = &filter
+	}
+	return err
+}
+
+func (r *ListBlobsReply) MarshalWire() ([]byte, error) {
+	m := &blbpb.ListBlobsReply{Err: int64(r.Err), Next: uint32(r.Next), More: r.More}
+	for _, k := range r.Keys {
+		m.Keys = append(m.Keys, uint32(k))
+	}
+	for _, info := range r.Infos {
+		m.Infos = append(m.Infos, info.toWire())
+	}
+	return m.Marshal()
+}
+func (r *ListBlobsReply) UnmarshalWire(b []byte) error {
+	var m blbpb.ListBlobsReply
+	err := m.Unmarshal(b)
+	*r = ListBlobsReply{Err: Error(m.Err), Next: BlobKey(m.Next), More: m.More}
+	for _, k := range m.Keys {
+		r.Keys = append(r.Keys, BlobKey(k))
+	}
+	for _, info := range m.Infos {
+		r.Infos = append(r.Infos, blobInfoFromWire(info))
+	}
+	return err
+}
+
+func (r *GetEventsReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.GetEventsReq{Partition: uint32(r.Partition), After: r.After}).Marshal()
+}
+func (r *GetEventsReq) UnmarshalWire(b []byte) error {
+	var m blbpb.GetEventsReq
+	err := m.Unmarshal(b)
+	*r = GetEventsReq{Partition: PartitionID(m.Partition), After: m.After}
+	return err
+}
+
+func (r *GetEventsReply) MarshalWire() ([]byte, error) {
+	m := &blbpb.GetEventsReply{Next: r.Next, Err: int64(r.Err)}
+	for _, e := range r.Events {
+		m.Events = append(m.Events, &blbpb.BlobEvent{Type: uint32(e.Type), Blob: uint64(e.Blob), Class: int32(e.Class), Index: e.Index})
+	}
+	return m.Marshal()
+}
+func (r *GetEventsReply) UnmarshalWire(b []byte) error {
+	var m blbpb.GetEventsReply
+	err := m.Unmarshal(b)
+	*r = GetEventsReply{Next: m.Next, Err: Error(m.Err)}
+	for _, e := range m.Events {
+		r.Events = append(r.Events, BlobEvent{Type: BlobEventType(e.Type), Blob: BlobID(e.Blob), Class: StorageClass(e.Class), Index: e.Index})
+	}
+	return err
+}
+
+func (r *LookupNameReply) MarshalWire() ([]byte, error) {
+	return (&blbpb.LookupNameReply{Blob: uint64(r.Blob), Err: int64(r.Err)}).Marshal()
+}
+func (r *LookupNameReply) UnmarshalWire(b []byte) error {
+	var m blbpb.LookupNameReply
+	err := m.Unmarshal(b)
+	*r = LookupNameReply{Blob: BlobID(m.Blob), Err: Error(m.Err)}
+	return err
+}
+
+func (r *RenameReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.RenameReq{From: r.From, To: r.To, Replace: uint64(r.Replace)}).Marshal()
+}
+func (r *RenameReq) UnmarshalWire(b []byte) error {
+	var m blbpb.RenameReq
+	err := m.Unmarshal(b)
+	*r = RenameReq{From: m.From, To: m.To, Replace: BlobID(m.Replace)}
+	return err
+}
+
+func (r *UnbindNameReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.UnbindNameReq{Name: r.Name, Blob: uint64(r.Blob)}).Marshal()
+}
+func (r *UnbindNameReq) UnmarshalWire(b []byte) error {
+	var m blbpb.UnbindNameReq
+	err := m.Unmarshal(b)
+	*r = UnbindNameReq{Name: m.Name, Blob: BlobID(m.Blob)}
+	return err
+}
+
+func (r *ListNamesReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.ListNamesReq{Prefix: r.Prefix, Start: r.Start}).Marshal()
+}
+func (r *ListNamesReq) UnmarshalWire(b []byte) error {
+	var m blbpb.ListNamesReq
+	err := m.Unmarshal(b)
+	*r = ListNamesReq{Prefix: m.Prefix, Start: m.Start}
+	return err
+}
+
+func (r *ListNamesReply) MarshalWire() ([]byte, error) {
+	m := &blbpb.ListNamesReply{Err: int64(r.Err)}
+	for _, e := range r.Entries {
+		m.Entries = append(m.Entries, &blbpb.NameEntry{Name: e.Name, Blob: uint64(e.Blob)})
+	}
+	return m.Marshal()
+}
+func (r *ListNamesReply) UnmarshalWire(b []byte) error {
+	var m blbpb.ListNamesReply
+	err := m.Unmarshal(b)
+	*r = ListNamesReply{Err: Error(m.Err)}
+	for _, e := range m.Entries {
+		r.Entries = append(r.Entries, NameEntry{Name: e.Name, Blob: BlobID(e.Blob)})
+	}
+	return err
+}
+
+// Tractserver. Bulk data isn't part of the messages.
+
+func (r *CreateTractReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.CreateTractReq{
+		Tsid:    uint32(r.TSID),
+		Id:      r.ID.toWire(),
+		Off:     r.Off,
+		Pri:     int32(r.Pri),
+		Version: int64(r.Version),
+	}).Marshal()
+}
+func (r *CreateTractReq) UnmarshalWire(b []byte) error {
+	var m blbpb.CreateTractReq
+	err := m.Unmarshal(b)
+	*r = CreateTractReq{
+		TSID:    TractserverID(m.Tsid),
+		ID:      tractIDFromWire(m.Id),
+		Off:     m.Off,
+		Pri:     Priority(m.Pri),
+		Version: int(m.Version),
+	}
+	return err
+}
+
+func (r *WriteReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.WriteReq{
+		Id:      r.ID.toWire(),
+		Version: int64(r.Version),
+		Off:     r.Off,
+		Pri:     int32(r.Pri),
+		ReqId:   r.ReqID,
+	}).Marshal()
+}
+func (r *WriteReq) UnmarshalWire(b []byte) error {
+	var m blbpb.WriteReq
+	err := m.Unmarshal(b)
+	*r = WriteReq{
+		ID:      tractIDFromWire(m.Id),
+		Version: int(m.Version),
+		Off:     m.Off,
+		Pri:     Priority(m.Pri),
+		ReqID:   m.ReqId,
+	}
+	return err
+}
+
+func (r *ReadReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.ReadReq{
+		Id:      r.ID.toWire(),
+		Version: int64(r.Version),
+		Len:     int64(r.Len),
+		Off:     r.Off,
+		Pri:     int32(r.Pri),
+		ReqId:   r.ReqID,
+	}).Marshal()
+}
+func (r *ReadReq) UnmarshalWire(b []byte) error {
+	var m blbpb.ReadReq
+	err := m.Unmarshal(b)
+	*r = ReadReq{
+		ID:      tractIDFromWire(m.Id),
+		Version: int(m.Version),
+		Len:     int(m.Len),
+		Off:     m.Off,
+		Pri:     Priority(m.Pri),
+		ReqID:   m.ReqId,
+	}
+	return err
+}
+
+func (r *ReadReply) MarshalWire() ([]byte, error) {
+	return (&blbpb.ReadReply{Err: int64(r.Err)}).Marshal()
+}
+func (r *ReadReply) UnmarshalWire(b []byte) error {
+	var m blbpb.ReadReply
+	err := m.Unmarshal(b)
+	*r = ReadReply{Err: Error(m.Err)}
+	return err
+}
+
+func (r *ReadVReq) MarshalWire() ([]byte, error) {
+	m := &blbpb.ReadVReq{Pri: int32(r.Pri), ReqId: r.ReqID}
+	for _, rr := range r.Reads {
+		m.Reads = append(m.Reads, &blbpb.ReadVRange{Id: rr.ID.toWire(), Version: int64(rr.Version), Len: int64(rr.Len), Off: rr.Off})
+	}
+	return m.Marshal()
+}
+func (r *ReadVReq) UnmarshalWire(b []byte) error {
+	var m blbpb.ReadVReq
+	err := m.Unmarshal(b)
+	*r = ReadVReq{Pri: Priority(m.Pri), ReqID: m.ReqId}
+	for _, rr := range m.Reads {
+		r.Reads = append(r.Reads, ReadVRange{ID: tractIDFromWire(rr.Id), Version: int(rr.Version), Len: int(rr.Len), Off: rr.Off})
+	}
+	return err
+}
+
+func (r *ReadVReply) MarshalWire() ([]byte, error) {
+	m := &blbpb.ReadVReply{Err: int64(r.Err)}
+	for _, n := range r.N {
+		m.N = append(m.N, int64(n))
+	}
+	for _, e := range r.Errs {
+		m.Errs = append(m.Errs, int64(e))
+	}
+	return m.Marshal()
+}
+func (r *ReadVReply) UnmarshalWire(b []byte) error {
+	var m blbpb.ReadVReply
+	err := m.Unmarshal(b)
+	*r = ReadVReply{Err: Error(m.Err)}
+	for _, n := range m.N {
+		r.N = append(r.N, int(n))
+	}
+	for _, e := range m.Errs {
+		r.Errs = append(r.Errs, Error(e))
+	}
+	return err
+}
+
+func (r *TruncateReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.TruncateReq{Id: r.ID.toWire(), Version: int64(r.Version), Size_: r.Size, Pri: int32(r.Pri)}).Marshal()
+}
+func (r *TruncateReq) UnmarshalWire(b []byte) error {
+	var m blbpb.TruncateReq
+	err := m.Unmarshal(b)
+	*r = TruncateReq{ID: tractIDFromWire(m.Id), Version: int(m.Version), Size: m.Size_, Pri: Priority(m.Pri)}
+	return err
+}
+
+func (r *StatTractReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.StatTractReq{Id: r.ID.toWire(), Version: int64(r.Version), Pri: int32(r.Pri)}).Marshal()
+}
+func (r *StatTractReq) UnmarshalWire(b []byte) error {
+	var m blbpb.StatTractReq
+	err := m.Unmarshal(b)
+	*r = StatTractReq{ID: tractIDFromWire(m.Id), Version: int(m.Version), Pri: Priority(m.Pri)}
+	return err
+}
+
+func (r *StatTractReply) MarshalWire() ([]byte, error) {
+	return (&blbpb.StatTractReply{Err: int64(r.Err), Size_: r.Size, ModStamp: r.ModStamp}).Marshal()
+}
+func (r *StatTractReply) UnmarshalWire(b []byte) error {
+	var m blbpb.StatTractReply
+	err := m.Unmarshal(b)
+	*r = StatTractReply{Err: Error(m.Err), Size: m.Size_, ModStamp: m.ModStamp}
+	return err
+}
+
+func (r *GetDiskInfoReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.GetDiskInfoReq{}).Marshal()
+}
+func (r *GetDiskInfoReq) UnmarshalWire(b []byte) error {
+	return (&blbpb.GetDiskInfoReq{}).Unmarshal(b)
+}
+
+func (r *GetDiskInfoReply) MarshalWire() ([]byte, error) {
+	return (&blbpb.GetDiskInfoReply{Disks: fsStatusesToWire(r.Disks), Err: int64(r.Err)}).Marshal()
+}
+func (r *GetDiskInfoReply) UnmarshalWire(b []byte) error {
+	var m blbpb.GetDiskInfoReply
+	err := m.Unmarshal(b)
+	*r = GetDiskInfoReply{Disks: fsStatusesFromWire(m.Disks), Err: Error(m.Err)}
+	return err
+}
+
+func (r *SetControlFlagsReq) MarshalWire() ([]byte, error) {
+	return (&blbpb.SetControlFlagsReq{Root: r.Root, Flags: r.Flags.toWire()}).Marshal()
+}
+func (r *SetControlFlagsReq) UnmarshalWire(b []byte) error {
+	var m blbpb.SetControlFlagsReq
+	err := m.Unmarshal(b)
+	*r = SetControlFlagsReq{Root: m.Root, Flags: diskControlFlagsFromWire(m.Flags)}
+	return err
+}
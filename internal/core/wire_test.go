@@ -0,0 +1,100 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package core
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
+)
+
+// fill sets every exported field of 'v' to a non-zero value, using 'n' to make
+// the values distinct.
+func fill(v reflect.Value, n *int) {
+	*n++
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(-int64(*n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(*n))
+	case reflect.String:
+		v.SetString(fmt.Sprintf("s%d", *n))
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		fill(v.Index(0), n)
+		fill(v.Index(1), n)
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		for i := 0; i < 2; i++ {
+			k := reflect.New(v.Type().Key()).Elem()
+			e := reflect.New(v.Type().Elem()).Elem()
+			fill(k, n)
+			fill(e, n)
+			v.SetMapIndex(k, e)
+		}
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem(), n)
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			v.Set(reflect.ValueOf(time.Unix(int64(*n)*1000, int64(*n))))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				fill(v.Field(i), n)
+			}
+		}
+	}
+}
+
+// Test that every message in WireServices comes out the same whether it's
+// sent with gob or protocol buffers.
+func TestWireCompat(t *testing.T) {
+	var n int
+	for _, svc := range WireServices {
+		for _, m := range svc.Methods {
+			for _, msg := range []interface{}{m.Req, m.Reply} {
+				in := reflect.New(reflect.TypeOf(msg))
+				fill(in.Elem(), &n)
+				if bb, ok := in.Interface().(rpc.BulkData); ok {
+					// Bulk data is sent separately.
+					bb.Get()
+				}
+
+				var buf bytes.Buffer
+				viaGob := reflect.New(in.Type().Elem())
+				if err := gob.NewEncoder(&buf).Encode(in.Interface()); err != nil {
+					t.Fatal(err)
+				}
+				if err := gob.NewDecoder(&buf).Decode(viaGob.Interface()); err != nil {
+					t.Fatal(err)
+				}
+
+				viaProto := reflect.New(in.Type().Elem())
+				b, err := rpc.MarshalWire(in.Interface())
+				if err != nil {
+					t.Fatalf("%s.%s: %s", svc.Name, m.Name, err)
+				}
+				if err := rpc.UnmarshalWire(b, viaProto.Interface()); err != nil {
+					t.Fatalf("%s.%s: %s", svc.Name, m.Name, err)
+				}
+
+				if !reflect.DeepEqual(viaGob.Interface(), viaProto.Interface()) {
+					t.Errorf("%s.%s: %T differs:\ngob:   %+v\nproto: %+v", svc.Name, m.Name, msg, viaGob.Elem(), viaProto.Elem())
+				}
+				if !reflect.DeepEqual(in.Interface(), viaProto.Interface()) {
+					t.Errorf("%s.%s: %T changed:\nin:    %+v\nproto: %+v", svc.Name, m.Name, msg, in.Elem(), viaProto.Elem())
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package curator
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/curator/durable"
+	"github.com/westerndigitalcorporation/blb/internal/server"
+)
+
+// SetVersion rpcs to do at once when fencing the tracts of a blob that's being
+// cloned.
+const maxFencesInFlight = 100
+
+// clone creates a blob owned by 'owner' that shares the tracts of 'id'. Shared
+// tracts are stored once, so they only count against the quota of the origin's
+// owner. The clone's owner pays for the tracts it gets its own copies of, see
+// unshareTract.
+func (c *Curator) clone(id core.BlobID, owner string) (core.BlobID, core.Error) {
+	// Keep the blob from being extended while we fence its tracts.
+	c.lockMgr.LockBlob(id)
+	defer c.lockMgr.UnlockBlob(id)
+	term := c.stateHandler.GetTerm()
+
+	info, err := c.stateHandler.Stat(id)
+	if err != core.NoError {
+		return core.BlobID(0), err
+	}
+	add := core.TenantUsage{Blobs: 1}
+	if err = c.checkQuota(owner, add); err != core.NoError {
+		return core.BlobID(0), err
+	}
+
+	tracts, _, err := c.stateHandler.GetTracts(id, 0, info.NumTracts)
+	if err != core.NoError {
+		return core.BlobID(0), err
+	}
+	// Keep re-replication and fixVersion from changing the versions until
+	// the new ones are committed.
+	for _, tract := range tracts {
+		if needsFence(tract) {
+			c.lockMgr.LockTract(tract.Tract)
+			defer c.lockMgr.UnlockTract(tract.Tract)
+		}
+	}
+	versions, err := c.fenceTracts(tracts)
+	if err != core.NoError {
+		return core.BlobID(0), err
+	}
+
+	cmd := durable.CloneBlobCommand{Src: id, Owner: owner, InitialTime: time.Now().UnixNano(), Versions: versions}
+	clone, err := c.stateHandler.CloneBlob(cmd, term)
+	if err == core.NoError {
+		c.addUsage(owner, add)
+	}
+	return clone, err
+}
+
+// needsFence returns true if 'tract' is about to become shared and can be
+// written to.
+func needsFence(tract core.TractInfo) bool {
+	return !tract.Shared && len(tract.TSIDs) > 0
+}
+
+// fenceTracts gives the replicated tracts in 'tracts' that aren't shared yet a
+// new version on all of their tractservers. Clients that had the tracts cached
+// before the clone don't know that they're shared, and would write to them in
+// place. With the new version their writes fail, and when they get the tracts
+// again they see that the tracts are shared. It returns the new version of
+// each tract, or zero for the tracts that were left alone.
+//
+// If it fails, some tractservers may have the new version already. Clients
+// that get ErrVersionMismatch from them have the version fixed, like after an
+// aborted re-replication.
+func (c *Curator) fenceTracts(tracts []core.TractInfo) ([]int, core.Error) {
+	versions := make([]int, len(tracts))
+	sem := server.NewSemaphore(maxFencesInFlight)
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	res := core.NoError
+
+	for i, tract := range tracts {
+		if !needsFence(tract) {
+			continue
+		}
+		addrs, missing := c.tsMon.getTractserverAddrs(tract.TSIDs)
+		if missing > 0 {
+			log.Errorf("fenceTracts: %s couldn't look up address of current hosts %+v", tract.Tract, tract.TSIDs)
+			res = core.ErrHostNotExist
+			break
+		}
+		versions[i] = tract.Version + 1
+		for j, addr := range addrs {
+			wg.Add(1)
+			sem.Acquire()
+			go func(addr string, tsid core.TractserverID, id core.TractID, version int) {
+				defer wg.Done()
+				defer sem.Release()
+				if err := c.tt.SetVersion(addr, tsid, id, version, 0); err != core.NoError {
+					log.Errorf("fenceTracts: %s SetVersion to %d on tsid=%d host=%s failed: %s", id, version, tsid, addr, err)
+					lock.Lock()
+					res = err
+					lock.Unlock()
+				}
+			}(addr, tract.TSIDs[j], tract.Tract, versions[i])
+		}
+	}
+	wg.Wait()
+
+	if res != core.NoError {
+		return nil, res
+	}
+	return versions, core.NoError
+}
+
+// unshareTract gives every blob that shares the tract 'id' its own copy, so
+// that 'id' can be written. The copies are pulled by tractservers, like the
+// ones copyTracts makes, and count against the quota of the owner of the blob
+// that gets them.
+func (c *Curator) unshareTract(id core.TractID) core.Error {
+	for {
+		blob, repl, source, shared, err := c.stateHandler.UnshareTarget(id)
+		if err != core.NoError || !shared {
+			return err
+		}
+		info, err := c.stateHandler.Stat(blob)
+		if err != core.NoError {
+			return err
+		}
+		add := tractUsage(1, repl, info.Class)
+		if err = c.checkQuota(info.Owner, add); err != core.NoError {
+			return err
+		}
+		c.fillHosts(&source)
+
+		tsAddrs, tsIDs := c.allocateTS(repl, nil, nil)
+		if tsAddrs == nil {
+			log.Errorf("unshareTract: %v failed to pick %d hosts", id, repl)
+			return core.ErrAllocHost
+		}
+		target := core.TractInfo{
+			Tract:   core.TractID{Blob: blob, Index: id.Index},
+			Version: 1,
+			Hosts:   tsAddrs,
+			TSIDs:   tsIDs,
+		}
+		if err = c.pullTracts([]core.TractInfo{source}, []core.TractInfo{target}); err != core.NoError {
+			return err
+		}
+
+		err = c.stateHandler.UnshareTract(target.Tract, target.TSIDs)
+		if err == core.NoError {
+			c.addUsage(info.Owner, add)
+		} else if err != core.ErrConflictingState {
+			return err
+		}
+		// On a conflict someone else unshared it for the same blob first, and
+		// our copy is garbage. Either way, see if there's more to do.
+	}
+}
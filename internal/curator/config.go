@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/westerndigitalcorporation/blb/internal/core"
+	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
+	"github.com/westerndigitalcorporation/blb/internal/curator/storageclass"
 )
 
 // Config encapsulates parameters for Curator.
@@ -37,6 +39,7 @@ type Config struct {
 	// --- GC ---
 	MetadataGCInterval   time.Duration
 	MetadataUndeleteTime time.Duration
+	UnsealedBlobTTL      time.Duration // Write-once blobs not sealed this long after their last write are removed.
 
 	// --- Tract Server Monitor ---
 	// TODO(PL-1107)
@@ -63,6 +66,29 @@ type Config struct {
 	// --- Erasure Coding ---
 	// Time after last write that a blob can be considered for erasure coding.
 	WriteDelay time.Duration
+	// Rules that pick the storage class of blobs. If nil, DefaultStoragePolicy
+	// is used.
+	StoragePolicy *StoragePolicy
+	// Storage classes to add to the built-in ones, or to replace them with. The
+	// leader adds these to the durable state, so they can't be taken away again
+	// or have their coding changed.
+	StorageClasses []*pb.StorageClassDef
+
+	// --- Quotas ---
+	// How often to recompute the usage of blob owners.
+	UsageScanInterval time.Duration
+
+	// --- Namespace ---
+	// The partition whose curator stores the blob namespace. Every curator
+	// and client of a cluster must use the same value.
+	NamespacePartition core.PartitionID
+
+	// --- Authentication ---
+	// File with the tokens that peers may present. If empty, there's no
+	// authentication.
+	TokensFile string
+	// File with the token we present to other servers.
+	TokenFile string
 }
 
 // Validate validates the configuration object has reasonable(not obviously
@@ -71,9 +97,38 @@ func (c *Config) Validate() error {
 	if c.Addr == "" {
 		return fmt.Errorf("Address of the curator can not be empty")
 	}
+	if c.NamespacePartition == 0 {
+		return fmt.Errorf("NamespacePartition can not be zero")
+	}
+	reg, err := storageclass.NewRegistry(c.storageClasses())
+	if err != nil {
+		return err
+	}
+	if c.StoragePolicy != nil {
+		if err := c.StoragePolicy.validate(reg); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// storageClasses returns the built-in storage classes, with the ones in the
+// config added to them or replacing them.
+func (c *Config) storageClasses() []*pb.StorageClassDef {
+	defs := append([]*pb.StorageClassDef(nil), storageclass.Builtin...)
+outer:
+	for _, def := range c.StorageClasses {
+		for i := range defs {
+			if defs[i].Id == def.Id {
+				defs[i] = def
+				continue outer
+			}
+		}
+		defs = append(defs, def)
+	}
+	return defs
+}
+
 // DefaultProdConfig specifies the default values for Config that is used for
 // production environment.
 var DefaultProdConfig = Config{
@@ -121,6 +176,7 @@ var DefaultProdConfig = Config{
 	// --- GC ----
 	MetadataGCInterval:   time.Hour,
 	MetadataUndeleteTime: 3 * 24 * time.Hour,
+	UnsealedBlobTTL:      7 * 24 * time.Hour,
 
 	// --- Tract Server Monitor ---
 	TsUnhealthy:            1 * time.Minute,
@@ -137,6 +193,12 @@ var DefaultProdConfig = Config{
 	// that the times of day that we do lots of EC will tend to be opposite from
 	// the times of day that lots of data is written.
 	WriteDelay: (8*24 + 12) * time.Hour,
+
+	// --- Quotas ---
+	UsageScanInterval: 10 * time.Minute,
+
+	// --- Namespace ---
+	NamespacePartition: core.DefaultNamespacePartition,
 }
 
 // DefaultTestConfig specifies the default values for Config that is used for
@@ -182,6 +244,7 @@ var DefaultTestConfig = Config{
 	// --- GC ----
 	MetadataGCInterval:   15 * time.Minute,
 	MetadataUndeleteTime: 3 * time.Hour,
+	UnsealedBlobTTL:      3 * time.Hour,
 
 	// --- Tract Server Monitor ---
 	TsUnhealthy:            20 * time.Second,
@@ -193,4 +256,10 @@ var DefaultTestConfig = Config{
 
 	// --- Erasure Coding ---
 	WriteDelay: 30 * time.Second,
+
+	// --- Quotas ---
+	UsageScanInterval: 10 * time.Second,
+
+	// --- Namespace ---
+	NamespacePartition: core.DefaultNamespacePartition,
 }
@@ -0,0 +1,164 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package curator
+
+import (
+	log "github.com/golang/glog"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+// copyTracts extends the blob 'id' with copies of the tracts [start, end) of
+// blob 'src', starting at tract 'first'. The new tracts are placed like any
+// other new tracts, and their tractservers pull the data directly from the
+// source tracts' tractservers, so no data passes through the client or the
+// curator. The source tracts are looked up here, so 'src' has to be one of our
+// blobs.
+//
+// The copies are only added to the blob once all of them exist. If anything
+// fails, the blob is unchanged and the tracts that were already copied are left
+// for garbage collection.
+func (c *Curator) copyTracts(id core.BlobID, first core.TractKey, src core.BlobID, start, end int) core.Error {
+	n := end - start
+	if start < 0 || n < 0 {
+		return core.ErrInvalidArgument
+	} else if n == 0 {
+		return core.NoError
+	} else if n > c.config.MaxTractsToExtend {
+		return core.ErrTooBig
+	}
+
+	// Prevent parallel calls to extend.
+	c.lockMgr.LockBlob(id)
+	defer c.lockMgr.UnlockBlob(id)
+
+	info, err := c.stateHandler.Stat(id)
+	if err != core.NoError {
+		log.Errorf("copyTracts: %v couldn't Stat err=%s", id, err)
+		return err
+	}
+	if info.Sealed {
+		return core.ErrSealed
+	}
+	if info.Class != core.StorageClass_REPLICATED {
+		return core.ErrReadOnlyStorageClass
+	}
+	if info.NumTracts != int(first) {
+		return core.ErrExtendConflict
+	}
+	if int(first)+n > core.MaxBlobSize {
+		return core.ErrBlobFull
+	}
+	if err = c.checkQuota(info.Owner, tractUsage(n, info.Repl, info.Class)); err != core.NoError {
+		return err
+	}
+
+	srcInfo, err := c.stateHandler.Stat(src)
+	if err != core.NoError {
+		return err
+	}
+	if srcInfo.WriteOnce && !srcInfo.Sealed {
+		return core.ErrNotSealed
+	}
+	tracts, _, err := c.getTracts(src, start, end)
+	if err != core.NoError {
+		return err
+	}
+	if len(tracts) != n {
+		return core.ErrNoSuchTract
+	}
+	for _, tract := range tracts {
+		if tract.RS.Present() && tract.RS.Host == "" {
+			log.Errorf("copyTracts: %v couldn't look up address of RS piece host %d", tract.Tract, tract.RS.TSID)
+			return core.ErrHostNotExist
+		}
+	}
+
+	versions, err := c.stateHandler.NewTractVersions(id, int(first), n)
+	if err != core.NoError {
+		return err
+	}
+
+	// Allocate tractservers for all the copies first, so we don't copy
+	// anything if we can't copy everything.
+	dsts := make([]core.TractInfo, len(tracts))
+	for i := range tracts {
+		tsAddrs, tsIDs := c.allocateTS(info.Repl, nil, nil)
+		if tsAddrs == nil {
+			log.Errorf("copyTracts: %v failed to pick %d hosts for %d tracts", id, info.Repl, n)
+			return core.ErrAllocHost
+		}
+		dsts[i] = core.TractInfo{
+			Tract:   core.TractID{Blob: id, Index: first + core.TractKey(i)},
+			Version: versions[i],
+			Hosts:   tsAddrs,
+			TSIDs:   tsIDs,
+		}
+	}
+
+	if err = c.pullTracts(tracts, dsts); err != core.NoError {
+		return err
+	}
+
+	hosts := make([][]core.TractserverID, len(dsts))
+	for i, dst := range dsts {
+		hosts[i] = dst.TSIDs
+	}
+	// The copies have the same data, so they have the same checksums.
+	var updates []core.ChecksumUpdate
+	for i, tract := range tracts {
+		if tract.Checksum.Present() {
+			updates = append(updates, core.ChecksumUpdate{Index: first + core.TractKey(i), New: tract.Checksum})
+		}
+	}
+	if _, err = c.stateHandler.ExtendBlob(id, first, hosts, updates); err != core.NoError {
+		log.Errorf("copyTracts: %v couldn't commit new tracts: %s", id, err)
+		return err
+	}
+	c.addUsage(info.Owner, tractUsage(n, info.Repl, info.Class))
+	return core.NoError
+}
+
+// pullTracts has the hosts of each tract in 'dsts' create it as a copy of the
+// tract at the same index in 'srcs'. It returns the first error.
+func (c *Curator) pullTracts(srcs, dsts []core.TractInfo) core.Error {
+	// The channel is buffered so that we can return at the first error
+	// without blocking the rest.
+	var n int
+	for _, dst := range dsts {
+		n += len(dst.Hosts)
+	}
+	errorChan := make(chan core.Error, n)
+	for i, dst := range dsts {
+		src := srcs[i]
+		for j := range dst.Hosts {
+			go func(addr string, tsid core.TractserverID, dst core.TractInfo) {
+				errorChan <- c.pullTract(addr, tsid, src, dst)
+			}(dst.Hosts[j], dst.TSIDs[j], dst)
+		}
+	}
+	for i := 0; i < n; i++ {
+		if res := <-errorChan; res != core.NoError {
+			// The errors are logged in the goroutine started above.
+			return res
+		}
+	}
+	return core.NoError
+}
+
+// pullTract has the tractserver at 'addr' create 'dst' as a copy of 'src'. If
+// 'src' is only stored in an RS chunk, its data is read out of the data piece
+// that holds it, like unpackTract does.
+func (c *Curator) pullTract(addr string, tsid core.TractserverID, src, dst core.TractInfo) core.Error {
+	var err core.Error
+	if src.RS.Present() {
+		err = c.tt.UnpackTract(addr, tsid, []string{src.RS.Host}, src.RS.Chunk, int(src.RS.Offset), int(src.RS.Length), dst.Tract, dst.Version)
+	} else {
+		err = c.tt.CopyTract(addr, tsid, src.Hosts, src.Tract, src.Version, dst.Tract, dst.Version)
+	}
+	if err != core.NoError {
+		log.Errorf("pullTract: copying %v version %d to %v on (%d at %s) failed: %s", src.Tract, src.Version, dst.Tract, tsid, addr, err)
+	}
+	return err
+}
@@ -70,6 +70,21 @@ type Curator struct {
 	// Batched mtime/atime updates.
 	timeUpdates []state.UpdateTime
 
+	// Quotas of blob owners, and their usage on other curators, as of the last
+	// heartbeat to the master.
+	quotas, otherUsage map[string]core.TenantUsage
+
+	// Usage of blob owners on this curator. See quota.go.
+	usage map[string]core.TenantUsage
+
+	// Storage policy from dyconfig, or nil to use the one from 'config'. See
+	// storage_policy.go.
+	policy *StoragePolicy
+
+	// What the storage policy matched in the last pass of the storage class
+	// loop.
+	policyStatus StoragePolicyStatus
+
 	// The latest leadership change from 'leaderChan'. True if it's leader, false otherwise.
 	// We use it to keep track of the latest leadership change notification from
 	// 'leaderChan' so the curator node can act based on whether it's leader or not.
@@ -176,6 +191,9 @@ func NewCurator(curatorCfg *Config,
 	// Migrate storage classes.
 	go c.storageClassLoop()
 
+	// Keep track of how much space blob owners use.
+	go c.usageLoop()
+
 	return c
 }
 
@@ -282,22 +300,46 @@ func (c *Curator) getCorruptBatch() map[core.TractID]TSIDSet {
 	return toMerge
 }
 
-// create creates a blob with replication factor 'repl' and storage hint 'hint'.
+// create creates a blob with the replication factor, storage hint, metadata,
+// owner, and ACL in 'req'. If req.Owner is set, the blob counts against their
+// quota. If req.Name is set, it's bound to the blob in the same Raft command;
+// this curator must own the namespace.
 // Create does not create any tracts in the blob.
-func (c *Curator) create(repl int, hint core.StorageHint, expires time.Time) (core.BlobID, core.Error) {
-	if repl <= 0 || repl > c.config.MaxReplFactor {
+func (c *Curator) create(req core.CreateBlobReq) (core.BlobID, core.Error) {
+	if req.Repl <= 0 || req.Repl > c.config.MaxReplFactor {
 		return core.BlobID(0), core.ErrInvalidArgument
 	}
-	if _, ok := core.StorageHint_name[int32(hint)]; !ok {
+	if _, ok := core.StorageHint_name[int32(req.Hint)]; !ok {
+		return core.BlobID(0), core.ErrInvalidArgument
+	}
+	if req.Name != "" && !core.ValidName(req.Name) {
 		return core.BlobID(0), core.ErrInvalidArgument
 	}
 
-	// Have Raft figure out the Blob's ID and commit the creation.
-	var exp int64
-	if !expires.IsZero() {
-		exp = expires.UnixNano()
+	if err := c.checkQuota(req.Owner, core.TenantUsage{Blobs: 1}); err != core.NoError {
+		return core.BlobID(0), err
 	}
-	return c.stateHandler.CreateBlob(repl, time.Now().UnixNano(), exp, hint, c.stateHandler.GetTerm())
+
+	// Have Raft figure out the Blob's ID and commit the creation.
+	cmd := durable.CreateBlobCommand{
+		Repl:        req.Repl,
+		InitialTime: time.Now().UnixNano(),
+		Hint:        req.Hint,
+		Metadata:    req.Metadata,
+		WriteOnce:   req.WriteOnce,
+		Owner:       req.Owner,
+		ACL:         req.ACL,
+		Name:        req.Name,
+		Namespace:   c.config.NamespacePartition,
+	}
+	if !req.Expires.IsZero() {
+		cmd.Expires = req.Expires.UnixNano()
+	}
+	id, err := c.stateHandler.CreateBlob(cmd, c.stateHandler.GetTerm())
+	if err == core.NoError {
+		c.addUsage(req.Owner, core.TenantUsage{Blobs: 1})
+	}
+	return id, err
 }
 
 // extend allocates additional tracts to the blob. The allocated tractservers
@@ -321,6 +363,9 @@ func (c *Curator) extend(id core.BlobID, desiredSize int) ([]core.TractInfo, cor
 		log.Errorf("extend: %v couldn't Stat err=%s", id, err)
 		return nil, err
 	}
+	if info.Sealed {
+		return nil, core.ErrSealed
+	}
 
 	// Figure out how many tracts we'd need to add to reach the target size.
 	tractsToAdd := desiredSize - info.NumTracts
@@ -334,6 +379,16 @@ func (c *Curator) extend(id core.BlobID, desiredSize int) ([]core.TractInfo, cor
 		log.Errorf("extend: %v client wants to add too many tracts: wants %d, tract has %d already, max extend is %d", id, desiredSize, info.NumTracts, c.config.MaxTractsToExtend)
 		return nil, core.ErrTooBig
 	}
+	if err = c.checkQuota(info.Owner, tractUsage(tractsToAdd, info.Repl, info.Class)); err != core.NoError {
+		return nil, err
+	}
+
+	// Tracts that replace ones that were truncated away start at a higher
+	// version, so that leftover copies can be told apart.
+	versions, err := c.stateHandler.NewTractVersions(id, info.NumTracts, tractsToAdd)
+	if err != core.NoError {
+		return nil, err
+	}
 
 	// Allocate tractservers to host replicas of these tracts.
 	var tracts []core.TractInfo
@@ -345,16 +400,20 @@ func (c *Curator) extend(id core.BlobID, desiredSize int) ([]core.TractInfo, cor
 		}
 		tracts = append(tracts, core.TractInfo{
 			Tract:   core.TractID{Blob: id, Index: core.TractKey(info.NumTracts + i)},
-			Version: 1,
+			Version: versions[i],
 			Hosts:   tsAddrs,
 			TSIDs:   tsIDs,
 		})
 	}
+	// Count the tracts now, since acking them doesn't tell us the owner. If
+	// they're never acked, the next usage scan will correct it.
+	c.addUsage(info.Owner, tractUsage(tractsToAdd, info.Repl, info.Class))
 	return tracts, core.NoError
 }
 
-// ackExtend acknowledges the success of a previous extend operation.
-func (c *Curator) ackExtend(id core.BlobID, tracts []core.TractInfo) (int, core.Error) {
+// ackExtend acknowledges the success of a previous extend operation, and
+// applies 'checksums' along with it.
+func (c *Curator) ackExtend(id core.BlobID, tracts []core.TractInfo, checksums []core.ChecksumUpdate) (int, core.Error) {
 	if len(tracts) == 0 {
 		return 0, core.NoError
 	} else if len(tracts) > c.config.MaxTractsToExtend {
@@ -370,7 +429,55 @@ func (c *Curator) ackExtend(id core.BlobID, tracts []core.TractInfo) (int, core.
 		hosts = append(hosts, tract.TSIDs)
 	}
 
-	return c.stateHandler.ExtendBlob(id, tracts[0].Tract.Index, hosts)
+	return c.stateHandler.ExtendBlob(id, tracts[0].Tract.Index, hosts, checksums)
+}
+
+// reserveAppend reserves space at the end of a blob for an append. Raft
+// serializes reservations, so each one gets a distinct range.
+func (c *Curator) reserveAppend(id core.BlobID, length, minOffset int64) (int64, core.Error) {
+	return c.stateHandler.ReserveAppend(id, length, minOffset)
+}
+
+// truncate drops the tracts of blob 'id' beyond 'size' bytes, and tells their
+// tractservers to delete them.
+//
+// Deleting them here frees the space right away. We hold the blob lock until
+// the tractservers are done, so that an extend can't create a tract with the
+// same ID in the meantime. Failing to delete is only logged: the blob records
+// how many tracts it had and the highest version among them, so the regular
+// garbage collection deletes whatever is left behind.
+func (c *Curator) truncate(id core.BlobID, size int64) core.Error {
+	c.lockMgr.LockBlob(id)
+	defer c.lockMgr.UnlockBlob(id)
+
+	dropped, err := c.stateHandler.TruncateBlob(id, size)
+	if err != core.NoError {
+		return err
+	}
+
+	old := make(map[core.TractserverID][]core.TractState)
+	for _, tract := range dropped {
+		for _, tsid := range tract.TSIDs {
+			old[tsid] = append(old[tsid], core.TractState{ID: tract.Tract, Version: tract.Version})
+		}
+	}
+	var wg sync.WaitGroup
+	for tsid, tracts := range old {
+		addr, ok := c.tsMon.getAddrByID(tsid)
+		if !ok {
+			log.Errorf("truncate: %v no address for ts %d, leaving %d tracts", id, tsid, len(tracts))
+			continue
+		}
+		wg.Add(1)
+		go func(addr string, tsid core.TractserverID, tracts []core.TractState) {
+			if err := c.tt.GCTract(addr, tsid, tracts, nil); err != core.NoError {
+				log.Errorf("truncate: %v couldn't delete %d tracts on ts %d (@%s): %s", id, len(tracts), tsid, addr, err)
+			}
+			wg.Done()
+		}(addr, tsid, tracts)
+	}
+	wg.Wait()
+	return core.NoError
 }
 
 // remove removes a blob.
@@ -385,13 +492,55 @@ func (c *Curator) unremove(id core.BlobID) core.Error {
 	return c.stateHandler.UndeleteBlob(id, c.stateHandler.GetTerm())
 }
 
+// purge removes a deleted blob for good, without waiting for the undelete
+// period to pass.
+func (c *Curator) purge(id core.BlobID) core.Error {
+	return c.stateHandler.PurgeBlob(id, c.stateHandler.GetTerm())
+}
+
 func (c *Curator) setMetadata(id core.BlobID, md core.BlobInfo) core.Error {
 	return c.stateHandler.SetMetadata(id, md)
 }
 
+// seal seals a write-once blob.
+func (c *Curator) seal(id core.BlobID) core.Error {
+	return c.stateHandler.SealBlob(id)
+}
+
+// updateChecksums changes end-to-end checksums for tracts of a blob.
+func (c *Curator) updateChecksums(id core.BlobID, updates []core.ChecksumUpdate) core.Error {
+	if len(updates) == 0 {
+		return core.NoError
+	}
+	return c.stateHandler.UpdateChecksums(id, updates)
+}
+
 // Stat returns information about the blob.
 func (c *Curator) stat(id core.BlobID) (core.BlobInfo, core.Error) {
-	return c.stateHandler.Stat(id)
+	info, err := c.stateHandler.Stat(id)
+	c.setPurgeAt(&info)
+	return info, err
+}
+
+// checkACL returns core.ErrPermissionDenied if the ACL of blob 'id' doesn't let
+// 'name' read it or, if 'write' is set, change it. Blobs that don't exist are
+// left for the operation itself to fail on.
+func (c *Curator) checkACL(id core.BlobID, name string, write bool) core.Error {
+	if acl, ok := c.stateHandler.GetACL(id); ok && !acl.Allows(name, write) {
+		log.Infof("%q may not use blob %s", name, id)
+		return core.ErrPermissionDenied
+	}
+	return core.NoError
+}
+
+// setPurgeAt fills in when the metadata GC will remove a blob, which happens
+// once it's been deleted or expired for MetadataUndeleteTime.
+func (c *Curator) setPurgeAt(info *core.BlobInfo) {
+	if !info.Deleted.IsZero() {
+		info.PurgeAt = info.Deleted.Add(c.config.MetadataUndeleteTime)
+	} else if !info.Expires.IsZero() {
+		info.PurgeAt = info.Expires.Add(c.config.MetadataUndeleteTime)
+	}
 }
 
 // getTracts returns the tract information for the tracts [start, end) in the blob
@@ -419,22 +568,27 @@ func (c *Curator) getTracts(id core.BlobID, start, end int) ([]core.TractInfo, c
 
 	// Retrieve tractserver addresses from tractserver monitor.
 	for i := range tracts {
-		if len(tracts[i].TSIDs) > 0 {
-			tracts[i].Hosts, _ = c.tsMon.getTractserverAddrs(tracts[i].TSIDs)
-		}
-		if tracts[i].RS.Present() {
-			// Durable layer will always fill in OtherTSIDs here.
-			tracts[i].RS.OtherHosts, _ = c.tsMon.getTractserverAddrs(tracts[i].RS.OtherTSIDs)
-			// One of them will be the TSID that this data is on directly. Copy that to Host.
-			for j, tsid := range tracts[i].RS.OtherTSIDs {
-				if tsid == tracts[i].RS.TSID {
-					tracts[i].RS.Host = tracts[i].RS.OtherHosts[j]
-					break
-				}
+		c.fillHosts(&tracts[i])
+	}
+	return tracts, cls, core.NoError
+}
+
+// fillHosts translates the tractserver IDs in 'tract' to addresses.
+func (c *Curator) fillHosts(tract *core.TractInfo) {
+	if len(tract.TSIDs) > 0 {
+		tract.Hosts, _ = c.tsMon.getTractserverAddrs(tract.TSIDs)
+	}
+	if tract.RS.Present() {
+		// Durable layer will always fill in OtherTSIDs here.
+		tract.RS.OtherHosts, _ = c.tsMon.getTractserverAddrs(tract.RS.OtherTSIDs)
+		// One of them will be the TSID that this data is on directly. Copy that to Host.
+		for j, tsid := range tract.RS.OtherTSIDs {
+			if tsid == tract.RS.TSID {
+				tract.RS.Host = tract.RS.OtherHosts[j]
+				break
 			}
 		}
 	}
-	return tracts, cls, core.NoError
 }
 
 // forward a request for timely re-replication of a tract to the replication scheduler.
@@ -477,12 +631,11 @@ func (c *Curator) fixVersion(id core.TractID, cliVersion int, badAddr string) co
 	defer c.lockMgr.UnlockTract(id)
 
 	// Pull out current state for the tract the client is complaining about.
-	durInfoArr, _, err := c.stateHandler.GetTracts(id.Blob, int(id.Index), 1+int(id.Index))
+	durInfo, err := c.stateHandler.GetTract(id)
 	if err != core.NoError {
-		log.Errorf("fixVersion: %s failed to GetTracts for %d: %s", id, int(id.Index), err)
+		log.Errorf("fixVersion: %s failed to GetTract: %s", id, err)
 		return err
 	}
-	durInfo := durInfoArr[0]
 
 	// If the tract they're complaining about is RS-coded in addition to being replicated,
 	// that means we're in the middle of transitioning the blob to RS storage. We've
@@ -548,6 +701,53 @@ func (c *Curator) listBlobs(partition core.PartitionID, start core.BlobKey) ([]c
 	return c.stateHandler.ListBlobs(partition, start)
 }
 
+func (c *Curator) listBlobsWithInfo(partition core.PartitionID, start core.BlobKey, filter core.BlobFilter) ([]core.BlobKey, []core.BlobInfo, core.BlobKey, bool, core.Error) {
+	keys, infos, next, more, err := c.stateHandler.ListBlobsWithInfo(partition, start, filter)
+	for i := range infos {
+		c.setPurgeAt(&infos[i])
+	}
+	return keys, infos, next, more, err
+}
+
+func (c *Curator) getEvents(partition core.PartitionID, after uint64) ([]core.BlobEvent, uint64, core.Error) {
+	return c.stateHandler.GetEvents(partition, after)
+}
+
+// lookupName resolves a name to a blob.
+func (c *Curator) lookupName(name string) (core.BlobID, core.Error) {
+	if !core.ValidName(name) {
+		return 0, core.ErrInvalidArgument
+	}
+	return c.stateHandler.LookupName(c.config.NamespacePartition, name)
+}
+
+// rename atomically moves a name binding, or a directory, replacing the binding
+// of 'to' to 'replace' if that's non-zero. If 'caller' is set, it must be the
+// client that bound the names.
+func (c *Curator) rename(from, to string, replace core.BlobID, caller string) core.Error {
+	if !core.ValidRename(from, to) {
+		return core.ErrInvalidArgument
+	}
+	return c.stateHandler.Rename(c.config.NamespacePartition, from, to, replace, caller, c.stateHandler.GetTerm())
+}
+
+// unbindName removes a name binding. If 'caller' is set, it must be the client
+// that bound the name.
+func (c *Curator) unbindName(name string, id core.BlobID, caller string) core.Error {
+	if !core.ValidName(name) {
+		return core.ErrInvalidArgument
+	}
+	return c.stateHandler.UnbindName(c.config.NamespacePartition, name, id, caller, c.stateHandler.GetTerm())
+}
+
+// listNames returns a batch of entries directly under 'prefix'.
+func (c *Curator) listNames(prefix, start string) ([]core.NameEntry, core.Error) {
+	if !core.ValidNamePrefix(prefix) {
+		return nil, core.ErrInvalidArgument
+	}
+	return c.stateHandler.ListNames(c.config.NamespacePartition, prefix, start)
+}
+
 func (c *Curator) stats() map[string]interface{} {
 	m := make(map[string]interface{})
 	for _, op := range []string{
@@ -11,9 +11,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/westerndigitalcorporation/blb/internal/core"
 	"github.com/westerndigitalcorporation/blb/internal/curator/durable"
 	"github.com/westerndigitalcorporation/blb/internal/curator/durable/state"
+	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
 	"github.com/westerndigitalcorporation/blb/pkg/raft/raft"
 	test "github.com/westerndigitalcorporation/blb/pkg/testutil"
 )
@@ -69,7 +72,10 @@ type testMasterConnection struct {
 	// Allocated partitions.
 	partitions map[core.CuratorID][]core.PartitionID
 
-	// Lock for 'nextPartition', 'nextCuratorID' and 'partitions'
+	// Quotas and usage on other curators to return in heartbeats.
+	quotas, otherUsage map[string]core.TenantUsage
+
+	// Lock for 'nextPartition', 'nextCuratorID', 'partitions', and quotas.
 	lock sync.Mutex
 }
 
@@ -97,13 +103,18 @@ func (mc *testMasterConnection) RegisterCurator() (core.RegisterCuratorReply, co
 
 // SendHeartbeat always succeeds as well, but sends on heartbeatChan to allow tests to wait until
 // the curator is ready to serve before sending commands to it.
-func (mc *testMasterConnection) CuratorHeartbeat(id core.CuratorID) (core.CuratorHeartbeatReply, core.Error) {
+func (mc *testMasterConnection) CuratorHeartbeat(id core.CuratorID, usage map[string]core.TenantUsage) (core.CuratorHeartbeatReply, core.Error) {
 	// This allows the test to wait until the curator is ready to serve.
 	mc.heartbeatChan <- true
 
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
 	reply := core.CuratorHeartbeatReply{
 		Err:        core.NoError,
 		Partitions: mc.partitions[id],
+		Quotas:     mc.quotas,
+		OtherUsage: mc.otherUsage,
 	}
 	return reply, core.NoError
 }
@@ -132,12 +143,12 @@ func TestBadCreate(t *testing.T) {
 	<-mc.heartbeatChan
 
 	for _, repl := range badRepl {
-		if _, err := c.create(repl, defHint, time.Time{}); core.NoError == err {
+		if _, err := c.create(core.CreateBlobReq{Repl: repl, Hint: defHint}); core.NoError == err {
 			t.Errorf("could create a blob with replication %d", repl)
 		}
 	}
 
-	if _, err := c.create(3, 100, time.Time{}); core.NoError == err {
+	if _, err := c.create(core.CreateBlobReq{Repl: 3, Hint: 100}); core.NoError == err {
 		t.Errorf("could create a blob with hint %d", 100)
 	}
 }
@@ -150,7 +161,7 @@ func TestBasicCreateDelete(t *testing.T) {
 	<-mc.heartbeatChan
 
 	// Create a blob with replication factor 2.
-	id, err := c.create(2, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 2, Hint: defHint})
 	if core.NoError != err {
 		t.Error("could not create blob with reasonable replication")
 	}
@@ -175,7 +186,7 @@ func TestExtendNoHosts(t *testing.T) {
 	<-mc.heartbeatChan
 
 	// Create a blob with replication factor 1.
-	id, err := c.create(1, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint})
 	if core.NoError != err {
 		t.Error("could not create blob with reasonable replication")
 	}
@@ -192,7 +203,7 @@ func TestExtendNoHosts(t *testing.T) {
 	if newTracts, err = c.extend(id, 1); core.NoError != err {
 		t.Errorf("extending should have worked but did not, got %s", err)
 	}
-	if size, err := c.ackExtend(id, newTracts); err != core.NoError {
+	if size, err := c.ackExtend(id, newTracts, nil); err != core.NoError {
 		t.Errorf("ack extending failed: %s", err)
 	} else if size != 1 {
 		t.Error("added 1 tract but size is not 1")
@@ -217,7 +228,7 @@ func TestExtendHostsButHighRepl(t *testing.T) {
 
 	// Create a blob with high repl factor
 	repl := 5
-	id, err := c.create(repl, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: repl, Hint: defHint})
 	if core.NoError != err {
 		t.Error("could not create blob with reasonable replication")
 	}
@@ -260,7 +271,7 @@ func TestExtendTooLong(t *testing.T) {
 	<-mc.heartbeatChan
 
 	// Create a blob.
-	id, err := c.create(1, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint})
 	if core.NoError != err {
 		t.Error("could not create blob with reasonable replication")
 	}
@@ -280,7 +291,7 @@ func TestExtendTooLong(t *testing.T) {
 		if newTracts, err := c.extend(id, i+1); core.NoError != err {
 			t.Fatalf("extending should have worked with a reasonable number")
 		} else {
-			c.ackExtend(id, newTracts)
+			c.ackExtend(id, newTracts, nil)
 		}
 	}
 
@@ -316,6 +327,14 @@ func (f *failTalker) PullTract(addr string, tsid core.TractserverID, from []stri
 	return core.ErrNotYetImplemented
 }
 
+func (f *failTalker) CopyTract(addr string, tsid core.TractserverID, from []string, src core.TractID, srcVersion int, id core.TractID, version int) core.Error {
+	return core.ErrNotYetImplemented
+}
+
+func (f *failTalker) UnpackTract(addr string, tsid core.TractserverID, from []string, piece core.RSChunkID, offset, length int, id core.TractID, version int) core.Error {
+	return core.ErrNotYetImplemented
+}
+
 func (f *failTalker) GCTract(addr string, tsid core.TractserverID, old []core.TractState, gone []core.TractID) core.Error {
 	return core.ErrNotYetImplemented
 }
@@ -332,7 +351,7 @@ func (f *failTalker) PackTracts(addr string, tsid core.TractserverID, length int
 	return core.ErrNotYetImplemented
 }
 
-func (f *failTalker) RSEncode(addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs, dests []core.TSAddr, im []int) core.Error {
+func (f *failTalker) RSEncode(addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs, dests []core.TSAddr, im []int, code core.LRCParams) core.Error {
 	return core.ErrNotYetImplemented
 }
 
@@ -344,7 +363,7 @@ func TestExtendWithoutAck(t *testing.T) {
 	<-mc.heartbeatChan
 
 	// Create a blob.
-	id, err := c.create(3, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 3, Hint: defHint})
 	for i := 0; i < 3; i++ {
 		c.addTS(core.TractserverID(i), fmt.Sprintf("addr%d", i))
 	}
@@ -364,12 +383,128 @@ func TestExtendWithoutAck(t *testing.T) {
 	}
 
 	// Ack the extend and check again.
-	c.ackExtend(id, newTracts)
+	c.ackExtend(id, newTracts, nil)
 	if blob, _ := c.stat(id); blob.NumTracts != 2 {
 		t.Errorf("ack'd extend should be visiable")
 	}
 }
 
+// Test that quotas are enforced when creating, cloning, and extending blobs.
+func TestQuota(t *testing.T) {
+	mc := newTestMasterConnection()
+	tt := &failTalker{}
+	c := newTestCurator(mc, tt, DefaultTestConfig)
+	<-mc.heartbeatChan
+	for i := 0; i < 3; i++ {
+		c.addTS(core.TractserverID(i), fmt.Sprintf("addr%d", i))
+	}
+
+	// "a" can have two blobs, one of which is on another curator. "b" can
+	// have three tracts.
+	quotas := map[string]core.TenantUsage{
+		"a": {Blobs: 2},
+		"b": {Bytes: 3 * core.TractLength},
+	}
+	other := map[string]core.TenantUsage{"a": {Blobs: 1}}
+	mc.lock.Lock()
+	mc.quotas, mc.otherUsage = quotas, other
+	mc.lock.Unlock()
+	c.setQuotas(quotas, other)
+
+	if _, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint, Owner: "a"}); err != core.NoError {
+		t.Fatalf("failed to create blob: %s", err)
+	}
+	if _, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint, Owner: "a"}); err != core.ErrQuotaExceeded {
+		t.Errorf("expected ErrQuotaExceeded, got %s", err)
+	}
+	// Other owners aren't affected.
+	if _, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint}); err != core.NoError {
+		t.Errorf("failed to create blob without an owner: %s", err)
+	}
+
+	id, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint, Owner: "b"})
+	if err != core.NoError {
+		t.Fatalf("failed to create blob: %s", err)
+	}
+	tracts, err := c.extend(id, 2)
+	if err != core.NoError {
+		t.Fatalf("failed to extend blob: %s", err)
+	}
+	if _, err = c.ackExtend(id, tracts, nil); err != core.NoError {
+		t.Fatalf("failed to ack extend: %s", err)
+	}
+	if info, _ := c.stat(id); info.Owner != "b" {
+		t.Errorf("expected owner b, got %q", info.Owner)
+	}
+	if _, err = c.extend(id, 4); err != core.ErrQuotaExceeded {
+		t.Errorf("expected ErrQuotaExceeded, got %s", err)
+	}
+	// Clones count against the quota of whoever makes them.
+	if _, err = c.clone(id, "a"); err != core.ErrQuotaExceeded {
+		t.Errorf("expected ErrQuotaExceeded, got %s", err)
+	}
+	if _, err = c.extend(id, 3); err != core.NoError {
+		t.Errorf("failed to extend blob to its quota: %s", err)
+	}
+
+	if usage := c.getUsage(); usage["a"].Blobs != 1 || usage["b"] != (core.TenantUsage{Bytes: 3 * core.TractLength, Blobs: 1}) {
+		t.Errorf("unexpected usage %+v", usage)
+	}
+}
+
+// Usage counts the raw space of each tract.
+func TestTractUsage(t *testing.T) {
+	if u := tractUsage(2, 3, core.StorageClass_REPLICATED); u.Bytes != 6*core.TractLength {
+		t.Errorf("wrong usage of replicated tracts: %+v", u)
+	}
+	if u := tractUsage(6, 3, core.StorageClass_RS_6_3); u.Bytes != 9*core.TractLength {
+		t.Errorf("wrong usage of RS tracts: %+v", u)
+	}
+}
+
+// Usage of a blob counts the raw space of its data, not of whole tracts.
+func TestBlobUsage(t *testing.T) {
+	small := &pb.Blob{
+		Repl:   proto.Uint32(3),
+		Tracts: []*pb.Tract{{Checksum: proto.Uint32(1), ChecksumLength: proto.Uint32(100)}},
+	}
+	if u := blobUsage(small); u != (core.TenantUsage{Bytes: 300, Blobs: 1}) {
+		t.Errorf("wrong usage of small blob: %+v", u)
+	}
+
+	// Only the last tract can be partly full, and without a checksum we don't
+	// know how much.
+	big := &pb.Blob{
+		Repl:   proto.Uint32(2),
+		Tracts: []*pb.Tract{{}, {Checksum: proto.Uint32(1), ChecksumLength: proto.Uint32(100)}},
+	}
+	if u := blobUsage(big); u.Bytes != 2*(core.TractLength+100) {
+		t.Errorf("wrong usage of blob with a full tract: %+v", u)
+	}
+	big.Tracts[1].Checksum, big.Tracts[1].ChecksumLength = nil, nil
+	if u := blobUsage(big); u.Bytes != 4*core.TractLength {
+		t.Errorf("wrong usage of blob without checksums: %+v", u)
+	}
+
+	rs := &pb.Blob{
+		Storage: core.StorageClass_RS_6_3.Enum(),
+		Tracts:  []*pb.Tract{{Checksum: proto.Uint32(1), ChecksumLength: proto.Uint32(600)}},
+	}
+	if u := blobUsage(rs); u.Bytes != 900 {
+		t.Errorf("wrong usage of RS blob: %+v", u)
+	}
+
+	// Shared tracts count for their origin.
+	origin := core.BlobID(1)
+	clone := &pb.Blob{
+		Repl:   proto.Uint32(3),
+		Tracts: []*pb.Tract{{Origin: &origin}},
+	}
+	if u := blobUsage(clone); u != (core.TenantUsage{Blobs: 1}) {
+		t.Errorf("wrong usage of clone: %+v", u)
+	}
+}
+
 // Test concurrent extend works correctly.
 func TestConcurrentExtend(t *testing.T) {
 	mc := newTestMasterConnection()
@@ -378,7 +513,7 @@ func TestConcurrentExtend(t *testing.T) {
 	<-mc.heartbeatChan
 
 	// Create a blob.
-	id, err := c.create(3, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 3, Hint: defHint})
 	for i := 0; i < 3; i++ {
 		c.addTS(core.TractserverID(i), fmt.Sprintf("addr%d", i))
 	}
@@ -396,7 +531,7 @@ func TestConcurrentExtend(t *testing.T) {
 	if tracts, err := c.extend(id, 1); core.NoError != err {
 		t.Errorf("failed to extend the blob: %s", err)
 	} else {
-		if _, err := c.ackExtend(id, tracts); core.NoError != err {
+		if _, err := c.ackExtend(id, tracts, nil); core.NoError != err {
 			t.Errorf("failed to ack extending the blob: %s", err)
 		}
 	}
@@ -408,7 +543,7 @@ func TestConcurrentExtend(t *testing.T) {
 
 	// Acking the first extend should fail as the second one has already
 	// made to the durable state.
-	if _, err := c.ackExtend(id, newTracts); core.NoError == err {
+	if _, err := c.ackExtend(id, newTracts, nil); core.NoError == err {
 		t.Errorf("conflicting ack extend should fail")
 	}
 
@@ -443,7 +578,7 @@ func TestStatBlobBasic(t *testing.T) {
 	c.addTS(0, addr)
 
 	// repl=1
-	id, err := c.create(1, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint})
 	if core.NoError != err {
 		t.Errorf("create should have worked, got %s", err)
 	}
@@ -460,7 +595,7 @@ func TestStatBlobBasic(t *testing.T) {
 	if newTracts, err = c.extend(id, 1); core.NoError != err {
 		t.Error("extending should have worked")
 	}
-	if _, err = c.ackExtend(id, newTracts); err != core.NoError {
+	if _, err = c.ackExtend(id, newTracts, nil); err != core.NoError {
 		t.Errorf("failed to ack extending the blob: %s", err)
 	}
 
@@ -489,7 +624,7 @@ func TestGetTracts(t *testing.T) {
 		addr := fmt.Sprintf("tsaddr:%d", i)
 		c.addTS(core.TractserverID(i), addr)
 	}
-	id, err := c.create(3, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 3, Hint: defHint})
 	if err != core.NoError {
 		t.Fatalf("couldn't create a blob with r=3, err=%s", err)
 	}
@@ -499,7 +634,7 @@ func TestGetTracts(t *testing.T) {
 	if newTracts, err = c.extend(id, 1); err != core.NoError || len(newTracts) != 1 {
 		t.Fatalf("couldn't extend the blob: %s", err)
 	}
-	if _, err = c.ackExtend(id, newTracts); core.NoError != err {
+	if _, err = c.ackExtend(id, newTracts, nil); core.NoError != err {
 		t.Fatalf("failed to ack extending the blob: %s", err)
 	}
 
@@ -535,7 +670,7 @@ func TestGetTractsOverlap(t *testing.T) {
 	c.addTS(0, addr)
 
 	// Create a blob.
-	id, err := c.create(1, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint})
 	if err != core.NoError {
 		t.Fatalf("couldn't create a blob err=%s", err)
 	}
@@ -545,7 +680,7 @@ func TestGetTractsOverlap(t *testing.T) {
 	if newTracts, err = c.extend(id, 10); len(newTracts) != 10 || err != core.NoError {
 		t.Fatalf("couldn't extend the blob, err=%s", err)
 	}
-	if _, err = c.ackExtend(id, newTracts); err != core.NoError {
+	if _, err = c.ackExtend(id, newTracts, nil); err != core.NoError {
 		t.Fatalf("failed to ack extending the blob: %s", err)
 	}
 
@@ -617,7 +752,7 @@ func TestGetTractsEC(t *testing.T) {
 	}
 
 	// create the blob and 13 tracts
-	id, err := c.create(1, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint})
 	if err != core.NoError {
 		t.Fatalf("couldn't create a blob err=%s", err)
 	}
@@ -625,7 +760,7 @@ func TestGetTractsEC(t *testing.T) {
 	if tracts, err = c.extend(id, 13); err != core.NoError || len(tracts) != 13 {
 		t.Fatalf("couldn't extend the blob: %s", err)
 	}
-	if _, err = c.ackExtend(id, tracts); core.NoError != err {
+	if _, err = c.ackExtend(id, tracts, nil); core.NoError != err {
 		t.Fatalf("failed to ack extending the blob: %s", err)
 	}
 
@@ -647,7 +782,7 @@ func TestGetTractsEC(t *testing.T) {
 	if err := c.stateHandler.CommitRSChunk(chunk, core.StorageClass_RS_6_3, hosts, data, 0); err != core.NoError {
 		t.Fatalf("CommitRSChunk failed: %s", err)
 	}
-	if err := c.stateHandler.UpdateStorageClass(id, core.StorageClass_RS_6_3, 0); err != core.NoError {
+	if err := c.stateHandler.UpdateStorageClass(id, core.StorageClass_RS_6_3, time.Now().UnixNano(), 0); err != core.NoError {
 		t.Fatalf("UpdateStorageClass failed: %s", err)
 	}
 
@@ -693,6 +828,97 @@ func TestGetTractsEC(t *testing.T) {
 	check(12, 7)
 }
 
+// Test that tracts of an RS-encoded blob are copied out of their chunk, and
+// that the blob can then go back to being replicated.
+func TestUnpackBlob(t *testing.T) {
+	mc := newTestMasterConnection()
+	tt := newTestTractserverTalker()
+	c := newTestCurator(mc, tt, DefaultTestConfig)
+	<-mc.heartbeatChan
+
+	for i := 1; i <= 10; i++ {
+		addr := fmt.Sprintf("tsaddr:%d", i)
+		c.addTS(core.TractserverID(i), addr)
+		for j := 0; j < 3; j++ {
+			tt.addPullTractReply(addr, core.NoError)
+		}
+	}
+
+	id, err := c.create(core.CreateBlobReq{Repl: 2, Hint: defHint})
+	if err != core.NoError {
+		t.Fatalf("couldn't create a blob err=%s", err)
+	}
+	var tracts []core.TractInfo
+	if tracts, err = c.extend(id, 2); err != core.NoError {
+		t.Fatalf("couldn't extend the blob: %s", err)
+	}
+	if _, err = c.ackExtend(id, tracts, nil); core.NoError != err {
+		t.Fatalf("failed to ack extending the blob: %s", err)
+	}
+
+	chunk := core.RSChunkID{Partition: id.Partition() | core.PartitionID(1<<31), ID: 123}
+	hosts := []core.TractserverID{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	data := [][]state.EncodedTract{
+		{{ID: tracts[0].Tract, Offset: 0, Length: 100, NewVersion: 2}},
+		{{ID: tracts[1].Tract, Offset: 0, Length: 200, NewVersion: 2}}, {}, {}, {}, {},
+	}
+	if err := c.stateHandler.CommitRSChunk(chunk, core.StorageClass_RS_6_3, hosts, data, 0); err != core.NoError {
+		t.Fatalf("CommitRSChunk failed: %s", err)
+	}
+	if err := c.stateHandler.UpdateStorageClass(id, core.StorageClass_RS_6_3, time.Now().UnixNano(), 0); err != core.NoError {
+		t.Fatalf("UpdateStorageClass failed: %s", err)
+	}
+
+	// Can't go back until the tracts are unpacked.
+	if err := c.stateHandler.UpdateStorageClass(id, REPLICATED, time.Now().UnixNano(), 0); err != core.ErrInvalidArgument {
+		t.Fatalf("expected UpdateStorageClass to fail, got %s", err)
+	}
+
+	var unpacked int32
+	var wg sync.WaitGroup
+	c.stateHandler.ForEachBlob(false, func(bid core.BlobID, blob *pb.Blob) {
+		if bid == id {
+			wg.Add(1)
+			go c.unpackBlob(id, blob, &unpacked, &wg, 0)
+		}
+	}, nil)
+	wg.Wait()
+	if unpacked != 2 {
+		t.Fatalf("expected 2 tracts to be unpacked, got %d", unpacked)
+	}
+
+	// Each tract should have been pulled from the piece it's in.
+	var calls []core.PullTractReq
+	for _, reqs := range tt.pullTractCalls {
+		calls = append(calls, reqs...)
+	}
+	if len(calls) != 4 {
+		t.Fatalf("expected 4 pulls, got %+v", calls)
+	}
+	for _, req := range calls {
+		piece := chunk.Add(int(req.ID.Index)).ToTractID()
+		if req.SrcID != piece || req.SrcLength != 100*(int(req.ID.Index)+1) || req.Version != 3 ||
+			!reflect.DeepEqual(req.From, []string{fmt.Sprintf("tsaddr:%d", hosts[req.ID.Index])}) {
+			t.Errorf("unexpected pull: %+v", req)
+		}
+	}
+
+	if err := c.stateHandler.UpdateStorageClass(id, REPLICATED, time.Now().UnixNano(), 0); err != core.NoError {
+		t.Fatalf("UpdateStorageClass failed: %s", err)
+	}
+	if c.stateHandler.GetRSChunk(chunk) != nil {
+		t.Errorf("rs chunk should have been released")
+	}
+	if tracts, _, err = c.getTracts(id, 0, 2); err != core.NoError {
+		t.Fatal(err)
+	}
+	for _, tr := range tracts {
+		if len(tr.Hosts) != 2 || tr.Version != 3 || tr.RS.Present() {
+			t.Errorf("unexpected tract: %+v", tr)
+		}
+	}
+}
+
 // Curator should get a new partition when it's running out of space.
 func TestNewPartition(t *testing.T) {
 	cfg := DefaultTestConfig
@@ -710,7 +936,7 @@ func TestNewPartition(t *testing.T) {
 
 	// Create enough blobs so that we reach the point for a second partition.
 	for i := 0; i < 10; i++ {
-		if _, err := c.create(1, defHint, time.Time{}); core.NoError != err {
+		if _, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint}); core.NoError != err {
 			t.Fatalf("couldn't create a blob err=%s", err)
 		}
 	}
@@ -749,7 +975,7 @@ func TestFixVersion(t *testing.T) {
 	c.addTS(core.TractserverID(2), addr2)
 
 	// Create a blob.
-	id, err := c.create(2, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 2, Hint: defHint})
 	if core.NoError != err {
 		t.Fatalf("failed to create blob: %s", err)
 	}
@@ -759,7 +985,7 @@ func TestFixVersion(t *testing.T) {
 	if newTracts, err = c.extend(id, 1); err != core.NoError || len(newTracts) != 1 {
 		t.Fatalf("failed to extend the blob: %s", err)
 	}
-	if _, err = c.ackExtend(id, newTracts); core.NoError != err {
+	if _, err = c.ackExtend(id, newTracts, nil); core.NoError != err {
 		t.Fatalf("failed to ack extending the blob: %s", err)
 	}
 
@@ -820,7 +1046,7 @@ func TestReplicateTract(t *testing.T) {
 	c.addTS(core.TractserverID(2), addr2)
 
 	// Create a blob.
-	id, err := c.create(2, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 2, Hint: defHint})
 	if core.NoError != err {
 		t.Fatalf("failed to create blob: %s", err)
 	}
@@ -830,7 +1056,7 @@ func TestReplicateTract(t *testing.T) {
 	if newTracts, err = c.extend(id, 1); err != core.NoError || len(newTracts) != 1 {
 		t.Fatalf("failed to extend the blob: %s", err)
 	}
-	if _, err = c.ackExtend(id, newTracts); core.NoError != err {
+	if _, err = c.ackExtend(id, newTracts, nil); core.NoError != err {
 		t.Fatalf("failed to ack extending the blob: %s", err)
 	}
 
@@ -882,6 +1108,292 @@ func TestReplicateTract(t *testing.T) {
 	}
 }
 
+func TestCopyTracts(t *testing.T) {
+	mc := newTestMasterConnection()
+	tt := newTestTractserverTalker()
+	c := newTestCurator(mc, tt, DefaultTestConfig)
+	<-mc.heartbeatChan
+
+	addr1, addr2 := "addr1", "addr2"
+	c.addTS(core.TractserverID(1), addr1)
+	c.addTS(core.TractserverID(2), addr2)
+
+	// Create a source blob with one tract, and an empty destination.
+	src, err := c.create(core.CreateBlobReq{Repl: 2, Hint: defHint})
+	if core.NoError != err {
+		t.Fatalf("failed to create blob: %s", err)
+	}
+	newTracts, err := c.extend(src, 1)
+	if err != core.NoError {
+		t.Fatalf("failed to extend the blob: %s", err)
+	}
+	checksum := core.TractChecksum{CRC: 1234, Length: 100}
+	if _, err = c.ackExtend(src, newTracts, []core.ChecksumUpdate{{Index: 0, New: checksum}}); core.NoError != err {
+		t.Fatalf("failed to ack extending the blob: %s", err)
+	}
+	dst, err := c.create(core.CreateBlobReq{Repl: 2, Hint: defHint})
+	if core.NoError != err {
+		t.Fatalf("failed to create blob: %s", err)
+	}
+	tracts, _, err := c.getTracts(src, 0, 1)
+	if core.NoError != err {
+		t.Fatalf("failed to get tract info")
+	}
+
+	// The source tracts have to exist.
+	if err := c.copyTracts(dst, 0, src, 0, 2); err != core.ErrNoSuchTract {
+		t.Fatalf("expected ErrNoSuchTract, got %s", err)
+	}
+
+	// If a tractserver fails, nothing is added.
+	tt.addPullTractReply(addr1, core.NoError)
+	tt.addPullTractReply(addr2, core.ErrNoSpace)
+	if err := c.copyTracts(dst, 0, src, 0, 1); err != core.ErrNoSpace {
+		t.Fatalf("expected copy to fail, got %s", err)
+	}
+	if info, _ := c.stat(dst); info.NumTracts != 0 {
+		t.Fatalf("failed copy added tracts")
+	}
+
+	tt.addPullTractReply(addr1, core.NoError)
+	tt.addPullTractReply(addr2, core.NoError)
+	if err := c.copyTracts(dst, 0, src, 0, 1); err != core.NoError {
+		t.Fatalf("failed to copy tracts: %s", err)
+	}
+	req := tt.pullTractCalls[addr1][1]
+	if req.SrcID != tracts[0].Tract || req.SrcVersion != tracts[0].Version || req.ID != (core.TractID{Blob: dst, Index: 0}) {
+		t.Fatalf("wrong copy request: %+v", req)
+	}
+	copies, _, err := c.getTracts(dst, 0, 1)
+	if core.NoError != err || len(copies) != 1 {
+		t.Fatalf("failed to get tract info")
+	}
+	if copies[0].Version != 1 || copies[0].Checksum != checksum {
+		t.Fatalf("wrong copied tract: %+v", copies[0])
+	}
+
+	// The destination has to end where the client thinks it does.
+	if err := c.copyTracts(dst, 0, src, 0, 1); err != core.ErrExtendConflict {
+		t.Fatalf("expected ErrExtendConflict, got %s", err)
+	}
+
+	// Unsealed write-once blobs can't be read, so they can't be copied.
+	unsealed, err := c.create(core.CreateBlobReq{Repl: 2, Hint: defHint, WriteOnce: true})
+	if core.NoError != err {
+		t.Fatalf("failed to create blob: %s", err)
+	}
+	if newTracts, err = c.extend(unsealed, 1); err != core.NoError {
+		t.Fatalf("failed to extend the blob: %s", err)
+	}
+	if _, err = c.ackExtend(unsealed, newTracts, nil); core.NoError != err {
+		t.Fatalf("failed to ack extending the blob: %s", err)
+	}
+	if err := c.copyTracts(dst, 1, unsealed, 0, 1); err != core.ErrNotSealed {
+		t.Fatalf("expected ErrNotSealed, got %s", err)
+	}
+}
+
+// Test that cloning fences the tracts that become shared, and that unsharing
+// has tractservers copy them.
+func TestCloneAndUnshare(t *testing.T) {
+	mc := newTestMasterConnection()
+	tt := newTestTractserverTalker()
+	c := newTestCurator(mc, tt, DefaultTestConfig)
+	<-mc.heartbeatChan
+
+	addr1, addr2 := "addr1", "addr2"
+	c.addTS(core.TractserverID(1), addr1)
+	c.addTS(core.TractserverID(2), addr2)
+
+	src, err := c.create(core.CreateBlobReq{Repl: 2, Hint: defHint, Owner: "a"})
+	if core.NoError != err {
+		t.Fatalf("failed to create blob: %s", err)
+	}
+	newTracts, err := c.extend(src, 1)
+	if err != core.NoError {
+		t.Fatalf("failed to extend the blob: %s", err)
+	}
+	if _, err = c.ackExtend(src, newTracts, nil); core.NoError != err {
+		t.Fatalf("failed to ack extending the blob: %s", err)
+	}
+	id := core.TractID{Blob: src, Index: 0}
+
+	// "b" can clone the blob, but not copy its tract.
+	c.setQuotas(map[string]core.TenantUsage{"b": {Blobs: 1, Bytes: core.TractLength}}, nil)
+
+	// If a tractserver can't be fenced, there's no clone.
+	tt.addSetVersionReply(addr1, core.SetVersionReply{Err: core.NoError})
+	if _, err := c.clone(src, "b"); err != core.ErrRPC {
+		t.Fatalf("expected clone to fail, got %s", err)
+	}
+
+	tt.addSetVersionReply(addr1, core.SetVersionReply{Err: core.NoError})
+	tt.addSetVersionReply(addr2, core.SetVersionReply{Err: core.NoError})
+	clone, err := c.clone(src, "b")
+	if err != core.NoError {
+		t.Fatalf("failed to clone blob: %s", err)
+	}
+	if info, _ := c.stat(clone); info.Owner != "b" {
+		t.Errorf("expected clone to be owned by b, got %q", info.Owner)
+	}
+	for _, addr := range []string{addr1, addr2} {
+		calls := tt.setVersionCalls[addr]
+		if req := calls[len(calls)-1]; req.ID != id || req.NewVersion != 2 {
+			t.Errorf("wrong SetVersion request to %s: %+v", addr, req)
+		}
+	}
+	tracts, _, err := c.getTracts(src, 0, 1)
+	if err != core.NoError || tracts[0].Version != 2 || !tracts[0].Shared {
+		t.Fatalf("unexpected tracts after clone: %+v (%s)", tracts, err)
+	}
+
+	// Unsharing the origin's tract gives the clone its own copy, which "b"
+	// pays for.
+	if err := c.unshareTract(id); err != core.ErrQuotaExceeded {
+		t.Fatalf("expected ErrQuotaExceeded, got %s", err)
+	}
+	c.setQuotas(nil, nil)
+	tt.addPullTractReply(addr1, core.NoError)
+	tt.addPullTractReply(addr2, core.NoError)
+	if err := c.unshareTract(id); err != core.NoError {
+		t.Fatalf("failed to unshare tract: %s", err)
+	}
+	req := tt.pullTractCalls[addr1][0]
+	if req.SrcID != id || req.SrcVersion != 2 || req.ID != (core.TractID{Blob: clone, Index: 0}) || req.Version != 1 {
+		t.Errorf("wrong copy request: %+v", req)
+	}
+	for _, blob := range []core.BlobID{src, clone} {
+		tracts, _, err := c.getTracts(blob, 0, 1)
+		if err != core.NoError || tracts[0].Shared || tracts[0].Tract.Blob != blob {
+			t.Errorf("unexpected tracts of %s after unsharing: %+v (%s)", blob, tracts, err)
+		}
+	}
+
+	if usage := c.getUsage(); usage["a"] != (core.TenantUsage{Bytes: 2 * core.TractLength, Blobs: 1}) ||
+		usage["b"] != (core.TenantUsage{Bytes: 2 * core.TractLength, Blobs: 1}) {
+		t.Errorf("unexpected usage %+v", usage)
+	}
+
+	// The tract isn't shared anymore, so there's nothing to do.
+	if err := c.unshareTract(id); err != core.NoError {
+		t.Errorf("failed to unshare unshared tract: %s", err)
+	}
+}
+
+// Test that tracts that are only stored in RS chunks are copied out of the
+// pieces that hold them.
+func TestCopyRSTracts(t *testing.T) {
+	mc := newTestMasterConnection()
+	tt := newTestTractserverTalker()
+	c := newTestCurator(mc, tt, DefaultTestConfig)
+	<-mc.heartbeatChan
+
+	for i := 1; i <= 10; i++ {
+		addr := fmt.Sprintf("tsaddr:%d", i)
+		c.addTS(core.TractserverID(i), addr)
+		for j := 0; j < 3; j++ {
+			tt.addPullTractReply(addr, core.NoError)
+		}
+	}
+
+	src, err := c.create(core.CreateBlobReq{Repl: 2, Hint: defHint})
+	if err != core.NoError {
+		t.Fatalf("couldn't create a blob err=%s", err)
+	}
+	var tracts []core.TractInfo
+	if tracts, err = c.extend(src, 2); err != core.NoError {
+		t.Fatalf("couldn't extend the blob: %s", err)
+	}
+	if _, err = c.ackExtend(src, tracts, nil); core.NoError != err {
+		t.Fatalf("failed to ack extending the blob: %s", err)
+	}
+
+	chunk := core.RSChunkID{Partition: src.Partition() | core.PartitionID(1<<31), ID: 123}
+	hosts := []core.TractserverID{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	data := [][]state.EncodedTract{
+		{{ID: tracts[0].Tract, Offset: 0, Length: 100, NewVersion: 2}},
+		{{ID: tracts[1].Tract, Offset: 0, Length: 200, NewVersion: 2}}, {}, {}, {}, {},
+	}
+	if err := c.stateHandler.CommitRSChunk(chunk, core.StorageClass_RS_6_3, hosts, data, 0); err != core.NoError {
+		t.Fatalf("CommitRSChunk failed: %s", err)
+	}
+	if err := c.stateHandler.UpdateStorageClass(src, core.StorageClass_RS_6_3, time.Now().UnixNano(), 0); err != core.NoError {
+		t.Fatalf("UpdateStorageClass failed: %s", err)
+	}
+
+	dst, err := c.create(core.CreateBlobReq{Repl: 2, Hint: defHint})
+	if err != core.NoError {
+		t.Fatalf("couldn't create a blob err=%s", err)
+	}
+	if err := c.copyTracts(dst, 0, src, 0, 2); err != core.NoError {
+		t.Fatalf("failed to copy tracts: %s", err)
+	}
+
+	// Each tract should have been pulled from the piece it's in.
+	var calls []core.PullTractReq
+	for _, reqs := range tt.pullTractCalls {
+		calls = append(calls, reqs...)
+	}
+	if len(calls) != 4 {
+		t.Fatalf("expected 4 pulls, got %+v", calls)
+	}
+	for _, req := range calls {
+		piece := chunk.Add(int(req.ID.Index)).ToTractID()
+		if req.ID.Blob != dst || req.SrcID != piece || req.SrcLength != 100*(int(req.ID.Index)+1) || req.Version != 1 ||
+			!reflect.DeepEqual(req.From, []string{fmt.Sprintf("tsaddr:%d", hosts[req.ID.Index])}) {
+			t.Errorf("unexpected pull: %+v", req)
+		}
+	}
+
+	// The copies are replicated.
+	if tracts, _, err = c.getTracts(dst, 0, 2); err != core.NoError || len(tracts) != 2 {
+		t.Fatalf("failed to get tracts: %+v (%s)", tracts, err)
+	}
+	for _, tr := range tracts {
+		if len(tr.Hosts) != 2 || tr.Version != 1 || tr.RS.Present() {
+			t.Errorf("unexpected tract: %+v", tr)
+		}
+	}
+}
+
+// Test that truncating a blob deletes the dropped tracts from tractservers.
+func TestTruncate(t *testing.T) {
+	mc := newTestMasterConnection()
+	tt := newTestTractserverTalker()
+	c := newTestCurator(mc, tt, DefaultTestConfig)
+	<-mc.heartbeatChan
+
+	addr1, addr2 := "addr1", "addr2"
+	c.addTS(core.TractserverID(1), addr1)
+	c.addTS(core.TractserverID(2), addr2)
+
+	id, err := c.create(core.CreateBlobReq{Repl: 2, Hint: defHint})
+	if core.NoError != err {
+		t.Fatalf("failed to create blob: %s", err)
+	}
+	newTracts, err := c.extend(id, 3)
+	if err != core.NoError {
+		t.Fatalf("failed to extend the blob: %s", err)
+	}
+	if _, err = c.ackExtend(id, newTracts, nil); core.NoError != err {
+		t.Fatalf("failed to ack extending the blob: %s", err)
+	}
+
+	if err := c.truncate(id, core.TractLength+1); err != core.NoError {
+		t.Fatalf("failed to truncate: %s", err)
+	}
+	if info, _ := c.stat(id); info.NumTracts != 2 {
+		t.Fatalf("expected 2 tracts after truncating, got %d", info.NumTracts)
+	}
+	for _, addr := range []string{addr1, addr2} {
+		calls := tt.gcTractCalls[addr]
+		exp := []core.TractState{{ID: core.TractID{Blob: id, Index: 2}, Version: 1}}
+		if len(calls) != 1 || !reflect.DeepEqual(calls[0].Old, exp) || len(calls[0].Gone) != 0 {
+			t.Errorf("unexpected gc calls to %s: %+v", addr, calls)
+		}
+	}
+}
+
 func TestReconstructRSChunk(t *testing.T) {
 	mc := newTestMasterConnection()
 	tt := newTestTractserverTalker()
@@ -893,7 +1405,7 @@ func TestReconstructRSChunk(t *testing.T) {
 	}
 
 	// create the blob and 6 tracts
-	id, err := c.create(1, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint})
 	if err != core.NoError {
 		t.Fatalf("couldn't create a blob err=%s", err)
 	}
@@ -901,7 +1413,7 @@ func TestReconstructRSChunk(t *testing.T) {
 	if tracts, err = c.extend(id, 6); err != core.NoError || len(tracts) != 6 {
 		t.Fatalf("couldn't extend the blob: %s", err)
 	}
-	if _, err = c.ackExtend(id, tracts); core.NoError != err {
+	if _, err = c.ackExtend(id, tracts, nil); core.NoError != err {
 		t.Fatalf("failed to ack extending the blob: %s", err)
 	}
 
@@ -914,7 +1426,7 @@ func TestReconstructRSChunk(t *testing.T) {
 	if err := c.stateHandler.CommitRSChunk(chunk, core.StorageClass_RS_6_3, hosts, data, 0); err != core.NoError {
 		t.Fatalf("CommitRSChunk failed: %s", err)
 	}
-	if err := c.stateHandler.UpdateStorageClass(id, core.StorageClass_RS_6_3, 0); err != core.NoError {
+	if err := c.stateHandler.UpdateStorageClass(id, core.StorageClass_RS_6_3, time.Now().UnixNano(), 0); err != core.NoError {
 		t.Fatalf("UpdateStorageClass failed: %s", err)
 	}
 
@@ -986,7 +1498,7 @@ func TestGCTracts(t *testing.T) {
 	c.addTS(tsid1, addr1)
 
 	// Create a blob.
-	id, err := c.create(1, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint})
 	if core.NoError != err {
 		t.Fatalf("failed to create blob: %s", err)
 	}
@@ -996,7 +1508,7 @@ func TestGCTracts(t *testing.T) {
 	if newTracts, err = c.extend(id, 1); err != core.NoError || len(newTracts) != 1 {
 		t.Fatalf("failed to extend the blob: %s", err)
 	}
-	if _, err = c.ackExtend(id, newTracts); core.NoError != err {
+	if _, err = c.ackExtend(id, newTracts, nil); core.NoError != err {
 		t.Fatalf("failed to ack extending the blob: %s", err)
 	}
 
@@ -1024,7 +1536,7 @@ func TestUndelete(t *testing.T) {
 	c.addTS(tsid1, addr1)
 
 	// Create a blob.
-	id, err := c.create(1, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 1, Hint: defHint})
 	if core.NoError != err {
 		t.Fatalf("failed to create blob: %s", err)
 	}
@@ -1034,7 +1546,7 @@ func TestUndelete(t *testing.T) {
 	if newTracts, err = c.extend(id, 1); err != core.NoError || len(newTracts) != 1 {
 		t.Fatalf("failed to extend the blob: err %s || len(newTracts) %d != 1", err, len(newTracts))
 	}
-	if _, err = c.ackExtend(id, newTracts); core.NoError != err {
+	if _, err = c.ackExtend(id, newTracts, nil); core.NoError != err {
 		t.Fatalf("failed to ack extending the blob: %s", err)
 	}
 
@@ -1057,7 +1569,7 @@ func TestUndelete(t *testing.T) {
 	if newTracts, err = c.extend(id, 2); err != core.NoError || len(newTracts) != 1 {
 		t.Fatalf("failed to extend the blob: %s", err)
 	}
-	if _, err = c.ackExtend(id, newTracts); core.NoError != err {
+	if _, err = c.ackExtend(id, newTracts, nil); core.NoError != err {
 		t.Fatalf("failed to ack extending the blob: %s", err)
 	}
 }
@@ -1079,7 +1591,7 @@ func TestCheckTracts(t *testing.T) {
 	c.addTS(tsid2, addr2)
 
 	// Make a blob with r=2.  Each TS should get a replica of each tract.
-	id, err := c.create(2, defHint, time.Time{})
+	id, err := c.create(core.CreateBlobReq{Repl: 2, Hint: defHint})
 	if core.NoError != err {
 		t.Fatalf("failed to create blob: %s", err)
 	}
@@ -1091,7 +1603,7 @@ func TestCheckTracts(t *testing.T) {
 		if err != core.NoError {
 			t.Fatalf("couldn't extend the blob")
 		}
-		if _, err = c.ackExtend(id, newTracts); core.NoError != err {
+		if _, err = c.ackExtend(id, newTracts, nil); core.NoError != err {
 			t.Fatalf("failed to ack extending the blob: %s", err)
 		}
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/westerndigitalcorporation/blb/internal/core"
 	"github.com/westerndigitalcorporation/blb/internal/curator/durable/state"
+	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
 )
 
 // Command is a command that is serialized and handed to the Raft algorithm.
@@ -34,7 +35,14 @@ func init() {
 	gob.Register(DeleteBlobCommand{})
 	gob.Register(UndeleteBlobCommand{})
 	gob.Register(FinishDeleteCommand{})
+	gob.Register(PurgeBlobCommand{})
 	gob.Register(SetMetadataCommand{})
+	gob.Register(UpdateChecksumsCommand{})
+	gob.Register(SealBlobCommand{})
+	gob.Register(ReserveAppendCommand{})
+	gob.Register(TruncateBlobCommand{})
+	gob.Register(CloneBlobCommand{})
+	gob.Register(UnshareTractCommand{})
 	gob.Register(UpdateTimesCommand{})
 	gob.Register(ChecksumCommand{})
 	gob.Register(VerifyChecksumCommand{})
@@ -42,7 +50,11 @@ func init() {
 	gob.Register(CommitRSChunkCommand{})
 	gob.Register(UpdateRSHostsCommand{})
 	gob.Register(UpdateStorageClassCommand{})
+	gob.Register(UnpackTractCommand{})
 	gob.Register(CreateTSIDCacheCommand{})
+	gob.Register(AddStorageClassCommand{})
+	gob.Register(RenameCommand{})
+	gob.Register(UnbindNameCommand{})
 }
 
 // SetReadOnlyModeCommand changes the read-only mode of the curator's state.
@@ -97,6 +109,23 @@ type CreateBlobCommand struct {
 
 	// Initial storage hint.
 	Hint core.StorageHint
+
+	// Initial user metadata.
+	Metadata map[string]string
+
+	// Should the blob be write-once?
+	WriteOnce bool
+
+	// Owner of the blob, or empty.
+	Owner string
+
+	// Who may use the blob.
+	ACL core.ACL
+
+	// If set, the blob is bound to Name in the namespace of partition
+	// Namespace, on behalf of Owner.
+	Name      string
+	Namespace core.PartitionID
 }
 
 // CreateBlobResult is a reply to a CreateBlobCommand.
@@ -120,6 +149,9 @@ type ExtendBlobCommand struct {
 
 	// Each Hosts[i] is a replication group for a new tract.
 	Hosts [][]core.TractserverID
+
+	// End-to-end checksum updates for the new tracts, or existing ones.
+	Checksums []core.ChecksumUpdate
 }
 
 // ExtendBlobResult is the result of a blob extension.
@@ -130,6 +162,67 @@ type ExtendBlobResult struct {
 	NewSize int
 }
 
+// ReserveAppendCommand reserves space at the end of a blob for an append.
+type ReserveAppendCommand struct {
+	ID core.BlobID
+
+	// How many bytes to reserve?
+	Length int64
+
+	// The reservation starts at or after this offset.
+	MinOffset int64
+}
+
+// ReserveAppendResult is the result of a ReserveAppendCommand.
+type ReserveAppendResult struct {
+	Err core.Error
+
+	// Where does the reserved space start?
+	Offset int64
+}
+
+// TruncateBlobCommand drops the tracts of a blob beyond a new size.
+type TruncateBlobCommand struct {
+	ID core.BlobID
+
+	// The new size of the blob, in bytes.
+	Size int64
+}
+
+// TruncateBlobResult is the result of a TruncateBlobCommand.
+type TruncateBlobResult struct {
+	Err core.Error
+
+	// The dropped tracts, which should be deleted from tractservers.
+	Dropped []core.TractInfo
+}
+
+// CloneBlobCommand creates a blob that shares the tracts of another blob. The
+// result is a CreateBlobResult.
+type CloneBlobCommand struct {
+	// What blob are we cloning?
+	Src core.BlobID
+
+	// Owner of the clone, or empty.
+	Owner string
+
+	// Initial value for MTime and ATime of the clone.
+	InitialTime int64
+
+	// New versions of the tracts of Src that are shared for the first time.
+	// See Txn.FenceTracts.
+	Versions []int
+}
+
+// UnshareTractCommand gives a clone its own copy of a tract that was shared
+// with the origin.
+type UnshareTractCommand struct {
+	ID core.TractID
+
+	// Where the copy is stored.
+	Hosts []core.TractserverID
+}
+
 // DeleteBlobCommand deletes a blob.
 type DeleteBlobCommand struct {
 	ID core.BlobID
@@ -155,12 +248,28 @@ type FinishDeleteCommand struct {
 	Blobs []core.BlobID
 }
 
+// PurgeBlobCommand removes one deleted blob for good.
+type PurgeBlobCommand struct {
+	ID core.BlobID
+}
+
 // SetMetadataCommand changes metadata for a blob.
 type SetMetadataCommand struct {
 	ID       core.BlobID
 	Metadata core.BlobInfo
 }
 
+// UpdateChecksumsCommand changes end-to-end checksums for tracts of a blob.
+type UpdateChecksumsCommand struct {
+	ID      core.BlobID
+	Updates []core.ChecksumUpdate
+}
+
+// SealBlobCommand seals a write-once blob.
+type SealBlobCommand struct {
+	ID core.BlobID
+}
+
 // UndeleteBlobResult is the result of an UndeleteBlobCommand.
 type UndeleteBlobResult struct {
 	Err core.Error
@@ -254,6 +363,17 @@ type UpdateRSHostsCommand struct {
 type UpdateStorageClassCommand struct {
 	ID      core.BlobID
 	Storage core.StorageClass
+
+	// When the class changed, recorded in the blob if it's different.
+	Time int64
+}
+
+// UnpackTractCommand asks the curator to record replicated copies of a tract
+// that is stored in an RS chunk, so that its blob can go back to REPLICATED.
+type UnpackTractCommand struct {
+	ID         core.TractID
+	NewVersion int
+	Hosts      []core.TractserverID
 }
 
 // CreateTSIDCacheCommand tells the curator to create the TSID cache in the
@@ -261,6 +381,34 @@ type UpdateStorageClassCommand struct {
 type CreateTSIDCacheCommand struct {
 }
 
+// AddStorageClassCommand adds a storage class definition, or changes the name
+// or placement of an existing class.
+type AddStorageClassCommand struct {
+	Def *pb.StorageClassDef
+}
+
+// RenameCommand atomically moves a name binding from one name to another, or
+// every name in a directory if From and To end with core.NameSeparator. If
+// Replace is non-zero, To must be bound to it, and that binding is replaced. If
+// Caller is set, it must be the client that bound the names. Namespace is the
+// partition that the namespace belongs to.
+type RenameCommand struct {
+	Namespace core.PartitionID
+	From, To  string
+	Replace   core.BlobID
+	Caller    string
+}
+
+// UnbindNameCommand removes a name from the namespace of partition Namespace.
+// If Blob is non-zero, the name is only removed if it's bound to that blob. If
+// Caller is set, it must be the client that bound the name.
+type UnbindNameCommand struct {
+	Namespace core.PartitionID
+	Name      string
+	Blob      core.BlobID
+	Caller    string
+}
+
 // cmdToBytes wraps 'cmd' in Command and serializes it into bytes. It dies if it
 // fails.
 func cmdToBytes(cmd interface{}) []byte {
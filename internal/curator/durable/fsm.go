@@ -82,10 +82,24 @@ func (h *StateHandler) Apply(ent raft.Entry) interface{} {
 		return c.apply(txn)
 	case FinishDeleteCommand:
 		return c.apply(txn)
+	case PurgeBlobCommand:
+		return c.apply(txn)
 	case UndeleteBlobCommand:
 		return c.apply(txn)
 	case SetMetadataCommand:
 		return c.apply(txn)
+	case UpdateChecksumsCommand:
+		return c.apply(txn)
+	case SealBlobCommand:
+		return c.apply(txn)
+	case ReserveAppendCommand:
+		return c.apply(txn)
+	case TruncateBlobCommand:
+		return c.apply(txn)
+	case CloneBlobCommand:
+		return c.apply(txn)
+	case UnshareTractCommand:
+		return c.apply(txn)
 	case ExtendBlobCommand:
 		return c.apply(txn)
 	case ChangeTractCommand:
@@ -100,8 +114,16 @@ func (h *StateHandler) Apply(ent raft.Entry) interface{} {
 		return c.apply(txn)
 	case UpdateStorageClassCommand:
 		return c.apply(txn)
+	case UnpackTractCommand:
+		return c.apply(txn)
 	case CreateTSIDCacheCommand:
 		return c.apply(txn)
+	case AddStorageClassCommand:
+		return c.apply(txn)
+	case RenameCommand:
+		return c.apply(txn)
+	case UnbindNameCommand:
+		return c.apply(txn)
 	}
 
 	log.Fatalf("applying unknown command %v", cmd)
@@ -162,6 +184,15 @@ func (h *StateHandler) verifyChecksum(c VerifyChecksumCommand) {
 
 //-------------------- Internal implementation of StateHandler.Apply --------------------//
 
+// withEvent records 'ev' if the command that caused it succeeded, and returns
+// 'err'.
+func withEvent(txn *state.Txn, err core.Error, ev core.BlobEvent) core.Error {
+	if err == core.NoError {
+		txn.AddEvent(ev)
+	}
+	return err
+}
+
 // Sets the curator's registration to the information provided in the command.
 func (cmd SetRegistrationCommand) apply(txn *state.Txn) SetRegistrationResult {
 	if !txn.GetCuratorID().IsValid() {
@@ -184,8 +215,8 @@ func (cmd SyncPartitionsCommand) apply(txn *state.Txn) SyncPartitionsResult {
 	return SyncPartitionsResult{Err: core.NoError}
 }
 
-// Creates a new blob in a partition that has space for it and returns it.
-func (cmd CreateBlobCommand) apply(txn *state.Txn) CreateBlobResult {
+// Allocates an ID for a new blob in a partition that has space for it.
+func newBlobID(txn *state.Txn) (core.BlobID, core.Error) {
 	// Find the non-full partition with the lowest id to create the blob in.
 	var partition *pb.Partition
 	for _, p := range txn.GetPartitions() {
@@ -197,7 +228,7 @@ func (cmd CreateBlobCommand) apply(txn *state.Txn) CreateBlobResult {
 	if partition == nil {
 		// This is not a fatal error. The curator could/should ask for another partition
 		// if memory permits.
-		return CreateBlobResult{Err: core.ErrGenBlobID}
+		return 0, core.ErrGenBlobID
 	}
 
 	// Update the partition's durable state...
@@ -205,8 +236,24 @@ func (cmd CreateBlobCommand) apply(txn *state.Txn) CreateBlobResult {
 	*partition.NextBlobKey++
 	txn.PutPartition(partition)
 
+	return core.BlobIDFromParts(core.PartitionID(partition.GetId()), key), core.NoError
+}
+
+// Creates a new blob in a partition that has space for it and returns it. If a
+// name is given, it's bound to the blob too.
+func (cmd CreateBlobCommand) apply(txn *state.Txn) CreateBlobResult {
+	// Check first so that we don't use up an ID.
+	if cmd.Name != "" {
+		if err := txn.CheckBindName(cmd.Namespace, cmd.Name); err != core.NoError {
+			return CreateBlobResult{Err: err}
+		}
+	}
+	ID, err := newBlobID(txn)
+	if err != core.NoError {
+		return CreateBlobResult{Err: err}
+	}
+
 	// Create the blob.
-	ID := core.BlobIDFromParts(core.PartitionID(partition.GetId()), key)
 	blob := pb.Blob{
 		Repl:  proto.Uint32(uint32(cmd.Repl)),
 		Mtime: &cmd.InitialTime,
@@ -217,19 +264,61 @@ func (cmd CreateBlobCommand) apply(txn *state.Txn) CreateBlobResult {
 	if cmd.Expires != 0 {
 		blob.Expires = &cmd.Expires
 	}
+	if len(cmd.Metadata) > 0 {
+		blob.Metadata = cmd.Metadata
+	}
+	if cmd.WriteOnce {
+		blob.Sealed = proto.Bool(false)
+	}
+	if cmd.Owner != "" {
+		blob.Owner = proto.String(cmd.Owner)
+	}
+	blob.Readers, blob.Writers = cmd.ACL.Readers, cmd.ACL.Writers
 	txn.PutBlob(ID, &blob)
+	if cmd.Name != "" {
+		if err := txn.BindName(cmd.Namespace, cmd.Name, ID, cmd.Owner); err != core.NoError {
+			log.Fatalf("bug: couldn't bind checked name %q: %s", cmd.Name, err)
+		}
+	}
+	txn.AddEvent(core.BlobEvent{Type: core.BlobCreated, Blob: ID})
 	return CreateBlobResult{ID: ID, Err: core.NoError}
 }
 
+// Creates a new blob that shares the tracts of another.
+func (cmd CloneBlobCommand) apply(txn *state.Txn) CreateBlobResult {
+	// Check first so that we don't use up an ID.
+	if txn.GetBlob(cmd.Src) == nil {
+		return CreateBlobResult{Err: core.ErrNoSuchBlob}
+	}
+	// The new versions are already on tractservers, so they're recorded even
+	// if there's no ID for the clone.
+	if err := txn.FenceTracts(cmd.Src, cmd.Versions); err != core.NoError {
+		return CreateBlobResult{Err: err}
+	}
+	ID, err := newBlobID(txn)
+	if err != core.NoError {
+		return CreateBlobResult{Err: err}
+	}
+	err = withEvent(txn, txn.CloneBlob(cmd.Src, ID, cmd.Owner, cmd.InitialTime), core.BlobEvent{Type: core.BlobCreated, Blob: ID})
+	return CreateBlobResult{ID: ID, Err: err}
+}
+
+// Gives a clone its own copy of a shared tract.
+func (cmd UnshareTractCommand) apply(txn *state.Txn) core.Error {
+	return txn.UnshareTract(cmd.ID, cmd.Hosts)
+}
+
 // Marks a blob as deleted in the database, but without removing it.
 func (cmd DeleteBlobCommand) apply(txn *state.Txn) DeleteBlobResult {
-	return DeleteBlobResult{Err: txn.DeleteBlob(cmd.ID, cmd.When)}
+	err := txn.DeleteBlob(cmd.ID, cmd.When)
+	return DeleteBlobResult{Err: withEvent(txn, err, core.BlobEvent{Type: core.BlobDeleted, Blob: cmd.ID})}
 }
 
 // Undeletes a blob that was deleted, or does nothing if the blob wasn't deleted.
 // Errors if there isn't a blob with that ID.
 func (cmd UndeleteBlobCommand) apply(txn *state.Txn) UndeleteBlobResult {
-	return UndeleteBlobResult{Err: txn.UndeleteBlob(cmd.ID)}
+	err := txn.UndeleteBlob(cmd.ID)
+	return UndeleteBlobResult{Err: withEvent(txn, err, core.BlobEvent{Type: core.BlobUndeleted, Blob: cmd.ID})}
 }
 
 // Finalizes the deletion of a blob, deleting it permanently.
@@ -237,9 +326,38 @@ func (cmd FinishDeleteCommand) apply(txn *state.Txn) core.Error {
 	return txn.FinishDeleteBlobs(cmd.Blobs)
 }
 
+// Removes a deleted blob permanently, skipping the undelete period.
+func (cmd PurgeBlobCommand) apply(txn *state.Txn) core.Error {
+	return txn.PurgeBlob(cmd.ID)
+}
+
 // Changes metadata for a blob.
 func (cmd SetMetadataCommand) apply(txn *state.Txn) core.Error {
-	return txn.SetBlobMetadata(cmd.ID, cmd.Metadata)
+	err := txn.SetBlobMetadata(cmd.ID, cmd.Metadata)
+	return withEvent(txn, err, core.BlobEvent{Type: core.BlobMetadataChanged, Blob: cmd.ID})
+}
+
+// Changes end-to-end checksums for tracts of a blob.
+func (cmd UpdateChecksumsCommand) apply(txn *state.Txn) core.Error {
+	return txn.UpdateChecksums(cmd.ID, cmd.Updates)
+}
+
+// Seals a write-once blob.
+func (cmd SealBlobCommand) apply(txn *state.Txn) core.Error {
+	return withEvent(txn, txn.SealBlob(cmd.ID), core.BlobEvent{Type: core.BlobMetadataChanged, Blob: cmd.ID})
+}
+
+// Reserves space for an append.
+func (cmd ReserveAppendCommand) apply(txn *state.Txn) ReserveAppendResult {
+	off, err := txn.ReserveAppend(cmd.ID, cmd.Length, cmd.MinOffset)
+	return ReserveAppendResult{Err: err, Offset: off}
+}
+
+// Drops the tracts of a blob beyond a new size.
+func (cmd TruncateBlobCommand) apply(txn *state.Txn) TruncateBlobResult {
+	dropped, err := txn.TruncateBlob(cmd.ID, cmd.Size)
+	err = withEvent(txn, err, core.BlobEvent{Type: core.BlobWritten, Blob: cmd.ID})
+	return TruncateBlobResult{Err: err, Dropped: dropped}
 }
 
 // Appends tracts to the blob.
@@ -249,6 +367,9 @@ func (cmd ExtendBlobCommand) apply(txn *state.Txn) ExtendBlobResult {
 	if blob == nil {
 		return ExtendBlobResult{Err: core.ErrNoSuchBlob}
 	}
+	if blob.GetSealed() {
+		return ExtendBlobResult{Err: core.ErrSealed}
+	}
 
 	// The tract key should match the tract count.
 	if int(cmd.FirstTractKey) != len(blob.Tracts) {
@@ -262,17 +383,29 @@ func (cmd ExtendBlobCommand) apply(txn *state.Txn) ExtendBlobResult {
 		}
 	}
 
+	// Check the checksum updates now, so that they can't fail after we've
+	// added the tracts.
+	for _, u := range cmd.Checksums {
+		if int(u.Index) >= len(blob.Tracts)+len(cmd.Hosts) || u.New.Length < 0 || u.New.Length > core.TractLength {
+			return ExtendBlobResult{Err: core.ErrInvalidArgument}
+		}
+	}
+
 	// Add the tracts.
 	for _, hosts := range cmd.Hosts {
 		// Add one tract to the blob.
 		blob.Tracts = append(blob.Tracts, &pb.Tract{
 			Hosts:   hosts,
-			Version: 1,
+			Version: state.NewTractVersion(blob, len(blob.Tracts)),
 		})
 	}
 
 	// Put the modified metadata back.
 	txn.PutBlob(cmd.ID, blob)
+	if len(cmd.Checksums) > 0 {
+		txn.UpdateChecksums(cmd.ID, cmd.Checksums)
+	}
+	txn.AddEvent(core.BlobEvent{Type: core.BlobWritten, Blob: cmd.ID})
 
 	return ExtendBlobResult{Err: core.NoError, NewSize: len(blob.Tracts)}
 }
@@ -280,7 +413,7 @@ func (cmd ExtendBlobCommand) apply(txn *state.Txn) ExtendBlobResult {
 // Change the repl group of a tract. Tract versions can only increase by one from their current
 // version to allow only one successful request to increase the version number per version number.
 func (cmd ChangeTractCommand) apply(txn *state.Txn) ChangeTractResult {
-	blob := txn.GetBlob(cmd.ID.Blob)
+	blob := txn.GetStoredBlob(cmd.ID.Blob)
 	if blob == nil {
 		return ChangeTractResult{Err: core.ErrNoSuchBlob}
 	}
@@ -315,6 +448,12 @@ func (cmd ChangeTractCommand) apply(txn *state.Txn) ChangeTractResult {
 
 // Update mtime/atime for a batch of blobs.
 func (cmd UpdateTimesCommand) apply(txn *state.Txn) core.Error {
+	// A new mtime means the blob was written.
+	for _, update := range cmd.Updates {
+		if update.MTime != 0 && txn.GetBlob(update.Blob) != nil {
+			txn.AddEvent(core.BlobEvent{Type: core.BlobWritten, Blob: update.Blob})
+		}
+	}
 	txn.BatchUpdateTimes(cmd.Updates)
 	return core.NoError
 }
@@ -354,9 +493,26 @@ func (cmd UpdateRSHostsCommand) apply(txn *state.Txn) core.Error {
 }
 
 func (cmd UpdateStorageClassCommand) apply(txn *state.Txn) core.Error {
-	return txn.UpdateStorageClass(cmd.ID, cmd.Storage)
+	err := txn.UpdateStorageClass(cmd.ID, cmd.Storage, cmd.Time)
+	return withEvent(txn, err, core.BlobEvent{Type: core.BlobClassChanged, Blob: cmd.ID, Class: cmd.Storage})
+}
+
+func (cmd UnpackTractCommand) apply(txn *state.Txn) core.Error {
+	return txn.UnpackTract(cmd.ID, cmd.NewVersion, cmd.Hosts)
 }
 
 func (cmd CreateTSIDCacheCommand) apply(txn *state.Txn) core.Error {
 	return txn.CreateTSIDCache()
 }
+
+func (cmd AddStorageClassCommand) apply(txn *state.Txn) core.Error {
+	return txn.AddStorageClass(cmd.Def)
+}
+
+func (cmd RenameCommand) apply(txn *state.Txn) core.Error {
+	return txn.RenameName(cmd.Namespace, cmd.From, cmd.To, cmd.Replace, cmd.Caller)
+}
+
+func (cmd UnbindNameCommand) apply(txn *state.Txn) core.Error {
+	return txn.UnbindName(cmd.Namespace, cmd.Name, cmd.Blob, cmd.Caller)
+}
@@ -6,6 +6,8 @@ package durable
 import (
 	"io/ioutil"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -459,6 +461,500 @@ func TestStatBlobCommand(t *testing.T) {
 	}
 }
 
+// Test creating and changing user metadata.
+func TestUserMetadata(t *testing.T) {
+	d := getTestState(t)
+
+	txn := d.WriteTxn(1)
+	defer txn.Commit()
+
+	SetRegistrationCommand{ID: 31337}.apply(txn)
+	AddPartitionCommand{ID: 7}.apply(txn)
+
+	blob := CreateBlobCommand{Repl: 1, Metadata: map[string]string{"owner": "bob", "type": "text/plain"}}.apply(txn)
+	check := func(exp map[string]string) {
+		info, err := txn.Stat(blob.ID)
+		if err != core.NoError {
+			t.Fatalf("expected stat to work")
+		}
+		if !reflect.DeepEqual(info.Metadata, exp) {
+			t.Errorf("expected metadata %v, got %v", exp, info.Metadata)
+		}
+	}
+	check(map[string]string{"owner": "bob", "type": "text/plain"})
+
+	// Empty values remove keys, others are added or replaced.
+	md := core.BlobInfo{Metadata: map[string]string{"owner": "", "type": "text/html", "tag": "x"}}
+	if err := (SetMetadataCommand{ID: blob.ID, Metadata: md}).apply(txn); err != core.NoError {
+		t.Fatalf("SetMetadata failed: %s", err)
+	}
+	check(map[string]string{"tag": "x", "type": "text/html"})
+
+	// Going over the limit fails and doesn't change anything.
+	big := strings.Repeat("x", core.MaxMetadataSize)
+	md = core.BlobInfo{Metadata: map[string]string{"big": big}}
+	if err := (SetMetadataCommand{ID: blob.ID, Metadata: md}).apply(txn); err != core.ErrMetadataTooLarge {
+		t.Fatalf("expected ErrMetadataTooLarge, got %s", err)
+	}
+	check(map[string]string{"tag": "x", "type": "text/html"})
+}
+
+// Test that checksum updates are applied only if the old value matches.
+func TestUpdateChecksums(t *testing.T) {
+	d := getTestState(t)
+
+	txn := d.WriteTxn(1)
+	defer txn.Commit()
+
+	SetRegistrationCommand{ID: 31337}.apply(txn)
+	AddPartitionCommand{ID: 7}.apply(txn)
+
+	blob := CreateBlobCommand{Repl: 1}.apply(txn)
+	extendCmd := ExtendBlobCommand{ID: blob.ID, FirstTractKey: 0, Hosts: [][]core.TractserverID{{1}}}
+	if extendCmd.apply(txn).Err != core.NoError {
+		t.Fatalf("expected extend to work")
+	}
+	check := func(exp core.TractChecksum) {
+		tracts, _, err := txn.GetTracts(blob.ID, 0, 1)
+		if err != core.NoError {
+			t.Fatalf("expected GetTracts to work")
+		}
+		if tracts[0].Checksum != exp {
+			t.Errorf("expected checksum %+v, got %+v", exp, tracts[0].Checksum)
+		}
+	}
+	check(core.TractChecksum{})
+
+	var empty core.TractChecksum
+	c1 := empty.Extend([]byte("hello"))
+	c2 := c1.Extend([]byte(" world"))
+	apply := func(u core.ChecksumUpdate) core.Error {
+		return UpdateChecksumsCommand{ID: blob.ID, Updates: []core.ChecksumUpdate{u}}.apply(txn)
+	}
+
+	if err := apply(core.ChecksumUpdate{Index: 0, New: c1}); err != core.NoError {
+		t.Fatalf("UpdateChecksums failed: %s", err)
+	}
+	check(c1)
+	apply(core.ChecksumUpdate{Index: 0, Old: c1, New: c2})
+	check(c2)
+
+	// A stale old value clears the checksum.
+	apply(core.ChecksumUpdate{Index: 0, Old: c1, New: c2})
+	check(core.TractChecksum{})
+
+	if err := apply(core.ChecksumUpdate{Index: 1, New: c1}); err != core.ErrNoSuchTract {
+		t.Errorf("expected ErrNoSuchTract, got %s", err)
+	}
+
+	// Extending can update checksums of existing and new tracts at once, and
+	// does nothing if any of them are out of range.
+	extendCmd = ExtendBlobCommand{ID: blob.ID, FirstTractKey: 1, Hosts: [][]core.TractserverID{{1}},
+		Checksums: []core.ChecksumUpdate{{Index: 0, New: c1}, {Index: 2, New: c2}}}
+	if err := extendCmd.apply(txn).Err; err != core.ErrInvalidArgument {
+		t.Errorf("expected ErrInvalidArgument, got %s", err)
+	}
+	extendCmd.Checksums[1].Index = 1
+	if err := extendCmd.apply(txn).Err; err != core.NoError {
+		t.Fatalf("extend failed: %s", err)
+	}
+	check(c1)
+	if tracts, _, _ := txn.GetTracts(blob.ID, 1, 2); len(tracts) != 1 || tracts[0].Checksum != c2 {
+		t.Errorf("expected checksum %+v on the new tract, got %+v", c2, tracts)
+	}
+}
+
+// Test sealing write-once blobs.
+func TestSealBlob(t *testing.T) {
+	d := getTestState(t)
+
+	txn := d.WriteTxn(1)
+	defer txn.Commit()
+
+	SetRegistrationCommand{ID: 31337}.apply(txn)
+	AddPartitionCommand{ID: 7}.apply(txn)
+
+	plain := CreateBlobCommand{Repl: 1}.apply(txn)
+	if err := (SealBlobCommand{ID: plain.ID}).apply(txn); err != core.ErrInvalidArgument {
+		t.Errorf("expected sealing a regular blob to fail, got %s", err)
+	}
+
+	blob := CreateBlobCommand{Repl: 1, WriteOnce: true}.apply(txn)
+	check := func(sealed bool) {
+		info, err := txn.Stat(blob.ID)
+		if err != core.NoError {
+			t.Fatalf("expected stat to work")
+		}
+		if !info.WriteOnce || info.Sealed != sealed {
+			t.Errorf("expected write-once blob with sealed=%t, got %+v", sealed, info)
+		}
+	}
+	check(false)
+
+	extendCmd := ExtendBlobCommand{ID: blob.ID, FirstTractKey: 0, Hosts: [][]core.TractserverID{{1}}}
+	if extendCmd.apply(txn).Err != core.NoError {
+		t.Fatalf("expected extend to work")
+	}
+	if err := (SealBlobCommand{ID: blob.ID}).apply(txn); err != core.NoError {
+		t.Fatalf("seal failed: %s", err)
+	}
+	check(true)
+
+	// Sealing again is fine, but extending isn't.
+	if err := (SealBlobCommand{ID: blob.ID}).apply(txn); err != core.NoError {
+		t.Errorf("second seal failed: %s", err)
+	}
+	extendCmd.FirstTractKey = 1
+	if err := extendCmd.apply(txn).Err; err != core.ErrSealed {
+		t.Errorf("expected ErrSealed extending a sealed blob, got %s", err)
+	}
+}
+
+// Test that append reservations don't overlap.
+func TestReserveAppend(t *testing.T) {
+	d := getTestState(t)
+
+	txn := d.WriteTxn(1)
+	defer txn.Commit()
+
+	SetRegistrationCommand{ID: 31337}.apply(txn)
+	AddPartitionCommand{ID: 7}.apply(txn)
+
+	blob := CreateBlobCommand{Repl: 1}.apply(txn)
+	reserve := func(length, minOffset, exp int64) {
+		res := ReserveAppendCommand{ID: blob.ID, Length: length, MinOffset: minOffset}.apply(txn)
+		if res.Err != core.NoError || res.Offset != exp {
+			t.Errorf("expected reservation at %d, got %+v", exp, res)
+		}
+	}
+	reserve(100, 0, 0)
+	reserve(50, 0, 100)
+	// A client that knows the blob is longer pushes appends past that.
+	reserve(10, 1000, 1000)
+	reserve(10, 0, 1010)
+
+	if res := (ReserveAppendCommand{ID: blob.ID, Length: 0}).apply(txn); res.Err != core.ErrInvalidArgument {
+		t.Errorf("expected empty reservation to fail, got %+v", res)
+	}
+}
+
+// Test that the owner of a blob is kept, and that clones have their own.
+func TestBlobOwner(t *testing.T) {
+	d := getTestState(t)
+
+	txn := d.WriteTxn(1)
+	defer txn.Commit()
+
+	SetRegistrationCommand{ID: 31337}.apply(txn)
+	AddPartitionCommand{ID: 7}.apply(txn)
+
+	owned := CreateBlobCommand{Repl: 1, Owner: "team-a"}.apply(txn).ID
+	unowned := CreateBlobCommand{Repl: 1}.apply(txn).ID
+	clone := CloneBlobCommand{Src: owned, Owner: "team-b"}.apply(txn).ID
+
+	for id, exp := range map[core.BlobID]string{owned: "team-a", unowned: "", clone: "team-b"} {
+		info, err := txn.Stat(id)
+		if err != core.NoError {
+			t.Fatalf("failed to stat %s: %s", id, err)
+		}
+		if info.Owner != exp {
+			t.Errorf("expected %s to be owned by %q, got %q", id, exp, info.Owner)
+		}
+	}
+	if txn.GetBlob(unowned).Owner != nil {
+		t.Errorf("expected no owner to be stored")
+	}
+}
+
+// Test that the ACL of a blob is kept, and passed on to clones.
+func TestBlobACL(t *testing.T) {
+	d := getTestState(t)
+
+	txn := d.WriteTxn(1)
+	defer txn.Commit()
+
+	SetRegistrationCommand{ID: 31337}.apply(txn)
+	AddPartitionCommand{ID: 7}.apply(txn)
+
+	acl := core.ACL{Readers: []string{"alice"}, Writers: []string{"bob"}}
+	restricted := CreateBlobCommand{Repl: 1, ACL: acl}.apply(txn).ID
+	open := CreateBlobCommand{Repl: 1}.apply(txn).ID
+	clone := CloneBlobCommand{Src: restricted}.apply(txn).ID
+
+	for id, exp := range map[core.BlobID]core.ACL{restricted: acl, open: {}, clone: acl} {
+		info, err := txn.Stat(id)
+		if err != core.NoError {
+			t.Fatalf("failed to stat %s: %s", id, err)
+		}
+		if !reflect.DeepEqual(info.ACL, exp) {
+			t.Errorf("expected %s to have ACL %+v, got %+v", id, exp, info.ACL)
+		}
+	}
+}
+
+// Test cloning blobs, unsharing tracts, and deleting shared tracts.
+func TestCloneBlob(t *testing.T) {
+	d := getTestState(t)
+
+	txn := d.WriteTxn(1)
+	defer txn.Commit()
+
+	SetRegistrationCommand{ID: 31337}.apply(txn)
+	AddPartitionCommand{ID: 7}.apply(txn)
+
+	a := CreateBlobCommand{Repl: 2}.apply(txn).ID
+	hosts := [][]core.TractserverID{{1, 2}, {3, 4}}
+	if res := (ExtendBlobCommand{ID: a, FirstTractKey: 0, Hosts: hosts}).apply(txn); res.Err != core.NoError {
+		t.Fatalf("failed to extend blob: %s", res.Err)
+	}
+
+	b := CloneBlobCommand{Src: a}.apply(txn)
+	if b.Err != core.NoError {
+		t.Fatalf("failed to clone blob: %s", b.Err)
+	}
+	// Clones of clones share with the origin.
+	c := CloneBlobCommand{Src: b.ID}.apply(txn)
+	if c.Err != core.NoError {
+		t.Fatalf("failed to clone blob: %s", c.Err)
+	}
+	if res := (CloneBlobCommand{Src: core.BlobIDFromParts(7, 300)}).apply(txn); res.Err != core.ErrNoSuchBlob {
+		t.Errorf("expected cloning a missing blob to fail, got %s", res.Err)
+	}
+
+	checkTract := func(id core.BlobID, i int, owner core.BlobID, tsids []core.TractserverID, shared bool) {
+		tracts, _, err := txn.GetTracts(id, i, i+1)
+		if err != core.NoError {
+			t.Fatalf("failed to get tracts of %s: %s", id, err)
+		}
+		tract := tracts[0]
+		if tract.Tract.Blob != owner || !reflect.DeepEqual(tract.TSIDs, tsids) || tract.Shared != shared {
+			t.Errorf("unexpected tract %d of %s: %+v", i, id, tract)
+		}
+	}
+	for _, id := range []core.BlobID{a, b.ID, c.ID} {
+		checkTract(id, 0, a, hosts[0], true)
+		checkTract(id, 1, a, hosts[1], true)
+	}
+
+	// Writing to the origin gives a clone its own copy first.
+	target, repl, source, shared, err := txn.UnshareTarget(core.TractIDFromParts(a, 0))
+	if err != core.NoError || !shared || target != b.ID || repl != 2 || source.Tract != core.TractIDFromParts(a, 0) {
+		t.Fatalf("unexpected unshare target %s from %+v (%t, %s)", target, source, shared, err)
+	}
+	if err := (UnshareTractCommand{ID: core.TractIDFromParts(b.ID, 0), Hosts: []core.TractserverID{5, 6}}).apply(txn); err != core.NoError {
+		t.Fatalf("failed to unshare tract: %s", err)
+	}
+	if err := (UnshareTractCommand{ID: core.TractIDFromParts(b.ID, 0), Hosts: []core.TractserverID{5, 6}}).apply(txn); err != core.ErrConflictingState {
+		t.Errorf("expected unsharing twice to fail, got %s", err)
+	}
+	checkTract(b.ID, 0, b.ID, []core.TractserverID{5, 6}, false)
+	checkTract(a, 0, a, hosts[0], true)
+
+	// Writing to a clone gives it its own copy.
+	target, _, _, shared, err = txn.UnshareTarget(core.TractIDFromParts(c.ID, 0))
+	if err != core.NoError || !shared || target != c.ID {
+		t.Fatalf("unexpected unshare target %s (%t, %s)", target, shared, err)
+	}
+	if err := (UnshareTractCommand{ID: core.TractIDFromParts(c.ID, 0), Hosts: []core.TractserverID{7, 8}}).apply(txn); err != core.NoError {
+		t.Fatalf("failed to unshare tract: %s", err)
+	}
+	checkTract(a, 0, a, hosts[0], false)
+	if _, _, _, shared, _ := txn.UnshareTarget(core.TractIDFromParts(a, 0)); shared {
+		t.Errorf("expected tract to be unshared")
+	}
+
+	// The origin can't go away while its tracts are shared, but it's still
+	// maintained after it's deleted.
+	DeleteBlobCommand{ID: a, When: time.Now()}.apply(txn)
+	FinishDeleteCommand{Blobs: []core.BlobID{a}}.apply(txn)
+	if txn.GetBlobAll(a) == nil {
+		t.Fatalf("blob with shared tracts was deleted")
+	}
+	if _, err := txn.GetTract(core.TractIDFromParts(a, 1)); err != core.NoError {
+		t.Errorf("failed to get shared tract of deleted blob: %s", err)
+	}
+	checkTract(c.ID, 1, a, hosts[1], true)
+
+	// Once the clones are gone, it can be.
+	FinishDeleteCommand{Blobs: []core.BlobID{b.ID, c.ID}}.apply(txn)
+	FinishDeleteCommand{Blobs: []core.BlobID{a}}.apply(txn)
+	if txn.GetBlobAll(a) != nil {
+		t.Errorf("blob wasn't deleted after its clones were")
+	}
+}
+
+// Cloning records the new versions of tracts that become shared.
+func TestCloneBlobFence(t *testing.T) {
+	d := getTestState(t)
+
+	txn := d.WriteTxn(1)
+	defer txn.Commit()
+
+	SetRegistrationCommand{ID: 31337}.apply(txn)
+	AddPartitionCommand{ID: 7}.apply(txn)
+
+	a := CreateBlobCommand{Repl: 2}.apply(txn).ID
+	hosts := [][]core.TractserverID{{1, 2}, {3, 4}}
+	if res := (ExtendBlobCommand{ID: a, FirstTractKey: 0, Hosts: hosts}).apply(txn); res.Err != core.NoError {
+		t.Fatalf("failed to extend blob: %s", res.Err)
+	}
+	versions := func(id core.BlobID) []int {
+		tracts, _, err := txn.GetTracts(id, 0, 2)
+		if err != core.NoError {
+			t.Fatalf("failed to get tracts of %s: %s", id, err)
+		}
+		return []int{tracts[0].Version, tracts[1].Version}
+	}
+
+	// The versions have to go up by one, for every tract.
+	for _, bad := range [][]int{{3, 2}, {2}} {
+		if res := (CloneBlobCommand{Src: a, Versions: bad}).apply(txn); res.Err != core.ErrConflictingState {
+			t.Errorf("expected cloning with versions %v to fail, got %s", bad, res.Err)
+		}
+	}
+	if v := versions(a); !reflect.DeepEqual(v, []int{1, 1}) {
+		t.Errorf("failed clones changed versions to %v", v)
+	}
+
+	b := CloneBlobCommand{Src: a, Versions: []int{2, 0}}.apply(txn)
+	if b.Err != core.NoError {
+		t.Fatalf("failed to clone blob: %s", b.Err)
+	}
+	if v := versions(b.ID); !reflect.DeepEqual(v, []int{2, 1}) {
+		t.Errorf("unexpected versions %v after clone", v)
+	}
+
+	// Shared tracts are already fenced.
+	if res := (CloneBlobCommand{Src: a, Versions: []int{0, 2}}).apply(txn); res.Err != core.ErrConflictingState {
+		t.Errorf("expected fencing a shared tract to fail, got %s", res.Err)
+	}
+}
+
+// Deleted clones don't stop the origin from being written.
+func TestUnshareDeletedClone(t *testing.T) {
+	d := getTestState(t)
+
+	txn := d.WriteTxn(1)
+	defer txn.Commit()
+
+	SetRegistrationCommand{ID: 31337}.apply(txn)
+	AddPartitionCommand{ID: 7}.apply(txn)
+
+	a := CreateBlobCommand{Repl: 1}.apply(txn).ID
+	if res := (ExtendBlobCommand{ID: a, FirstTractKey: 0, Hosts: [][]core.TractserverID{{1}}}).apply(txn); res.Err != core.NoError {
+		t.Fatalf("failed to extend blob: %s", res.Err)
+	}
+	b := CloneBlobCommand{Src: a}.apply(txn).ID
+	c := CloneBlobCommand{Src: a}.apply(txn).ID
+	DeleteBlobCommand{ID: b, When: time.Now()}.apply(txn)
+
+	// The live clone gets its copy first.
+	tid := core.TractIDFromParts(a, 0)
+	target, _, _, shared, err := txn.UnshareTarget(tid)
+	if err != core.NoError || !shared || target != c {
+		t.Fatalf("unexpected unshare target %s (%t, %s)", target, shared, err)
+	}
+	if err := (UnshareTractCommand{ID: core.TractIDFromParts(c, 0), Hosts: []core.TractserverID{2}}).apply(txn); err != core.NoError {
+		t.Fatalf("failed to unshare tract: %s", err)
+	}
+
+	// Then the deleted one, so that it still has its data if it's undeleted.
+	target, repl, _, shared, err := txn.UnshareTarget(tid)
+	if err != core.NoError || !shared || target != b || repl != 1 {
+		t.Fatalf("unexpected unshare target %s (%t, %s)", target, shared, err)
+	}
+	if err := (UnshareTractCommand{ID: core.TractIDFromParts(b, 0), Hosts: []core.TractserverID{3}}).apply(txn); err != core.NoError {
+		t.Fatalf("failed to unshare tract of deleted clone: %s", err)
+	}
+	if _, _, _, shared, _ := txn.UnshareTarget(tid); shared {
+		t.Errorf("expected tract to be unshared")
+	}
+}
+
+// Test truncating blobs, including ones that share tracts.
+func TestTruncateBlob(t *testing.T) {
+	d := getTestState(t)
+
+	txn := d.WriteTxn(1)
+	defer txn.Commit()
+
+	SetRegistrationCommand{ID: 31337}.apply(txn)
+	AddPartitionCommand{ID: 7}.apply(txn)
+
+	a := CreateBlobCommand{Repl: 1}.apply(txn).ID
+	hosts := [][]core.TractserverID{{1}, {2}, {3}, {4}}
+	if res := (ExtendBlobCommand{ID: a, FirstTractKey: 0, Hosts: hosts}).apply(txn); res.Err != core.NoError {
+		t.Fatalf("failed to extend blob: %s", res.Err)
+	}
+	ReserveAppendCommand{ID: a, Length: 4 * core.TractLength}.apply(txn)
+	UpdateChecksumsCommand{ID: a, Updates: []core.ChecksumUpdate{
+		{Index: 1, New: core.TractChecksum{CRC: 1, Length: 100}},
+	}}.apply(txn)
+
+	truncate := func(id core.BlobID, size int64) []core.TractInfo {
+		res := TruncateBlobCommand{ID: id, Size: size}.apply(txn)
+		if res.Err != core.NoError {
+			t.Fatalf("failed to truncate %s to %d: %s", id, size, res.Err)
+		}
+		return res.Dropped
+	}
+	numTracts := func(id core.BlobID) int {
+		info, err := txn.Stat(id)
+		if err != core.NoError {
+			t.Fatalf("failed to stat %s: %s", id, err)
+		}
+		return info.NumTracts
+	}
+
+	// Truncating within the last tract doesn't drop anything.
+	if dropped := truncate(a, 4*core.TractLength-1); len(dropped) != 0 || numTracts(a) != 4 {
+		t.Errorf("unexpected truncation: dropped %+v, %d tracts left", dropped, numTracts(a))
+	}
+
+	// The rest of the blob is shared with a clone, which may drop its tracts.
+	b := CloneBlobCommand{Src: a}.apply(txn).ID
+	if dropped := truncate(b, core.TractLength); len(dropped) != 0 || numTracts(b) != 1 {
+		t.Errorf("unexpected truncation of clone: dropped %+v, %d tracts left", dropped, numTracts(b))
+	}
+	if res := (TruncateBlobCommand{ID: a, Size: 0}).apply(txn); res.Err != core.ErrConflictingState {
+		t.Errorf("expected dropping a shared tract to fail, got %s", res.Err)
+	}
+	// Tract 1 isn't shared anymore, but cutting tract 0 short would change the clone.
+	if res := (TruncateBlobCommand{ID: a, Size: 10}).apply(txn); res.Err != core.ErrConflictingState {
+		t.Errorf("expected cutting a shared tract short to fail, got %s", res.Err)
+	}
+
+	// Dropped tracts are returned for deletion, and the checksum that no
+	// longer fits is cleared.
+	dropped := truncate(a, core.TractLength+50)
+	if len(dropped) != 2 || dropped[0].Tract != core.TractIDFromParts(a, 2) ||
+		!reflect.DeepEqual(dropped[1].TSIDs, hosts[3]) || numTracts(a) != 2 {
+		t.Errorf("unexpected truncation: dropped %+v, %d tracts left", dropped, numTracts(a))
+	}
+	tracts, _, _ := txn.GetTracts(a, 1, 2)
+	if tracts[0].Checksum.Present() {
+		t.Errorf("expected checksum to be cleared, got %+v", tracts[0].Checksum)
+	}
+	if off := (ReserveAppendCommand{ID: a, Length: 1}).apply(txn).Offset; off != core.TractLength+50 {
+		t.Errorf("expected appends to start at the new end, got %d", off)
+	}
+
+	// Tracts added in place of the dropped ones start above their versions.
+	if res := (ExtendBlobCommand{ID: a, FirstTractKey: 2, Hosts: hosts[:3]}).apply(txn); res.Err != core.NoError {
+		t.Fatalf("failed to extend blob: %s", res.Err)
+	}
+	tracts, _, _ = txn.GetTracts(a, 2, 5)
+	if tracts[0].Version != 2 || tracts[1].Version != 2 || tracts[2].Version != 1 {
+		t.Errorf("unexpected versions after truncation: %+v", tracts)
+	}
+
+	w := CreateBlobCommand{Repl: 1, WriteOnce: true}.apply(txn).ID
+	SealBlobCommand{ID: w}.apply(txn)
+	if res := (TruncateBlobCommand{ID: w, Size: 0}).apply(txn); res.Err != core.ErrSealed {
+		t.Errorf("expected truncating a sealed blob to fail, got %s", res.Err)
+	}
+}
+
 // Test error cases for getting tracts.
 func TestGetTractsErrors(t *testing.T) {
 	d := getTestState(t)
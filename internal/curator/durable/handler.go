@@ -23,6 +23,16 @@ const (
 	// works out to about 4KB per reply (without compression).
 	maxListBlobResults = 1000
 
+	// How many blobs to look at per rpc when listing with a filter.
+	maxListBlobScan = 10000
+
+	// How many events to return, and event records to look at, per rpc.
+	maxEventResults = 1000
+	maxEventScan    = 10000
+
+	// How many namespace entries to return per rpc.
+	maxListNameResults = 1000
+
 	// How many blobs/chunks to read per transaction when iterating.
 	blobsPerTxn  = 1000
 	chunksPerTxn = 500
@@ -202,6 +212,20 @@ func (h *StateHandler) GetCuratorInfoLocal() (id core.CuratorID, partitionIDs []
 	return
 }
 
+// GetACL returns the ACL of blob 'id', which may be deleted. It returns false
+// if there's no such blob. ACLs don't change after blobs are created, so this
+// read is processed locally.
+func (h *StateHandler) GetACL(id core.BlobID) (core.ACL, bool) {
+	txn := h.LocalReadOnlyTxn()
+	defer txn.Commit()
+
+	blob := txn.GetBlobAll(id)
+	if blob == nil {
+		return core.ACL{}, false
+	}
+	return core.ACL{Readers: blob.GetReaders(), Writers: blob.GetWriters()}, true
+}
+
 // ReadOnlyMode returns the current state of read-only mode.
 func (h *StateHandler) ReadOnlyMode() (bool, core.Error) {
 	txn, err := h.LinearizableReadOnlyTxn()
@@ -299,13 +323,18 @@ func (h *StateHandler) SyncPartitions(partitions []core.PartitionID, term uint64
 	return res.Err
 }
 
-// CreateBlob creates a blob and returns its ID, or an error.
+// CreateBlob creates the blob described by 'cmd' and returns its ID, or an error.
 //
 // If there are no partitions available to create a blob in, core.ErrGenBlobID will be returned.
+// If cmd.Metadata has invalid keys or is too large, core.ErrInvalidArgument or
+// core.ErrMetadataTooLarge will be returned.
 //
 // Returns core.NoError on success, another core.Error otherwise (including expected Raft errors).
-func (h *StateHandler) CreateBlob(repl int, now, expires int64, hint core.StorageHint, term uint64) (core.BlobID, core.Error) {
-	pending := h.raft.ProposeIfTerm(cmdToBytes(CreateBlobCommand{repl, now, expires, hint}), term)
+func (h *StateHandler) CreateBlob(cmd CreateBlobCommand, term uint64) (core.BlobID, core.Error) {
+	if err := validateMetadata(cmd.Metadata); err != core.NoError {
+		return core.BlobID(0), err
+	}
+	pending := h.raft.ProposeIfTerm(cmdToBytes(cmd), term)
 
 	select {
 	case <-time.After(core.ProposalTimeout):
@@ -328,8 +357,8 @@ func (h *StateHandler) CreateBlob(repl int, now, expires int64, hint core.Storag
 // ExtendBlob extends a blob.
 //
 // Returns core.NoError on success, another core.Error otherwise (including expected Raft errors).
-func (h *StateHandler) ExtendBlob(id core.BlobID, firstTractKey core.TractKey, hosts [][]core.TractserverID) (int, core.Error) {
-	pending := h.raft.Propose(cmdToBytes(ExtendBlobCommand{id, firstTractKey, hosts}))
+func (h *StateHandler) ExtendBlob(id core.BlobID, firstTractKey core.TractKey, hosts [][]core.TractserverID, checksums []core.ChecksumUpdate) (int, core.Error) {
+	pending := h.raft.Propose(cmdToBytes(ExtendBlobCommand{id, firstTractKey, hosts, checksums}))
 
 	select {
 	case <-time.After(core.ProposalTimeout):
@@ -349,6 +378,52 @@ func (h *StateHandler) ExtendBlob(id core.BlobID, firstTractKey core.TractKey, h
 	return res.NewSize, res.Err
 }
 
+// ReserveAppend reserves 'length' bytes at the end of a blob, starting no
+// earlier than 'minOffset', and returns the offset of the reserved space.
+func (h *StateHandler) ReserveAppend(id core.BlobID, length, minOffset int64) (int64, core.Error) {
+	pending := h.raft.Propose(cmdToBytes(ReserveAppendCommand{id, length, minOffset}))
+
+	select {
+	case <-time.After(core.ProposalTimeout):
+		return 0, core.ErrRaftTimeout
+	case <-pending.Done:
+		// Fall through
+	}
+
+	if nil != pending.Err {
+		return 0, core.FromRaftError(pending.Err)
+	}
+	if err, ok := pending.Res.(core.Error); ok {
+		return 0, err
+	}
+
+	res := pending.Res.(ReserveAppendResult)
+	return res.Offset, res.Err
+}
+
+// TruncateBlob drops the tracts of a blob that lie entirely beyond 'size'
+// bytes, and returns the ones that should be deleted from tractservers.
+func (h *StateHandler) TruncateBlob(id core.BlobID, size int64) ([]core.TractInfo, core.Error) {
+	pending := h.raft.Propose(cmdToBytes(TruncateBlobCommand{id, size}))
+
+	select {
+	case <-time.After(core.ProposalTimeout):
+		return nil, core.ErrRaftTimeout
+	case <-pending.Done:
+		// Fall through
+	}
+
+	if nil != pending.Err {
+		return nil, core.FromRaftError(pending.Err)
+	}
+	if err, ok := pending.Res.(core.Error); ok {
+		return nil, err
+	}
+
+	res := pending.Res.(TruncateBlobResult)
+	return res.Dropped, res.Err
+}
+
 // DeleteBlob deletes a blob.
 //
 // Returns information about the just-deleted blob so the caller could clean up the tracts.
@@ -412,8 +487,25 @@ func (h *StateHandler) FinishDelete(blobs []core.BlobID) core.Error {
 	return pending.Res.(core.Error)
 }
 
+// PurgeBlob permanently deletes one blob that was marked as deleted.
+func (h *StateHandler) PurgeBlob(id core.BlobID, term uint64) core.Error {
+	pending := h.raft.ProposeIfTerm(cmdToBytes(PurgeBlobCommand{id}), term)
+	select {
+	case <-time.After(core.ProposalTimeout):
+		return core.ErrRaftTimeout
+	case <-pending.Done:
+	}
+	if nil != pending.Err {
+		return core.FromRaftError(pending.Err)
+	}
+	return pending.Res.(core.Error)
+}
+
 // SetMetadata changes metadata for a blob.
 func (h *StateHandler) SetMetadata(id core.BlobID, md core.BlobInfo) core.Error {
+	if err := validateMetadata(md.Metadata); err != core.NoError {
+		return err
+	}
 	pending := h.raft.Propose(cmdToBytes(SetMetadataCommand{id, md}))
 	select {
 	case <-time.After(core.ProposalTimeout):
@@ -426,6 +518,100 @@ func (h *StateHandler) SetMetadata(id core.BlobID, md core.BlobInfo) core.Error
 	return pending.Res.(core.Error)
 }
 
+// UpdateChecksums changes end-to-end checksums for tracts of a blob.
+func (h *StateHandler) UpdateChecksums(id core.BlobID, updates []core.ChecksumUpdate) core.Error {
+	pending := h.raft.Propose(cmdToBytes(UpdateChecksumsCommand{id, updates}))
+	select {
+	case <-time.After(core.ProposalTimeout):
+		return core.ErrRaftTimeout
+	case <-pending.Done:
+	}
+	if nil != pending.Err {
+		return core.FromRaftError(pending.Err)
+	}
+	return pending.Res.(core.Error)
+}
+
+// SealBlob seals a write-once blob.
+func (h *StateHandler) SealBlob(id core.BlobID) core.Error {
+	pending := h.raft.Propose(cmdToBytes(SealBlobCommand{id}))
+	select {
+	case <-time.After(core.ProposalTimeout):
+		return core.ErrRaftTimeout
+	case <-pending.Done:
+	}
+	if nil != pending.Err {
+		return core.FromRaftError(pending.Err)
+	}
+	return pending.Res.(core.Error)
+}
+
+// CloneBlob creates a blob that shares the tracts of cmd.Src and returns its
+// ID. cmd.Versions are the new versions of the tracts that become shared, see
+// Txn.FenceTracts.
+func (h *StateHandler) CloneBlob(cmd CloneBlobCommand, term uint64) (core.BlobID, core.Error) {
+	pending := h.raft.ProposeIfTerm(cmdToBytes(cmd), term)
+
+	select {
+	case <-time.After(core.ProposalTimeout):
+		return core.BlobID(0), core.ErrRaftTimeout
+	case <-pending.Done:
+		// Fall through
+	}
+
+	if nil != pending.Err {
+		return core.BlobID(0), core.FromRaftError(pending.Err)
+	}
+	if err, ok := pending.Res.(core.Error); ok {
+		return 0, err
+	}
+
+	res := pending.Res.(CreateBlobResult)
+	return res.ID, res.Err
+}
+
+// UnshareTarget returns the blob that should get its own copy of the shared
+// tract 'id' next, its replication factor, and the tract to copy the data
+// from. If the tract isn't shared, 'shared' is false.
+func (h *StateHandler) UnshareTarget(id core.TractID) (target core.BlobID, repl int, source core.TractInfo, shared bool, err core.Error) {
+	txn, err := h.LinearizableReadOnlyTxn()
+	if err != core.NoError {
+		return 0, 0, core.TractInfo{}, false, err
+	}
+	defer txn.Commit()
+	return txn.UnshareTarget(id)
+}
+
+// UnshareTract gives a clone its own copy, stored on 'hosts', of the tract
+// 'id' that it shared with the origin.
+func (h *StateHandler) UnshareTract(id core.TractID, hosts []core.TractserverID) core.Error {
+	pending := h.raft.Propose(cmdToBytes(UnshareTractCommand{id, hosts}))
+	select {
+	case <-time.After(core.ProposalTimeout):
+		return core.ErrRaftTimeout
+	case <-pending.Done:
+	}
+	if nil != pending.Err {
+		return core.FromRaftError(pending.Err)
+	}
+	return pending.Res.(core.Error)
+}
+
+// validateMetadata checks that the keys in user metadata are valid, and that
+// its total size is within limits. Changes that would make a blob's metadata
+// too large are caught when they're applied.
+func validateMetadata(md map[string]string) core.Error {
+	for k := range md {
+		if !core.ValidMetadataKey(k) {
+			return core.ErrInvalidArgument
+		}
+	}
+	if core.MetadataSize(md) > core.MaxMetadataSize {
+		return core.ErrMetadataTooLarge
+	}
+	return core.NoError
+}
+
 // GetTracts returns information about tracts in a blob.
 func (h *StateHandler) GetTracts(id core.BlobID, start, end int) ([]core.TractInfo, core.StorageClass, core.Error) {
 	txn, err := h.LinearizableReadOnlyTxn()
@@ -436,6 +622,17 @@ func (h *StateHandler) GetTracts(id core.BlobID, start, end int) ([]core.TractIn
 	return txn.GetTracts(id, start, end)
 }
 
+// GetTract returns information about a tract as it's stored on tractservers.
+// See Txn.GetTract.
+func (h *StateHandler) GetTract(id core.TractID) (core.TractInfo, core.Error) {
+	txn, err := h.LinearizableReadOnlyTxn()
+	if err != core.NoError {
+		return core.TractInfo{}, err
+	}
+	defer txn.Commit()
+	return txn.GetTract(id)
+}
+
 // ChangeTract changes the repl group of a tract.
 func (h *StateHandler) ChangeTract(id core.TractID, newVersion int, hosts []core.TractserverID, term uint64) (core.TractInfo, core.Error) {
 	pending := h.raft.ProposeIfTerm(cmdToBytes(ChangeTractCommand{id, newVersion, hosts}), term)
@@ -488,6 +685,25 @@ func (h *StateHandler) Stat(id core.BlobID) (core.BlobInfo, core.Error) {
 	return txn.Stat(id)
 }
 
+// NewTractVersions returns the versions that 'n' tracts added to blob 'id' at
+// index 'first' start with. See state.NewTractVersion.
+func (h *StateHandler) NewTractVersions(id core.BlobID, first, n int) ([]int, core.Error) {
+	txn, err := h.LinearizableReadOnlyTxn()
+	if err != core.NoError {
+		return nil, err
+	}
+	defer txn.Commit()
+	blob := txn.GetBlob(id)
+	if blob == nil {
+		return nil, core.ErrNoSuchBlob
+	}
+	versions := make([]int, n)
+	for i := range versions {
+		versions[i] = state.NewTractVersion(blob, first+i)
+	}
+	return versions, core.NoError
+}
+
 // GetRSChunk looks up one RSChunk.
 func (h *StateHandler) GetRSChunk(id core.RSChunkID) *pb.RSChunk {
 	txn, err := h.LinearizableReadOnlyTxn()
@@ -522,6 +738,99 @@ func (h *StateHandler) ListBlobs(partition core.PartitionID, start core.BlobKey)
 	return
 }
 
+// ListBlobsWithInfo returns a range of blob keys in one partition that match
+// 'filter', with their info. It looks at no more than maxListBlobScan blobs,
+// so it may return nothing even if there are more matching blobs. If 'more' is
+// true, the caller should continue from 'next'.
+func (h *StateHandler) ListBlobsWithInfo(partition core.PartitionID, start core.BlobKey, filter core.BlobFilter) (keys []core.BlobKey, infos []core.BlobInfo, next core.BlobKey, more bool, err core.Error) {
+	txn, err := h.LinearizableReadOnlyTxn()
+	if err != core.NoError {
+		return
+	}
+	defer txn.Commit()
+
+	iter, has := txn.GetIterator(core.BlobIDFromParts(partition, start))
+	for scanned := 0; has; scanned++ {
+		id, blob := iter.Blob()
+		if id.Partition() != partition {
+			// we're at the end of this partition
+			break
+		}
+		if len(keys) == maxListBlobResults || scanned == maxListBlobScan {
+			return keys, infos, id.ID(), true, core.NoError
+		}
+		if info := state.BlobInfo(blob); filter.Match(info) {
+			keys = append(keys, id.ID())
+			infos = append(infos, info)
+		}
+		has = iter.Next()
+	}
+	return keys, infos, 0, false, core.NoError
+}
+
+// GetEvents returns events in 'partition' after raft index 'after'. The caller
+// should continue from 'next'.
+func (h *StateHandler) GetEvents(partition core.PartitionID, after uint64) (events []core.BlobEvent, next uint64, err core.Error) {
+	txn, err := h.LinearizableReadOnlyTxn()
+	if err != core.NoError {
+		return nil, after, err
+	}
+	defer txn.Commit()
+	return txn.GetEvents(partition, after, maxEventResults, maxEventScan)
+}
+
+// Rename atomically moves the binding of 'from' to 'to' in the namespace of
+// partition 'ns', or every name in the directory 'from' if both end with
+// core.NameSeparator. If 'replace' is
+// non-zero, 'to' must be bound to it, and the binding is replaced. If 'caller'
+// is set, it must be the client that bound the names.
+func (h *StateHandler) Rename(ns core.PartitionID, from, to string, replace core.BlobID, caller string, term uint64) core.Error {
+	return h.proposeNameCommand(RenameCommand{ns, from, to, replace, caller}, term)
+}
+
+// UnbindName removes a name from the namespace of partition 'ns'. If 'id' is
+// non-zero, the name is only removed if it's bound to that blob. If 'caller' is
+// set, it must be the client that bound the name.
+func (h *StateHandler) UnbindName(ns core.PartitionID, name string, id core.BlobID, caller string, term uint64) core.Error {
+	return h.proposeNameCommand(UnbindNameCommand{ns, name, id, caller}, term)
+}
+
+// proposeNameCommand proposes a namespace mutation and waits for its result.
+func (h *StateHandler) proposeNameCommand(cmd interface{}, term uint64) core.Error {
+	pending := h.raft.ProposeIfTerm(cmdToBytes(cmd), term)
+	select {
+	case <-time.After(core.ProposalTimeout):
+		return core.ErrRaftTimeout
+	case <-pending.Done:
+	}
+	if nil != pending.Err {
+		return core.FromRaftError(pending.Err)
+	}
+	return pending.Res.(core.Error)
+}
+
+// LookupName returns the blob that a name is bound to in the namespace of
+// partition 'ns'.
+func (h *StateHandler) LookupName(ns core.PartitionID, name string) (core.BlobID, core.Error) {
+	txn, err := h.LinearizableReadOnlyTxn()
+	if err != core.NoError {
+		return 0, err
+	}
+	defer txn.Commit()
+	return txn.LookupName(ns, name)
+}
+
+// ListNames returns a batch of entries of the namespace of partition 'ns' that
+// are directly under 'prefix' and sort after 'start'.
+func (h *StateHandler) ListNames(ns core.PartitionID, prefix, start string) ([]core.NameEntry, core.Error) {
+	txn, err := h.LinearizableReadOnlyTxn()
+	if err != core.NoError {
+		return nil, err
+	}
+	defer txn.Commit()
+	return txn.ListNames(ns, prefix, start, maxListNameResults)
+}
+
 // AllocateRSChunkIDs allocates a contiguous range of n RSChunkIDs.
 //
 // If there are no partitions available to allocate ids in, core.ErrGenBlobID will be returned.
@@ -575,10 +884,25 @@ func (h *StateHandler) UpdateRSHosts(id core.RSChunkID, hosts []core.Tractserver
 	return pending.Res.(core.Error)
 }
 
-// UpdateStorageClass changes the storage class of a blob and removes metadata
-// related to other storage classes.
-func (h *StateHandler) UpdateStorageClass(id core.BlobID, target core.StorageClass, term uint64) core.Error {
-	pending := h.raft.ProposeIfTerm(cmdToBytes(UpdateStorageClassCommand{id, target}), term)
+// UnpackTract records that the RS-encoded tract 'id' has been copied to
+// 'hosts' with version 'newVersion'.
+func (h *StateHandler) UnpackTract(id core.TractID, newVersion int, hosts []core.TractserverID, term uint64) core.Error {
+	pending := h.raft.ProposeIfTerm(cmdToBytes(UnpackTractCommand{id, newVersion, hosts}), term)
+	select {
+	case <-time.After(core.ProposalTimeout):
+		return core.ErrRaftTimeout
+	case <-pending.Done:
+	}
+	if pending.Err != nil {
+		return core.FromRaftError(pending.Err)
+	}
+	return pending.Res.(core.Error)
+}
+
+// UpdateStorageClass changes the storage class of a blob at time 'now' and
+// removes metadata related to other storage classes.
+func (h *StateHandler) UpdateStorageClass(id core.BlobID, target core.StorageClass, now int64, term uint64) core.Error {
+	pending := h.raft.ProposeIfTerm(cmdToBytes(UpdateStorageClassCommand{id, target, now}), term)
 	select {
 	case <-time.After(core.ProposalTimeout):
 		return core.ErrRaftTimeout
@@ -611,6 +935,28 @@ func (h *StateHandler) GetKnownTSIDs() ([]core.TractserverID, core.Error) {
 	return txn.GetKnownTSIDs()
 }
 
+// AddStorageClass adds a storage class, or changes the name or placement of an
+// existing one.
+func (h *StateHandler) AddStorageClass(def *pb.StorageClassDef, term uint64) core.Error {
+	pending := h.raft.ProposeIfTerm(cmdToBytes(AddStorageClassCommand{def}), term)
+	select {
+	case <-time.After(core.ProposalTimeout):
+		return core.ErrRaftTimeout
+	case <-pending.Done:
+	}
+	if pending.Err != nil {
+		return core.FromRaftError(pending.Err)
+	}
+	return pending.Res.(core.Error)
+}
+
+// GetStorageClasses returns the definitions of all the storage classes.
+func (h *StateHandler) GetStorageClasses() []*pb.StorageClassDef {
+	txn := h.LocalReadOnlyTxn()
+	defer txn.Commit()
+	return txn.GetStorageClasses()
+}
+
 // ForEachBlob calls the given function for each blob in the database.
 // If includeDeleted is true, deleted blobs will be included, otherwise they'll
 // be skipped.
@@ -651,11 +997,18 @@ func (h *StateHandler) ForEachBlob(
 	}
 }
 
-// ForEachTract calls the given function for each tract in non-deleted blobs.
+// ForEachTract calls the given function for each tract that's stored on
+// tractservers: tracts in non-deleted blobs, and tracts of deleted blobs that
+// are still shared with clones. Tracts that clones share with their origin are
+// only visited once, as tracts of the origin.
 // inBetween is as in ForEachBlob.
 func (h *StateHandler) ForEachTract(tfunc func(core.TractID, *pb.Tract), inBetween func() bool) {
-	h.ForEachBlob(false, func(id core.BlobID, blob *pb.Blob) {
+	h.ForEachBlob(true, func(id core.BlobID, blob *pb.Blob) {
+		deleted := blob.GetDeleted() != 0
 		for i, tract := range blob.Tracts {
+			if tract.GetOrigin() != 0 || (deleted && len(tract.Clones) == 0) {
+				continue
+			}
 			tfunc(core.TractID{Blob: id, Index: core.TractKey(i)}, tract)
 		}
 	}, inBetween)
@@ -761,11 +1114,21 @@ func (h *StateHandler) CheckForGarbage(tsid core.TractserverID, tracts []core.Tr
 			// We're possibly creating it right now, or the tract only exists because of a failed create attempt.
 			// Either way, we don't GC it -- it's simpler to assume it's being actively created, and if it was a
 			// failed create attempt, we assume the attempt will eventually succeed.
+			//
+			// The exception is a tract that was truncated away. Any copy of it has a version at most
+			// TruncatedVersion, while a tract that's being created in its place starts above that.
 			if id.Index >= core.TractKey(len(blob.Tracts)) {
+				if id.Index < core.TractKey(blob.GetTruncatedTracts()) {
+					old = append(old, core.TractState{ID: id, Version: int(blob.GetTruncatedVersion())})
+				}
 				continue
 			}
 
 			// See if the set of hosts includes the host that reports having a copy of the tract.
+			//
+			// A tract that's still shared with an origin has no hosts and version zero. A
+			// tractserver only has a copy of it if a client is in the middle of unsharing it,
+			// and that copy has a higher version, so it's left alone.
 			t := blob.Tracts[id.Index]
 			contains := false
 			for i := range t.Hosts {
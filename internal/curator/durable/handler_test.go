@@ -6,6 +6,7 @@ package durable
 import (
 	"fmt"
 	"io/ioutil"
+	"strings"
 	"testing"
 	"time"
 
@@ -64,7 +65,7 @@ func TestGC(t *testing.T) {
 	}
 
 	// Create a blob.
-	id, e := h.CreateBlob(1, 123456789, 0, defHint, h.GetTerm())
+	id, e := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: defHint}, h.GetTerm())
 	if e != core.NoError {
 		t.Fatalf("couldn't create a blob to test GC with")
 	}
@@ -81,7 +82,7 @@ func TestGC(t *testing.T) {
 	}
 
 	// Add a tract with tsid as host.
-	if _, e = h.ExtendBlob(id, 0, [][]core.TractserverID{{tsid}}); e != core.NoError {
+	if _, e = h.ExtendBlob(id, 0, [][]core.TractserverID{{tsid}}, nil); e != core.NoError {
 		t.Fatalf("couldn't extend")
 	}
 
@@ -114,6 +115,44 @@ func TestGC(t *testing.T) {
 	}
 }
 
+// Test that tracts dropped by truncation are GC-ed.
+func TestGCTruncated(t *testing.T) {
+	h := newTestHandler(t)
+	h.Register(core.CuratorID(1))
+	h.AddPartition(core.PartitionID(1), h.GetTerm())
+
+	tsid := core.TractserverID(1)
+	id, _ := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: defHint}, h.GetTerm())
+	if _, e := h.ExtendBlob(id, 0, [][]core.TractserverID{{tsid}, {tsid}}, nil); e != core.NoError {
+		t.Fatalf("couldn't extend")
+	}
+	if _, e := h.TruncateBlob(id, core.TractLength); e != core.NoError {
+		t.Fatalf("couldn't truncate")
+	}
+
+	// The dropped tract looks like one that's being created, but it's old.
+	tid := core.TractID{Blob: id, Index: 1}
+	old, gone := h.CheckForGarbage(tsid, []core.TractID{tid, {Blob: id, Index: 2}})
+	if len(old) != 1 || old[0].ID != tid || old[0].Version != 1 || len(gone) != 0 {
+		t.Errorf("expected truncated tract to be old, got %+v %+v", old, gone)
+	}
+
+	// A tract that takes its place has a higher version, so it's safe to
+	// create it on the same host.
+	if versions, e := h.NewTractVersions(id, 1, 2); e != core.NoError || versions[0] != 2 || versions[1] != 1 {
+		t.Errorf("unexpected versions for new tracts: %v %s", versions, e)
+	}
+	if _, e := h.ExtendBlob(id, 1, [][]core.TractserverID{{tsid}}, nil); e != core.NoError {
+		t.Fatalf("couldn't extend")
+	}
+	if old, gone = h.CheckForGarbage(tsid, []core.TractID{tid}); len(old)+len(gone) != 0 {
+		t.Errorf("told to GC a live tract: %+v %+v", old, gone)
+	}
+	if old, _ = h.CheckForGarbage(core.TractserverID(2), []core.TractID{tid}); len(old) != 1 || old[0].Version != 2 {
+		t.Errorf("expected stray copy to be old, got %+v", old)
+	}
+}
+
 // Basic test for ListBlobs
 func TestList(t *testing.T) {
 	h := newTestHandler(t)
@@ -121,10 +160,10 @@ func TestList(t *testing.T) {
 	h.AddPartition(core.PartitionID(1), h.GetTerm())
 
 	// create a few blobs. assumes keys are assigned in order.
-	_, _ = h.CreateBlob(1, 123456789, 0, defHint, h.GetTerm())
-	id2, _ := h.CreateBlob(1, 123456789, 0, defHint, h.GetTerm())
-	id3, _ := h.CreateBlob(1, 123456789, 0, defHint, h.GetTerm())
-	id4, _ := h.CreateBlob(1, 123456789, 0, defHint, h.GetTerm())
+	_, _ = h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: defHint}, h.GetTerm())
+	id2, _ := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: defHint}, h.GetTerm())
+	id3, _ := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: defHint}, h.GetTerm())
+	id4, _ := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: defHint}, h.GetTerm())
 
 	// delete one
 	h.DeleteBlob(id3, time.Now(), h.GetTerm())
@@ -139,6 +178,91 @@ func TestList(t *testing.T) {
 	}
 }
 
+// Test ListBlobsWithInfo with filters.
+func TestListWithInfo(t *testing.T) {
+	h := newTestHandler(t)
+	h.Register(core.CuratorID(1))
+	h.AddPartition(core.PartitionID(1), h.GetTerm())
+
+	id1, _ := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: core.StorageHint_COLD}, h.GetTerm())
+	id2, _ := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: core.StorageHint_HOT}, h.GetTerm())
+	id3, _ := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: core.StorageHint_COLD}, h.GetTerm())
+	h.DeleteBlob(id3, time.Now(), h.GetTerm())
+
+	list := func(filter core.BlobFilter) (keys []core.BlobKey) {
+		keys, infos, _, more, err := h.ListBlobsWithInfo(core.PartitionID(1), 0, filter)
+		if err != core.NoError || more || len(infos) != len(keys) {
+			t.Fatalf("ListBlobsWithInfo error: %s, more %v", err, more)
+		}
+		return keys
+	}
+	if keys := list(core.BlobFilter{}); len(keys) != 2 || keys[0] != id1.ID() || keys[1] != id2.ID() {
+		t.Errorf("ListBlobsWithInfo wrong result: %v", keys)
+	}
+	if keys := list(core.BlobFilter{Hints: []core.StorageHint{core.StorageHint_COLD}}); len(keys) != 1 || keys[0] != id1.ID() {
+		t.Errorf("ListBlobsWithInfo wrong result for hint: %v", keys)
+	}
+	if keys := list(core.BlobFilter{Deleted: true}); len(keys) != 1 || keys[0] != id3.ID() {
+		t.Errorf("ListBlobsWithInfo wrong result for deleted: %v", keys)
+	}
+	if keys := list(core.BlobFilter{MTimeAfter: time.Now().Add(time.Hour)}); len(keys) != 0 {
+		t.Errorf("ListBlobsWithInfo wrong result for mtime: %v", keys)
+	}
+}
+
+// Test purging deleted blobs.
+func TestPurge(t *testing.T) {
+	h := newTestHandler(t)
+	h.Register(core.CuratorID(1))
+	h.AddPartition(core.PartitionID(1), h.GetTerm())
+
+	id, _ := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: defHint}, h.GetTerm())
+	if err := h.PurgeBlob(id, h.GetTerm()); err != core.ErrInvalidState {
+		t.Errorf("expected ErrInvalidState purging a live blob, got %s", err)
+	}
+	h.DeleteBlob(id, time.Now(), h.GetTerm())
+	if err := h.PurgeBlob(id, h.GetTerm()); err != core.NoError {
+		t.Fatalf("PurgeBlob error: %s", err)
+	}
+	if err := h.UndeleteBlob(id, h.GetTerm()); err != core.ErrNoSuchBlob {
+		t.Errorf("expected ErrNoSuchBlob undeleting a purged blob, got %s", err)
+	}
+	if err := h.PurgeBlob(id, h.GetTerm()); err != core.ErrNoSuchBlob {
+		t.Errorf("expected ErrNoSuchBlob purging twice, got %s", err)
+	}
+}
+
+// Test that applying commands records events.
+func TestEvents(t *testing.T) {
+	h := newTestHandler(t)
+	h.Register(core.CuratorID(1))
+	h.AddPartition(core.PartitionID(1), h.GetTerm())
+
+	id, _ := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: defHint}, h.GetTerm())
+	h.ExtendBlob(id, 0, [][]core.TractserverID{{1}}, nil)
+	h.DeleteBlob(id, time.Now(), h.GetTerm())
+	h.UndeleteBlob(id, h.GetTerm())
+	// This fails, so there's no event.
+	h.UndeleteBlob(core.BlobIDFromParts(1, 100), h.GetTerm())
+
+	events, next, err := h.GetEvents(core.PartitionID(1), 0)
+	if err != core.NoError {
+		t.Fatalf("GetEvents error: %s", err)
+	}
+	exp := []core.BlobEventType{core.BlobCreated, core.BlobWritten, core.BlobDeleted, core.BlobUndeleted}
+	if len(events) != len(exp) {
+		t.Fatalf("expected %d events, got %+v", len(exp), events)
+	}
+	for i, ev := range events {
+		if ev.Type != exp[i] || ev.Blob != id || (i > 0 && ev.Index <= events[i-1].Index) {
+			t.Errorf("unexpected event %d: %+v", i, ev)
+		}
+	}
+	if events, _, err = h.GetEvents(core.PartitionID(1), next); err != core.NoError || len(events) != 0 {
+		t.Errorf("expected no more events, got %+v, %s", events, err)
+	}
+}
+
 func TestReadOnly(t *testing.T) {
 	h := newTestHandler(t)
 	h.Register(core.CuratorID(1))
@@ -171,3 +295,93 @@ func TestReadOnly(t *testing.T) {
 		t.Fatal("add failed")
 	}
 }
+
+// Test namespace operations through raft.
+func TestNames(t *testing.T) {
+	ns := core.DefaultNamespacePartition
+	h := newTestHandler(t)
+	if _, err := h.Register(core.CuratorID(1)); err != core.NoError {
+		t.Fatalf("Failed to register: %v", err)
+	}
+
+	// We don't own the namespace partition yet.
+	if err := h.AddPartition(2, h.GetTerm()); err != core.NoError {
+		t.Fatalf("Failed to add partition: %v", err)
+	}
+	if _, err := h.CreateBlob(CreateBlobCommand{Repl: 1, Hint: defHint, Namespace: ns, Name: "x"}, h.GetTerm()); err != core.ErrWrongCurator {
+		t.Fatalf("expected ErrWrongCurator, got %s", err)
+	}
+	if err := h.AddPartition(ns, h.GetTerm()); err != core.NoError {
+		t.Fatalf("Failed to add partition: %v", err)
+	}
+
+	// Creating a blob binds its name in the same command.
+	id, e := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: defHint, Namespace: ns, Name: "dir/x"}, h.GetTerm())
+	if e != core.NoError {
+		t.Fatalf("couldn't create a blob: %s", e)
+	}
+	if got, err := h.LookupName(ns, "dir/x"); err != core.NoError || got != id {
+		t.Fatalf("lookup returned %v, %s", got, err)
+	}
+	if _, err := h.CreateBlob(CreateBlobCommand{Repl: 1, Hint: defHint, Namespace: ns, Name: "dir"}, h.GetTerm()); err != core.ErrAlreadyExists {
+		t.Fatalf("expected ErrAlreadyExists, got %s", err)
+	}
+	if err := h.Rename(ns, "dir/x", "dir/y", 0, "", h.GetTerm()); err != core.NoError {
+		t.Fatalf("rename failed: %s", err)
+	}
+	if got, err := h.LookupName(ns, "dir/y"); err != core.NoError || got != id {
+		t.Fatalf("lookup returned %v, %s", got, err)
+	}
+	entries, err := h.ListNames(ns, "dir/", "")
+	if err != core.NoError || len(entries) != 1 || entries[0] != (core.NameEntry{Name: "dir/y", Blob: id}) {
+		t.Fatalf("unexpected listing %v, %s", entries, err)
+	}
+	if err := h.UnbindName(ns, "dir/y", id, "", h.GetTerm()); err != core.NoError {
+		t.Fatalf("unbind failed: %s", err)
+	}
+	if _, err := h.LookupName(ns, "dir/y"); err != core.ErrNoSuchName {
+		t.Fatalf("expected ErrNoSuchName, got %s", err)
+	}
+
+	// Deleting a blob removes its names.
+	id, e = h.CreateBlob(CreateBlobCommand{Repl: 1, Hint: defHint, Namespace: ns, Name: "dir/z"}, h.GetTerm())
+	if e != core.NoError {
+		t.Fatalf("couldn't create a blob: %s", e)
+	}
+	if err := h.DeleteBlob(id, time.Now(), h.GetTerm()); err != core.NoError {
+		t.Fatalf("delete failed: %s", err)
+	}
+	if _, err := h.LookupName(ns, "dir/z"); err != core.ErrNoSuchName {
+		t.Fatalf("expected ErrNoSuchName, got %s", err)
+	}
+}
+
+// Test that invalid user metadata is rejected before it's proposed.
+func TestValidateMetadata(t *testing.T) {
+	h := newTestHandler(t)
+	if _, err := h.Register(core.CuratorID(1)); err != core.NoError {
+		t.Fatalf("Failed to register: %v", err)
+	}
+	if err := h.AddPartition(1, h.GetTerm()); err != core.NoError {
+		t.Fatalf("Failed to add partition: %v", err)
+	}
+
+	for _, key := range []string{"", "a=b", "a b", "\x00", strings.Repeat("k", core.MaxMetadataKeyLength+1)} {
+		md := map[string]string{key: "v"}
+		if _, err := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: defHint, Metadata: md}, h.GetTerm()); err != core.ErrInvalidArgument {
+			t.Errorf("key %q: expected ErrInvalidArgument, got %s", key, err)
+		}
+	}
+
+	id, err := h.CreateBlob(CreateBlobCommand{Repl: 1, InitialTime: 123456789, Hint: defHint, Metadata: map[string]string{"content-type": "text/plain"}}, h.GetTerm())
+	if err != core.NoError {
+		t.Fatalf("couldn't create a blob: %s", err)
+	}
+	big := core.BlobInfo{Metadata: map[string]string{"k": strings.Repeat("v", core.MaxMetadataSize)}}
+	if err := h.SetMetadata(id, big); err != core.ErrMetadataTooLarge {
+		t.Errorf("expected ErrMetadataTooLarge, got %s", err)
+	}
+	if info, err := h.Stat(id); err != core.NoError || info.Metadata["content-type"] != "text/plain" {
+		t.Errorf("unexpected stat %+v, %s", info, err)
+	}
+}
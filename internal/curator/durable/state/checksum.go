@@ -13,18 +13,20 @@ import (
 type ChecksumPosition struct {
 	Blob []byte
 	RS   []byte
+	Name []byte
 }
 
 var crcTable = crc64.MakeTable(crc64.ECMA)
 
 // Checksum computes a checksum of a portion of the state. Partitions and
-// metadata are always included, and a subset of the blob and RS data (since
+// metadata are always included, and a subset of the blob, RS, and name data (since
 // that can be very large).
 func (t *Txn) Checksum(start ChecksumPosition, n int) (checksum uint64, next ChecksumPosition) {
 	crc := checksumWholeBucket(0, t.txn.Bucket(metaBucket))
 	crc = checksumWholeBucket(crc, t.txn.Bucket(partitionBucket))
 	crc, next.Blob = checksumPartialBucket(crc, t.txn.Bucket(blobBucket), start.Blob, n)
 	crc, next.RS = checksumPartialBucket(crc, t.txn.Bucket(rschunkBucket), start.RS, n)
+	crc, next.Name = checksumPartialBucket(crc, t.txn.Bucket(nameBucket), start.Name, n)
 	return crc, next
 }
 
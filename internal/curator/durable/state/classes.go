@@ -0,0 +1,258 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package state
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/boltdb/bolt"
+
+	log "github.com/golang/glog"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
+	"github.com/westerndigitalcorporation/blb/internal/curator/storageclass"
+)
+
+// Keys in metaBucket:
+var (
+	classesKey = []byte("storage_classes")  // value is a pb.StorageClasses
+	schemaKey  = []byte("schema")           // value is a uint32
+	upgradeKey = []byte("upgrade_progress") // value is the key of the last blob upgraded
+)
+
+// schemaVersion is the version of the layout of the database. Open upgrades
+// older databases in place. The versions are:
+//
+// 0: Storage classes were hard-coded, and pb.Tract had a field for each one.
+// 1: Storage classes are defined in metaBucket, and tracts use ChunkPointers.
+// 2: RS chunks record their storage class.
+const schemaVersion = 2
+
+// How many blobs to upgrade in each transaction. A var for testing.
+var upgradeBatch = 1024
+
+// upgrade brings the database up to schemaVersion. Upgrades of blobs are done
+// in batches, one transaction each, and record how far they got in upgradeKey
+// so that they pick up from there if the curator restarts in the middle. The
+// upgrades only depend on what's in the database, so replicas that start with
+// the same state end up with the same state.
+func upgrade(db *bolt.DB) {
+	version := uint32(0)
+	if err := db.View(func(tx *bolt.Tx) error {
+		if v, ok := (&Txn{txn: tx, readOnly: true}).get(metaBucket, schemaKey); ok {
+			version = binary.BigEndian.Uint32(v)
+		}
+		return nil
+	}); err != nil {
+		log.Fatalf("failed to read schema version: %v", err)
+	}
+	if version > schemaVersion {
+		log.Fatalf("state has schema version %d, but we only know up to %d", version, schemaVersion)
+	}
+	if version == schemaVersion {
+		return
+	}
+
+	steps := []func(t *Txn) bool{
+		(*Txn).upgradeToClassDefs,
+		(*Txn).upgradeChunkClasses,
+	}
+	for v := version; v < schemaVersion; v++ {
+		for more := true; more; {
+			upgradeTxn(db, func(t *Txn) { more = steps[v](t) })
+		}
+		// Each step starts from the first blob.
+		upgradeTxn(db, func(t *Txn) {
+			var b [4]byte
+			binary.BigEndian.PutUint32(b[:], v+1)
+			t.put(metaBucket, schemaKey, b[:], defaultFillPct)
+			t.delete(metaBucket, upgradeKey)
+		})
+		log.Infof("upgraded state from schema version %d to %d", v, v+1)
+	}
+}
+
+// upgradeTxn runs 'f' in its own write transaction.
+func upgradeTxn(db *bolt.DB, f func(t *Txn)) {
+	if err := db.Update(func(tx *bolt.Tx) error {
+		f(&Txn{txn: tx})
+		return nil
+	}); err != nil {
+		log.Fatalf("failed to upgrade state: %v", err)
+	}
+}
+
+// upgradeToClassDefs stores the built-in storage classes, and moves the chunk
+// pointers of the next upgradeBatch blobs after the one in upgradeKey from
+// the old per-class fields to Chunks. It returns true if there are more blobs
+// to upgrade.
+func (t *Txn) upgradeToClassDefs() bool {
+	if _, ok := t.get(metaBucket, classesKey); !ok {
+		t.put(metaBucket, classesKey, mustMarshal(&pb.StorageClasses{Classes: storageclass.Builtin}), defaultFillPct)
+	}
+
+	// Changing the bucket invalidates the cursor, so collect the changes, and
+	// then make them.
+	var keys, vals [][]byte
+	var tracts int
+	more := t.upgradeBlobs(func(k []byte, blob *pb.Blob) {
+		moved := 0
+		for _, tract := range blob.Tracts {
+			moved += moveLegacyChunks(tract)
+		}
+		if moved > 0 {
+			keys = append(keys, append([]byte(nil), k...))
+			vals = append(vals, mustMarshal(blob))
+			tracts += moved
+		}
+	})
+	for i := range keys {
+		t.put(blobBucket, keys[i], vals[i], blobFillPct)
+	}
+	log.Infof("moved %d chunk pointers in %d blobs", tracts, len(keys))
+	return more
+}
+
+// upgradeChunkClasses sets the storage class of the RS chunks used by the next
+// upgradeBatch blobs after the one in upgradeKey, from the blobs' chunk
+// pointers. It returns true if there are more blobs to upgrade.
+func (t *Txn) upgradeChunkClasses() bool {
+	classes := make(map[string]core.StorageClass)
+	more := t.upgradeBlobs(func(k []byte, blob *pb.Blob) {
+		for _, tract := range blob.Tracts {
+			for _, p := range tract.Chunks {
+				classes[string(p.Chunk)] = p.Class
+			}
+		}
+	})
+	// Go in order so that every replica makes the same changes.
+	keys := make([]string, 0, len(classes))
+	for key := range classes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	chunks := 0
+	for _, key := range keys {
+		v, ok := t.get(rschunkBucket, []byte(key))
+		if !ok {
+			continue
+		}
+		var chunk pb.RSChunk
+		mustUnmarshal(v, &chunk)
+		if chunk.Class == core.StorageClass_REPLICATED {
+			chunk.Class = classes[key]
+			t.put(rschunkBucket, []byte(key), mustMarshal(&chunk), rsChunkFillPct)
+			chunks++
+		}
+	}
+	log.Infof("recorded the storage class of %d rs chunks", chunks)
+	return more
+}
+
+// upgradeBlobs calls 'f' with the next upgradeBatch blobs after the one in
+// upgradeKey, and records the last of them there. It returns true if there are
+// more blobs after them.
+func (t *Txn) upgradeBlobs(f func(k []byte, blob *pb.Blob)) bool {
+	cursor := t.txn.Bucket(blobBucket).Cursor()
+	k, v := cursor.First()
+	if last, ok := t.get(metaBucket, upgradeKey); ok {
+		if k, v = cursor.Seek(last); bytes.Equal(k, last) {
+			k, v = cursor.Next()
+		}
+	}
+	var last []byte
+	for n := 0; k != nil && n < upgradeBatch; k, v = cursor.Next() {
+		var blob pb.Blob
+		mustUnmarshal(v, &blob)
+		f(k, &blob)
+		last = append(last[:0], k...)
+		n++
+	}
+	if last != nil {
+		t.put(metaBucket, upgradeKey, last, defaultFillPct)
+	}
+	return k != nil
+}
+
+// moveLegacyChunks moves the chunk pointers in the old per-class fields of
+// 'tract' to Chunks, and returns how many there were.
+func moveLegacyChunks(tract *pb.Tract) int {
+	legacy := []struct {
+		id    core.StorageClass
+		chunk *[]byte
+	}{
+		{core.StorageClass_RS_6_3, &tract.Rs63Chunk},
+		{core.StorageClass_RS_8_3, &tract.Rs83Chunk},
+		{core.StorageClass_RS_10_3, &tract.Rs103Chunk},
+		{core.StorageClass_RS_12_5, &tract.Rs125Chunk},
+		{core.StorageClass_LRC_12_2_2, &tract.Lrc1222Chunk},
+	}
+	moved := 0
+	for _, l := range legacy {
+		if *l.chunk != nil {
+			tract.Chunks = append(tract.Chunks, &pb.ChunkPointer{Class: l.id, Chunk: *l.chunk})
+			*l.chunk = nil
+			moved++
+		}
+	}
+	return moved
+}
+
+// GetStorageClasses returns the definitions of all storage classes.
+func (t *Txn) GetStorageClasses() []*pb.StorageClassDef {
+	return t.classes().Defs()
+}
+
+// AddStorageClass adds a storage class. Classes can't be removed or have their
+// coding changed, since chunks depend on them, but the name and placement of an
+// existing class can be changed.
+func (t *Txn) AddStorageClass(def *pb.StorageClassDef) core.Error {
+	// The registry keeps the pointer, so don't share it with the caller.
+	copied := *def
+	def = &copied
+
+	defs := t.classes().Defs()
+	found := false
+	for i, old := range defs {
+		if old.Id != def.Id {
+			continue
+		}
+		if old.Family != def.Family || old.N != def.N || old.M != def.M ||
+			old.LocalGroups != def.LocalGroups || old.PieceLength != def.PieceLength {
+			return core.ErrConflictingState
+		}
+		defs[i], found = def, true
+	}
+	if !found {
+		defs = append(defs, def)
+	}
+
+	reg, err := storageclass.NewRegistry(defs)
+	if err != nil {
+		log.Errorf("can't add storage class %+v: %s", def, err)
+		return core.ErrInvalidArgument
+	}
+	t.put(metaBucket, classesKey, mustMarshal(&pb.StorageClasses{Classes: defs}), defaultFillPct)
+	t.reg = reg
+	return core.NoError
+}
+
+// classes returns the storage classes in the state.
+func (t *Txn) classes() *storageclass.Registry {
+	if t.reg != nil {
+		return t.reg
+	}
+	var classes pb.StorageClasses
+	if v, ok := t.get(metaBucket, classesKey); ok {
+		mustUnmarshal(v, &classes)
+	}
+	reg, err := storageclass.NewRegistry(classes.Classes)
+	if err != nil {
+		log.Fatalf("invalid storage classes in state: %s", err)
+	}
+	t.reg = reg
+	return reg
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package state
+
+import (
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
+)
+
+// Clones share tracts with the blob they were cloned from. A shared tract
+// belongs to one blob, its origin, and the data is stored on tractservers
+// under the origin's tract ID. The origin's tract lists the clones that share
+// it, and each clone's tract points back at the origin. Tracts are matched up
+// by index, so a clone's tract i is always the origin's tract i.
+//
+// Shared tracts are never written. Before either side writes one, the curator
+// has the data copied into a new tract that belongs to one of the clones and
+// commits it with UnshareTract, repeating until the tract isn't shared.
+// Clients that had the tracts cached before the blob was cloned don't know
+// that they're shared, so the curator first gives the tracts a new version on
+// their tractservers, and records it with FenceTracts. Writes with the cached
+// version fail, and the client finds out about the clone when it gets the
+// tracts again.
+//
+// An origin blob that's deleted keeps its record, and its tracts stay on
+// tractservers, until no clones share its tracts anymore.
+
+// CloneBlob creates blob 'dst' that shares all of the tracts of 'src'. Tracts
+// that 'src' itself shares with an origin are shared with that origin, so
+// there's only ever one level of sharing. The clone is owned by 'owner', has
+// the same ACL, isn't write-once, and doesn't expire.
+func (t *Txn) CloneBlob(src, dst core.BlobID, owner string, now int64) core.Error {
+	b := t.GetBlob(src)
+	if b == nil {
+		return core.ErrNoSuchBlob
+	}
+
+	clone := &pb.Blob{
+		Storage:      b.Storage,
+		ClassTime:    b.ClassTime,
+		Hint:         b.Hint,
+		Repl:         b.Repl,
+		Mtime:        &now,
+		Atime:        &now,
+		AppendOffset: b.AppendOffset,
+		Readers:      b.Readers,
+		Writers:      b.Writers,
+	}
+	if owner != "" {
+		clone.Owner = proto.String(owner)
+	}
+	if len(b.Metadata) > 0 {
+		clone.Metadata = make(map[string]string)
+		for k, v := range b.Metadata {
+			clone.Metadata[k] = v
+		}
+	}
+
+	origins := map[core.BlobID]*pb.Blob{src: b}
+	for i, tract := range b.Tracts {
+		origin := src
+		if o := tract.GetOrigin(); o != 0 {
+			origin = o
+			tract = t.originTract(origins, o, i)
+		}
+		tract.Clones = append(tract.Clones, dst)
+		clone.Tracts = append(clone.Tracts, &pb.Tract{Origin: &origin})
+	}
+	for id, blob := range origins {
+		t.PutBlob(id, blob)
+	}
+	t.PutBlob(dst, clone)
+	return core.NoError
+}
+
+// FenceTracts records that the tracts of 'id' that are about to become shared
+// have new versions on their tractservers. versions[i] is the new version of
+// tract i, which must be one more than its current version, or zero if the
+// tract keeps its version. If 'versions' is empty, nothing changes.
+func (t *Txn) FenceTracts(id core.BlobID, versions []int) core.Error {
+	if len(versions) == 0 {
+		return core.NoError
+	}
+	b := t.GetBlob(id)
+	if b == nil {
+		return core.ErrNoSuchBlob
+	}
+	if len(versions) != len(b.Tracts) {
+		return core.ErrConflictingState
+	}
+	for i, v := range versions {
+		tract := b.Tracts[i]
+		if v != 0 && (tract.GetOrigin() != 0 || len(tract.Clones) > 0 || len(tract.Chunks) > 0 || tract.Version+1 != v) {
+			return core.ErrConflictingState
+		}
+	}
+	for i, v := range versions {
+		if v != 0 {
+			b.Tracts[i].Version = v
+		}
+	}
+	t.PutBlob(id, b)
+	return core.NoError
+}
+
+// UnshareTarget finds a blob that should get its own copy of the shared tract
+// 'id', which may belong to the origin or to a clone. It returns that blob and
+// its replication factor, along with the shared tract the data should be copied
+// from. If the tract isn't shared, 'shared' is false.
+func (t *Txn) UnshareTarget(id core.TractID) (target core.BlobID, repl int, source core.TractInfo, shared bool, err core.Error) {
+	b := t.GetBlob(id.Blob)
+	if b == nil {
+		return 0, 0, core.TractInfo{}, false, core.ErrNoSuchBlob
+	}
+	if int(id.Index) >= len(b.Tracts) {
+		return 0, 0, core.TractInfo{}, false, core.ErrNoSuchTract
+	}
+
+	tract := b.Tracts[id.Index]
+	if o := tract.GetOrigin(); o != 0 {
+		// Writing to a clone, it gets the copy.
+		target, id.Blob = id.Blob, o
+		tract = t.originTract(make(map[core.BlobID]*pb.Blob), o, int(id.Index))
+	} else if len(tract.Clones) > 0 {
+		// Writing to the origin, all clones need copies. Deleted clones keep
+		// sharing until they're purged, so that undeleting them brings back
+		// the data they had, but live ones go first.
+		target = tract.Clones[0]
+		for _, c := range tract.Clones {
+			if clone := t.GetBlobAll(c); clone != nil && clone.GetDeleted() == 0 {
+				target = c
+				break
+			}
+		}
+	} else {
+		return 0, 0, core.TractInfo{}, false, core.NoError
+	}
+	targetBlob := t.GetBlobAll(target)
+	if targetBlob == nil {
+		log.Errorf("blob %s shares tract %s but doesn't exist", target, id)
+		return 0, 0, core.TractInfo{}, false, core.ErrNoSuchBlob
+	}
+	return target, int(targetBlob.GetRepl()), t.tractInfo(id, tract), true, core.NoError
+}
+
+// UnshareTract gives a clone its own copy of the tract 'id', which was shared
+// with the origin, stored on 'hosts'.
+func (t *Txn) UnshareTract(id core.TractID, hosts []core.TractserverID) core.Error {
+	// Deleted clones get copies too. See UnshareTarget.
+	b := t.GetBlobAll(id.Blob)
+	if b == nil {
+		return core.ErrNoSuchBlob
+	}
+	if int(id.Index) >= len(b.Tracts) {
+		return core.ErrNoSuchTract
+	}
+	if len(hosts) != int(b.GetRepl()) {
+		return core.ErrInvalidArgument
+	}
+
+	tract := b.Tracts[id.Index]
+	o := tract.GetOrigin()
+	if o == 0 {
+		// Someone else unshared it first.
+		return core.ErrConflictingState
+	}
+	origin := t.GetBlobAll(o)
+	shared := origin.Tracts[id.Index]
+	shared.Clones = removeBlobID(shared.Clones, id.Blob)
+
+	b.Tracts[id.Index] = &pb.Tract{
+		Hosts:          hosts,
+		Version:        1,
+		Checksum:       shared.Checksum,
+		ChecksumLength: shared.ChecksumLength,
+	}
+	t.PutBlob(o, origin)
+	t.PutBlob(id.Blob, b)
+	return core.NoError
+}
+
+// GetTract returns the tract 'id' as it's stored on tractservers. Unlike
+// GetTracts, it doesn't follow shared tracts to their origin, and it finds the
+// tracts of deleted blobs that are kept for their clones.
+func (t *Txn) GetTract(id core.TractID) (core.TractInfo, core.Error) {
+	b := t.GetStoredBlob(id.Blob)
+	if b == nil {
+		return core.TractInfo{}, core.ErrNoSuchBlob
+	}
+	if int(id.Index) >= len(b.Tracts) || b.Tracts[id.Index].GetOrigin() != 0 {
+		return core.TractInfo{}, core.ErrNoSuchTract
+	}
+	return t.tractInfo(id, b.Tracts[id.Index]), core.NoError
+}
+
+// GetStoredBlob is like GetBlob, but also returns deleted blobs that still
+// share tracts with clones, since their tracts need to be maintained.
+func (t *Txn) GetStoredBlob(id core.BlobID) *pb.Blob {
+	b := t.GetBlobAll(id)
+	if b == nil || (b.GetDeleted() != 0 && !HasClones(b)) {
+		return nil
+	}
+	return b
+}
+
+// HasClones returns true if any tracts of 'b' are shared with clones.
+func HasClones(b *pb.Blob) bool {
+	for _, tract := range b.Tracts {
+		if len(tract.Clones) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// IsShared returns true if any tracts of 'b' are shared, either with clones or
+// with an origin.
+func IsShared(b *pb.Blob) bool {
+	for _, tract := range b.Tracts {
+		if len(tract.Clones) > 0 || tract.GetOrigin() != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// releaseSharedTracts removes 'id' from the clones of all tracts it shares.
+func (t *Txn) releaseSharedTracts(id core.BlobID, b *pb.Blob) {
+	origins := make(map[core.BlobID]*pb.Blob)
+	for i, tract := range b.Tracts {
+		if o := tract.GetOrigin(); o != 0 {
+			shared := t.originTract(origins, o, i)
+			shared.Clones = removeBlobID(shared.Clones, id)
+		}
+	}
+	for o, origin := range origins {
+		t.PutBlob(o, origin)
+	}
+}
+
+// originTract returns tract 'i' of the blob 'origin', loading the blob into
+// 'origins' if it isn't there yet so that the caller can modify and put it.
+func (t *Txn) originTract(origins map[core.BlobID]*pb.Blob, origin core.BlobID, i int) *pb.Tract {
+	b, ok := origins[origin]
+	if !ok {
+		// The origin may be deleted, but it's kept while shared.
+		if b = t.GetBlobAll(origin); b == nil || i >= len(b.Tracts) {
+			log.Fatalf("bug: tract %d of origin %s is missing", i, origin)
+		}
+		origins[origin] = b
+	}
+	return b.Tracts[i]
+}
+
+func removeBlobID(ids []core.BlobID, id core.BlobID) []core.BlobID {
+	for i := range ids {
+		if ids[i] == id {
+			return append(ids[:i], ids[i+1:]...)
+		}
+	}
+	return ids
+}
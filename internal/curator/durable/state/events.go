@@ -0,0 +1,118 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package state
+
+import (
+	"encoding/binary"
+
+	log "github.com/golang/glog"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+// Events are kept in eventBucket, keyed by the raft index of the command that
+// caused them followed by the partition, so the oldest ones are easy to trim.
+// The value is all the events for that partition from that command. The
+// bucket isn't included in checksums, since replicas running older code don't
+// record events.
+//
+// We only keep events from the most recent eventRetention raft entries. We
+// trim as part of applying commands, so all replicas trim the same way.
+const eventRetention = 1 << 20
+
+const (
+	eventKeyLen = 12
+	eventLen    = 10
+)
+
+// Key in eventBucket that holds the highest raft index we've trimmed events
+// from. It's not eventKeyLen long, so it can't be confused with an event key.
+var eventsTrimmedKey = []byte("trimmed")
+
+func eventKey(index uint64, partition core.PartitionID) []byte {
+	var key [eventKeyLen]byte
+	binary.BigEndian.PutUint64(key[0:8], index)
+	binary.BigEndian.PutUint32(key[8:12], uint32(partition))
+	return key[:]
+}
+
+func appendEvent(b []byte, ev core.BlobEvent) []byte {
+	var e [eventLen]byte
+	e[0] = byte(ev.Type)
+	binary.BigEndian.PutUint64(e[1:9], uint64(ev.Blob))
+	e[9] = byte(ev.Class)
+	return append(b, e[:]...)
+}
+
+func decodeEvents(index uint64, b []byte, events []core.BlobEvent) []core.BlobEvent {
+	for ; len(b) >= eventLen; b = b[eventLen:] {
+		events = append(events, core.BlobEvent{
+			Type:  core.BlobEventType(b[0]),
+			Blob:  core.BlobID(binary.BigEndian.Uint64(b[1:9])),
+			Class: core.StorageClass(b[9]),
+			Index: index,
+		})
+	}
+	return events
+}
+
+// AddEvent records that the command being applied in this transaction caused
+// 'ev'. It also trims events that are too old.
+func (t *Txn) AddEvent(ev core.BlobEvent) {
+	key := eventKey(t.index, ev.Blob.Partition())
+	old, _ := t.get(eventBucket, key)
+	t.put(eventBucket, key, appendEvent(append([]byte(nil), old...), ev), eventFillPct)
+
+	if t.index > eventRetention {
+		t.trimEvents(t.index - eventRetention)
+	}
+}
+
+// trimEvents removes events from commands at or before 'index'.
+func (t *Txn) trimEvents(index uint64) {
+	c := t.txn.Bucket(eventBucket).Cursor()
+	var trimmed uint64
+	for k, _ := c.First(); k != nil && len(k) == eventKeyLen; k, _ = c.First() {
+		i := binary.BigEndian.Uint64(k[0:8])
+		if i > index {
+			break
+		}
+		if err := c.Delete(); err != nil {
+			log.Fatalf("Failed to delete from db: %v", err)
+		}
+		trimmed = i
+	}
+	if trimmed != 0 {
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], trimmed)
+		t.put(eventBucket, eventsTrimmedKey, b[:], eventFillPct)
+	}
+}
+
+// GetEvents returns events in 'partition' from commands after raft index
+// 'after'. It stops after looking at about 'maxScan' records, or when it has
+// at least 'maxResults' events. The caller should continue from 'next'. If
+// events after 'after' have been trimmed, it returns ErrEventsTrimmed, unless
+// 'after' is zero.
+func (t *Txn) GetEvents(partition core.PartitionID, after uint64, maxResults, maxScan int) (events []core.BlobEvent, next uint64, err core.Error) {
+	if b, ok := t.get(eventBucket, eventsTrimmedKey); ok && after != 0 && after < binary.BigEndian.Uint64(b) {
+		return nil, after, core.ErrEventsTrimmed
+	}
+
+	next = after
+	c := t.txn.Bucket(eventBucket).Cursor()
+	k, v := c.Seek(eventKey(after+1, 0))
+	for scanned := 0; k != nil && len(k) == eventKeyLen; scanned++ {
+		// Only stop between raft indices, since we continue after 'next'.
+		index := binary.BigEndian.Uint64(k[0:8])
+		if index != next && (len(events) >= maxResults || scanned >= maxScan) {
+			break
+		}
+		if core.PartitionID(binary.BigEndian.Uint32(k[8:12])) == partition {
+			events = decodeEvents(index, v, events)
+		}
+		next = index
+		k, v = c.Next()
+	}
+	return events, next, core.NoError
+}
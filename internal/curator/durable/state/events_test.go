@@ -0,0 +1,71 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package state
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+// Test adding, getting, and trimming events.
+func TestEvents(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+
+	b1, b2 := core.BlobIDFromParts(1, 1), core.BlobIDFromParts(2, 1)
+	add := func(index uint64, evs ...core.BlobEvent) {
+		txn := s.WriteTxn(index)
+		for _, ev := range evs {
+			txn.AddEvent(ev)
+		}
+		txn.Commit()
+	}
+	add(5, core.BlobEvent{Type: core.BlobCreated, Blob: b1}, core.BlobEvent{Type: core.BlobCreated, Blob: b2})
+	add(6, core.BlobEvent{Type: core.BlobClassChanged, Blob: b1, Class: core.StorageClass_RS_6_3})
+	add(8, core.BlobEvent{Type: core.BlobDeleted, Blob: b2}, core.BlobEvent{Type: core.BlobUndeleted, Blob: b2})
+
+	get := func(partition core.PartitionID, after uint64, maxResults int) ([]core.BlobEvent, uint64, core.Error) {
+		txn := s.ReadOnlyTxn()
+		defer txn.Commit()
+		return txn.GetEvents(partition, after, maxResults, 100)
+	}
+
+	events, next, err := get(1, 0, 100)
+	exp := []core.BlobEvent{
+		{Type: core.BlobCreated, Blob: b1, Index: 5},
+		{Type: core.BlobClassChanged, Blob: b1, Class: core.StorageClass_RS_6_3, Index: 6},
+	}
+	if err != core.NoError || next != 8 || !reflect.DeepEqual(events, exp) {
+		t.Errorf("got %+v, %d, %s; expected %+v", events, next, err, exp)
+	}
+
+	// Both events from index 8 come back together even though we asked for one.
+	events, next, err = get(2, 5, 1)
+	exp = []core.BlobEvent{
+		{Type: core.BlobDeleted, Blob: b2, Index: 8},
+		{Type: core.BlobUndeleted, Blob: b2, Index: 8},
+	}
+	if err != core.NoError || next != 8 || !reflect.DeepEqual(events, exp) {
+		t.Errorf("got %+v, %d, %s; expected %+v", events, next, err, exp)
+	}
+	if events, next, err = get(2, 8, 100); err != core.NoError || next != 8 || len(events) != 0 {
+		t.Errorf("expected no more events, got %+v, %d, %s", events, next, err)
+	}
+
+	// Adding an event far enough ahead trims the old ones.
+	add(6+eventRetention, core.BlobEvent{Type: core.BlobWritten, Blob: b1})
+	if _, _, err = get(1, 5, 100); err != core.ErrEventsTrimmed {
+		t.Errorf("expected ErrEventsTrimmed, got %s", err)
+	}
+	events, next, err = get(1, 0, 100)
+	exp = []core.BlobEvent{{Type: core.BlobWritten, Blob: b1, Index: 6 + eventRetention}}
+	if err != core.NoError || next != 6+eventRetention || !reflect.DeepEqual(events, exp) {
+		t.Errorf("got %+v, %d, %s; expected %+v", events, next, err, exp)
+	}
+	if events, _, err = get(2, 6, 100); err != core.NoError || len(events) != 2 {
+		t.Errorf("expected events at index 8, got %+v, %s", events, err)
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package state
+
+import (
+	"strings"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+// The namespace belongs to a partition that's picked by configuration, usually
+// core.DefaultNamespacePartition. Only the curator that owns that partition
+// stores names, and every namespace operation says which partition it expects.
+//
+// The name bucket maps full blob names (as raw bytes) to blob IDs (encoded with
+// blobID2Key), followed by the identity of the client that bound the name, if
+// there was one. Directories are implicit, so bolt's key ordering gives us
+// per-directory listings with a single cursor.
+//
+// A name is either a blob or a directory, never both: "a" and "a/b" can't be
+// bound at the same time.
+//
+// Named blobs are always stored by the curator that owns the namespace, so the
+// blob name bucket can index the names of each blob by blobID2Key(id)+name,
+// with empty values. Deleting a blob removes its names.
+
+// nameValue returns the value stored for a name bound to 'id' by 'binder'.
+func nameValue(id core.BlobID, binder string) []byte {
+	return append(blobID2Key(id), binder...)
+}
+
+// parseNameValue is the reverse of nameValue.
+func parseNameValue(v []byte) (core.BlobID, string) {
+	return key2BlobID(v[:8]), string(v[8:])
+}
+
+// blobNameKey returns the key in the blob name bucket for 'name' of 'id'.
+func blobNameKey(id core.BlobID, name string) []byte {
+	return append(blobID2Key(id), name...)
+}
+
+// mayChangeName returns core.ErrPermissionDenied if 'caller' may not move or
+// remove a name bound by 'binder'. Names bound without an identity, and
+// callers without one, aren't checked.
+func mayChangeName(binder, caller string) core.Error {
+	if binder != "" && caller != "" && binder != caller {
+		return core.ErrPermissionDenied
+	}
+	return core.NoError
+}
+
+// ownsNamespace returns true if this curator stores the blob namespace, which
+// belongs to the partition 'ns'.
+func (t *Txn) ownsNamespace(ns core.PartitionID) bool {
+	return t.GetPartition(ns) != nil
+}
+
+// nameConflicts returns true if binding 'name' would make a path both a blob
+// and a directory, i.e. if a parent directory of 'name' is bound, or 'name' is
+// a directory that contains names. A name equal to 'ignore' doesn't count;
+// it's being moved out of the way. 'name' may end with core.NameSeparator, in
+// which case it's a directory itself.
+func (t *Txn) nameConflicts(name, ignore string) bool {
+	for i := strings.Index(name, core.NameSeparator); i >= 0; {
+		if parent := name[:i]; parent != ignore {
+			if _, ok := t.get(nameBucket, []byte(parent)); ok {
+				return true
+			}
+		}
+		j := strings.Index(name[i+1:], core.NameSeparator)
+		if j < 0 {
+			break
+		}
+		i += j + 1
+	}
+
+	dir := strings.TrimSuffix(name, core.NameSeparator) + core.NameSeparator
+	c := t.txn.Bucket(nameBucket).Cursor()
+	for k, _ := c.Seek([]byte(dir)); k != nil && strings.HasPrefix(string(k), dir); k, _ = c.Next() {
+		if string(k) != ignore {
+			return true
+		}
+	}
+	return false
+}
+
+// bindName binds 'name' to 'id' for 'binder' without any checks.
+func (t *Txn) bindName(name string, id core.BlobID, binder string) {
+	t.put(nameBucket, []byte(name), nameValue(id, binder), defaultFillPct)
+	t.put(blobNameBucket, blobNameKey(id, name), nil, defaultFillPct)
+}
+
+// unbindName removes the binding of 'name' to 'id' without any checks.
+func (t *Txn) unbindName(name string, id core.BlobID) {
+	t.delete(nameBucket, []byte(name))
+	t.delete(blobNameBucket, blobNameKey(id, name))
+}
+
+// CheckBindName returns an error if 'name' can't be bound to a new blob in the
+// namespace of partition 'ns'.
+func (t *Txn) CheckBindName(ns core.PartitionID, name string) core.Error {
+	if !t.ownsNamespace(ns) {
+		return core.ErrWrongCurator
+	}
+	if _, ok := t.get(nameBucket, []byte(name)); ok || t.nameConflicts(name, "") {
+		return core.ErrAlreadyExists
+	}
+	return core.NoError
+}
+
+// BindName binds 'name' to the blob 'id' on behalf of 'binder'. It fails if the
+// name is already bound or conflicts with a directory. The blob must be stored
+// by this curator, so that deleting it can remove the name.
+func (t *Txn) BindName(ns core.PartitionID, name string, id core.BlobID, binder string) core.Error {
+	if err := t.CheckBindName(ns, name); err != core.NoError {
+		return err
+	}
+	if t.GetBlob(id) == nil {
+		return core.ErrNoSuchBlob
+	}
+	t.bindName(name, id, binder)
+	return core.NoError
+}
+
+// LookupName returns the blob that 'name' is bound to.
+func (t *Txn) LookupName(ns core.PartitionID, name string) (core.BlobID, core.Error) {
+	if !t.ownsNamespace(ns) {
+		return 0, core.ErrWrongCurator
+	}
+	v, ok := t.get(nameBucket, []byte(name))
+	if !ok {
+		return 0, core.ErrNoSuchName
+	}
+	id, _ := parseNameValue(v)
+	return id, core.NoError
+}
+
+// RenameName moves the binding of 'from' to 'to' on behalf of 'caller'. If
+// 'replace' is non-zero, 'to' must be bound to it, and that binding is
+// replaced; otherwise 'to' must not be bound. It also fails if 'from' isn't
+// bound, or 'caller' didn't bind the names.
+//
+// If 'from' and 'to' end with core.NameSeparator, every name in the directory
+// 'from' is moved to the directory 'to', which must not exist yet. Directories
+// can't replace anything.
+func (t *Txn) RenameName(ns core.PartitionID, from, to string, replace core.BlobID, caller string) core.Error {
+	if !t.ownsNamespace(ns) {
+		return core.ErrWrongCurator
+	}
+	if strings.HasSuffix(from, core.NameSeparator) {
+		return t.renameDir(from, to, replace, caller)
+	}
+	v, ok := t.get(nameBucket, []byte(from))
+	if !ok {
+		return core.ErrNoSuchName
+	}
+	// Bolt values are only valid for the life of the transaction and may not
+	// survive a mutation, so copy before we delete.
+	id, binder := parseNameValue(v)
+	if err := mayChangeName(binder, caller); err != core.NoError {
+		return err
+	}
+	var replaced core.BlobID
+	if v, ok := t.get(nameBucket, []byte(to)); ok && replace == 0 {
+		return core.ErrAlreadyExists
+	} else if replace != 0 {
+		if !ok {
+			return core.ErrConflictingState
+		}
+		bound, binder := parseNameValue(v)
+		if bound != replace {
+			return core.ErrConflictingState
+		}
+		if err := mayChangeName(binder, caller); err != core.NoError {
+			return err
+		}
+		replaced = bound
+	}
+	if t.nameConflicts(to, from) {
+		return core.ErrAlreadyExists
+	}
+	if replaced != 0 {
+		t.unbindName(to, replaced)
+	}
+	t.unbindName(from, id)
+	t.bindName(to, id, binder)
+	return core.NoError
+}
+
+// renameDir moves every name in the directory 'from' to the directory 'to'.
+func (t *Txn) renameDir(from, to string, replace core.BlobID, caller string) core.Error {
+	if !strings.HasSuffix(to, core.NameSeparator) || strings.HasPrefix(to, from) || replace != 0 {
+		return core.ErrInvalidArgument
+	}
+
+	type binding struct {
+		name, binder string
+		id           core.BlobID
+	}
+	var moved []binding
+	c := t.txn.Bucket(nameBucket).Cursor()
+	for k, v := c.Seek([]byte(from)); k != nil && strings.HasPrefix(string(k), from); k, v = c.Next() {
+		id, binder := parseNameValue(v)
+		if err := mayChangeName(binder, caller); err != core.NoError {
+			return err
+		}
+		moved = append(moved, binding{name: string(k), binder: binder, id: id})
+	}
+	if len(moved) == 0 {
+		return core.ErrNoSuchName
+	}
+	if t.nameConflicts(to, "") {
+		return core.ErrAlreadyExists
+	}
+
+	for _, b := range moved {
+		t.unbindName(b.name, b.id)
+		t.bindName(to+b.name[len(from):], b.id, b.binder)
+	}
+	return core.NoError
+}
+
+// UnbindName removes 'name' from the namespace on behalf of 'caller'. If 'id'
+// is non-zero, the name is only removed if it's bound to that blob.
+func (t *Txn) UnbindName(ns core.PartitionID, name string, id core.BlobID, caller string) core.Error {
+	if !t.ownsNamespace(ns) {
+		return core.ErrWrongCurator
+	}
+	v, ok := t.get(nameBucket, []byte(name))
+	if !ok {
+		return core.ErrNoSuchName
+	}
+	bound, binder := parseNameValue(v)
+	if id != 0 && bound != id {
+		return core.ErrConflictingState
+	}
+	if err := mayChangeName(binder, caller); err != core.NoError {
+		return err
+	}
+	t.unbindName(name, bound)
+	return core.NoError
+}
+
+// unbindBlob removes every name bound to 'id'.
+func (t *Txn) unbindBlob(id core.BlobID) {
+	prefix := blobID2Key(id)
+	var names []string
+	c := t.txn.Bucket(blobNameBucket).Cursor()
+	for k, _ := c.Seek(prefix); k != nil && strings.HasPrefix(string(k), string(prefix)); k, _ = c.Next() {
+		names = append(names, string(k[len(prefix):]))
+	}
+	for _, name := range names {
+		t.unbindName(name, id)
+	}
+}
+
+// ListNames returns up to 'n' entries directly under 'prefix' that sort after
+// 'start'. Names in subdirectories are collapsed into one entry for the
+// subdirectory, whose name ends with core.NameSeparator.
+func (t *Txn) ListNames(ns core.PartitionID, prefix, start string, n int) (entries []core.NameEntry, err core.Error) {
+	if !t.ownsNamespace(ns) {
+		return nil, core.ErrWrongCurator
+	}
+	seek := prefix
+	if start != "" && start >= seek {
+		seek = skipName(start)
+	}
+	c := t.txn.Bucket(nameBucket).Cursor()
+	k, v := c.Seek([]byte(seek))
+	for k != nil && len(entries) < n {
+		name := string(k)
+		if !strings.HasPrefix(name, prefix) {
+			break
+		}
+		if i := strings.Index(name[len(prefix):], core.NameSeparator); i >= 0 {
+			dir := name[:len(prefix)+i+1]
+			entries = append(entries, core.NameEntry{Name: dir})
+			k, v = c.Seek([]byte(skipName(dir)))
+			continue
+		}
+		id, _ := parseNameValue(v)
+		entries = append(entries, core.NameEntry{Name: name, Blob: id})
+		k, v = c.Next()
+	}
+	return entries, core.NoError
+}
+
+// skipName returns the smallest key that sorts after 'name' and, if 'name' is
+// a directory, everything in it.
+func skipName(name string) string {
+	if strings.HasSuffix(name, core.NameSeparator) {
+		return name[:len(name)-1] + string(core.NameSeparator[0]+1)
+	}
+	return name + "\x00"
+}
@@ -0,0 +1,346 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package state
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
+)
+
+// Test that names can only be used on the curator that owns the namespace.
+func TestNamesWrongCurator(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+
+	txn := s.WriteTxn(1)
+	defer txn.Commit()
+	txn.PutPartition(&pb.Partition{Id: proto.Uint32(2)})
+
+	if err := txn.BindName(testNS, "a", core.BlobIDFromParts(2, 1), ""); err != core.ErrWrongCurator {
+		t.Errorf("expected ErrWrongCurator, got %s", err)
+	}
+	if _, err := txn.LookupName(testNS, "a"); err != core.ErrWrongCurator {
+		t.Errorf("expected ErrWrongCurator, got %s", err)
+	}
+
+	// The namespace can be configured to live in partition 2 instead.
+	putBlobs(txn, core.BlobIDFromParts(2, 1))
+	if err := txn.BindName(2, "a", core.BlobIDFromParts(2, 1), ""); err != core.NoError {
+		t.Errorf("bind failed: %s", err)
+	}
+	if id, err := txn.LookupName(2, "a"); err != core.NoError || id != core.BlobIDFromParts(2, 1) {
+		t.Errorf("bad lookup: %s %s", id, err)
+	}
+}
+
+// The namespace partition used by tests.
+const testNS = core.DefaultNamespacePartition
+
+// putBlobs adds empty blobs with the given IDs.
+func putBlobs(txn *Txn, ids ...core.BlobID) {
+	for _, id := range ids {
+		txn.PutBlob(id, &pb.Blob{Repl: proto.Uint32(1)})
+	}
+}
+
+// Test binding, renaming, and unbinding names.
+func TestNamesBasics(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+
+	txn := s.WriteTxn(1)
+	defer txn.Commit()
+	txn.PutPartition(&pb.Partition{Id: proto.Uint32(uint32(testNS))})
+	txn.PutPartition(&pb.Partition{Id: proto.Uint32(5)})
+
+	b1, b2 := core.BlobIDFromParts(1, 1), core.BlobIDFromParts(5, 7)
+	if err := txn.BindName(testNS, "a", b1, ""); err != core.ErrNoSuchBlob {
+		t.Fatalf("expected ErrNoSuchBlob, got %s", err)
+	}
+	putBlobs(txn, b1, b2)
+	if err := txn.BindName(testNS, "a/b", b1, ""); err != core.NoError {
+		t.Fatalf("bind failed: %s", err)
+	}
+	if err := txn.BindName(testNS, "c", b2, ""); err != core.NoError {
+		t.Fatalf("bind failed: %s", err)
+	}
+	if err := txn.BindName(testNS, "a/b", b2, ""); err != core.ErrAlreadyExists {
+		t.Fatalf("expected ErrAlreadyExists, got %s", err)
+	}
+	if id, err := txn.LookupName(testNS, "a/b"); err != core.NoError || id != b1 {
+		t.Fatalf("lookup returned %v, %s", id, err)
+	}
+	if _, err := txn.LookupName(testNS, "a"); err != core.ErrNoSuchName {
+		t.Fatalf("expected ErrNoSuchName, got %s", err)
+	}
+
+	// Renaming onto an existing name fails and leaves both alone.
+	if err := txn.RenameName(testNS, "a/b", "c", 0, ""); err != core.ErrAlreadyExists {
+		t.Fatalf("expected ErrAlreadyExists, got %s", err)
+	}
+	if err := txn.RenameName(testNS, "a/b", "d/e", 0, ""); err != core.NoError {
+		t.Fatalf("rename failed: %s", err)
+	}
+	if _, err := txn.LookupName(testNS, "a/b"); err != core.ErrNoSuchName {
+		t.Fatalf("old name still bound: %s", err)
+	}
+	if id, err := txn.LookupName(testNS, "d/e"); err != core.NoError || id != b1 {
+		t.Fatalf("lookup returned %v, %s", id, err)
+	}
+
+	// Unbinding checks the blob if asked to.
+	if err := txn.UnbindName(testNS, "d/e", b2, ""); err != core.ErrConflictingState {
+		t.Fatalf("expected ErrConflictingState, got %s", err)
+	}
+	if err := txn.UnbindName(testNS, "d/e", b1, ""); err != core.NoError {
+		t.Fatalf("unbind failed: %s", err)
+	}
+	if err := txn.UnbindName(testNS, "d/e", 0, ""); err != core.ErrNoSuchName {
+		t.Fatalf("expected ErrNoSuchName, got %s", err)
+	}
+
+	// Renaming over a name only works if it's bound to the expected blob.
+	if err := txn.BindName(testNS, "d/e", b1, ""); err != core.NoError {
+		t.Fatalf("bind failed: %s", err)
+	}
+	if err := txn.RenameName(testNS, "c", "d/e", b2, ""); err != core.ErrConflictingState {
+		t.Fatalf("expected ErrConflictingState, got %s", err)
+	}
+	if err := txn.RenameName(testNS, "c", "f", b1, ""); err != core.ErrConflictingState {
+		t.Fatalf("expected ErrConflictingState, got %s", err)
+	}
+	if err := txn.RenameName(testNS, "c", "d/e", b1, ""); err != core.NoError {
+		t.Fatalf("rename failed: %s", err)
+	}
+	if _, err := txn.LookupName(testNS, "c"); err != core.ErrNoSuchName {
+		t.Fatalf("old name still bound: %s", err)
+	}
+	if id, err := txn.LookupName(testNS, "d/e"); err != core.NoError || id != b2 {
+		t.Fatalf("lookup returned %v, %s", id, err)
+	}
+}
+
+// Only the client that bound a name can move or remove it.
+func TestNamesBinder(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+
+	txn := s.WriteTxn(1)
+	defer txn.Commit()
+	txn.PutPartition(&pb.Partition{Id: proto.Uint32(uint32(testNS))})
+
+	b1 := core.BlobIDFromParts(1, 1)
+	putBlobs(txn, b1)
+	if err := txn.BindName(testNS, "a", b1, "alice"); err != core.NoError {
+		t.Fatalf("bind failed: %s", err)
+	}
+	if id, err := txn.LookupName(testNS, "a"); err != core.NoError || id != b1 {
+		t.Fatalf("lookup returned %v, %s", id, err)
+	}
+	if err := txn.RenameName(testNS, "a", "b", 0, "bob"); err != core.ErrPermissionDenied {
+		t.Errorf("expected ErrPermissionDenied, got %s", err)
+	}
+	if err := txn.UnbindName(testNS, "a", 0, "bob"); err != core.ErrPermissionDenied {
+		t.Errorf("expected ErrPermissionDenied, got %s", err)
+	}
+
+	// The binder is kept across renames.
+	if err := txn.RenameName(testNS, "a", "b", 0, "alice"); err != core.NoError {
+		t.Fatalf("rename failed: %s", err)
+	}
+	if err := txn.UnbindName(testNS, "b", 0, "bob"); err != core.ErrPermissionDenied {
+		t.Errorf("expected ErrPermissionDenied, got %s", err)
+	}
+	// Callers that are allowed to change any name don't pass an identity.
+	if err := txn.UnbindName(testNS, "b", 0, ""); err != core.NoError {
+		t.Errorf("unbind failed: %s", err)
+	}
+}
+
+// Test per-directory listings.
+func TestListNames(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+
+	txn := s.WriteTxn(1)
+	defer txn.Commit()
+	txn.PutPartition(&pb.Partition{Id: proto.Uint32(uint32(testNS))})
+
+	names := []string{"a", "b/1", "b/2", "b/c/1", "b/c/2", "b/d/1", "b0", "c"}
+	for i, n := range names {
+		id := core.BlobIDFromParts(1, core.BlobKey(i+1))
+		putBlobs(txn, id)
+		if err := txn.BindName(testNS, n, id, ""); err != core.NoError {
+			t.Fatalf("bind failed: %s", err)
+		}
+	}
+
+	list := func(prefix, start string, n int) (out []string) {
+		entries, err := txn.ListNames(testNS, prefix, start, n)
+		if err != core.NoError {
+			t.Fatalf("list failed: %s", err)
+		}
+		for _, e := range entries {
+			out = append(out, e.Name)
+		}
+		return
+	}
+	check := func(got []string, exp ...string) {
+		if !reflect.DeepEqual(got, exp) {
+			t.Errorf("expected %v, got %v", exp, got)
+		}
+	}
+
+	check(list("", "", 100), "a", "b/", "b0", "c")
+	check(list("b/", "", 100), "b/1", "b/2", "b/c/", "b/d/")
+	check(list("b/c/", "", 100), "b/c/1", "b/c/2")
+	check(list("b/x/", "", 100))
+
+	// Paginate with start.
+	check(list("", "", 2), "a", "b/")
+	check(list("", "b/", 2), "b0", "c")
+	check(list("b/", "b/2", 1), "b/c/")
+	check(list("b/", "b/c/", 10), "b/d/")
+}
+
+// A path can't be both a blob and a directory.
+func TestNameConflicts(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+
+	txn := s.WriteTxn(1)
+	defer txn.Commit()
+	txn.PutPartition(&pb.Partition{Id: proto.Uint32(uint32(testNS))})
+
+	b1, b2, b3 := core.BlobIDFromParts(1, 1), core.BlobIDFromParts(1, 2), core.BlobIDFromParts(1, 3)
+	putBlobs(txn, b1, b2, b3)
+	if err := txn.BindName(testNS, "a/b/c", b1, ""); err != core.NoError {
+		t.Fatalf("bind failed: %s", err)
+	}
+	for _, name := range []string{"a", "a/b", "a/b/c/d"} {
+		if err := txn.BindName(testNS, name, b2, ""); err != core.ErrAlreadyExists {
+			t.Errorf("binding %q: expected ErrAlreadyExists, got %s", name, err)
+		}
+	}
+	if err := txn.BindName(testNS, "x", b2, ""); err != core.NoError {
+		t.Fatalf("bind failed: %s", err)
+	}
+	if err := txn.BindName(testNS, "ab", b3, ""); err != core.NoError {
+		t.Fatalf("bind failed: %s", err)
+	}
+	if err := txn.RenameName(testNS, "x", "a/b", 0, ""); err != core.ErrAlreadyExists {
+		t.Errorf("expected ErrAlreadyExists, got %s", err)
+	}
+	if err := txn.RenameName(testNS, "x", "ab/c", 0, ""); err != core.ErrAlreadyExists {
+		t.Errorf("expected ErrAlreadyExists, got %s", err)
+	}
+
+	// A name can move into or out of the directory it would replace.
+	if err := txn.RenameName(testNS, "x", "x/y", 0, ""); err != core.NoError {
+		t.Fatalf("rename failed: %s", err)
+	}
+	if err := txn.RenameName(testNS, "x/y", "x", 0, ""); err != core.NoError {
+		t.Fatalf("rename failed: %s", err)
+	}
+	if id, err := txn.LookupName(testNS, "x"); err != core.NoError || id != b2 {
+		t.Fatalf("lookup returned %v, %s", id, err)
+	}
+}
+
+// Test moving whole directories.
+func TestRenameDir(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+
+	txn := s.WriteTxn(1)
+	defer txn.Commit()
+	txn.PutPartition(&pb.Partition{Id: proto.Uint32(uint32(testNS))})
+
+	names := []string{"d/1", "d/e/2", "d0", "f", "g/3"}
+	for i, n := range names {
+		id := core.BlobIDFromParts(1, core.BlobKey(i+1))
+		putBlobs(txn, id)
+		if err := txn.BindName(testNS, n, id, ""); err != core.NoError {
+			t.Fatalf("bind failed: %s", err)
+		}
+	}
+
+	for _, c := range []struct {
+		from, to string
+		err      core.Error
+	}{
+		{"d/", "d/e/", core.ErrInvalidArgument},
+		{"d/", "h", core.ErrInvalidArgument},
+		{"x/", "h/", core.ErrNoSuchName},
+		{"d/", "g/", core.ErrAlreadyExists},
+		{"d/", "f/", core.ErrAlreadyExists},
+		{"d/", "f/x/", core.ErrAlreadyExists},
+	} {
+		if err := txn.RenameName(testNS, c.from, c.to, 0, ""); err != c.err {
+			t.Errorf("renaming %q to %q: expected %s, got %s", c.from, c.to, c.err, err)
+		}
+	}
+
+	if err := txn.RenameName(testNS, "d/", "h/i/", 0, ""); err != core.NoError {
+		t.Fatalf("rename failed: %s", err)
+	}
+	for i, n := range []string{"h/i/1", "h/i/e/2", "d0"} {
+		if id, err := txn.LookupName(testNS, n); err != core.NoError || id != core.BlobIDFromParts(1, core.BlobKey(i+1)) {
+			t.Errorf("lookup of %q returned %v, %s", n, id, err)
+		}
+	}
+	if entries, _ := txn.ListNames(testNS, "d/", "", 10); len(entries) != 0 {
+		t.Errorf("old directory still has %v", entries)
+	}
+
+	// Every name must be movable by the caller.
+	putBlobs(txn, core.BlobIDFromParts(1, 10))
+	txn.BindName(testNS, "h/j", core.BlobIDFromParts(1, 10), "alice")
+	if err := txn.RenameName(testNS, "h/", "k/", 0, "bob"); err != core.ErrPermissionDenied {
+		t.Errorf("expected ErrPermissionDenied, got %s", err)
+	}
+	if id, err := txn.LookupName(testNS, "h/i/1"); err != core.NoError || id != core.BlobIDFromParts(1, 1) {
+		t.Errorf("lookup returned %v, %s", id, err)
+	}
+}
+
+// Deleting a blob removes its names.
+func TestDeleteBlobUnbindsNames(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+
+	txn := s.WriteTxn(1)
+	defer txn.Commit()
+	txn.PutPartition(&pb.Partition{Id: proto.Uint32(uint32(testNS))})
+
+	b1, b2 := core.BlobIDFromParts(1, 1), core.BlobIDFromParts(1, 2)
+	putBlobs(txn, b1, b2)
+	txn.BindName(testNS, "a", b1, "")
+	txn.BindName(testNS, "b", b2, "")
+	if err := txn.RenameName(testNS, "a", "c/a", 0, ""); err != core.NoError {
+		t.Fatalf("rename failed: %s", err)
+	}
+
+	if err := txn.DeleteBlob(b1, time.Now()); err != core.NoError {
+		t.Fatalf("delete failed: %s", err)
+	}
+	if _, err := txn.LookupName(testNS, "c/a"); err != core.ErrNoSuchName {
+		t.Errorf("expected ErrNoSuchName, got %s", err)
+	}
+	if err := txn.FinishDeleteBlobs([]core.BlobID{b2}); err != core.NoError {
+		t.Fatalf("delete failed: %s", err)
+	}
+	if _, err := txn.LookupName(testNS, "b"); err != core.ErrNoSuchName {
+		t.Errorf("expected ErrNoSuchName, got %s", err)
+	}
+	if k, _ := txn.txn.Bucket(blobNameBucket).Cursor().First(); k != nil {
+		t.Errorf("blob name bucket still has %q", k)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"io"
+	"math"
 	"os"
 	"time"
 
@@ -27,7 +28,10 @@ var (
 	partitionBucket = []byte("partition") // Bucket that stores all partition metadata.
 	blobBucket      = []byte("blob")      // Bucket that stores all blob metadata.
 	rschunkBucket   = []byte("rschunk")   // Bucket that stores RSChunks.
+	nameBucket      = []byte("name")      // Bucket that stores the blob namespace.
+	blobNameBucket  = []byte("blobname")  // Bucket that stores the names of each blob.
 	metaBucket      = []byte("metadata")  // Bucket that stores all other data.
+	eventBucket     = []byte("event")     // Bucket that stores recent blob events.
 
 	// Keys in metaBucket:
 	curatorIDKey = []byte("curator_id")
@@ -78,10 +82,12 @@ const (
 	// that when there's no reason to use another. We write blobs in mostly
 	// sequential order, so we can use a higher fill percent to save space
 	// there. We write RS chunks in almost totally sequential order (except for
-	// going back and updating hosts), so we can use an even higher value there.
+	// going back and updating hosts), so we can use an even higher value there,
+	// and for events, which we only add at the end and trim from the start.
 	defaultFillPct = 0.50
 	blobFillPct    = 0.75
 	rsChunkFillPct = 0.90
+	eventFillPct   = 0.90
 )
 
 // State represents replicated state of a curator.
@@ -111,12 +117,22 @@ func Open(path string) *State {
 	if _, err := tx.CreateBucketIfNotExists(rschunkBucket); err != nil {
 		log.Fatalf("Failed to create rschunk bucket: %v", err)
 	}
+	if _, err := tx.CreateBucketIfNotExists(nameBucket); err != nil {
+		log.Fatalf("Failed to create name bucket: %v", err)
+	}
+	if _, err := tx.CreateBucketIfNotExists(blobNameBucket); err != nil {
+		log.Fatalf("Failed to create blob name bucket: %v", err)
+	}
 	if _, err := tx.CreateBucketIfNotExists(metaBucket); err != nil {
 		log.Fatalf("Failed to create id bucket: %v", err)
 	}
+	if _, err := tx.CreateBucketIfNotExists(eventBucket); err != nil {
+		log.Fatalf("Failed to create event bucket: %v", err)
+	}
 	if err := tx.Commit(); err != nil {
 		log.Fatalf("Failed to commit creation of buckets: %v", err)
 	}
+	upgrade(db)
 
 	s := &State{db: db}
 	failures.Register("corrupt_curator_state", s.corrupt)
@@ -145,7 +161,7 @@ func (s *State) WriteTxn(index uint64) *Txn {
 		log.Fatalf("Failed to create RW txn: %v", err)
 	}
 
-	t := &Txn{txn: txn, readOnly: false}
+	t := &Txn{txn: txn, readOnly: false, index: index}
 
 	// Set the transaction index first. It will be committed atomically
 	// with all other mutations in this transaction.
@@ -225,8 +241,12 @@ type Txn struct {
 	readOnly bool // Is this txn read-only?
 	txn      *bolt.Tx
 
+	// Storage classes, loaded when they're first needed.
+	reg *storageclass.Registry
+
 	// Only for write transactions:
 	newTSIDs tsidbitmap
+	index    uint64 // The raft index being applied
 }
 
 // GetReadOnlyMode returns true if the read-only flag is set in the state.
@@ -279,6 +299,11 @@ func (t *Txn) Stat(id core.BlobID) (info core.BlobInfo, err core.Error) {
 		err = core.ErrNoSuchBlob
 		return
 	}
+	return BlobInfo(blob), core.NoError
+}
+
+// BlobInfo returns the information about 'blob' that we give to clients.
+func BlobInfo(blob *pb.Blob) (info core.BlobInfo) {
 	info = core.BlobInfo{
 		NumTracts: len(blob.Tracts),
 		Repl:      int(blob.GetRepl()),
@@ -286,10 +311,20 @@ func (t *Txn) Stat(id core.BlobID) (info core.BlobInfo, err core.Error) {
 		ATime:     time.Unix(0, blob.GetAtime()),
 		Class:     blob.GetStorage(),
 		Hint:      blob.GetHint(),
+		Metadata:  blob.GetMetadata(),
+		Owner:     blob.GetOwner(),
+		ACL:       core.ACL{Readers: blob.GetReaders(), Writers: blob.GetWriters()},
 	}
 	if blob.GetExpires() != 0 {
 		info.Expires = time.Unix(0, blob.GetExpires())
 	}
+	if blob.Sealed != nil {
+		info.WriteOnce = true
+		info.Sealed = blob.GetSealed()
+	}
+	if blob.GetDeleted() != 0 {
+		info.Deleted = time.Unix(0, blob.GetDeleted())
+	}
 	return
 }
 
@@ -306,7 +341,8 @@ func (t *Txn) PutBlob(id core.BlobID, blob *pb.Blob) {
 	}
 }
 
-// DeleteBlob marks a blob as deleted instead of actually deleting it.
+// DeleteBlob marks a blob as deleted instead of actually deleting it. Any names
+// bound to the blob are removed, and aren't restored by UndeleteBlob.
 func (t *Txn) DeleteBlob(id core.BlobID, when time.Time) core.Error {
 	b := t.GetBlob(id)
 	if b == nil {
@@ -314,6 +350,7 @@ func (t *Txn) DeleteBlob(id core.BlobID, when time.Time) core.Error {
 	}
 	b.Deleted = proto.Int64(when.UnixNano())
 	t.PutBlob(id, b)
+	t.unbindBlob(id)
 	return core.NoError
 }
 
@@ -328,9 +365,166 @@ func (t *Txn) UndeleteBlob(id core.BlobID) core.Error {
 	return core.NoError
 }
 
+// PurgeBlob removes a blob that is marked as deleted from the database, without
+// waiting for the undelete period to pass. Like FinishDeleteBlobs, this is
+// final. Blobs that still share tracts with clones can't be purged yet.
+func (t *Txn) PurgeBlob(id core.BlobID) core.Error {
+	b := t.GetBlobAll(id)
+	if b == nil {
+		return core.ErrNoSuchBlob
+	}
+	if b.GetDeleted() == 0 {
+		return core.ErrInvalidState
+	}
+	if HasClones(b) {
+		return core.ErrConflictingState
+	}
+	return t.FinishDeleteBlobs([]core.BlobID{id})
+}
+
+// SealBlob seals a write-once blob, after which it can't be modified. Sealing
+// a blob that's already sealed does nothing.
+func (t *Txn) SealBlob(id core.BlobID) core.Error {
+	b := t.GetBlob(id)
+	if b == nil {
+		return core.ErrNoSuchBlob
+	}
+	if b.Sealed == nil {
+		return core.ErrInvalidArgument
+	}
+	if !b.GetSealed() {
+		b.Sealed = proto.Bool(true)
+		t.PutBlob(id, b)
+	}
+	return core.NoError
+}
+
+// ReserveAppend reserves 'length' bytes at the end of the blob 'id' for an
+// append, starting no earlier than 'minOffset', and returns the offset of the
+// reserved space.
+func (t *Txn) ReserveAppend(id core.BlobID, length, minOffset int64) (int64, core.Error) {
+	if length <= 0 || minOffset < 0 {
+		return 0, core.ErrInvalidArgument
+	}
+	b := t.GetBlob(id)
+	if b == nil {
+		return 0, core.ErrNoSuchBlob
+	}
+	if b.GetSealed() {
+		return 0, core.ErrSealed
+	}
+	if b.GetStorage() != core.StorageClass_REPLICATED {
+		return 0, core.ErrReadOnlyStorageClass
+	}
+	off := b.GetAppendOffset()
+	if off < minOffset {
+		off = minOffset
+	}
+	// Tract keys are 16 bits, which limits how long a blob can get.
+	if off+length > (math.MaxUint16+1)*core.TractLength {
+		return 0, core.ErrBlobFull
+	}
+	b.AppendOffset = proto.Int64(off + length)
+	t.PutBlob(id, b)
+	return off, core.NoError
+}
+
+// NewTractVersion returns the version that a tract added to blob 'b' at 'index'
+// starts with. Tractservers may still have copies of tracts that were
+// truncated away, so a tract that takes the place of one starts at a higher
+// version than any of them.
+func NewTractVersion(b *pb.Blob, index int) int {
+	if index < int(b.GetTruncatedTracts()) {
+		return int(b.GetTruncatedVersion()) + 1
+	}
+	return 1
+}
+
+// TruncateBlob drops the tracts of the blob 'id' that lie entirely beyond
+// 'size' bytes, and returns the dropped tracts whose data belongs to 'id' so
+// that the caller can have them deleted from tractservers. Truncating the data
+// in the new last tract is up to the caller; if that tract's checksum covers
+// more than what's left, it's cleared.
+//
+// The blob keeps a record of what was dropped, so that copies the caller
+// fails to delete are still garbage collected later; see NewTractVersion.
+//
+// Tracts that 'id' shares with its clones can't be dropped, and neither can the
+// new last tract be cut short while it's shared, since the data would change
+// for the other blobs too.
+func (t *Txn) TruncateBlob(id core.BlobID, size int64) ([]core.TractInfo, core.Error) {
+	if size < 0 {
+		return nil, core.ErrInvalidArgument
+	}
+	b := t.GetBlob(id)
+	if b == nil {
+		return nil, core.ErrNoSuchBlob
+	}
+	if b.GetSealed() {
+		return nil, core.ErrSealed
+	}
+	if b.GetStorage() != core.StorageClass_REPLICATED {
+		return nil, core.ErrReadOnlyStorageClass
+	}
+
+	keep := int((size + core.TractLength - 1) / core.TractLength)
+	if keep > len(b.Tracts) {
+		keep = len(b.Tracts)
+	}
+	for _, tract := range b.Tracts[keep:] {
+		if len(tract.Clones) > 0 {
+			return nil, core.ErrConflictingState
+		}
+	}
+	var last *pb.Tract
+	lastLength := size - int64(keep-1)*core.TractLength
+	if keep > 0 && lastLength < core.TractLength {
+		last = b.Tracts[keep-1]
+		if len(last.Clones) > 0 || last.GetOrigin() != 0 {
+			return nil, core.ErrConflictingState
+		}
+	}
+
+	var dropped []core.TractInfo
+	origins := make(map[core.BlobID]*pb.Blob)
+	if keep < len(b.Tracts) && uint32(len(b.Tracts)) > b.GetTruncatedTracts() {
+		b.TruncatedTracts = proto.Uint32(uint32(len(b.Tracts)))
+	}
+	for i, tract := range b.Tracts[keep:] {
+		i += keep
+		if o := tract.GetOrigin(); o != 0 {
+			shared := t.originTract(origins, o, i)
+			shared.Clones = removeBlobID(shared.Clones, id)
+			continue
+		}
+		tid := core.TractIDFromParts(id, core.TractKey(i))
+		for _, p := range tract.Chunks {
+			t.removeTractFromRSChunk(p.Chunk, tid)
+		}
+		dropped = append(dropped, core.TractInfo{Tract: tid, Version: tract.Version, TSIDs: tract.Hosts})
+		if uint32(tract.Version) > b.GetTruncatedVersion() {
+			b.TruncatedVersion = proto.Uint32(uint32(tract.Version))
+		}
+	}
+	for o, origin := range origins {
+		t.PutBlob(o, origin)
+	}
+
+	b.Tracts = b.Tracts[:keep]
+	if last != nil && int64(last.GetChecksumLength()) > lastLength {
+		last.Checksum, last.ChecksumLength = nil, nil
+	}
+	if b.GetAppendOffset() > size {
+		b.AppendOffset = proto.Int64(size)
+	}
+	t.PutBlob(id, b)
+	return dropped, core.NoError
+}
+
 // SetBlobMetadata changes metadata for a blob. Only fields Hint, MTime, ATime,
-// and Expires are used from md, others are ignored. Zero values for those
-// fields mean "don't change this".
+// Expires, and Metadata are used from md, others are ignored. Zero values for
+// those fields mean "don't change this". Keys in md.Metadata with empty values
+// are removed from the blob's user metadata, others are added or replaced.
 func (t *Txn) SetBlobMetadata(id core.BlobID, md core.BlobInfo) core.Error {
 	b := t.GetBlob(id)
 	if b == nil {
@@ -353,33 +547,58 @@ func (t *Txn) SetBlobMetadata(id core.BlobID, md core.BlobInfo) core.Error {
 	if md.Hint != 0 {
 		b.Hint = &md.Hint
 	}
+	for k, v := range md.Metadata {
+		if v == "" {
+			delete(b.Metadata, k)
+			continue
+		}
+		if b.Metadata == nil {
+			b.Metadata = make(map[string]string)
+		}
+		b.Metadata[k] = v
+	}
+	if core.MetadataSize(b.Metadata) > core.MaxMetadataSize {
+		return core.ErrMetadataTooLarge
+	}
 	t.PutBlob(id, b)
 	return core.NoError
 }
 
 // FinishDeleteBlobs deletes the given blobs from the database. This is final
 // and the blobs CANNOT be recovered after this. The caller must ensure that the
-// given blobs have a deletion time or expiry time in the past.
+// given blobs have a deletion time or expiry time in the past. Blobs that still
+// share tracts with clones are skipped, the caller should try again later.
 func (t *Txn) FinishDeleteBlobs(ids []core.BlobID) core.Error {
 	for _, id := range ids {
+		if b := t.GetBlobAll(id); b != nil {
+			if HasClones(b) {
+				log.Infof("not deleting blob %s yet, its tracts are shared with clones", id)
+				continue
+			}
+			t.releaseSharedTracts(id, b)
+		}
 		// Ignore any errors we get removing these tracts from RS chunks, we can
 		// continue deleting the blob anyway.
-		t.removeTractsFromRSChunks(id)
+		t.removeTractsFromRSChunks(id, core.StorageClass_REPLICATED)
+		// Expired blobs may still have names.
+		t.unbindBlob(id)
 		t.delete(blobBucket, blobID2Key(id))
 	}
 	return core.NoError
 }
 
-func (t *Txn) removeTractsFromRSChunks(bid core.BlobID) core.Error {
+// removeTractsFromRSChunks removes the tracts of 'bid' from the RS chunks of
+// every class except 'keep'. Chunks with no tracts left are removed too.
+func (t *Txn) removeTractsFromRSChunks(bid core.BlobID, keep core.StorageClass) core.Error {
 	blob := t.GetBlobAll(bid)
 	if blob == nil {
 		return core.ErrNoSuchBlob
 	}
 	for k, tract := range blob.Tracts {
 		tid := core.TractIDFromParts(bid, core.TractKey(k))
-		for _, cls := range storageclass.AllRS {
-			if cid := cls.GetRS(tract); cid != nil {
-				t.removeTractFromRSChunk(cid, tid)
+		for _, p := range tract.Chunks {
+			if p.Class != keep {
+				t.removeTractFromRSChunk(p.Chunk, tid)
 			}
 		}
 	}
@@ -394,7 +613,7 @@ func (t *Txn) removeTractFromRSChunk(cid []byte, tid core.TractID) core.Error {
 	chunk := new(pb.RSChunk)
 	mustUnmarshal(v, chunk)
 
-	found := false
+	found, empty := false, true
 outer:
 	for _, data := range chunk.Data {
 		for k, tract := range data.Tracts {
@@ -413,6 +632,18 @@ outer:
 		return core.ErrNoSuchTract
 	}
 
+	for _, data := range chunk.Data {
+		if len(data.Tracts) > 0 {
+			empty = false
+		}
+	}
+	if empty {
+		// Nothing is left in the chunk, so forget about it. Tractservers will
+		// garbage collect its pieces when they report them.
+		t.delete(rschunkBucket, cid)
+		return core.NoError
+	}
+
 	t.put(rschunkBucket, cid, mustMarshal(chunk), rsChunkFillPct)
 	return core.NoError
 }
@@ -478,35 +709,86 @@ func (t *Txn) GetTracts(id core.BlobID, start, end int) ([]core.TractInfo, core.
 	}
 
 	var ret []core.TractInfo
+	origins := make(map[core.BlobID]*pb.Blob)
 	for i := start; i < end; i++ {
 		tid := core.TractID{Blob: id, Index: core.TractKey(i)}
 		tt := blob.Tracts[i]
-		tract := core.TractInfo{Tract: tid, Version: tt.Version}
-		if rsp, ok := t.getRSPointer(tt, tid); ok {
-			tract.RS = rsp
-		} else {
-			tract.TSIDs = tt.Hosts
+		shared := len(tt.Clones) > 0
+		if o := tt.GetOrigin(); o != 0 {
+			// Return the shared tract that has the data.
+			tid.Blob = o
+			tt = t.originTract(origins, o, i)
+			shared = true
 		}
+		tract := t.tractInfo(tid, tt)
+		tract.Shared = shared
 		ret = append(ret, tract)
 	}
 	return ret, cls, core.NoError
 }
 
+// tractInfo returns the information clients need to access 'tract'.
+func (t *Txn) tractInfo(id core.TractID, tract *pb.Tract) core.TractInfo {
+	info := core.TractInfo{Tract: id, Version: tract.Version, Checksum: tractChecksum(tract)}
+	if rsp, ok := t.getRSPointer(tract, id); ok {
+		info.RS = rsp
+	} else {
+		info.TSIDs = tract.Hosts
+	}
+	return info
+}
+
+// tractChecksum returns the end-to-end checksum stored for a tract.
+func tractChecksum(tract *pb.Tract) core.TractChecksum {
+	return core.TractChecksum{CRC: tract.GetChecksum(), Length: int(tract.GetChecksumLength())}
+}
+
+// UpdateChecksums applies end-to-end checksum updates to tracts of a blob. An
+// update whose old value doesn't match the current checksum clears it instead.
+func (t *Txn) UpdateChecksums(id core.BlobID, updates []core.ChecksumUpdate) core.Error {
+	b := t.GetBlob(id)
+	if b == nil {
+		return core.ErrNoSuchBlob
+	}
+	for _, u := range updates {
+		if int(u.Index) >= len(b.Tracts) {
+			return core.ErrNoSuchTract
+		}
+		if u.New.Length < 0 || u.New.Length > core.TractLength {
+			return core.ErrInvalidArgument
+		}
+	}
+	for _, u := range updates {
+		tract := b.Tracts[u.Index]
+		next := u.New
+		if tractChecksum(tract) != u.Old {
+			log.Infof("checksum of tract %v changed concurrently, clearing", core.TractIDFromParts(id, u.Index))
+			next = core.TractChecksum{}
+		}
+		if next.Present() {
+			tract.Checksum = proto.Uint32(next.CRC)
+			tract.ChecksumLength = proto.Uint32(uint32(next.Length))
+		} else {
+			tract.Checksum, tract.ChecksumLength = nil, nil
+		}
+	}
+	t.PutBlob(id, b)
+	return core.NoError
+}
+
 // getRSPointer returns the metadata used by the client to read from an RS-coded tract.
 func (t *Txn) getRSPointer(tract *pb.Tract, tid core.TractID) (core.TractPointer, bool) {
-	var cid []byte
-	var clsid core.StorageClass
 	// See if this tract is present in any RS chunks. If it is present in
 	// multiple RS chunks, that means it's being transitioned from one RS class
 	// to another. In that case it doesn't really matter which one we pick, as
 	// long as we pick one.
-	for _, cls := range storageclass.AllRS {
-		if cid = cls.GetRS(tract); cid != nil {
-			clsid = cls.ID()
-			break
-		}
+	if len(tract.Chunks) == 0 {
+		return core.TractPointer{}, false
 	}
-	if cid == nil {
+	cid := tract.Chunks[0].Chunk
+	cls := t.classes().Get(tract.Chunks[0].Class)
+	if cls == nil {
+		log.Errorf("[curator] unknown storage class %d", tract.Chunks[0].Class)
 		return core.TractPointer{}, false
 	}
 	b, ok := t.get(rschunkBucket, cid)
@@ -516,10 +798,11 @@ func (t *Txn) getRSPointer(tract *pb.Tract, tid core.TractID) (core.TractPointer
 	}
 	var c pb.RSChunk
 	mustUnmarshal(b, &c)
-	return lookupTractInChunk(&c, tid, cid, clsid)
+	return lookupTractInChunk(&c, tid, cid, cls)
 }
 
-func lookupTractInChunk(c *pb.RSChunk, tid core.TractID, cid []byte, clsid core.StorageClass) (core.TractPointer, bool) {
+func lookupTractInChunk(c *pb.RSChunk, tid core.TractID, cid []byte, cls storageclass.Class) (core.TractPointer, bool) {
+	lrc, _ := cls.LRCParams()
 	for i, data := range c.Data {
 		for _, tract := range data.Tracts {
 			if tract.Id == tid {
@@ -529,9 +812,11 @@ func lookupTractInChunk(c *pb.RSChunk, tid core.TractID, cid []byte, clsid core.
 					TSID:       c.Hosts[i],
 					Offset:     tract.GetOffset(),
 					Length:     tract.GetLength(),
-					Class:      clsid,
+					Class:      cls.ID(),
 					BaseChunk:  id,
 					OtherTSIDs: c.Hosts,
+					Data:       len(c.Data),
+					LRC:        lrc,
 				}, true
 			}
 		}
@@ -594,12 +879,16 @@ func (t *Txn) PutRSChunk(id core.RSChunkID,
 		return core.ErrConflictingState
 	}
 
-	cls := storageclass.Get(storage)
+	cls := t.classes().Get(storage)
+	if cls == nil || cls.ID() == core.StorageClass_REPLICATED {
+		return core.ErrInvalidArgument
+	}
 	key := rschunkID2Key(id)
 
 	chunk := pb.RSChunk{
 		Data:  make([]*pb.RSChunk_Data, len(data)),
 		Hosts: hosts,
+		Class: storage,
 	}
 	blobUpdates := make(map[core.BlobID]*pb.Blob)
 	for i, c := range data {
@@ -656,29 +945,68 @@ func (t *Txn) UpdateRSHosts(id core.RSChunkID, hosts []core.TractserverID) core.
 	return core.NoError
 }
 
-// UpdateStorageClass changes the storage class of a blob. All the tracts in the
-// blob must already support the new class.
-func (t *Txn) UpdateStorageClass(id core.BlobID, storage core.StorageClass) core.Error {
+// UnpackTract records that the RS-encoded tract 'id' has been copied to
+// 'hosts' with version 'newVersion', so that it's also stored as REPLICATED.
+// This is how blobs move from an RS class back to REPLICATED, one tract at a
+// time, before UpdateStorageClass.
+func (t *Txn) UnpackTract(id core.TractID, newVersion int, hosts []core.TractserverID) core.Error {
+	blob := t.GetBlob(id.Blob)
+	if blob == nil {
+		return core.ErrNoSuchBlob
+	}
+	if int(id.Index) >= len(blob.Tracts) {
+		return core.ErrNoSuchTract
+	}
+	tract := blob.Tracts[id.Index]
+	if _, ok := t.getRSPointer(tract, id); !ok || len(hosts) == 0 {
+		return core.ErrInvalidArgument
+	}
+	// The copies are made with a new version. Until they're committed here,
+	// the tract has no hosts, and garbage collection would remove any copies
+	// that aren't newer than the tract.
+	if len(tract.Hosts) > 0 || tract.Version+1 != newVersion {
+		return core.ErrConflictingState
+	}
+	tract.Hosts = hosts
+	tract.Version = newVersion
+	t.PutBlob(id.Blob, blob)
+	return core.NoError
+}
+
+// UpdateStorageClass changes the storage class of a blob at time 'now'. All the
+// tracts in the blob must already support the new class.
+func (t *Txn) UpdateStorageClass(id core.BlobID, storage core.StorageClass, now int64) core.Error {
 	blob := t.GetBlob(id)
 	if blob == nil {
 		return core.ErrNoSuchBlob
 	}
 
-	targetCls := storageclass.Get(storage)
+	targetCls := t.classes().Get(storage)
+	if targetCls == nil {
+		return core.ErrInvalidArgument
+	}
 
 	for _, tract := range blob.Tracts {
 		// Double-check that this is valid.
 		if !targetCls.Has(tract) {
 			return core.ErrInvalidArgument
 		}
+	}
+	// Take the tracts out of the RS chunks of other classes, so that chunks
+	// can be released once all their tracts are gone.
+	t.removeTractsFromRSChunks(id, storage)
+	for _, tract := range blob.Tracts {
 		// Clear the others.
-		for _, cls := range storageclass.All {
+		for _, cls := range t.classes().All() {
 			if cls.ID() != storage {
 				cls.Clear(tract)
 			}
 		}
 	}
 
+	if blob.GetStorage() != storage {
+		blob.ClassTime = &now
+	}
 	blob.Storage = &storage
 	t.PutBlob(id, blob)
 	return core.NoError
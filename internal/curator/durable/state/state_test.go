@@ -4,6 +4,8 @@
 package state
 
 import (
+	"bytes"
+	"encoding/binary"
 	"io/ioutil"
 	"path/filepath"
 	"reflect"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/westerndigitalcorporation/blb/internal/core"
 	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
+	"github.com/westerndigitalcorporation/blb/internal/curator/storageclass"
 	test "github.com/westerndigitalcorporation/blb/pkg/testutil"
 )
 
@@ -272,7 +275,7 @@ func TestLookupTractInChunk(t *testing.T) {
 	}
 
 	check := func(tid core.TractID, exp core.TractPointer) {
-		tp, _ := lookupTractInChunk(chunk, tid, rschunkID2Key(cid), core.StorageClass_RS_6_3)
+		tp, _ := lookupTractInChunk(chunk, tid, rschunkID2Key(cid), storageclass.Get(core.StorageClass_RS_6_3))
 		if !reflect.DeepEqual(tp, exp) {
 			t.Errorf("wrong result for %v: %+v != %+v", tid, tp, exp)
 		}
@@ -282,11 +285,11 @@ func TestLookupTractInChunk(t *testing.T) {
 	check(tid(777, 777, 777), core.TractPointer{})
 	// present:
 	check(tid(123, 456, 2), core.TractPointer{Chunk: cid.Add(3), Offset: 2000, Length: 654, TSID: 6,
-		Class: core.StorageClass_RS_6_3, BaseChunk: cid, OtherTSIDs: chunk.Hosts})
+		Class: core.StorageClass_RS_6_3, BaseChunk: cid, OtherTSIDs: chunk.Hosts, Data: 6})
 	check(tid(321, 654, 3), core.TractPointer{Chunk: cid.Add(0), Offset: 3000, Length: 987, TSID: 9,
-		Class: core.StorageClass_RS_6_3, BaseChunk: cid, OtherTSIDs: chunk.Hosts})
+		Class: core.StorageClass_RS_6_3, BaseChunk: cid, OtherTSIDs: chunk.Hosts, Data: 6})
 	check(tid(321, 654, 0), core.TractPointer{Chunk: cid.Add(3), Offset: 0, Length: 100, TSID: 6,
-		Class: core.StorageClass_RS_6_3, BaseChunk: cid, OtherTSIDs: chunk.Hosts})
+		Class: core.StorageClass_RS_6_3, BaseChunk: cid, OtherTSIDs: chunk.Hosts, Data: 6})
 }
 
 func TestLookupRSPiece(t *testing.T) {
@@ -337,6 +340,8 @@ func TestDeleteTractFromRSChunk(t *testing.T) {
 	defer txn.Commit()
 	bid := core.BlobIDFromParts(7, 3)
 	tid := core.TractIDFromParts(bid, 0)
+	bid2 := core.BlobIDFromParts(7, 4)
+	tid2 := core.TractIDFromParts(bid2, 0)
 	cid := core.RSChunkID{Partition: 0x80000007, ID: 5}
 	txn.PutPartition(&pb.Partition{Id: proto.Uint32(7)})
 	txn.PutBlob(bid, &pb.Blob{
@@ -344,9 +349,14 @@ func TestDeleteTractFromRSChunk(t *testing.T) {
 			{Version: 1},
 		},
 	})
+	txn.PutBlob(bid2, &pb.Blob{
+		Tracts: []*pb.Tract{
+			{Version: 1},
+		},
+	})
 	hosts := []core.TractserverID{9, 8, 7, 6, 5, 4, 3, 2, 1}
 	err := txn.PutRSChunk(cid, core.StorageClass_RS_6_3, hosts, [][]EncodedTract{
-		{}, {}, {{ID: tid, Offset: 123, Length: 456}}, {}, {}, {}, {}, {}, {},
+		{}, {}, {{ID: tid, Offset: 123, Length: 456}}, {{ID: tid2, Offset: 0, Length: 789}}, {}, {}, {}, {}, {},
 	})
 	if err != core.NoError {
 		t.Fatal(err)
@@ -357,6 +367,9 @@ func TestDeleteTractFromRSChunk(t *testing.T) {
 	if c.Data[2].Tracts[0].Id != tid {
 		t.Fatalf("tract not present in rs chunk")
 	}
+	if c.Class != core.StorageClass_RS_6_3 {
+		t.Errorf("rs chunk has class %s", c.Class)
+	}
 
 	// delete the blob
 	txn.FinishDeleteBlobs([]core.BlobID{bid})
@@ -366,6 +379,130 @@ func TestDeleteTractFromRSChunk(t *testing.T) {
 	if len(c.Data[2].Tracts) > 0 {
 		t.Fatalf("tract is still present in rs chunk")
 	}
+
+	// once the last tract is gone, so is the chunk
+	txn.FinishDeleteBlobs([]core.BlobID{bid2})
+	if txn.GetRSChunk(cid) != nil {
+		t.Fatalf("empty rs chunk is still present")
+	}
+	if _, ok := txn.LookupRSPiece(cid.Add(3).ToTractID()); ok {
+		t.Fatalf("pieces of empty rs chunk are still present")
+	}
+}
+
+// Test moving a tract from an RS chunk back to replicated storage.
+func TestUnpackTract(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+	txn := s.WriteTxn(1)
+	defer txn.Commit()
+	bid := core.BlobIDFromParts(7, 3)
+	tid := core.TractIDFromParts(bid, 0)
+	cid := core.RSChunkID{Partition: 0x80000007, ID: 5}
+	txn.PutPartition(&pb.Partition{Id: proto.Uint32(7)})
+	txn.PutBlob(bid, &pb.Blob{
+		Repl:   proto.Uint32(2),
+		Tracts: []*pb.Tract{{Version: 1, Hosts: []core.TractserverID{1, 2}}},
+	})
+	hosts := []core.TractserverID{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	err := txn.PutRSChunk(cid, core.StorageClass_RS_6_3, hosts, [][]EncodedTract{
+		{{ID: tid, Offset: 0, Length: 456, NewVersion: 2}}, {}, {}, {}, {}, {},
+	})
+	if err != core.NoError {
+		t.Fatal(err)
+	}
+	if err := txn.UpdateStorageClass(bid, core.StorageClass_RS_6_3, 0); err != core.NoError {
+		t.Fatal(err)
+	}
+
+	// The version has to be bumped, and there have to be hosts.
+	if err := txn.UnpackTract(tid, 2, []core.TractserverID{3, 4}); err != core.ErrConflictingState {
+		t.Errorf("expected unpack with old version to fail, got %s", err)
+	}
+	if err := txn.UnpackTract(tid, 3, nil); err != core.ErrInvalidArgument {
+		t.Errorf("expected unpack with no hosts to fail, got %s", err)
+	}
+	if err := txn.UnpackTract(tid, 3, []core.TractserverID{3, 4}); err != core.NoError {
+		t.Fatal(err)
+	}
+	if err := txn.UnpackTract(tid, 4, []core.TractserverID{5, 6}); err != core.ErrConflictingState {
+		t.Errorf("expected second unpack to fail, got %s", err)
+	}
+
+	// It's still read from the chunk until the class changes.
+	info, err := txn.GetTract(tid)
+	if err != core.NoError {
+		t.Fatal(err)
+	}
+	if info.Version != 3 || !info.RS.Present() {
+		t.Fatalf("unexpected tract after unpack: %+v", info)
+	}
+	if hosts := txn.GetBlob(bid).Tracts[0].Hosts; !reflect.DeepEqual(hosts, []core.TractserverID{3, 4}) {
+		t.Fatalf("unexpected hosts after unpack: %v", hosts)
+	}
+
+	// Going back to replicated releases the chunk.
+	if err := txn.UpdateStorageClass(bid, core.StorageClass_REPLICATED, 0); err != core.NoError {
+		t.Fatal(err)
+	}
+	if txn.GetRSChunk(cid) != nil {
+		t.Errorf("rs chunk is still present")
+	}
+	if tract := txn.GetBlob(bid).Tracts[0]; len(tract.Chunks) != 0 || len(tract.Hosts) != 2 {
+		t.Errorf("unexpected tract after changing class: %+v", tract)
+	}
+}
+
+// Test that moving a tract to another RS class releases its old chunk.
+func TestTranscodeTract(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+	txn := s.WriteTxn(1)
+	defer txn.Commit()
+	bid := core.BlobIDFromParts(7, 3)
+	tid := core.TractIDFromParts(bid, 0)
+	old := core.RSChunkID{Partition: 0x80000007, ID: 5}
+	cur := core.RSChunkID{Partition: 0x80000007, ID: 100}
+	txn.PutPartition(&pb.Partition{Id: proto.Uint32(7)})
+	txn.PutBlob(bid, &pb.Blob{Tracts: []*pb.Tract{{Version: 1}}})
+
+	err := txn.PutRSChunk(old, core.StorageClass_RS_6_3, []core.TractserverID{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		[][]EncodedTract{{{ID: tid, Offset: 0, Length: 456, NewVersion: 2}}, {}, {}, {}, {}, {}})
+	if err != core.NoError {
+		t.Fatal(err)
+	}
+	if err := txn.UpdateStorageClass(bid, core.StorageClass_RS_6_3, 10); err != core.NoError {
+		t.Fatal(err)
+	}
+	err = txn.PutRSChunk(cur, core.StorageClass_RS_8_3, []core.TractserverID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+		[][]EncodedTract{{}, {}, {{ID: tid, Offset: 0, Length: 456, NewVersion: 2}}, {}, {}, {}, {}, {}})
+	if err != core.NoError {
+		t.Fatal(err)
+	}
+	if err := txn.UpdateStorageClass(bid, core.StorageClass_RS_8_3, 30); err != core.NoError {
+		t.Fatal(err)
+	}
+	// Cleaning up without changing the class keeps the time it changed.
+	if err := txn.UpdateStorageClass(bid, core.StorageClass_RS_8_3, 40); err != core.NoError {
+		t.Fatal(err)
+	}
+	if ct := txn.GetBlob(bid).GetClassTime(); ct != 30 {
+		t.Errorf("expected class time 30, got %d", ct)
+	}
+
+	if txn.GetRSChunk(old) != nil {
+		t.Errorf("old rs chunk is still present")
+	}
+	if c := txn.GetRSChunk(cur); c == nil || len(c.Data[2].Tracts) != 1 {
+		t.Errorf("new rs chunk is missing the tract: %+v", c)
+	}
+	info, err := txn.GetTract(tid)
+	if err != core.NoError {
+		t.Fatal(err)
+	}
+	if info.RS.Class != core.StorageClass_RS_8_3 || info.RS.Chunk != cur.Add(2) || info.Version != 2 {
+		t.Errorf("unexpected tract: %+v", info)
+	}
 }
 
 func TestGetKnownTSIDs(t *testing.T) {
@@ -421,3 +558,146 @@ func TestGetKnownTSIDs(t *testing.T) {
 		t.Errorf("mismatch: %v", ids)
 	}
 }
+
+// Databases from before storage classes were defined in state are upgraded to
+// use ChunkPointers.
+func TestUpgradeClassDefs(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+
+	// Make it look like an old database.
+	tx := s.WriteTxn(1)
+	tx.delete(metaBucket, schemaKey)
+	tx.delete(metaBucket, classesKey)
+	tx.PutPartition(&pb.Partition{Id: proto.Uint32(1)})
+	tx.PutBlob(core.BlobIDFromParts(1, 1), &pb.Blob{Repl: proto.Uint32(3),
+		Tracts: []*pb.Tract{{Hosts: []core.TractserverID{1, 2, 3}}}})
+	tx.PutBlob(core.BlobIDFromParts(1, 2), &pb.Blob{Repl: proto.Uint32(3),
+		Tracts: []*pb.Tract{
+			{Rs63Chunk: []byte{1}},
+			{Rs63Chunk: []byte{2}, Lrc1222Chunk: []byte{3}},
+		}})
+	tx.Commit()
+
+	// Do one blob at a time, and stop after the first.
+	defer func(n int) { upgradeBatch = n }(upgradeBatch)
+	upgradeBatch = 1
+	upgradeTxn(s.db, func(t *Txn) { t.upgradeToClassDefs() })
+	tx = s.ReadOnlyTxn()
+	if v, ok := tx.get(metaBucket, upgradeKey); !ok || !bytes.Equal(v, blobID2Key(core.BlobIDFromParts(1, 1))) {
+		t.Errorf("progress wasn't recorded: %v", v)
+	}
+	if tract := tx.GetBlob(core.BlobIDFromParts(1, 2)).Tracts[0]; tract.Chunks != nil {
+		t.Errorf("second blob was upgraded in the first batch")
+	}
+	tx.Commit()
+
+	// The rest of the upgrade resumes from there.
+	upgrade(s.db)
+
+	tx = s.ReadOnlyTxn()
+	defer tx.Commit()
+	if v, ok := tx.get(metaBucket, schemaKey); !ok || binary.BigEndian.Uint32(v) != schemaVersion {
+		t.Errorf("schema version wasn't updated")
+	}
+	if _, ok := tx.get(metaBucket, upgradeKey); ok {
+		t.Errorf("progress wasn't cleared")
+	}
+	if defs := tx.GetStorageClasses(); !reflect.DeepEqual(defs, storageclass.Builtin) {
+		t.Errorf("expected the built-in classes, got %v", defs)
+	}
+	if tract := tx.GetBlob(core.BlobIDFromParts(1, 1)).Tracts[0]; len(tract.Hosts) != 3 || tract.Chunks != nil {
+		t.Errorf("replicated tract changed: %+v", tract)
+	}
+	tracts := tx.GetBlob(core.BlobIDFromParts(1, 2)).Tracts
+	expected := [][]*pb.ChunkPointer{
+		{{Class: core.StorageClass_RS_6_3, Chunk: []byte{1}}},
+		{{Class: core.StorageClass_RS_6_3, Chunk: []byte{2}}, {Class: core.StorageClass_LRC_12_2_2, Chunk: []byte{3}}},
+	}
+	for i, tract := range tracts {
+		if tract.Rs63Chunk != nil || tract.Lrc1222Chunk != nil || !reflect.DeepEqual(tract.Chunks, expected[i]) {
+			t.Errorf("tract %d wasn't upgraded: %+v", i, tract)
+		}
+	}
+}
+
+// RS chunks from before they recorded their class get it from the tracts
+// that point to them.
+func TestUpgradeChunkClasses(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+
+	// Make it look like a database from schema version 1.
+	bid := core.BlobIDFromParts(7, 3)
+	cid := core.RSChunkID{Partition: 0x80000007, ID: 5}
+	tx := s.WriteTxn(1)
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], 1)
+	tx.put(metaBucket, schemaKey, b[:], defaultFillPct)
+	tx.PutPartition(&pb.Partition{Id: proto.Uint32(7)})
+	tx.PutBlob(bid, &pb.Blob{Tracts: []*pb.Tract{{Version: 1}}})
+	hosts := []core.TractserverID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	data := make([][]EncodedTract, 12)
+	data[0] = []EncodedTract{{ID: core.TractIDFromParts(bid, 0), Length: 100}}
+	if err := tx.PutRSChunk(cid, core.StorageClass_LRC_12_2_2, hosts, data); err != core.NoError {
+		t.Fatal(err)
+	}
+	chunk := tx.GetRSChunk(cid)
+	chunk.Class = core.StorageClass_REPLICATED
+	tx.put(rschunkBucket, rschunkID2Key(cid), mustMarshal(chunk), rsChunkFillPct)
+	tx.Commit()
+
+	upgrade(s.db)
+
+	tx = s.ReadOnlyTxn()
+	defer tx.Commit()
+	if c := tx.GetRSChunk(cid).Class; c != core.StorageClass_LRC_12_2_2 {
+		t.Errorf("expected chunk to be LRC_12_2_2, got %s", c)
+	}
+	if v, ok := tx.get(metaBucket, schemaKey); !ok || binary.BigEndian.Uint32(v) != schemaVersion {
+		t.Errorf("schema version wasn't updated")
+	}
+}
+
+func TestAddStorageClass(t *testing.T) {
+	s := getTestState(t)
+	defer s.Close()
+
+	tx := s.WriteTxn(1)
+	defer tx.Commit()
+
+	def := &pb.StorageClassDef{Id: 20, Name: "RS_4_2", Family: pb.StorageClassDef_RS, N: 4, M: 2}
+	if err := tx.AddStorageClass(def); err != core.NoError {
+		t.Fatalf("couldn't add class: %s", err)
+	}
+	if cls := tx.classes().Get(20); cls == nil || cls.Def().Name != "RS_4_2" {
+		t.Errorf("class wasn't added")
+	}
+
+	// The name and placement can change, but not the coding.
+	changed := *def
+	changed.Name, changed.MaxPerDomain = "RS_4_2_spread", 2
+	if err := tx.AddStorageClass(&changed); err != core.NoError {
+		t.Errorf("couldn't change the placement: %s", err)
+	}
+	changed.M = 3
+	if err := tx.AddStorageClass(&changed); err != core.ErrConflictingState {
+		t.Errorf("changed the coding of a class: %s", err)
+	}
+
+	// Chunks record their class, so two classes can have the same shape.
+	same := &pb.StorageClassDef{Id: 21, Name: "RS_12_4", Family: pb.StorageClassDef_RS, N: 12, M: 4}
+	if err := tx.AddStorageClass(same); err != core.NoError {
+		t.Errorf("couldn't add a class with the same pieces as another: %s", err)
+	}
+	bad := &pb.StorageClassDef{Id: 22, Name: "RS_0_2", Family: pb.StorageClassDef_RS, N: 0, M: 2}
+	if err := tx.AddStorageClass(bad); err != core.ErrInvalidArgument {
+		t.Errorf("added an invalid class: %s", err)
+	}
+
+	defs := tx.GetStorageClasses()
+	if len(defs) != len(storageclass.Builtin)+2 || *defs[len(defs)-2] != (pb.StorageClassDef{
+		Id: 20, Name: "RS_4_2_spread", Family: pb.StorageClassDef_RS, N: 4, M: 2, MaxPerDomain: 2}) {
+		t.Errorf("unexpected classes: %v", defs)
+	}
+}
@@ -12,6 +12,9 @@
 		Blob
 		Partition
 		RSChunk
+		ChunkPointer
+		StorageClassDef
+		StorageClasses
 */
 package statepb
 
@@ -23,6 +26,8 @@ import core "github.com/westerndigitalcorporation/blb/internal/core"
 
 import github_com_westerndigitalcorporation_blb_internal_core "github.com/westerndigitalcorporation/blb/internal/core"
 
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -36,17 +41,71 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type StorageClassDef_Family int32
+
+const (
+	StorageClassDef_REPLICATED StorageClassDef_Family = 0
+	StorageClassDef_RS         StorageClassDef_Family = 1
+	StorageClassDef_LRC        StorageClassDef_Family = 2
+)
+
+var StorageClassDef_Family_name = map[int32]string{
+	0: "REPLICATED",
+	1: "RS",
+	2: "LRC",
+}
+var StorageClassDef_Family_value = map[string]int32{
+	"REPLICATED": 0,
+	"RS":         1,
+	"LRC":        2,
+}
+
+func (x StorageClassDef_Family) Enum() *StorageClassDef_Family {
+	p := new(StorageClassDef_Family)
+	*p = x
+	return p
+}
+func (x StorageClassDef_Family) String() string {
+	return proto.EnumName(StorageClassDef_Family_name, int32(x))
+}
+func (x *StorageClassDef_Family) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(StorageClassDef_Family_value, data, "StorageClassDef_Family")
+	if err != nil {
+		return err
+	}
+	*x = StorageClassDef_Family(value)
+	return nil
+}
+func (StorageClassDef_Family) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorState, []int{5, 0}
+}
+
 type Tract struct {
 	// Used for REPLICATED class.
 	Hosts   []github_com_westerndigitalcorporation_blb_internal_core.TractserverID `protobuf:"varint,1,rep,name=hosts,casttype=github.com/westerndigitalcorporation/blb/internal/core.TractserverID" json:"hosts,omitempty"`
 	Version int                                                                    `protobuf:"varint,2,opt,name=version,casttype=int" json:"version"`
-	// Used for RS_X classes. The values here are always 10 bytes (80 bits).
-	// There must be exactly one of these for each RS_n_m enum value in
-	// core.StorageClass.
-	Rs63Chunk  []byte `protobuf:"bytes,11,opt,name=rs63_chunk,json=rs63Chunk" json:"rs63_chunk,omitempty"`
-	Rs83Chunk  []byte `protobuf:"bytes,12,opt,name=rs83_chunk,json=rs83Chunk" json:"rs83_chunk,omitempty"`
-	Rs103Chunk []byte `protobuf:"bytes,13,opt,name=rs103_chunk,json=rs103Chunk" json:"rs103_chunk,omitempty"`
-	Rs125Chunk []byte `protobuf:"bytes,14,opt,name=rs125_chunk,json=rs125Chunk" json:"rs125_chunk,omitempty"`
+	// End-to-end checksum (CRC32-Castagnoli) of the first checksum_length bytes
+	// of the tract, as computed by the client that wrote it. A missing or zero
+	// checksum_length means there is no checksum.
+	Checksum       *uint32 `protobuf:"varint,3,opt,name=checksum" json:"checksum,omitempty"`
+	ChecksumLength *uint32 `protobuf:"varint,4,opt,name=checksum_length,json=checksumLength" json:"checksum_length,omitempty"`
+	// Set if this tract is shared with the blob it was cloned from. The data
+	// lives in the tract with the same index in the origin blob, and hosts,
+	// version, and checksum here are unused.
+	Origin *github_com_westerndigitalcorporation_blb_internal_core.BlobID `protobuf:"varint,5,opt,name=origin,casttype=github.com/westerndigitalcorporation/blb/internal/core.BlobID" json:"origin,omitempty"`
+	// The clones that share this tract. The tract can't be written or deleted
+	// while this is non-empty.
+	Clones []github_com_westerndigitalcorporation_blb_internal_core.BlobID `protobuf:"varint,6,rep,name=clones,casttype=github.com/westerndigitalcorporation/blb/internal/core.BlobID" json:"clones,omitempty"`
+	// The RS chunks that this tract is stored in, at most one for each class.
+	// There's usually only one, unless the tract is moving between classes.
+	Chunks []*ChunkPointer `protobuf:"bytes,16,rep,name=chunks" json:"chunks,omitempty"`
+	// Before storage classes were defined in state, each class had its own
+	// field here. State.Open moves these to chunks, and nothing else uses them.
+	Rs63Chunk    []byte `protobuf:"bytes,11,opt,name=rs63_chunk,json=rs63Chunk" json:"rs63_chunk,omitempty"`
+	Rs83Chunk    []byte `protobuf:"bytes,12,opt,name=rs83_chunk,json=rs83Chunk" json:"rs83_chunk,omitempty"`
+	Rs103Chunk   []byte `protobuf:"bytes,13,opt,name=rs103_chunk,json=rs103Chunk" json:"rs103_chunk,omitempty"`
+	Rs125Chunk   []byte `protobuf:"bytes,14,opt,name=rs125_chunk,json=rs125Chunk" json:"rs125_chunk,omitempty"`
+	Lrc1222Chunk []byte `protobuf:"bytes,15,opt,name=lrc1222_chunk,json=lrc1222Chunk" json:"lrc1222_chunk,omitempty"`
 }
 
 func (m *Tract) Reset()                    { *m = Tract{} }
@@ -68,6 +127,41 @@ func (m *Tract) GetVersion() int {
 	return 0
 }
 
+func (m *Tract) GetChecksum() uint32 {
+	if m != nil && m.Checksum != nil {
+		return *m.Checksum
+	}
+	return 0
+}
+
+func (m *Tract) GetChecksumLength() uint32 {
+	if m != nil && m.ChecksumLength != nil {
+		return *m.ChecksumLength
+	}
+	return 0
+}
+
+func (m *Tract) GetOrigin() github_com_westerndigitalcorporation_blb_internal_core.BlobID {
+	if m != nil && m.Origin != nil {
+		return *m.Origin
+	}
+	return 0
+}
+
+func (m *Tract) GetClones() []github_com_westerndigitalcorporation_blb_internal_core.BlobID {
+	if m != nil {
+		return m.Clones
+	}
+	return nil
+}
+
+func (m *Tract) GetChunks() []*ChunkPointer {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
 func (m *Tract) GetRs63Chunk() []byte {
 	if m != nil {
 		return m.Rs63Chunk
@@ -96,6 +190,13 @@ func (m *Tract) GetRs125Chunk() []byte {
 	return nil
 }
 
+func (m *Tract) GetLrc1222Chunk() []byte {
+	if m != nil {
+		return m.Lrc1222Chunk
+	}
+	return nil
+}
+
 type Blob struct {
 	// Storage class for this blob (applies to all tracts).
 	Storage *core.StorageClass `protobuf:"varint,4,opt,name=storage,enum=core.StorageClass,def=0" json:"storage,omitempty"`
@@ -111,6 +212,29 @@ type Blob struct {
 	Atime *int64 `protobuf:"varint,11,opt,name=atime" json:"atime,omitempty"`
 	// Time that this blob can be automatically deleted, or zero if it is permanent.
 	Expires *int64 `protobuf:"varint,12,opt,name=expires,def=0" json:"expires,omitempty"`
+	// User-defined key/value metadata.
+	Metadata map[string]string `protobuf:"bytes,13,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Set only for write-once blobs: false until the blob is sealed, true after.
+	Sealed *bool `protobuf:"varint,14,opt,name=sealed" json:"sealed,omitempty"`
+	// Offset where the next append to this blob will go, or unset if nothing
+	// has been appended.
+	AppendOffset *int64 `protobuf:"varint,15,opt,name=append_offset,json=appendOffset" json:"append_offset,omitempty"`
+	// Tenant that owns this blob, for quotas and usage accounting. Unset if the
+	// blob has no owner.
+	Owner *string `protobuf:"bytes,16,opt,name=owner" json:"owner,omitempty"`
+	// Names that may read this blob, and that may also change it. If both are
+	// empty, any client may use it.
+	Readers []string `protobuf:"bytes,17,rep,name=readers" json:"readers,omitempty"`
+	Writers []string `protobuf:"bytes,18,rep,name=writers" json:"writers,omitempty"`
+	// Set once the blob has been truncated: the most tracts it had before, and
+	// the highest version of any tract that was dropped. Tractservers may still
+	// have copies of dropped tracts, so tracts added again below this point
+	// start at a higher version, and older copies are garbage.
+	TruncatedTracts  *uint32 `protobuf:"varint,19,opt,name=truncated_tracts,json=truncatedTracts" json:"truncated_tracts,omitempty"`
+	TruncatedVersion *uint32 `protobuf:"varint,20,opt,name=truncated_version,json=truncatedVersion" json:"truncated_version,omitempty"`
+	// Time that the storage class of this blob last changed, or unset if it
+	// never has.
+	ClassTime *int64 `protobuf:"varint,21,opt,name=class_time,json=classTime" json:"class_time,omitempty"`
 }
 
 func (m *Blob) Reset()                    { *m = Blob{} }
@@ -179,6 +303,69 @@ func (m *Blob) GetExpires() int64 {
 	return Default_Blob_Expires
 }
 
+func (m *Blob) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Blob) GetSealed() bool {
+	if m != nil && m.Sealed != nil {
+		return *m.Sealed
+	}
+	return false
+}
+
+func (m *Blob) GetAppendOffset() int64 {
+	if m != nil && m.AppendOffset != nil {
+		return *m.AppendOffset
+	}
+	return 0
+}
+
+func (m *Blob) GetOwner() string {
+	if m != nil && m.Owner != nil {
+		return *m.Owner
+	}
+	return ""
+}
+
+func (m *Blob) GetReaders() []string {
+	if m != nil {
+		return m.Readers
+	}
+	return nil
+}
+
+func (m *Blob) GetWriters() []string {
+	if m != nil {
+		return m.Writers
+	}
+	return nil
+}
+
+func (m *Blob) GetTruncatedTracts() uint32 {
+	if m != nil && m.TruncatedTracts != nil {
+		return *m.TruncatedTracts
+	}
+	return 0
+}
+
+func (m *Blob) GetTruncatedVersion() uint32 {
+	if m != nil && m.TruncatedVersion != nil {
+		return *m.TruncatedVersion
+	}
+	return 0
+}
+
+func (m *Blob) GetClassTime() int64 {
+	if m != nil && m.ClassTime != nil {
+		return *m.ClassTime
+	}
+	return 0
+}
+
 type Partition struct {
 	Id             *uint32 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 	NextBlobKey    *uint32 `protobuf:"varint,2,opt,name=next_blob_key,json=nextBlobKey" json:"next_blob_key,omitempty"`
@@ -221,6 +408,7 @@ func (m *Partition) GetNextRsChunkKey() uint64 {
 type RSChunk struct {
 	Data  []*RSChunk_Data                                                        `protobuf:"bytes,1,rep,name=data" json:"data,omitempty"`
 	Hosts []github_com_westerndigitalcorporation_blb_internal_core.TractserverID `protobuf:"varint,2,rep,name=hosts,casttype=github.com/westerndigitalcorporation/blb/internal/core.TractserverID" json:"hosts,omitempty"`
+	Class core.StorageClass                                                     `protobuf:"varint,3,opt,name=class,enum=core.StorageClass" json:"class"`
 }
 
 func (m *RSChunk) Reset()                    { *m = RSChunk{} }
@@ -242,6 +430,13 @@ func (m *RSChunk) GetHosts() []github_com_westerndigitalcorporation_blb_internal
 	return nil
 }
 
+func (m *RSChunk) GetClass() core.StorageClass {
+	if m != nil {
+		return m.Class
+	}
+	return core.StorageClass_REPLICATED
+}
+
 type RSChunk_Data struct {
 	Tracts []*RSChunk_Data_Tract `protobuf:"bytes,1,rep,name=tracts" json:"tracts,omitempty"`
 }
@@ -283,13 +478,135 @@ func (m *RSChunk_Data_Tract) GetOffset() uint32 {
 	return 0
 }
 
+type ChunkPointer struct {
+	Class core.StorageClass `protobuf:"varint,1,opt,name=class,enum=core.StorageClass" json:"class"`
+	Chunk []byte            `protobuf:"bytes,2,opt,name=chunk" json:"chunk,omitempty"`
+}
+
+func (m *ChunkPointer) Reset()                    { *m = ChunkPointer{} }
+func (m *ChunkPointer) String() string            { return proto.CompactTextString(m) }
+func (*ChunkPointer) ProtoMessage()               {}
+func (*ChunkPointer) Descriptor() ([]byte, []int) { return fileDescriptorState, []int{4} }
+
+func (m *ChunkPointer) GetClass() core.StorageClass {
+	if m != nil {
+		return m.Class
+	}
+	return core.StorageClass_REPLICATED
+}
+
+func (m *ChunkPointer) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+type StorageClassDef struct {
+	Id     core.StorageClass      `protobuf:"varint,1,opt,name=id,enum=core.StorageClass" json:"id"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name" json:"name"`
+	Family StorageClassDef_Family `protobuf:"varint,3,opt,name=family,enum=statepb.StorageClassDef_Family" json:"family"`
+	// Number of data and parity pieces in each chunk. For LRC, m counts both the
+	// local parity pieces, one for each local group, and the global ones.
+	N           int `protobuf:"varint,4,opt,name=n,casttype=int" json:"n"`
+	M           int `protobuf:"varint,5,opt,name=m,casttype=int" json:"m"`
+	LocalGroups int `protobuf:"varint,6,opt,name=local_groups,json=localGroups,casttype=int" json:"local_groups"`
+	// Length of each piece in bytes, or zero for the curator's default.
+	PieceLength int `protobuf:"varint,7,opt,name=piece_length,json=pieceLength,casttype=int" json:"piece_length"`
+	// If non-zero, at most this many pieces of a chunk are put in the same
+	// top-level failure domain.
+	MaxPerDomain int `protobuf:"varint,8,opt,name=max_per_domain,json=maxPerDomain,casttype=int" json:"max_per_domain"`
+}
+
+func (m *StorageClassDef) Reset()                    { *m = StorageClassDef{} }
+func (m *StorageClassDef) String() string            { return proto.CompactTextString(m) }
+func (*StorageClassDef) ProtoMessage()               {}
+func (*StorageClassDef) Descriptor() ([]byte, []int) { return fileDescriptorState, []int{5} }
+
+func (m *StorageClassDef) GetId() core.StorageClass {
+	if m != nil {
+		return m.Id
+	}
+	return core.StorageClass_REPLICATED
+}
+
+func (m *StorageClassDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StorageClassDef) GetFamily() StorageClassDef_Family {
+	if m != nil {
+		return m.Family
+	}
+	return StorageClassDef_REPLICATED
+}
+
+func (m *StorageClassDef) GetN() int {
+	if m != nil {
+		return m.N
+	}
+	return 0
+}
+
+func (m *StorageClassDef) GetM() int {
+	if m != nil {
+		return m.M
+	}
+	return 0
+}
+
+func (m *StorageClassDef) GetLocalGroups() int {
+	if m != nil {
+		return m.LocalGroups
+	}
+	return 0
+}
+
+func (m *StorageClassDef) GetPieceLength() int {
+	if m != nil {
+		return m.PieceLength
+	}
+	return 0
+}
+
+func (m *StorageClassDef) GetMaxPerDomain() int {
+	if m != nil {
+		return m.MaxPerDomain
+	}
+	return 0
+}
+
+type StorageClasses struct {
+	Classes []*StorageClassDef `protobuf:"bytes,1,rep,name=classes" json:"classes,omitempty"`
+}
+
+func (m *StorageClasses) Reset()                    { *m = StorageClasses{} }
+func (m *StorageClasses) String() string            { return proto.CompactTextString(m) }
+func (*StorageClasses) ProtoMessage()               {}
+func (*StorageClasses) Descriptor() ([]byte, []int) { return fileDescriptorState, []int{6} }
+
+func (m *StorageClasses) GetClasses() []*StorageClassDef {
+	if m != nil {
+		return m.Classes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Tract)(nil), "statepb.Tract")
 	proto.RegisterType((*Blob)(nil), "statepb.Blob")
+	proto.RegisterMapType((map[string]string)(nil), "statepb.Blob.MetadataEntry")
 	proto.RegisterType((*Partition)(nil), "statepb.Partition")
 	proto.RegisterType((*RSChunk)(nil), "statepb.RSChunk")
 	proto.RegisterType((*RSChunk_Data)(nil), "statepb.RSChunk.Data")
 	proto.RegisterType((*RSChunk_Data_Tract)(nil), "statepb.RSChunk.Data.Tract")
+	proto.RegisterType((*ChunkPointer)(nil), "statepb.ChunkPointer")
+	proto.RegisterType((*StorageClassDef)(nil), "statepb.StorageClassDef")
+	proto.RegisterType((*StorageClasses)(nil), "statepb.StorageClasses")
+	proto.RegisterEnum("statepb.StorageClassDef_Family", StorageClassDef_Family_name, StorageClassDef_Family_value)
 }
 func (m *Tract) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -316,6 +633,28 @@ func (m *Tract) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x10
 	i++
 	i = encodeVarintState(dAtA, i, uint64(m.Version))
+	if m.Checksum != nil {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintState(dAtA, i, uint64(*m.Checksum))
+	}
+	if m.ChecksumLength != nil {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintState(dAtA, i, uint64(*m.ChecksumLength))
+	}
+	if m.Origin != nil {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintState(dAtA, i, uint64(*m.Origin))
+	}
+	if len(m.Clones) > 0 {
+		for _, num := range m.Clones {
+			dAtA[i] = 0x30
+			i++
+			i = encodeVarintState(dAtA, i, uint64(num))
+		}
+	}
 	if m.Rs63Chunk != nil {
 		dAtA[i] = 0x5a
 		i++
@@ -340,6 +679,26 @@ func (m *Tract) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintState(dAtA, i, uint64(len(m.Rs125Chunk)))
 		i += copy(dAtA[i:], m.Rs125Chunk)
 	}
+	if m.Lrc1222Chunk != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintState(dAtA, i, uint64(len(m.Lrc1222Chunk)))
+		i += copy(dAtA[i:], m.Lrc1222Chunk)
+	}
+	if len(m.Chunks) > 0 {
+		for _, msg := range m.Chunks {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintState(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -405,6 +764,106 @@ func (m *Blob) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintState(dAtA, i, uint64(*m.Expires))
 	}
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k, _ := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for _, k := range keysForMetadata {
+			dAtA[i] = 0x6a
+			i++
+			v := m.Metadata[string(k)]
+			mapSize := 1 + len(k) + sovState(uint64(len(k))) + 1 + len(v) + sovState(uint64(len(v)))
+			i = encodeVarintState(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintState(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintState(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Sealed != nil {
+		dAtA[i] = 0x70
+		i++
+		if *m.Sealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.AppendOffset != nil {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintState(dAtA, i, uint64(*m.AppendOffset))
+	}
+	if m.Owner != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintState(dAtA, i, uint64(len(*m.Owner)))
+		i += copy(dAtA[i:], *m.Owner)
+	}
+	if len(m.Readers) > 0 {
+		for _, s := range m.Readers {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Writers) > 0 {
+		for _, s := range m.Writers {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.TruncatedTracts != nil {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintState(dAtA, i, uint64(*m.TruncatedTracts))
+	}
+	if m.TruncatedVersion != nil {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintState(dAtA, i, uint64(*m.TruncatedVersion))
+	}
+	if m.ClassTime != nil {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintState(dAtA, i, uint64(*m.ClassTime))
+	}
 	return i, nil
 }
 
@@ -475,6 +934,9 @@ func (m *RSChunk) MarshalTo(dAtA []byte) (int, error) {
 			i = encodeVarintState(dAtA, i, uint64(num))
 		}
 	}
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintState(dAtA, i, uint64(m.Class))
 	return i, nil
 }
 
@@ -540,41 +1002,165 @@ func (m *RSChunk_Data_Tract) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ChunkPointer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Tract) Size() (n int) {
+
+func (m *ChunkPointer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Hosts) > 0 {
-		for _, e := range m.Hosts {
-			n += 1 + sovState(uint64(e))
-		}
-	}
-	n += 1 + sovState(uint64(m.Version))
-	if m.Rs63Chunk != nil {
-		l = len(m.Rs63Chunk)
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.Rs83Chunk != nil {
-		l = len(m.Rs83Chunk)
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.Rs103Chunk != nil {
-		l = len(m.Rs103Chunk)
-		n += 1 + l + sovState(uint64(l))
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintState(dAtA, i, uint64(m.Class))
+	if m.Chunk != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintState(dAtA, i, uint64(len(m.Chunk)))
+		i += copy(dAtA[i:], m.Chunk)
 	}
-	if m.Rs125Chunk != nil {
-		l = len(m.Rs125Chunk)
-		n += 1 + l + sovState(uint64(l))
+	return i, nil
+}
+
+func (m *StorageClassDef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *StorageClassDef) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintState(dAtA, i, uint64(m.Id))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintState(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintState(dAtA, i, uint64(m.Family))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintState(dAtA, i, uint64(m.N))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintState(dAtA, i, uint64(m.M))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintState(dAtA, i, uint64(m.LocalGroups))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintState(dAtA, i, uint64(m.PieceLength))
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintState(dAtA, i, uint64(m.MaxPerDomain))
+	return i, nil
+}
+
+func (m *StorageClasses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageClasses) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Classes) > 0 {
+		for _, msg := range m.Classes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintState(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Tract) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Hosts) > 0 {
+		for _, e := range m.Hosts {
+			n += 1 + sovState(uint64(e))
+		}
+	}
+	n += 1 + sovState(uint64(m.Version))
+	if m.Checksum != nil {
+		n += 1 + sovState(uint64(*m.Checksum))
+	}
+	if m.ChecksumLength != nil {
+		n += 1 + sovState(uint64(*m.ChecksumLength))
+	}
+	if m.Origin != nil {
+		n += 1 + sovState(uint64(*m.Origin))
+	}
+	if len(m.Clones) > 0 {
+		for _, e := range m.Clones {
+			n += 1 + sovState(uint64(e))
+		}
+	}
+	if m.Rs63Chunk != nil {
+		l = len(m.Rs63Chunk)
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Rs83Chunk != nil {
+		l = len(m.Rs83Chunk)
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Rs103Chunk != nil {
+		l = len(m.Rs103Chunk)
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Rs125Chunk != nil {
+		l = len(m.Rs125Chunk)
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Lrc1222Chunk != nil {
+		l = len(m.Lrc1222Chunk)
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 2 + l + sovState(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *Blob) Size() (n int) {
@@ -607,6 +1193,45 @@ func (m *Blob) Size() (n int) {
 	if m.Expires != nil {
 		n += 1 + sovState(uint64(*m.Expires))
 	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovState(uint64(len(k))) + 1 + len(v) + sovState(uint64(len(v)))
+			n += mapEntrySize + 1 + sovState(uint64(mapEntrySize))
+		}
+	}
+	if m.Sealed != nil {
+		n += 2
+	}
+	if m.AppendOffset != nil {
+		n += 1 + sovState(uint64(*m.AppendOffset))
+	}
+	if m.Owner != nil {
+		l = len(*m.Owner)
+		n += 2 + l + sovState(uint64(l))
+	}
+	if len(m.Readers) > 0 {
+		for _, s := range m.Readers {
+			l = len(s)
+			n += 2 + l + sovState(uint64(l))
+		}
+	}
+	if len(m.Writers) > 0 {
+		for _, s := range m.Writers {
+			l = len(s)
+			n += 2 + l + sovState(uint64(l))
+		}
+	}
+	if m.TruncatedTracts != nil {
+		n += 2 + sovState(uint64(*m.TruncatedTracts))
+	}
+	if m.TruncatedVersion != nil {
+		n += 2 + sovState(uint64(*m.TruncatedVersion))
+	}
+	if m.ClassTime != nil {
+		n += 2 + sovState(uint64(*m.ClassTime))
+	}
 	return n
 }
 
@@ -639,6 +1264,7 @@ func (m *RSChunk) Size() (n int) {
 			n += 1 + sovState(uint64(e))
 		}
 	}
+	n += 1 + sovState(uint64(m.Class))
 	return n
 }
 
@@ -664,6 +1290,44 @@ func (m *RSChunk_Data_Tract) Size() (n int) {
 	return n
 }
 
+func (m *ChunkPointer) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovState(uint64(m.Class))
+	if m.Chunk != nil {
+		l = len(m.Chunk)
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *StorageClassDef) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovState(uint64(m.Id))
+	l = len(m.Name)
+	n += 1 + l + sovState(uint64(l))
+	n += 1 + sovState(uint64(m.Family))
+	n += 1 + sovState(uint64(m.N))
+	n += 1 + sovState(uint64(m.M))
+	n += 1 + sovState(uint64(m.LocalGroups))
+	n += 1 + sovState(uint64(m.PieceLength))
+	n += 1 + sovState(uint64(m.MaxPerDomain))
+	return n
+}
+
+func (m *StorageClasses) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Classes) > 0 {
+		for _, e := range m.Classes {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovState(x uint64) (n int) {
 	for {
 		n++
@@ -787,6 +1451,128 @@ func (m *Tract) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Checksum = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumLength", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChecksumLength = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var v github_com_westerndigitalcorporation_blb_internal_core.BlobID
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (github_com_westerndigitalcorporation_blb_internal_core.BlobID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Origin = &v
+		case 6:
+			if wireType == 0 {
+				var v github_com_westerndigitalcorporation_blb_internal_core.BlobID
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (github_com_westerndigitalcorporation_blb_internal_core.BlobID(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Clones = append(m.Clones, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthState
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v github_com_westerndigitalcorporation_blb_internal_core.BlobID
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowState
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (github_com_westerndigitalcorporation_blb_internal_core.BlobID(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Clones = append(m.Clones, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clones", wireType)
+			}
 		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rs63Chunk", wireType)
@@ -911,16 +1697,78 @@ func (m *Tract) Unmarshal(dAtA []byte) error {
 				m.Rs125Chunk = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lrc1222Chunk", wireType)
 			}
-			if skippy < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lrc1222Chunk = append(m.Lrc1222Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Lrc1222Chunk == nil {
+				m.Lrc1222Chunk = []byte{}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, &ChunkPointer{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -1132,61 +1980,129 @@ func (m *Blob) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Expires = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Partition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowState
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthState
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowState
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthState
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipState(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthState
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Partition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Partition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sealed", wireType)
 			}
-			var v uint32
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1196,17 +2112,18 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Id = &v
-		case 2:
+			b := bool(v != 0)
+			m.Sealed = &b
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextBlobKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppendOffset", wireType)
 			}
-			var v uint32
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1216,17 +2133,17 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (uint32(b) & 0x7F) << shift
+				v |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NextBlobKey = &v
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextRsChunkKey", wireType)
+			m.AppendOffset = &v
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1236,67 +2153,56 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NextRsChunkKey = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RSChunk) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+			s := string(dAtA[iNdEx:postIndex])
+			m.Owner = &s
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readers", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RSChunk: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RSChunk: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Readers = append(m.Readers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Writers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1306,31 +2212,280 @@ func (m *RSChunk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &RSChunk_Data{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Writers = append(m.Writers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v github_com_westerndigitalcorporation_blb_internal_core.TractserverID
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowState
-					}
-					if iNdEx >= l {
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncatedTracts", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TruncatedTracts = &v
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncatedVersion", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TruncatedVersion = &v
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassTime", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClassTime = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Partition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Partition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Partition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Id = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBlobKey", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NextBlobKey = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRsChunkKey", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NextRsChunkKey = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RSChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RSChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RSChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &RSChunk_Data{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v github_com_westerndigitalcorporation_blb_internal_core.TractserverID
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowState
+					}
+					if iNdEx >= l {
 						return io.ErrUnexpectedEOF
 					}
 					b := dAtA[iNdEx]
@@ -1357,33 +2512,251 @@ func (m *RSChunk) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthState
+				if packedLen < 0 {
+					return ErrInvalidLengthState
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v github_com_westerndigitalcorporation_blb_internal_core.TractserverID
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowState
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (github_com_westerndigitalcorporation_blb_internal_core.TractserverID(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Hosts = append(m.Hosts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			m.Class = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Class |= (core.StorageClass(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RSChunk_Data) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Data: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tracts = append(m.Tracts, &RSChunk_Data_Tract{})
+			if err := m.Tracts[len(m.Tracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RSChunk_Data_Tract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v github_com_westerndigitalcorporation_blb_internal_core.TractserverID
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowState
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (github_com_westerndigitalcorporation_blb_internal_core.TractserverID(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Hosts = append(m.Hosts, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -1406,7 +2779,7 @@ func (m *RSChunk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RSChunk_Data) Unmarshal(dAtA []byte) error {
+func (m *ChunkPointer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1429,17 +2802,36 @@ func (m *RSChunk_Data) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Data: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChunkPointer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChunkPointer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			m.Class = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Class |= (core.StorageClass(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1449,21 +2841,21 @@ func (m *RSChunk_Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tracts = append(m.Tracts, &RSChunk_Data_Tract{})
-			if err := m.Tracts[len(m.Tracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1487,7 +2879,7 @@ func (m *RSChunk_Data) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RSChunk_Data_Tract) Unmarshal(dAtA []byte) error {
+func (m *StorageClassDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1510,17 +2902,17 @@ func (m *RSChunk_Data_Tract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tract: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageClassDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageClassDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1530,27 +2922,45 @@ func (m *RSChunk_Data_Tract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Id |= (core.StorageClass(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Family", wireType)
 			}
-			m.Length = 0
+			m.Family = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1560,16 +2970,16 @@ func (m *RSChunk_Data_Tract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Length |= (uint32(b) & 0x7F) << shift
+				m.Family |= (StorageClassDef_Family(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
 			}
-			m.Offset = 0
+			m.N = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1579,11 +2989,168 @@ func (m *RSChunk_Data_Tract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= (uint32(b) & 0x7F) << shift
+				m.N |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+			}
+			m.M = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.M |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalGroups", wireType)
+			}
+			m.LocalGroups = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalGroups |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PieceLength", wireType)
+			}
+			m.PieceLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PieceLength |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerDomain", wireType)
+			}
+			m.MaxPerDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerDomain |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageClasses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageClasses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageClasses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Classes = append(m.Classes, &StorageClassDef{})
+			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -1715,44 +3282,75 @@ func init() {
 }
 
 var fileDescriptorState = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x41, 0x4f, 0xdb, 0x30,
-	0x14, 0x26, 0x69, 0x4a, 0xc5, 0x2b, 0xad, 0x86, 0xc5, 0xa4, 0xa8, 0x6c, 0x6d, 0x55, 0x69, 0x53,
-	0xb9, 0x24, 0xac, 0x88, 0x09, 0x71, 0x98, 0x44, 0x29, 0x08, 0x04, 0x07, 0x64, 0xd8, 0x8e, 0x43,
-	0x4e, 0x63, 0x5a, 0x8f, 0x10, 0x57, 0xb6, 0xcb, 0xe0, 0x4f, 0x4c, 0xd3, 0xae, 0xfb, 0x43, 0x1c,
-	0x77, 0xdd, 0x0e, 0xd5, 0xc4, 0xfe, 0xc0, 0xce, 0x9c, 0x26, 0xdb, 0x49, 0x59, 0xa7, 0xdd, 0xa6,
-	0x5d, 0x1c, 0xfb, 0xfb, 0x3e, 0xbf, 0xbc, 0xf7, 0xbd, 0x67, 0xe8, 0xb0, 0x54, 0x51, 0x91, 0x92,
-	0x24, 0xec, 0x8f, 0x05, 0x51, 0x5c, 0x84, 0xf1, 0x58, 0x90, 0x28, 0xa1, 0xa1, 0x54, 0x44, 0x65,
-	0xeb, 0x28, 0xb2, 0xdf, 0x60, 0x24, 0xb8, 0xe2, 0xa8, 0x94, 0x81, 0xb5, 0xe5, 0x01, 0x1f, 0x70,
-	0x83, 0x85, 0x7a, 0x67, 0xe9, 0x9a, 0xff, 0x10, 0x92, 0x0b, 0x6a, 0x16, 0xcb, 0xb4, 0x3e, 0xb8,
-	0x50, 0x3c, 0x15, 0xa4, 0xaf, 0xd0, 0x5b, 0x28, 0x0e, 0xb9, 0x54, 0xd2, 0x77, 0x9a, 0x85, 0x76,
-	0xa5, 0xbb, 0x7f, 0x3f, 0x69, 0xf4, 0x06, 0x4c, 0x0d, 0xc7, 0x51, 0xd0, 0xe7, 0x97, 0xe1, 0x7b,
-	0x2a, 0x75, 0x88, 0x98, 0x0d, 0x98, 0x22, 0x49, 0x9f, 0x8b, 0x11, 0x17, 0x44, 0x31, 0x9e, 0x86,
-	0x51, 0x12, 0x85, 0x33, 0xf1, 0x03, 0x13, 0x50, 0x52, 0x71, 0x45, 0xc5, 0x41, 0x0f, 0xdb, 0xb0,
-	0xe8, 0x19, 0x94, 0xae, 0xa8, 0x90, 0x8c, 0xa7, 0xbe, 0xdb, 0x74, 0xda, 0x95, 0x6e, 0xf9, 0x76,
-	0xd2, 0x98, 0xbb, 0x9f, 0x34, 0x0a, 0x2c, 0x55, 0x38, 0xe7, 0xd0, 0x53, 0x00, 0x21, 0x5f, 0xae,
-	0x9f, 0xf5, 0x87, 0xe3, 0xf4, 0xc2, 0x2f, 0x37, 0x9d, 0xf6, 0x22, 0x5e, 0xd0, 0xc8, 0x8e, 0x06,
-	0x2c, 0xbd, 0x99, 0xd3, 0x8b, 0x39, 0xbd, 0x99, 0xd1, 0x0d, 0x28, 0x0b, 0xf9, 0x62, 0x2d, 0xe7,
-	0x2b, 0x86, 0x07, 0x03, 0xfd, 0x2e, 0xe8, 0x6c, 0x64, 0x82, 0xea, 0x54, 0xd0, 0xd9, 0x30, 0x82,
-	0xd6, 0x27, 0x17, 0xbc, 0x6e, 0xc2, 0x23, 0xf4, 0x1c, 0xe6, 0x95, 0xa9, 0xc3, 0x18, 0x52, 0xee,
-	0x54, 0x83, 0xcc, 0x63, 0x5b, 0x1e, 0xce, 0x58, 0x84, 0xc0, 0x13, 0x74, 0x94, 0xd8, 0xa2, 0xb0,
-	0xd9, 0xa3, 0x15, 0x28, 0xc5, 0x34, 0xa1, 0x8a, 0xc6, 0x7e, 0xa1, 0xe9, 0xb4, 0x0b, 0x5b, 0xce,
-	0x1a, 0xce, 0x11, 0xb4, 0x09, 0x25, 0xa9, 0xb8, 0x20, 0x03, 0xea, 0x7b, 0x4d, 0xa7, 0x5d, 0xed,
-	0xa0, 0xc0, 0xb8, 0x76, 0x62, 0xc1, 0x9d, 0x84, 0x48, 0xb9, 0x05, 0x78, 0xf7, 0xf8, 0xe8, 0x60,
-	0x67, 0xfb, 0x74, 0xb7, 0x87, 0x73, 0x39, 0x0a, 0xc0, 0x1b, 0xb2, 0x54, 0xf9, 0x45, 0x73, 0x6d,
-	0x69, 0xe6, 0xda, 0x3e, 0x4b, 0xd5, 0x56, 0xa9, 0xb7, 0xbb, 0xb7, 0xfd, 0xfa, 0xe8, 0x14, 0x1b,
-	0x1d, 0x5a, 0x86, 0xe2, 0xa5, 0x62, 0x97, 0xd4, 0x07, 0x9d, 0x04, 0xb6, 0x07, 0x8d, 0x12, 0x83,
-	0x96, 0x2d, 0x6a, 0x0e, 0x3a, 0x65, 0x7a, 0x3d, 0x62, 0x82, 0x4a, 0xe3, 0xaa, 0x4d, 0x39, 0x43,
-	0x5a, 0xef, 0x60, 0xe1, 0x98, 0x08, 0xc5, 0x74, 0xbf, 0x51, 0x15, 0x5c, 0x16, 0xfb, 0x8e, 0x29,
-	0xd7, 0x65, 0x31, 0x6a, 0x41, 0x25, 0xa5, 0xd7, 0xea, 0x2c, 0x4a, 0x78, 0x74, 0x76, 0x41, 0x6f,
-	0x32, 0x27, 0xca, 0x1a, 0xd4, 0x4e, 0x1e, 0xd2, 0x1b, 0xb4, 0x0a, 0x4b, 0x46, 0x23, 0xa4, 0x35,
-	0xde, 0xe8, 0xb4, 0x35, 0x1e, 0xae, 0x6a, 0x02, 0x4b, 0xe3, 0xfe, 0x21, 0xbd, 0x69, 0xfd, 0x74,
-	0xa1, 0x84, 0x4f, 0x6c, 0xb7, 0x56, 0xc1, 0x8b, 0x89, 0x22, 0x59, 0x07, 0x1e, 0x4f, 0x3b, 0x90,
-	0xf1, 0x41, 0x8f, 0x28, 0x82, 0x8d, 0xe4, 0x61, 0x7c, 0xdd, 0xff, 0x32, 0xbe, 0xb5, 0xaf, 0x0e,
-	0x78, 0xfa, 0x77, 0x68, 0xfd, 0x8f, 0xb9, 0x58, 0xf9, 0x6b, 0x56, 0xb3, 0x43, 0x52, 0xfb, 0xec,
-	0xe4, 0xcf, 0xec, 0xcd, 0xd4, 0xbd, 0xc5, 0xee, 0x9e, 0x7e, 0x01, 0xdf, 0x26, 0x8d, 0x57, 0xff,
-	0x92, 0xe8, 0x41, 0xcf, 0x74, 0xe1, 0x09, 0xcc, 0x27, 0x34, 0x1d, 0xa8, 0x61, 0xf6, 0xba, 0x3c,
-	0x1d, 0x1b, 0x67, 0x98, 0x66, 0xf9, 0xf9, 0xb9, 0xa4, 0xca, 0x98, 0x3e, 0x65, 0x2d, 0xd6, 0x7d,
-	0x74, 0x7b, 0x57, 0x77, 0xbe, 0xdc, 0xd5, 0x9d, 0xef, 0x77, 0x75, 0xe7, 0xe3, 0x8f, 0xfa, 0xdc,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x3c, 0xd3, 0x8c, 0x84, 0x04, 0x00, 0x00,
+	// 1112 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcb, 0x6e, 0x23, 0x45,
+	0x17, 0x9e, 0x76, 0xb7, 0xed, 0xe4, 0xf8, 0x12, 0xa7, 0xfe, 0xf9, 0x51, 0xe3, 0x81, 0xd8, 0x32,
+	0x02, 0x1c, 0x21, 0xda, 0x33, 0x1e, 0x0d, 0x44, 0x41, 0x83, 0x14, 0xc7, 0x09, 0x13, 0x4d, 0x10,
+	0x51, 0x25, 0x8c, 0xc4, 0x06, 0xab, 0xdc, 0x5d, 0xb1, 0x9b, 0x74, 0x77, 0x59, 0xd5, 0xe5, 0x5c,
+	0xde, 0x80, 0x25, 0x4b, 0x24, 0x36, 0xf0, 0x36, 0xb3, 0x64, 0x0b, 0x12, 0x11, 0x0a, 0x6b, 0x5e,
+	0x20, 0x2b, 0x54, 0x97, 0x76, 0x9c, 0xcb, 0x20, 0xc4, 0x65, 0x63, 0xd7, 0xf9, 0xbe, 0xaf, 0x4e,
+	0xd7, 0xb9, 0xd4, 0x29, 0xe8, 0x86, 0x89, 0xa0, 0x3c, 0x21, 0x51, 0xc7, 0x9f, 0x72, 0x22, 0x18,
+	0xef, 0x04, 0x53, 0x4e, 0x86, 0x11, 0xed, 0xa4, 0x82, 0x08, 0xf3, 0x3b, 0x19, 0xea, 0x7f, 0x6f,
+	0xc2, 0x99, 0x60, 0xa8, 0x68, 0xc0, 0xfa, 0xfd, 0x11, 0x1b, 0x31, 0x85, 0x75, 0xe4, 0x4a, 0xd3,
+	0x75, 0xf7, 0xca, 0x25, 0xe3, 0x54, 0xfd, 0x68, 0xa6, 0xf5, 0x8b, 0x03, 0xf9, 0x03, 0x4e, 0x7c,
+	0x81, 0xbe, 0x84, 0xfc, 0x98, 0xa5, 0x22, 0x75, 0xad, 0xa6, 0xdd, 0xae, 0xf4, 0x9e, 0x5d, 0x9e,
+	0x37, 0xfa, 0xa3, 0x50, 0x8c, 0xa7, 0x43, 0xcf, 0x67, 0x71, 0xe7, 0x84, 0xa6, 0xd2, 0x45, 0x10,
+	0x8e, 0x42, 0x41, 0x22, 0x9f, 0xf1, 0x09, 0xe3, 0x44, 0x84, 0x2c, 0xe9, 0x0c, 0xa3, 0x61, 0xe7,
+	0x9a, 0x7f, 0x4f, 0x39, 0x4c, 0x29, 0x3f, 0xa6, 0x7c, 0xa7, 0x8f, 0xb5, 0x5b, 0xf4, 0x36, 0x14,
+	0x8f, 0x29, 0x4f, 0x43, 0x96, 0xb8, 0xb9, 0xa6, 0xd5, 0xae, 0xf4, 0x4a, 0x2f, 0xcf, 0x1b, 0xf7,
+	0x2e, 0xcf, 0x1b, 0x76, 0x98, 0x08, 0x9c, 0x71, 0xa8, 0x0e, 0x0b, 0xfe, 0x98, 0xfa, 0x47, 0xe9,
+	0x34, 0x76, 0x6d, 0xa9, 0xc3, 0x33, 0x1b, 0xbd, 0x0b, 0x4b, 0xd9, 0x7a, 0x10, 0xd1, 0x64, 0x24,
+	0xc6, 0xae, 0xa3, 0x24, 0xd5, 0x0c, 0xde, 0x55, 0x28, 0xfa, 0x02, 0x0a, 0x8c, 0x87, 0xa3, 0x30,
+	0x71, 0xf3, 0x4d, 0xab, 0xed, 0xf4, 0x36, 0x2e, 0xcf, 0x1b, 0x4f, 0xff, 0x66, 0x30, 0xbd, 0x88,
+	0x0d, 0x77, 0xfa, 0xd8, 0x38, 0x94, 0xae, 0xfd, 0x88, 0x25, 0x34, 0x75, 0x0b, 0x4d, 0xfb, 0x5f,
+	0x72, 0xad, 0x1d, 0xa2, 0xf7, 0xa1, 0xe0, 0x8f, 0xa7, 0xc9, 0x51, 0xea, 0xd6, 0x9a, 0x76, 0xbb,
+	0xd4, 0xfd, 0xbf, 0x67, 0xaa, 0xea, 0x6d, 0x4a, 0x78, 0x8f, 0xa9, 0xdd, 0xd8, 0x88, 0xd0, 0x9b,
+	0x00, 0x3c, 0xfd, 0xe0, 0xf1, 0x40, 0x99, 0x6e, 0xa9, 0x69, 0xb5, 0xcb, 0x78, 0x51, 0x22, 0x4a,
+	0xad, 0xe9, 0xb5, 0x8c, 0x2e, 0x67, 0xf4, 0x9a, 0xa1, 0x1b, 0x50, 0xe2, 0xe9, 0xa3, 0x87, 0x19,
+	0x5f, 0x51, 0x3c, 0x28, 0x68, 0x5e, 0xd0, 0x7d, 0x62, 0x04, 0xd5, 0x99, 0xa0, 0xfb, 0x44, 0x0b,
+	0xde, 0x82, 0x4a, 0xc4, 0xfd, 0x47, 0xdd, 0x6e, 0xd7, 0x48, 0x96, 0x94, 0xa4, 0x6c, 0x40, 0x25,
+	0x6a, 0xfd, 0xee, 0x80, 0x23, 0xc3, 0x44, 0x6b, 0x50, 0x4c, 0x05, 0xe3, 0x64, 0x44, 0x55, 0xcd,
+	0xaa, 0x5d, 0xe4, 0xa9, 0x1c, 0xec, 0x6b, 0x70, 0x33, 0x22, 0x69, 0xba, 0x0e, 0x78, 0x6b, 0x6f,
+	0x77, 0x67, 0x73, 0xe3, 0x60, 0xab, 0x8f, 0x33, 0x39, 0xf2, 0xc0, 0x19, 0x87, 0x89, 0x50, 0xa5,
+	0xac, 0x76, 0x97, 0xaf, 0x6d, 0x7b, 0x16, 0x26, 0x62, 0xbd, 0xd8, 0xdf, 0xda, 0xde, 0xf8, 0x7c,
+	0xf7, 0x00, 0x2b, 0x1d, 0x7a, 0x07, 0x0a, 0x42, 0x35, 0xa0, 0xea, 0xe4, 0x52, 0xb7, 0x3a, 0x4b,
+	0xa3, 0xea, 0x4b, 0x6c, 0x58, 0x84, 0xc0, 0xe1, 0x74, 0x12, 0xe9, 0x6e, 0xc4, 0x6a, 0x8d, 0x1e,
+	0x40, 0x31, 0xa0, 0x11, 0x15, 0x34, 0x50, 0xcd, 0x67, 0xaf, 0x5b, 0x0f, 0x71, 0x86, 0xa0, 0xfb,
+	0x90, 0x8f, 0x45, 0x18, 0x53, 0x17, 0x24, 0x85, 0xb5, 0x21, 0x51, 0xa2, 0xd0, 0x92, 0x46, 0x95,
+	0x21, 0x1d, 0xd1, 0xd3, 0x49, 0xc8, 0x69, 0xea, 0x96, 0x67, 0x8e, 0x0c, 0x82, 0x3e, 0x84, 0x85,
+	0x98, 0x0a, 0x12, 0x10, 0x41, 0xdc, 0x8a, 0x3a, 0xe3, 0x83, 0xd9, 0x19, 0x65, 0xb2, 0xbc, 0x4f,
+	0x0d, 0xbb, 0x95, 0x08, 0x7e, 0x86, 0x67, 0x62, 0xf4, 0x1a, 0x14, 0x52, 0x4a, 0x22, 0x1a, 0xa8,
+	0x72, 0x2c, 0x60, 0x63, 0xc9, 0x52, 0x90, 0xc9, 0x84, 0x26, 0xc1, 0x80, 0x1d, 0x1e, 0xa6, 0x54,
+	0xa8, 0x52, 0xd8, 0xb8, 0xac, 0xc1, 0xcf, 0x14, 0x26, 0x0f, 0xca, 0x4e, 0x12, 0xca, 0xdd, 0x5a,
+	0xd3, 0x6a, 0x2f, 0x62, 0x6d, 0x20, 0x17, 0x8a, 0x9c, 0x92, 0x80, 0xf2, 0xd4, 0x5d, 0x6e, 0xda,
+	0xed, 0x45, 0x9c, 0x99, 0x92, 0x39, 0xe1, 0xa1, 0x90, 0x0c, 0xd2, 0x8c, 0x31, 0xd1, 0x2a, 0xd4,
+	0x04, 0x9f, 0x26, 0x3e, 0x11, 0x34, 0x18, 0x98, 0x5c, 0xff, 0x4f, 0x65, 0x71, 0x69, 0x86, 0xeb,
+	0x19, 0x80, 0xde, 0x83, 0xe5, 0x2b, 0x69, 0x76, 0xff, 0xef, 0x2b, 0xed, 0x95, 0x8f, 0x17, 0x1a,
+	0xaf, 0x7f, 0x04, 0x95, 0x6b, 0x91, 0xa3, 0x1a, 0xd8, 0x47, 0xf4, 0xcc, 0xb5, 0xd4, 0x81, 0xe5,
+	0x52, 0x06, 0x71, 0x4c, 0xa2, 0x29, 0x55, 0x55, 0x5b, 0xc4, 0xda, 0x58, 0xcf, 0xad, 0x59, 0xeb,
+	0xce, 0xb7, 0x3f, 0x34, 0xac, 0xd6, 0x57, 0xb0, 0xb8, 0x47, 0xb8, 0x08, 0xe5, 0x8d, 0x43, 0x55,
+	0xc8, 0x85, 0x81, 0xda, 0x5d, 0xc1, 0xb9, 0x30, 0x40, 0x2d, 0xa8, 0x24, 0xf4, 0x54, 0x0c, 0x86,
+	0x11, 0x1b, 0x0e, 0xa4, 0x63, 0x5d, 0xfa, 0x92, 0x04, 0x65, 0xde, 0x9f, 0xd3, 0x33, 0xb4, 0x0a,
+	0xcb, 0x4a, 0xc3, 0x53, 0xdd, 0xd5, 0x4a, 0x27, 0x7b, 0xc1, 0xc1, 0x55, 0x49, 0xe0, 0x54, 0x35,
+	0xf6, 0x73, 0x7a, 0xd6, 0xfa, 0xde, 0x86, 0x22, 0xde, 0x57, 0x26, 0x5a, 0x05, 0x47, 0x95, 0xd3,
+	0xba, 0x71, 0x73, 0x0d, 0xef, 0xf5, 0x89, 0x20, 0x58, 0x49, 0xae, 0x06, 0x6d, 0xee, 0xbf, 0x19,
+	0xb4, 0x1e, 0xe4, 0x7d, 0x79, 0x9b, 0x5c, 0xfb, 0x55, 0xf7, 0xac, 0xe7, 0xc8, 0xd1, 0x8b, 0xb5,
+	0xac, 0xfe, 0x93, 0x05, 0x8e, 0x3c, 0x1e, 0x7a, 0x7c, 0xe3, 0xe2, 0x3c, 0xb8, 0x33, 0x8a, 0xeb,
+	0xb7, 0xa8, 0xfe, 0x9d, 0x95, 0x3d, 0x20, 0x2f, 0x66, 0xd9, 0x2e, 0xf7, 0xb6, 0xe5, 0x07, 0x7e,
+	0x3e, 0x6f, 0x7c, 0xfc, 0x4f, 0x02, 0xdb, 0xe9, 0xab, 0xaa, 0xbd, 0x01, 0x05, 0x33, 0xec, 0xf5,
+	0xbb, 0xa1, 0x0f, 0x6f, 0x30, 0xc9, 0x9a, 0x9e, 0xb7, 0xe7, 0x59, 0x8d, 0xb5, 0x0e, 0xa0, 0x3c,
+	0x3f, 0x3b, 0xaf, 0x72, 0x63, 0xfd, 0xa5, 0xdc, 0xc8, 0x76, 0xd3, 0xb3, 0x2d, 0xa7, 0x66, 0x9b,
+	0x36, 0x5a, 0x5f, 0xdb, 0xb0, 0x34, 0xbf, 0xa7, 0x4f, 0x0f, 0x51, 0x7b, 0x16, 0xfd, 0x9f, 0xb9,
+	0x95, 0xf1, 0xb8, 0xe0, 0x24, 0x24, 0x36, 0x1d, 0x6c, 0x70, 0x85, 0xa0, 0xa7, 0x50, 0x38, 0x24,
+	0x71, 0x18, 0x9d, 0x99, 0xd2, 0x35, 0x66, 0x05, 0xb8, 0xf1, 0x35, 0x6f, 0x5b, 0xc9, 0xb2, 0x60,
+	0xf5, 0x26, 0xf4, 0x3a, 0x58, 0x89, 0xeb, 0xdc, 0x7e, 0x5b, 0xad, 0x44, 0x52, 0xb1, 0x9b, 0xbf,
+	0x83, 0x8a, 0x91, 0x07, 0xe5, 0x88, 0xf9, 0x24, 0x1a, 0x8c, 0x38, 0x9b, 0x4e, 0xe4, 0xb3, 0x76,
+	0x4b, 0x55, 0x52, 0x82, 0x4f, 0x14, 0x2f, 0xf5, 0x93, 0x90, 0xfa, 0x34, 0x7b, 0x81, 0x8b, 0x77,
+	0xe8, 0x95, 0xc0, 0xbc, 0xc5, 0x8f, 0xa0, 0x1a, 0x93, 0xd3, 0xc1, 0x84, 0xf2, 0x41, 0xc0, 0x62,
+	0x12, 0x26, 0xee, 0xc2, 0xed, 0x1d, 0xe5, 0x98, 0x9c, 0xee, 0x51, 0xde, 0x57, 0x82, 0xd6, 0x2a,
+	0x14, 0x74, 0x80, 0xa8, 0x0a, 0x73, 0x4f, 0x42, 0xed, 0x1e, 0x2a, 0x40, 0x0e, 0xef, 0xd7, 0x2c,
+	0x54, 0x04, 0x7b, 0x17, 0x6f, 0xd6, 0x72, 0xad, 0x3e, 0x54, 0xe7, 0x73, 0x43, 0x53, 0xd4, 0x85,
+	0xa2, 0xaf, 0x97, 0xa6, 0x8d, 0xdd, 0x57, 0x65, 0x11, 0x67, 0xc2, 0x5e, 0xed, 0xe5, 0xc5, 0x8a,
+	0xf5, 0xe3, 0xc5, 0x8a, 0xf5, 0xeb, 0xc5, 0x8a, 0xf5, 0xcd, 0x6f, 0x2b, 0xf7, 0xfe, 0x18, 0x00,
+	0x48, 0x3d, 0x6a, 0xc2, 0x85, 0x09, 0x00, 0x00,
 }
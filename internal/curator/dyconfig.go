@@ -22,6 +22,9 @@ type DyConfig struct {
 
 	// Number of curator groups in this cluster.
 	CuratorGroups int
+
+	// If not nil, replaces the storage policy from the curator's config.
+	StoragePolicy *StoragePolicy
 }
 
 // DefaultDyConfig holds default values for dynamic configuration.
@@ -44,6 +47,7 @@ func (c *Curator) updateDyConfig(dyc DyConfig) {
 	}
 	updateRateGbps(c.rsEncodeBwLim, dyc.RSEncodeBandwidthGbps/float32(dyc.CuratorGroups))
 	updateRateGbps(c.recoveryBwLim, dyc.RecoveryBandwidthGbps/float32(dyc.CuratorGroups))
+	c.setStoragePolicy(dyc.StoragePolicy)
 }
 
 func updateRateGbps(tb *tokenbucket.TokenBucket, gbps float32) {
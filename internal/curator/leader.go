@@ -47,11 +47,13 @@ func (c *Curator) heartbeatLoop() {
 	for {
 		c.blockIfNotLeader()
 
-		if reply, err := c.mc.CuratorHeartbeat(curatorID); err != core.NoError {
+		if reply, err := c.mc.CuratorHeartbeat(curatorID, c.getUsage()); err != core.NoError {
 			log.Errorf("[curator] error sending heartbeat, will retry, err=%s", err)
 		} else {
 			log.V(2).Infof("[curator] sent heartbeat to master successfully")
 
+			c.setQuotas(reply.Quotas, reply.OtherUsage)
+
 			replyView := toMap(reply.Partitions)
 			c.lock.Lock()
 			cachedView := toMap(c.partitions)
@@ -213,13 +215,17 @@ func (c *Curator) gcMetadataLoop() {
 		c.blockIfNotLeader()
 		<-gcTicker.C
 
-		cutoff := time.Now().Add(-c.config.MetadataUndeleteTime).UnixNano()
+		now := time.Now()
+		cutoff := now.Add(-c.config.MetadataUndeleteTime).UnixNano()
+		unsealedCutoff := now.Add(-c.config.UnsealedBlobTTL).UnixNano()
 
 		var toDelete []core.BlobID
 		c.stateHandler.ForEachBlob(true, func(id core.BlobID, blob *pb.Blob) {
 			del := blob.GetDeleted()
 			exp := blob.GetExpires()
-			if (del != 0 && del < cutoff) || (exp != 0 && exp < cutoff) {
+			// Write-once blobs that were abandoned before being sealed.
+			abandoned := blob.Sealed != nil && !blob.GetSealed() && blob.GetMtime() < unsealedCutoff
+			if (del != 0 && del < cutoff) || (exp != 0 && exp < cutoff) || abandoned {
 				// The blob can be GC-ed.
 				toDelete = append(toDelete, id)
 
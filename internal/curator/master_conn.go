@@ -13,8 +13,9 @@ type MasterConnection interface {
 	// RegisterCurator sends a request to the master to register this curator.
 	RegisterCurator() (core.RegisterCuratorReply, core.Error)
 
-	// CuratorHeartbeat sends a heartbeat to the master.
-	CuratorHeartbeat(core.CuratorID) (core.CuratorHeartbeatReply, core.Error)
+	// CuratorHeartbeat sends a heartbeat to the master, with the usage of
+	// each blob owner.
+	CuratorHeartbeat(core.CuratorID, map[string]core.TenantUsage) (core.CuratorHeartbeatReply, core.Error)
 
 	// NewPartition sends a request for a new partition to the master.
 	NewPartition(core.CuratorID) (core.NewPartitionReply, core.Error)
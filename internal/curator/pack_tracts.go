@@ -12,6 +12,7 @@ import (
 
 	"github.com/westerndigitalcorporation/blb/internal/core"
 	"github.com/westerndigitalcorporation/blb/internal/curator/durable/state"
+	"github.com/westerndigitalcorporation/blb/internal/curator/storageclass"
 )
 
 const (
@@ -29,7 +30,7 @@ const (
 
 // Lifecycle:
 // Create a new one with makeTractPacker.
-// Add tracts with addTract.
+// Add tracts with addTract or addRSTract.
 // Call doneAdding.
 // Call packTracts.
 // Call packChunks.
@@ -57,19 +58,38 @@ func makeTractPacker(c tpContext, opm *server.OpMetric, cls core.StorageClass, n
 	return &tractPacker{c: c, cls: cls, n: n, m: m, target: target, statSem: ss, stamps: stamps, opm: opm}
 }
 
-func (tp *tractPacker) addTract(tid core.TractID, from []core.TSAddr, version int) {
+func (tp *tractPacker) addTract(tid core.TractID, from []core.TSAddr, version int, checksum core.TractChecksum) {
 	pts := &core.PackTractSpec{
-		ID:      tid,
-		From:    from,
-		Version: version,
-		Offset:  -1,
-		Length:  -1,
+		ID:       tid,
+		From:     from,
+		Version:  version,
+		Offset:   -1,
+		Length:   -1,
+		Checksum: checksum,
 	}
 	tp.tracts = append(tp.tracts, pts)
 	tp.sizeWg.Add(1)
 	go tp.doStat(pts)
 }
 
+// addRSTract adds a tract that's stored in an RS chunk of another class, so
+// that it can be moved to this class without becoming replicated first. Its
+// data is read from 'piece', at 'offset', on the tractserver 'from'.
+func (tp *tractPacker) addRSTract(tid core.TractID, piece core.RSChunkID, from core.TSAddr, offset, length, version int, checksum core.TractChecksum) {
+	// We already know the length, and tracts in RS chunks can't be written,
+	// so there's no need to stat anything.
+	tp.tracts = append(tp.tracts, &core.PackTractSpec{
+		ID:          tid,
+		From:        []core.TSAddr{from},
+		Version:     version,
+		Offset:      -1,
+		Length:      length,
+		Checksum:    checksum,
+		Piece:       piece,
+		PieceOffset: offset,
+	})
+}
+
 func (tp *tractPacker) doStat(pts *core.PackTractSpec) {
 	defer tp.sizeWg.Done()
 	tp.statSem.Acquire()
@@ -282,7 +302,8 @@ func (tp *tractPacker) encEncode(op *rsEncodeOp) (err core.Error) {
 	tsAddrs := op.zipAddrs()
 	dest0 := tsAddrs[tp.n] // first parity chunk destination
 	log.Infof("tractPacker: started RSEncode for %s on %s", op.base, dest0.Host)
-	err = tp.c.RSEncode(dest0.Host, dest0.ID, op.base, tp.target, tsAddrs[:tp.n], tsAddrs[tp.n:])
+	code, _ := storageclass.Get(tp.cls).LRCParams()
+	err = tp.c.RSEncode(dest0.Host, dest0.ID, op.base, tp.target, tsAddrs[:tp.n], tsAddrs[tp.n:], code)
 	if err != core.NoError {
 		log.Errorf("tractPacker: error from RSEncode for %s on %s: %s", op.base, dest0.Host, err)
 	} else {
@@ -304,6 +325,11 @@ func (tp *tractPacker) encBump(op *rsEncodeOp) (err core.Error) {
 
 	for _, chunk := range op.data {
 		for _, tract := range chunk.tracts {
+			if tract.Piece.IsValid() {
+				// Tracts in RS chunks can't be written, so there's nothing to
+				// check or bump.
+				continue
+			}
 			thisTractIdx := i
 			for _, addr := range tract.From {
 				if stamp, ok := tp.stamps[tractOnHost{tract.ID, addr.ID}]; ok {
@@ -347,7 +373,11 @@ func (tp *tractPacker) encCommit(op *rsEncodeOp) (err core.Error) {
 	for i, c := range op.data {
 		ets := make([]state.EncodedTract, len(c.tracts))
 		for j, t := range c.tracts {
-			ets[j] = state.EncodedTract{ID: t.ID, Offset: t.Offset, Length: t.Length, NewVersion: t.Version + 1}
+			newVersion := t.Version + 1
+			if t.Piece.IsValid() {
+				newVersion = t.Version // wasn't bumped in encBump
+			}
+			ets[j] = state.EncodedTract{ID: t.ID, Offset: t.Offset, Length: t.Length, NewVersion: newVersion}
 		}
 		data[i] = ets
 	}
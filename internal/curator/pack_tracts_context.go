@@ -16,7 +16,7 @@ type tpContext interface {
 	DelTract(addr string, tsid core.TractserverID, tid core.TractID) core.Error
 	CtlStatTract(addr string, tsid core.TractserverID, id core.TractID, version int) core.StatTractReply
 	PackTracts(addr string, tsid core.TractserverID, length int, tracts []*core.PackTractSpec, id core.RSChunkID) core.Error
-	RSEncode(addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs, dests []core.TSAddr) core.Error
+	RSEncode(addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs, dests []core.TSAddr, code core.LRCParams) core.Error
 
 	// Volatile state functions:
 	AllocateTS(num int) (addrs []string, ids []core.TractserverID)
@@ -34,8 +34,9 @@ type tpContext interface {
 // curatorTPContext is a very thin tpContext implemented in terms of the
 // curator, used outside of tests.
 type curatorTPContext struct {
-	c    *Curator
-	term uint64 // current raft term, used to enforce that tract packing happens within one term
+	c            *Curator
+	term         uint64 // current raft term, used to enforce that tract packing happens within one term
+	maxPerDomain int    // limit on pieces in a failure domain, from the storage class
 }
 
 func (c *curatorTPContext) SetVersion(addr string, tsid core.TractserverID, id core.TractID, newVersion int, conditionalStamp uint64) core.Error {
@@ -57,15 +58,15 @@ func (c *curatorTPContext) PackTracts(addr string, tsid core.TractserverID, leng
 	return c.c.tt.PackTracts(addr, tsid, length, tracts, id)
 }
 
-func (c *curatorTPContext) RSEncode(addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs, dests []core.TSAddr) core.Error {
+func (c *curatorTPContext) RSEncode(addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs, dests []core.TSAddr, code core.LRCParams) core.Error {
 	// We're going to ask one TS to read length bytes from each of N other TSs,
 	// then write out length bytes to itself and M-1 others.
 	c.c.rsEncodeBwLim.Take(float32(length * (len(srcs) + len(dests) - 1)))
-	return c.c.tt.RSEncode(addr, tsid, id, length, srcs, dests, nil)
+	return c.c.tt.RSEncode(addr, tsid, id, length, srcs, dests, nil, code)
 }
 
 func (c *curatorTPContext) AllocateTS(num int) (addrs []string, ids []core.TractserverID) {
-	return c.c.allocateTS(num, nil, nil)
+	return c.c.allocateTSSpread(num, c.maxPerDomain, nil, nil)
 }
 
 func (c *curatorTPContext) MarkPending(id core.RSChunkID, n int) {
@@ -42,7 +42,7 @@ func (m *mockTPContext) PackTracts(addr string, tsid core.TractserverID, length
 	return m.GetResult("PackTracts", addr, tsid, length, tracts, id).(core.Error)
 }
 
-func (m *mockTPContext) RSEncode(addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs []core.TSAddr, dests []core.TSAddr) core.Error {
+func (m *mockTPContext) RSEncode(addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs []core.TSAddr, dests []core.TSAddr, code core.LRCParams) core.Error {
 	return m.GetResult("RSEncode", addr, tsid, id, length, srcs, dests).(core.Error)
 }
 
@@ -90,7 +90,7 @@ var testOpMetric = server.NewOpMetric("test_internal_ops", "op")
 
 func newTestTractPacker(t *testing.T) (*mockTPContext, *tractPacker) {
 	mc := newMockTPContext(t)
-	cls := storageclass.AllRS[0] // RS(6, 3)
+	cls := storageclass.AllRS()[0] // RS(6, 3)
 	n, m := cls.RSParams()
 	return mc, makeTractPacker(mc, testOpMetric, cls.ID(), n, m, RSPieceLength)
 }
@@ -117,8 +117,8 @@ func TestTPCallsFixVersion(t *testing.T) {
 	mc.addSuggestFixVersion(t1id, t1ver, "addr2")
 
 	// Add the tracts:
-	tp.addTract(t1id, t1addrs, t1ver)
-	tp.addTract(t2id, t2addrs, t2ver)
+	tp.addTract(t1id, t1addrs, t1ver, core.TractChecksum{})
+	tp.addTract(t2id, t2addrs, t2ver, core.TractChecksum{})
 	tp.doneAdding()
 
 	mc.NoMoreCalls()
@@ -130,3 +130,38 @@ func TestTPCallsFixVersion(t *testing.T) {
 		t.Error("t2 has wrong len")
 	}
 }
+
+// Tracts from other RS chunks don't need stats or version bumps, and keep their
+// versions.
+func TestTPRSTracts(t *testing.T) {
+	mc, tp := newTestTractPacker(t)
+
+	tid := core.TractIDFromParts(core.BlobIDFromParts(1, 1), 0)
+	piece := core.RSChunkID{Partition: 0x80000001, ID: 77}
+	from := core.TSAddr{ID: 5, Host: "addr5"}
+
+	tp.addRSTract(tid, piece, from, 1000, 12345, 4, core.TractChecksum{})
+	tp.doneAdding()
+	mc.NoMoreCalls()
+
+	if pts := tp.tracts[0]; pts.Length != 12345 || pts.Piece != piece || pts.PieceOffset != 1000 {
+		t.Fatalf("wrong spec: %+v", pts)
+	}
+
+	op := &rsEncodeOp{
+		base:  core.RSChunkID{Partition: 0x80000001, ID: 100},
+		data:  []packedChunk{{tracts: tp.tracts, length: 12345}},
+		tsids: []core.TractserverID{1},
+	}
+	if err := tp.encBump(op); err != core.NoError {
+		t.Fatalf("encBump failed: %s", err)
+	}
+	mc.NoMoreCalls()
+
+	data := [][]state.EncodedTract{{{ID: tid, Offset: -1, Length: 12345, NewVersion: 4}}}
+	mc.AddCall("CommitRSChunk", core.NoError, op.base, tp.cls, op.tsids, data)
+	if err := tp.encCommit(op); err != core.NoError {
+		t.Fatalf("encCommit failed: %s", err)
+	}
+	mc.NoMoreCalls()
+}
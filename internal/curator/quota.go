@@ -0,0 +1,142 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package curator
+
+import (
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
+	"github.com/westerndigitalcorporation/blb/internal/curator/storageclass"
+)
+
+// Quotas are enforced here, but set on the master. Each heartbeat to the master
+// reports how much space the blobs of each owner use on this curator, and gets
+// back the quotas along with each owner's usage on the other curators. Between
+// scans of our own blobs, we add what we've created to the usage, counting new
+// tracts as if they were full. Deleted blobs keep counting until they're
+// purged, since their tracts are still stored. Since curators don't hear about
+// each other's usage until their next heartbeat, an owner can go a little over
+// their quota.
+
+// rawUsage returns the space that 'bytes' of data in a blob with replication
+// factor 'repl' and storage class 'cls' take up on tractservers.
+func rawUsage(bytes int64, repl int, cls core.StorageClass) core.TenantUsage {
+	if c := storageclass.Get(cls); cls != core.StorageClass_REPLICATED && c != nil {
+		data, parity := c.RSParams()
+		bytes = bytes * int64(data+parity) / int64(data)
+	} else {
+		bytes *= int64(repl)
+	}
+	return core.TenantUsage{Bytes: bytes}
+}
+
+// tractUsage returns the most space that 'n' tracts of a blob with replication
+// factor 'repl' and storage class 'cls' can take up, once they're full.
+func tractUsage(n, repl int, cls core.StorageClass) core.TenantUsage {
+	return rawUsage(int64(n)*core.TractLength, repl, cls)
+}
+
+// blobUsage returns the usage of 'blob', based on its length. Only the last
+// tract can be partly full. We know how much of it is written if we have its
+// checksum, and count it as full otherwise. Tracts that the blob shares with
+// an origin count for the origin only.
+func blobUsage(blob *pb.Blob) core.TenantUsage {
+	var bytes int64
+	for i, tract := range blob.Tracts {
+		if tract.GetOrigin() != 0 {
+			continue
+		}
+		if i == len(blob.Tracts)-1 && tract.ChecksumLength != nil {
+			bytes += int64(tract.GetChecksumLength())
+		} else {
+			bytes += core.TractLength
+		}
+	}
+	u := rawUsage(bytes, int(blob.GetRepl()), blob.GetStorage())
+	u.Blobs = 1
+	return u
+}
+
+// usageLoop periodically recomputes how much space the blobs of each owner use.
+func (c *Curator) usageLoop() {
+	for {
+		c.blockIfNotLeader()
+
+		op := c.internalOpM.Start("UsageScan")
+		usage := make(map[string]core.TenantUsage)
+		c.stateHandler.ForEachBlob(true, func(id core.BlobID, blob *pb.Blob) {
+			if owner := blob.GetOwner(); owner != "" {
+				usage[owner] = usage[owner].Add(blobUsage(blob))
+			}
+		}, c.stateHandler.IsLeader)
+		op.End()
+
+		c.lock.Lock()
+		c.usage = usage
+		c.lock.Unlock()
+		log.V(1).Infof("usage of %d owners: %+v", len(usage), usage)
+
+		time.Sleep(c.config.UsageScanInterval)
+	}
+}
+
+// checkQuota returns core.ErrQuotaExceeded if adding 'add' to the usage of
+// 'owner' would put them over their quota.
+func (c *Curator) checkQuota(owner string, add core.TenantUsage) core.Error {
+	if owner == "" {
+		return core.NoError
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	limit, ok := c.quotas[owner]
+	if !ok {
+		return core.NoError
+	}
+	if c.usage[owner].Add(c.otherUsage[owner]).Add(add).Exceeds(limit) {
+		log.Infof("owner %q is over their quota %+v", owner, limit)
+		return core.ErrQuotaExceeded
+	}
+	return core.NoError
+}
+
+// addUsage adds 'add' to the usage of 'owner'.
+func (c *Curator) addUsage(owner string, add core.TenantUsage) {
+	if owner == "" {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.usage == nil {
+		c.usage = make(map[string]core.TenantUsage)
+	}
+	c.usage[owner] = c.usage[owner].Add(add)
+}
+
+// getUsage returns a copy of the usage of each owner, to send to the master.
+func (c *Curator) getUsage() map[string]core.TenantUsage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	usage := make(map[string]core.TenantUsage, len(c.usage))
+	for owner, u := range c.usage {
+		usage[owner] = u
+	}
+	return usage
+}
+
+// setQuotas records the quotas and the usage on other curators that we got
+// from the master.
+func (c *Curator) setQuotas(quotas, otherUsage map[string]core.TenantUsage) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.quotas = quotas
+	c.otherUsage = otherUsage
+}
@@ -4,13 +4,19 @@
 package curator
 
 import (
+	"sync"
+
 	log "github.com/golang/glog"
 
 	"github.com/westerndigitalcorporation/blb/internal/core"
+	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
+	"github.com/westerndigitalcorporation/blb/internal/curator/storageclass"
+	"github.com/westerndigitalcorporation/blb/pkg/lrc"
 )
 
 // reconstructChunk sends an RPC to one tractserver asking it to reconstruct the
-// missing pieces of an RS chunk, and updates durable state if it succeeds.
+// missing pieces of an RS chunk, and updates durable state if it succeeds. For
+// locally repairable codes, it only reads the pieces that the code needs.
 func (c *Curator) reconstructChunk(id core.RSChunkID, badIds []core.TractserverID) core.Error {
 	term := c.stateHandler.GetTerm()
 	chunk := c.stateHandler.GetRSChunk(id)
@@ -20,6 +26,11 @@ func (c *Curator) reconstructChunk(id core.RSChunkID, badIds []core.TractserverI
 
 	n := len(chunk.Data)
 	m := len(chunk.Hosts) - n
+	cls := chunkClass(chunk)
+	if cls == nil {
+		log.Errorf("rs chunk %s has unknown storage class %d", id, chunk.Class)
+		return core.ErrInvalidArgument
+	}
 
 	// Figure out what TSIDs we're keeping in the set. Note that this will end
 	// up in the same order as Hosts, data followed by parity.
@@ -34,21 +45,38 @@ func (c *Curator) reconstructChunk(id core.RSChunkID, badIds []core.TractserverI
 		}
 	}
 
-	// We shouldn't have gotten here (recovery loop should filter this out).
-	if len(okIds) < n {
-		log.Errorf("not enough good pieces to recover rs chunk %s", id)
-		return core.ErrAllocHost
-	}
-
 	// If we're all good, there's nothing to do.
 	if len(dstIdx) == 0 {
 		log.Errorf("no work to do for recovering rs chunk %s", id)
 		return core.ErrInvalidArgument
 	}
 
-	// Use the first n good tractservers as sources.
-	srcIds := okIds[:n]
-	srcIdx := okIdx[:n]
+	var srcIds []core.TractserverID
+	var srcIdx []int
+	var code core.LRCParams
+	if enc := chunkLRC(cls); enc != nil {
+		// Locally repairable codes only need the pieces in the repair set,
+		// which is just the rest of the group for a single lost piece.
+		code, _ = cls.LRCParams()
+		read, ok := enc.RepairSet(dstIdx)
+		if !ok {
+			log.Errorf("not enough good pieces to recover lrc chunk %s", id)
+			return core.ErrAllocHost
+		}
+		for _, idx := range read {
+			srcIds = append(srcIds, chunk.Hosts[idx])
+			srcIdx = append(srcIdx, idx)
+		}
+	} else {
+		// We shouldn't have gotten here (recovery loop should filter this out).
+		if len(okIds) < n {
+			log.Errorf("not enough good pieces to recover rs chunk %s", id)
+			return core.ErrAllocHost
+		}
+		// Use the first n good tractservers as sources.
+		srcIds = okIds[:n]
+		srcIdx = okIdx[:n]
+	}
 	srcHosts, missing := c.tsMon.getTractserverAddrs(srcIds)
 	if missing > 0 {
 		log.Errorf("couldn't get addrs for good replicas for %s (%v)", id, srcHosts)
@@ -56,14 +84,15 @@ func (c *Curator) reconstructChunk(id core.RSChunkID, badIds []core.TractserverI
 	}
 
 	// We need to get back to n+m. dstIdx has the indexes of the bad pieces.
-	dstHosts, dstIds := c.allocateTS(len(dstIdx), okIds, badIds)
+	dstHosts, dstIds := c.allocateTSSpread(len(dstIdx), cls.Def().MaxPerDomain, okIds, badIds)
 	if dstHosts == nil {
 		log.Errorf("couldn't allocate TSs to replace bad pieces for %s (%v)", id, badIds)
 		return core.ErrAllocHost
 	}
 
-	// Pad to up m dests.
-	for len(dstIds) < m {
+	// Pad to up m dests. Reed-Solomon needs this, but the LRC path has an
+	// entry in the index map for every source and destination.
+	for code.K == 0 && len(dstIds) < m {
 		dstHosts = append(dstHosts, "")
 		dstIds = append(dstIds, 0)
 		dstIdx = append(dstIdx, -1)
@@ -77,10 +106,10 @@ func (c *Curator) reconstructChunk(id core.RSChunkID, badIds []core.TractserverI
 	indexMap := append(srcIdx, dstIdx...)
 	err := c.tt.RSEncode(
 		dstHosts[0], dstIds[0],
-		id, RSPieceLength,
+		id, pieceLength(cls),
 		zipAddrs(srcIds, srcHosts),
 		zipAddrs(dstIds, dstHosts),
-		indexMap)
+		indexMap, code)
 	if err != core.NoError {
 		return err
 	}
@@ -100,3 +129,42 @@ func (c *Curator) reconstructChunk(id core.RSChunkID, badIds []core.TractserverI
 	log.Infof("@@@ reconstruction %v succeeded", id)
 	return core.NoError
 }
+
+// lrcEncoders caches an encoder for each set of LRC parameters we've seen.
+// Classes can be added while we run, so they're made when first needed.
+var lrcEncoders = struct {
+	sync.Mutex
+	m map[core.LRCParams]*lrc.Encoder
+}{m: make(map[core.LRCParams]*lrc.Encoder)}
+
+// chunkClass returns the storage class of 'chunk', or nil if it's not a known RS
+// class.
+func chunkClass(chunk *pb.RSChunk) storageclass.Class {
+	if cls := storageclass.Get(chunk.Class); cls != nil && cls.ID() != core.StorageClass_REPLICATED {
+		return cls
+	}
+	return nil
+}
+
+// chunkLRC returns the encoder for RS chunks of class 'cls', or nil if it's not
+// a locally repairable code.
+func chunkLRC(cls storageclass.Class) *lrc.Encoder {
+	code, ok := cls.LRCParams()
+	if !ok {
+		return nil
+	}
+
+	lrcEncoders.Lock()
+	defer lrcEncoders.Unlock()
+	enc := lrcEncoders.m[code]
+	if enc == nil {
+		var err error
+		if enc, err = lrc.New(code.K, code.L, code.R); err != nil {
+			// storageclass.Validate doesn't allow this.
+			log.Errorf("bad parameters for %s: %s", cls.Def().Name, err)
+			return nil
+		}
+		lrcEncoders.m[code] = enc
+	}
+	return enc
+}
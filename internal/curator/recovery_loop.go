@@ -383,6 +383,7 @@ func (r *recovery) chunkTask(baseID core.RSChunkID, c *pb.RSChunk) (task, uint64
 	m := len(c.Hosts) - n
 
 	var badTs TSIDSet
+	var badIdx []int
 	blocking := int8(0)
 	for idx, ts := range c.Hosts {
 		tid := baseID.Add(idx).ToTractID()
@@ -399,14 +400,28 @@ func (r *recovery) chunkTask(baseID core.RSChunkID, c *pb.RSChunk) (task, uint64
 		}
 		if bad {
 			badTs = badTs.Add(ts)
+			badIdx = append(badIdx, idx)
 			hashTSID(h, ts)
 		}
 	}
 
+	// Reed-Solomon reads n pieces to rebuild any m, but locally repairable
+	// codes might need fewer, or might not be able to rebuild some sets of m.
+	reads, ok := n, len(badIdx) <= m
+	length := RSPieceLength
+	if cls := chunkClass(c); cls != nil {
+		length = pieceLength(cls)
+		if enc := chunkLRC(cls); enc != nil && len(badIdx) > 0 {
+			var read []int
+			read, ok = enc.RepairSet(badIdx)
+			reads = len(read)
+		}
+	}
+
 	if l := badTs.Len(); l == 0 {
 		// The hopefully-common case of a healthy chunk.
 		return nil, 0, false
-	} else if l > m {
+	} else if !ok {
 		// The hopefully-never case of possibly lost data.
 		log.Errorf("RS(%d,%d) chunk %s has %d pieces remaining; CANNOT RECONSTRUCT", n, m, baseID, n+m-l)
 		return nil, 0, true
@@ -418,7 +433,9 @@ func (r *recovery) chunkTask(baseID core.RSChunkID, c *pb.RSChunk) (task, uint64
 		id:       baseID,
 		n:        int8(n),
 		m:        int8(m),
+		reads:    int8(reads),
 		blocking: blocking,
+		length:   int32(length),
 		badTs:    badTs,
 	}, h.Sum64(), false
 }
@@ -615,7 +632,9 @@ func (rt *replTask) Run(c *Curator) core.Error {
 type rsTask struct {
 	id       core.RSChunkID
 	n, m     int8
+	reads    int8 // how many pieces we need to read to reconstruct
 	blocking int8
+	length   int32 // length of each piece
 	badTs    TSIDSet
 }
 
@@ -624,8 +643,8 @@ func (rt *rsTask) ID() core.TractID {
 }
 
 func (rt *rsTask) Bandwidth() float32 {
-	// one TS has to read n*length bytes and write (bad-1)*length bytes
-	return float32((int(rt.n) + rt.badTs.Len() - 1) * RSPieceLength)
+	// one TS has to read reads*length bytes and write (bad-1)*length bytes
+	return float32((int(rt.reads) + rt.badTs.Len() - 1) * int(rt.length))
 }
 
 func (rt *rsTask) Priority() (c, e int, blocking bool) {
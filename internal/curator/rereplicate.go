@@ -92,23 +92,23 @@ func (c *Curator) replicateTract(id core.TractID, badIds []core.TractserverID) c
 	term := c.stateHandler.GetTerm()
 
 	// Do some basic verification on the input.
-	info, _, err := c.stateHandler.GetTracts(id.Blob, int(id.Index), 1+int(id.Index))
+	info, err := c.stateHandler.GetTract(id)
 	if err != core.NoError {
-		log.Errorf("%v couldn't GetTracts, err %s", id, err)
+		log.Errorf("%v couldn't GetTract, err %s", id, err)
 		return err
 	}
 
 	// If this tract is RS-encoded, we don't need to worry about maintaining the replicated copies
 	// anymore. RS recovery will take care of failures. (We might get here if a tract is both
 	// replicated and RS-encoded during the transition period.)
-	if info[0].RS.Present() {
+	if info.RS.Present() {
 		log.Infof("%v is RS-encoded", id)
 		return core.ErrReadOnlyStorageClass
 	}
 
 	// Figure out what TSIDs we're keeping in the repl set.  We'll bump the versions on these and tell new hosts to pull tracts from them.
 	var okIds []core.TractserverID
-	hosts := info[0].TSIDs
+	hosts := info.TSIDs
 	for _, host := range hosts {
 		if !contains(badIds, host) {
 			okIds = append(okIds, host)
@@ -117,7 +117,7 @@ func (c *Curator) replicateTract(id core.TractID, badIds []core.TractserverID) c
 
 	// This should probably error more loudly.  The tract is lost or unavailable, hopefully the latter.
 	if len(okIds) == 0 {
-		log.Errorf("%v has no healthy hosts, current durable hosts are: %v", id, info[0].Hosts)
+		log.Errorf("%v has no healthy hosts, current durable hosts are: %v", id, info.Hosts)
 		return core.ErrAllocHost
 	}
 
@@ -129,7 +129,7 @@ func (c *Curator) replicateTract(id core.TractID, badIds []core.TractserverID) c
 	}
 
 	// Bump the version on all hosts aside from the ones the caller thinks are bad.
-	nextVersion := info[0].Version + 1
+	nextVersion := info.Version + 1
 
 	// If we haven't heart a heartbeat from every okHost, we can't bump the versions, so bail out now.
 	okHosts, missing := c.tsMon.getTractserverAddrs(okIds)
@@ -142,7 +142,7 @@ func (c *Curator) replicateTract(id core.TractID, badIds []core.TractserverID) c
 	// which we'll do by calling reReplicateTract again.
 	//
 	// We buffer the channel so that we can return when we first hit an error, and the goroutines
-	// we start that send over the channel won't block forever.  Using info[0].TSIDs means we
+	// we start that send over the channel won't block forever.  Using info.TSIDs means we
 	// can send one result for each good tract and each bad tract over it without any goroutine
 	// blocking on the send (though max(bad, good) would also suffice).
 	errorChan := make(chan core.Error, len(hosts))
@@ -42,11 +42,12 @@ func (r *RPCMasterConnection) RegisterCurator() (core.RegisterCuratorReply, core
 }
 
 // CuratorHeartbeat sends a heartbeat to the master and returns its reply.
-func (r *RPCMasterConnection) CuratorHeartbeat(id core.CuratorID) (core.CuratorHeartbeatReply, core.Error) {
+func (r *RPCMasterConnection) CuratorHeartbeat(id core.CuratorID, usage map[string]core.TenantUsage) (core.CuratorHeartbeatReply, core.Error) {
 	var reply core.CuratorHeartbeatReply
 	req := core.CuratorHeartbeatReq{
 		CuratorID: id,
 		Addr:      r.addr,
+		Usage:     usage,
 	}
 	err, _ := r.fc.FailoverRPC(context.Background(), core.CuratorHeartbeatMethod, req, &reply)
 	return reply, err
@@ -64,6 +64,29 @@ func (t *RPCTractserverTalker) PullTract(addr string, tsid core.TractserverID, f
 	return reply
 }
 
+// CopyTract implements TractserverTalker.CopyTract
+func (t *RPCTractserverTalker) CopyTract(addr string, tsid core.TractserverID, from []string, src core.TractID, srcVersion int, id core.TractID, version int) core.Error {
+	req := core.PullTractReq{TSID: tsid, From: from, ID: id, Version: version, SrcID: src, SrcVersion: srcVersion}
+	var reply core.Error
+	if err := t.cc.Send(context.Background(), addr, core.PullTractMethod, req, &reply); err != nil {
+		log.Errorf("CopyTract of tract %s to %s failed on tractserver %d (@%s): %s", src, id, tsid, addr, err)
+		return core.ErrRPC
+	}
+	return reply
+}
+
+// UnpackTract implements TractserverTalker.UnpackTract
+func (t *RPCTractserverTalker) UnpackTract(addr string, tsid core.TractserverID, from []string, piece core.RSChunkID, offset, length int, id core.TractID, version int) core.Error {
+	req := core.PullTractReq{TSID: tsid, From: from, ID: id, Version: version,
+		SrcID: piece.ToTractID(), SrcVersion: core.RSChunkVersion, SrcOffset: offset, SrcLength: length}
+	var reply core.Error
+	if err := t.cc.Send(context.Background(), addr, core.PullTractMethod, req, &reply); err != nil {
+		log.Errorf("UnpackTract of %s from piece %s failed on tractserver %d (@%s): %s", id, piece, tsid, addr, err)
+		return core.ErrRPC
+	}
+	return reply
+}
+
 // CheckTracts implements TractserverTalker.CheckTracts
 func (t *RPCTractserverTalker) CheckTracts(addr string, tsid core.TractserverID, tracts []core.TractState) core.Error {
 	req := core.CheckTractsReq{TSID: tsid, Tracts: tracts}
@@ -109,8 +132,8 @@ func (t *RPCTractserverTalker) PackTracts(
 
 // RSEncode implements TractserverTalker.RSEncode.
 func (t *RPCTractserverTalker) RSEncode(
-	addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs, dests []core.TSAddr, im []int) (reply core.Error) {
-	req := core.RSEncodeReq{TSID: tsid, ChunkID: id, Length: length, Srcs: srcs, Dests: dests, IndexMap: im}
+	addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs, dests []core.TSAddr, im []int, code core.LRCParams) (reply core.Error) {
+	req := core.RSEncodeReq{TSID: tsid, ChunkID: id, Length: length, Srcs: srcs, Dests: dests, IndexMap: im, LRC: code}
 	if err := t.cc.Send(context.Background(), addr, core.RSEncodeMethod, req, &reply); err != nil {
 		log.Errorf("RSEncode failed on tractserver %d (@%s): %s", tsid, addr, err)
 		reply = core.ErrRPC
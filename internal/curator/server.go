@@ -6,6 +6,7 @@ package curator
 import (
 	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	log "github.com/golang/glog"
@@ -54,17 +55,17 @@ func (s *Server) Start() (err error) {
 	//http.HandleFunc("/loglevel", health.HandleLogsLevel)
 
 	// Endpoint for shutting down the curator.
-	http.HandleFunc("/_quit", server.QuitHandler)
+	http.HandleFunc("/_quit", rpc.AuthHandler(rpc.RoleAdmin, server.QuitHandler))
 
 	// Endpoints for membership reconfiguration.
 	ac := server.NewAutoConfig(s.cfg.RaftACSpec, s.curator.stateHandler)
-	http.Handle("/reconfig/", http.StripPrefix("/reconfig", ac.HTTPHandlers()))
+	http.Handle("/reconfig/", rpc.AuthHandler(rpc.RoleAdmin, http.StripPrefix("/reconfig", ac.HTTPHandlers()).ServeHTTP))
 	ac.WatchDiscovery()
 
-	http.HandleFunc("/readonly", s.readOnlyHandler)
+	http.HandleFunc("/readonly", rpc.AuthHandler(rpc.RoleAdmin, s.readOnlyHandler))
 
 	// Expose administrative endpoints.
-	http.Handle("/raft/", http.StripPrefix("/raft", server.RaftAdminHandler(s.raft, s.raftStorage)))
+	http.Handle("/raft/", rpc.AuthHandler(rpc.RoleAdmin, http.StripPrefix("/raft", server.RaftAdminHandler(s.raft, s.raftStorage)).ServeHTTP))
 
 	opm := server.NewOpMetric("curator_rpc", "rpc")
 
@@ -72,7 +73,7 @@ func (s *Server) Start() (err error) {
 		curator: s.curator,
 		opm:     opm,
 	}
-	if err = rpc.RegisterName("CuratorCtlHandler", s.ctlHandler); err != nil {
+	if err = rpc.RegisterName("CuratorCtlHandler", s.ctlHandler, rpc.RoleControl); err != nil {
 		return err
 	}
 
@@ -82,9 +83,10 @@ func (s *Server) Start() (err error) {
 		pendingSem: server.NewSemaphore(s.cfg.RejectReqThreshold),
 		opm:        opm,
 	}
-	if err = rpc.RegisterName("CuratorSrvHandler", s.srvHandler); err != nil {
+	if err = rpc.RegisterName("CuratorSrvHandler", s.srvHandler, rpc.RoleClient); err != nil {
 		return err
 	}
+	rpc.RegisterWireServices(core.WireServices)
 
 	log.Infof("listening on address %s", s.cfg.Addr)
 	err = http.ListenAndServe(s.cfg.Addr, nil) // this blocks forever
@@ -107,6 +109,59 @@ type CuratorSrvHandler struct {
 
 	// Per-RPC info.
 	opm *server.OpMetric
+
+	// Who's calling. Each connection gets its own copy of the handler.
+	caller rpc.Identity
+}
+
+// ForCaller implements rpc.CallerAware.
+func (h *CuratorSrvHandler) ForCaller(caller rpc.Identity) interface{} {
+	handler := *h
+	handler.caller = caller
+	return &handler
+}
+
+// access returns core.ErrPermissionDenied if the caller may not read blob 'id'
+// or, if 'write' is set, change it.
+func (h *CuratorSrvHandler) access(id core.BlobID, write bool) core.Error {
+	if h.caller.Role >= rpc.RoleControl {
+		return core.NoError
+	}
+	return h.curator.checkACL(id, h.caller.Name, write)
+}
+
+// owner returns who a blob that the caller asks to be owned by 'owner' should
+// be owned by. Clients own the blobs they create, so that they can't avoid
+// their quota or use someone else's; other servers may pick any owner.
+func (h *CuratorSrvHandler) owner(owner string) (string, core.Error) {
+	if h.caller.Role >= rpc.RoleControl {
+		return owner, core.NoError
+	}
+	if owner != "" && owner != h.caller.Name {
+		log.Infof("%q may not create blobs owned by %q", h.caller.Name, owner)
+		return "", core.ErrPermissionDenied
+	}
+	return h.caller.Name, core.NoError
+}
+
+// nameCaller returns the identity that names must have been bound by for the
+// caller to change them, or "" if the caller may change any name.
+func (h *CuratorSrvHandler) nameCaller() string {
+	if h.caller.Role >= rpc.RoleControl {
+		return ""
+	}
+	return h.caller.Name
+}
+
+// nameAccess is access for the blob that 'name' is bound to. Blobs in other
+// partitions can't be checked here, but only the client that bound a name can
+// change it.
+func (h *CuratorSrvHandler) nameAccess(name string) core.Error {
+	id, err := h.curator.lookupName(name)
+	if err != core.NoError {
+		return err
+	}
+	return h.access(id, true)
 }
 
 func (h *CuratorSrvHandler) rpcStats() map[string]string {
@@ -114,7 +169,15 @@ func (h *CuratorSrvHandler) rpcStats() map[string]string {
 		"CreateBlob",
 		"DeleteBlob",
 		"UndeleteBlob",
+		"PurgeBlob",
 		"SetMetadata",
+		"UpdateChecksums",
+		"SealBlob",
+		"ReserveAppend",
+		"TruncateBlob",
+		"CloneBlob",
+		"UnshareTract",
+		"CopyTracts",
 		"ExtendBlob",
 		"AckExtendBlob",
 		"GetTracts",
@@ -122,6 +185,11 @@ func (h *CuratorSrvHandler) rpcStats() map[string]string {
 		"ReportBadTS",
 		"FixVersion",
 		"ListBlobs",
+		"GetEvents",
+		"LookupName",
+		"Rename",
+		"UnbindName",
+		"ListNames",
 	)
 }
 
@@ -137,7 +205,9 @@ func (h *CuratorSrvHandler) CreateBlob(req core.CreateBlobReq, reply *core.Creat
 	}
 	defer h.pendingSem.Release()
 
-	reply.ID, reply.Err = h.curator.create(req.Repl, req.Hint, req.Expires)
+	if req.Owner, reply.Err = h.owner(req.Owner); reply.Err == core.NoError {
+		reply.ID, reply.Err = h.curator.create(req)
+	}
 
 	log.Infof("CreateBlob: req %+v reply %+v", req, *reply)
 
@@ -156,7 +226,9 @@ func (h *CuratorSrvHandler) ExtendBlob(req core.ExtendBlobReq, reply *core.Exten
 	}
 	defer h.pendingSem.Release()
 
-	reply.NewTracts, reply.Err = h.curator.extend(req.Blob, req.NumTracts)
+	if reply.Err = h.access(req.Blob, true); reply.Err == core.NoError {
+		reply.NewTracts, reply.Err = h.curator.extend(req.Blob, req.NumTracts)
+	}
 
 	log.Infof("ExtendBlob: req %+v reply %+v", req, *reply)
 
@@ -171,7 +243,9 @@ func (h *CuratorSrvHandler) AckExtendBlob(req core.AckExtendBlobReq, reply *core
 
 	// This is really a confirmation rather than a request so we don't check
 	// pending request limit to allow it to always go through.
-	reply.NumTracts, reply.Err = h.curator.ackExtend(req.Blob, req.Tracts)
+	if reply.Err = h.access(req.Blob, true); reply.Err == core.NoError {
+		reply.NumTracts, reply.Err = h.curator.ackExtend(req.Blob, req.Tracts, req.Checksums)
+	}
 
 	log.Infof("AckExtendBlob: req %+v reply %+v", req, reply)
 
@@ -190,7 +264,9 @@ func (h *CuratorSrvHandler) DeleteBlob(id core.BlobID, reply *core.Error) error
 	}
 	defer h.pendingSem.Release()
 
-	*reply = h.curator.remove(id)
+	if *reply = h.access(id, true); *reply == core.NoError {
+		*reply = h.curator.remove(id)
+	}
 
 	log.Infof("DeleteBlob: req %+v reply %+v", id, *reply)
 
@@ -208,7 +284,9 @@ func (h *CuratorSrvHandler) UndeleteBlob(id core.BlobID, reply *core.Error) erro
 	}
 	defer h.pendingSem.Release()
 
-	*reply = h.curator.unremove(id)
+	if *reply = h.access(id, true); *reply == core.NoError {
+		*reply = h.curator.unremove(id)
+	}
 
 	log.Infof("UndeleteBlob: req %+v reply %+v", id, *reply)
 
@@ -226,13 +304,183 @@ func (h *CuratorSrvHandler) SetMetadata(req core.SetMetadataReq, reply *core.Err
 	}
 	defer h.pendingSem.Release()
 
-	*reply = h.curator.setMetadata(req.Blob, req.Metadata)
+	if *reply = h.access(req.Blob, true); *reply == core.NoError {
+		*reply = h.curator.setMetadata(req.Blob, req.Metadata)
+	}
 
 	log.Infof("SetMetadata: req %+v reply %+v", req, *reply)
 
 	return nil
 }
 
+// UpdateChecksums is the RPC callback for changing end-to-end tract checksums.
+func (h *CuratorSrvHandler) UpdateChecksums(req core.UpdateChecksumsReq, reply *core.Error) error {
+	op := h.opm.Start("UpdateChecksums")
+	defer op.EndWithBlbError(reply)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	if *reply = h.access(req.Blob, true); *reply == core.NoError {
+		*reply = h.curator.updateChecksums(req.Blob, req.Updates)
+	}
+
+	log.Infof("UpdateChecksums: req %+v reply %+v", req, *reply)
+
+	return nil
+}
+
+// ReserveAppend is the RPC callback for reserving space for an append.
+func (h *CuratorSrvHandler) ReserveAppend(req core.ReserveAppendReq, reply *core.ReserveAppendReply) error {
+	op := h.opm.Start("ReserveAppend")
+	defer op.EndWithBlbError(&reply.Err)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	if reply.Err = h.access(req.Blob, true); reply.Err == core.NoError {
+		reply.Offset, reply.Err = h.curator.reserveAppend(req.Blob, req.Length, req.MinOffset)
+	}
+
+	log.Infof("ReserveAppend: req %+v reply %+v", req, *reply)
+
+	return nil
+}
+
+// TruncateBlob is the RPC callback for truncating a blob.
+func (h *CuratorSrvHandler) TruncateBlob(req core.TruncateBlobReq, reply *core.Error) error {
+	op := h.opm.Start("TruncateBlob")
+	defer op.EndWithBlbError(reply)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	if *reply = h.access(req.Blob, true); *reply == core.NoError {
+		*reply = h.curator.truncate(req.Blob, req.Size)
+	}
+
+	log.Infof("TruncateBlob: req %+v reply %+v", req, *reply)
+
+	return nil
+}
+
+// PurgeBlob is the RPC callback for removing a deleted blob for good.
+func (h *CuratorSrvHandler) PurgeBlob(id core.BlobID, reply *core.Error) error {
+	op := h.opm.Start("PurgeBlob")
+	defer op.EndWithBlbError(reply)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	if *reply = h.access(id, true); *reply == core.NoError {
+		*reply = h.curator.purge(id)
+	}
+
+	log.Infof("PurgeBlob: req %+v reply %+v", id, *reply)
+
+	return nil
+}
+
+// SealBlob is the RPC callback for sealing a write-once blob.
+func (h *CuratorSrvHandler) SealBlob(id core.BlobID, reply *core.Error) error {
+	op := h.opm.Start("SealBlob")
+	defer op.EndWithBlbError(reply)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	if *reply = h.access(id, true); *reply == core.NoError {
+		*reply = h.curator.seal(id)
+	}
+
+	log.Infof("SealBlob: req %+v reply %+v", id, *reply)
+
+	return nil
+}
+
+// CloneBlob is the RPC callback for cloning a blob.
+func (h *CuratorSrvHandler) CloneBlob(id core.BlobID, reply *core.CreateBlobReply) error {
+	op := h.opm.Start("CloneBlob")
+	defer op.EndWithBlbError(&reply.Err)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	// The caller owns the clone, like the blobs they create.
+	var owner string
+	if reply.Err = h.access(id, false); reply.Err == core.NoError {
+		if owner, reply.Err = h.owner(""); reply.Err == core.NoError {
+			reply.ID, reply.Err = h.curator.clone(id, owner)
+		}
+	}
+
+	log.Infof("CloneBlob: req %+v reply %+v", id, *reply)
+
+	return nil
+}
+
+// UnshareTract is the RPC callback for unsharing a shared tract.
+func (h *CuratorSrvHandler) UnshareTract(req core.UnshareTractReq, reply *core.Error) error {
+	op := h.opm.Start("UnshareTract")
+	defer op.EndWithBlbError(reply)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	if *reply = h.access(req.Blob, true); *reply == core.NoError {
+		*reply = h.curator.unshareTract(core.TractID{Blob: req.Blob, Index: req.Index})
+	}
+
+	log.Infof("UnshareTract: req %+v reply %+v", req, *reply)
+
+	return nil
+}
+
+// CopyTracts is the RPC callback for copying tracts to the end of a blob.
+func (h *CuratorSrvHandler) CopyTracts(req core.CopyTractsReq, reply *core.Error) error {
+	op := h.opm.Start("CopyTracts")
+	defer op.EndWithBlbError(reply)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	// Copying needs to be able to read the source too.
+	if *reply = h.access(req.Dst, true); *reply == core.NoError {
+		*reply = h.access(req.Src, false)
+	}
+	if *reply == core.NoError {
+		*reply = h.curator.copyTracts(req.Dst, req.First, req.Src, req.Start, req.End)
+	}
+
+	log.Infof("CopyTracts: req %+v reply %+v", req, *reply)
+
+	return nil
+}
+
 // GetTracts is the RPC callback for getting tract location information for a read
 // or a write.
 func (h *CuratorSrvHandler) GetTracts(req core.GetTractsReq, reply *core.GetTractsReply) error {
@@ -247,7 +495,9 @@ func (h *CuratorSrvHandler) GetTracts(req core.GetTractsReq, reply *core.GetTrac
 	defer h.pendingSem.Release()
 
 	var cls core.StorageClass
-	reply.Tracts, cls, reply.Err = h.curator.getTracts(req.Blob, req.Start, req.End)
+	if reply.Err = h.access(req.Blob, req.ForWrite); reply.Err == core.NoError {
+		reply.Tracts, cls, reply.Err = h.curator.getTracts(req.Blob, req.Start, req.End)
+	}
 
 	// Signal error if trying to write to EC tract here.
 	if reply.Err == core.NoError && req.ForWrite && cls != core.StorageClass_REPLICATED {
@@ -255,7 +505,28 @@ func (h *CuratorSrvHandler) GetTracts(req core.GetTractsReq, reply *core.GetTrac
 		reply.Err = core.ErrReadOnlyStorageClass
 	}
 
-	if req.ForRead || req.ForWrite {
+	// Sealed blobs can't be written either, and write-once blobs can't be
+	// read until they're sealed.
+	checkRead := req.ForRead && !req.AllowUnsealed
+	if reply.Err == core.NoError && (req.ForWrite || req.WithInfo || checkRead) {
+		info, err := h.curator.stat(req.Blob)
+		if err == core.NoError && req.ForWrite && info.Sealed {
+			reply.Tracts = nil
+			reply.Err = core.ErrSealed
+		} else if err == core.NoError && checkRead && info.WriteOnce && !info.Sealed {
+			reply.Tracts = nil
+			reply.Err = core.ErrNotSealed
+		} else if req.WithInfo {
+			if err != core.NoError {
+				reply.Tracts = nil
+				reply.Err = err
+			} else {
+				reply.Info = &info
+			}
+		}
+	}
+
+	if (req.ForRead || req.ForWrite) && reply.Err != core.ErrPermissionDenied {
 		h.curator.touchBlob(req.Blob, time.Now().UnixNano(), req.ForRead, req.ForWrite)
 	}
 
@@ -276,7 +547,9 @@ func (h *CuratorSrvHandler) StatBlob(id core.BlobID, reply *core.StatBlobReply)
 	}
 	defer h.pendingSem.Release()
 
-	reply.Info, reply.Err = h.curator.stat(id)
+	if reply.Err = h.access(id, false); reply.Err == core.NoError {
+		reply.Info, reply.Err = h.curator.stat(id)
+	}
 
 	log.Infof("StatBlob: req %+v reply %+v", id, *reply)
 
@@ -334,13 +607,134 @@ func (h *CuratorSrvHandler) ListBlobs(req core.ListBlobsReq, reply *core.ListBlo
 	}
 	defer h.pendingSem.Release()
 
-	reply.Keys, reply.Err = h.curator.listBlobs(req.Partition, req.Start)
+	if req.Filter != nil {
+		reply.Keys, reply.Infos, reply.Next, reply.More, reply.Err = h.curator.listBlobsWithInfo(req.Partition, req.Start, *req.Filter)
+		// Leave out the blobs that the caller can't Stat.
+		keys, infos := reply.Keys[:0], reply.Infos[:0]
+		for i, key := range reply.Keys {
+			if h.access(core.BlobIDFromParts(req.Partition, key), false) == core.NoError {
+				keys, infos = append(keys, key), append(infos, reply.Infos[i])
+			}
+		}
+		reply.Keys, reply.Infos = keys, infos
+	} else {
+		reply.Keys, reply.Err = h.curator.listBlobs(req.Partition, req.Start)
+	}
 
 	log.Infof("ListBlobs: req %+v reply %d keys", req, len(reply.Keys))
 
 	return nil
 }
 
+// GetEvents returns recent changes to blobs in a partition.
+func (h *CuratorSrvHandler) GetEvents(req core.GetEventsReq, reply *core.GetEventsReply) error {
+	op := h.opm.Start("GetEvents")
+	defer op.EndWithBlbError(&reply.Err)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	reply.Events, reply.Next, reply.Err = h.curator.getEvents(req.Partition, req.After)
+	events := reply.Events[:0]
+	for _, e := range reply.Events {
+		if h.access(e.Blob, false) == core.NoError {
+			events = append(events, e)
+		}
+	}
+	reply.Events = events
+
+	// Watchers poll this, so don't log it by default.
+	log.V(1).Infof("GetEvents: req %+v reply %d events, next %d", req, len(reply.Events), reply.Next)
+
+	return nil
+}
+
+// LookupName is the RPC callback for resolving a name to a blob.
+func (h *CuratorSrvHandler) LookupName(name string, reply *core.LookupNameReply) error {
+	op := h.opm.Start("LookupName")
+	defer op.EndWithBlbError(&reply.Err)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	reply.Blob, reply.Err = h.curator.lookupName(name)
+
+	log.Infof("LookupName: req %q reply %+v", name, *reply)
+
+	return nil
+}
+
+// Rename is the RPC callback for renaming a blob.
+func (h *CuratorSrvHandler) Rename(req core.RenameReq, reply *core.Error) error {
+	op := h.opm.Start("Rename")
+	defer op.EndWithBlbError(reply)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	// Moving a directory only checks that the caller bound every name in it.
+	if !strings.HasSuffix(req.From, core.NameSeparator) {
+		*reply = h.nameAccess(req.From)
+	}
+	if *reply == core.NoError && req.Replace != 0 {
+		*reply = h.access(req.Replace, true)
+	}
+	if *reply == core.NoError {
+		*reply = h.curator.rename(req.From, req.To, req.Replace, h.nameCaller())
+	}
+
+	log.Infof("Rename: req %+v reply %+v", req, *reply)
+
+	return nil
+}
+
+// UnbindName is the RPC callback for removing a name.
+func (h *CuratorSrvHandler) UnbindName(req core.UnbindNameReq, reply *core.Error) error {
+	op := h.opm.Start("UnbindName")
+	defer op.EndWithBlbError(reply)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	if *reply = h.nameAccess(req.Name); *reply == core.NoError {
+		*reply = h.curator.unbindName(req.Name, req.Blob, h.nameCaller())
+	}
+
+	log.Infof("UnbindName: req %+v reply %+v", req, *reply)
+
+	return nil
+}
+
+// ListNames returns a batch of entries from one directory of the namespace.
+func (h *CuratorSrvHandler) ListNames(req core.ListNamesReq, reply *core.ListNamesReply) error {
+	op := h.opm.Start("ListNames")
+	defer op.EndWithBlbError(&reply.Err)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	reply.Entries, reply.Err = h.curator.listNames(req.Prefix, req.Start)
+
+	log.Infof("ListNames: req %+v reply %d entries", req, len(reply.Entries))
+
+	return nil
+}
+
 // CuratorCtlHandler handles heartbeat message and other non-client-generated RPCs.
 type CuratorCtlHandler struct {
 	curator *Curator
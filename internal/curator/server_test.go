@@ -0,0 +1,113 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package curator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/server"
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
+	"github.com/westerndigitalcorporation/blb/pkg/rpc/rpctest"
+)
+
+// TestServerCodecs calls the curator's RPC handlers with both gob and
+// protocol buffers, and checks that they get the same results.
+func TestServerCodecs(t *testing.T) {
+	mc := newTestMasterConnection()
+	tt := newTestTractserverTalker()
+	c := newTestCurator(mc, tt, DefaultTestConfig)
+	<-mc.heartbeatChan
+	for i := 1; i <= 3; i++ {
+		c.addTS(core.TractserverID(i), fmt.Sprintf("tsaddr:%d", i))
+	}
+
+	md := map[string]string{"k": "v"}
+	id, err := c.create(core.CreateBlobReq{Repl: 3, Hint: defHint, Metadata: md, Name: "name"})
+	if err != core.NoError {
+		t.Fatal(err)
+	}
+	tracts, err := c.extend(id, 2)
+	if err != core.NoError {
+		t.Fatal(err)
+	}
+	if _, err := c.ackExtend(id, tracts, nil); err != core.NoError {
+		t.Fatal(err)
+	}
+
+	h := &CuratorSrvHandler{
+		curator:    c,
+		pendingSem: server.NewSemaphore(100),
+		opm:        server.NewOpMetric("test_curator_rpc", "rpc"),
+	}
+	if err := rpc.RegisterName("CuratorSrvHandler", h, rpc.RoleClient); err != nil {
+		t.Fatal(err)
+	}
+	rpc.RegisterWireServices(core.WireServices)
+	ct := rpctest.NewCodecTester(t)
+	defer ct.Close()
+
+	var stat core.StatBlobReply
+	ct.Call(core.StatBlobMethod, id, &stat)
+	if stat.Err != core.NoError || stat.Info.NumTracts != 2 || stat.Info.Metadata["k"] != "v" {
+		t.Errorf("bad stat reply %+v", stat)
+	}
+	ct.Call(core.StatBlobMethod, core.BlobIDFromParts(5, 5), new(core.StatBlobReply))
+
+	var gt core.GetTractsReply
+	ct.Call(core.GetTractsMethod, core.GetTractsReq{Blob: id, Start: 0, End: 2, WithInfo: true}, &gt)
+	if gt.Err != core.NoError || len(gt.Tracts) != 2 || gt.Info == nil {
+		t.Errorf("bad GetTracts reply %+v", gt)
+	}
+
+	// Write-once blobs can't be read until they're sealed, unless the caller
+	// asks to.
+	wo, err := c.create(core.CreateBlobReq{Repl: 3, Hint: defHint, WriteOnce: true})
+	if err != core.NoError {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		req  core.GetTractsReq
+		want core.Error
+	}{
+		{core.GetTractsReq{Blob: wo, ForRead: true}, core.ErrNotSealed},
+		{core.GetTractsReq{Blob: wo, ForRead: true, AllowUnsealed: true}, core.NoError},
+		{core.GetTractsReq{Blob: wo}, core.NoError},
+	} {
+		var reply core.GetTractsReply
+		ct.Call(core.GetTractsMethod, tc.req, &reply)
+		if reply.Err != tc.want {
+			t.Errorf("GetTracts %+v: expected %s, got %+v", tc.req, tc.want, reply)
+		}
+	}
+	if err := c.seal(wo); err != core.NoError {
+		t.Fatal(err)
+	}
+	var sealed core.GetTractsReply
+	ct.Call(core.GetTractsMethod, core.GetTractsReq{Blob: wo, ForRead: true}, &sealed)
+	if sealed.Err != core.NoError {
+		t.Errorf("bad GetTracts reply %+v", sealed)
+	}
+
+	md["k2"] = "v2"
+	ct.Call(core.SetMetadataMethod, core.SetMetadataReq{Blob: id, Metadata: core.BlobInfo{Metadata: md}}, new(core.Error))
+	ct.Call(core.ExtendBlobMethod, core.ExtendBlobReq{Blob: core.BlobIDFromParts(5, 5), NumTracts: 1}, new(core.ExtendBlobReply))
+	ct.Call(core.DeleteBlobMethod, core.BlobIDFromParts(5, 5), new(core.Error))
+
+	var list core.ListBlobsReply
+	ct.Call(core.ListBlobsMethod, core.ListBlobsReq{Partition: id.Partition()}, &list)
+	if list.Err != core.NoError || len(list.Keys) != 2 {
+		t.Errorf("bad ListBlobs reply %+v", list)
+	}
+	filter := &core.BlobFilter{Hints: []core.StorageHint{defHint}}
+	ct.Call(core.ListBlobsMethod, core.ListBlobsReq{Partition: id.Partition(), Filter: filter}, new(core.ListBlobsReply))
+
+	var lookup core.LookupNameReply
+	ct.Call(core.LookupNameMethod, "name", &lookup)
+	if lookup.Err != core.NoError || lookup.Blob != id {
+		t.Errorf("bad LookupName reply %+v", lookup)
+	}
+	ct.Call(core.LookupNameMethod, "nonexistent", new(core.LookupNameReply))
+}
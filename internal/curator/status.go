@@ -204,6 +204,26 @@ Show logs:
 </table>
 <hr></hr>
 
+<br>
+<table class="status">
+  <caption>Storage Policy{{if .StoragePolicy.DryRun}} (dry run, nothing is moved){{end}}</caption>
+  <tr>
+    <th>Rule</th>
+    <th>Class</th>
+    <th>Matched</th>
+    <th>To Move</th>
+  </tr>
+  {{range .StoragePolicy.Rules}}
+  <tr>
+    <td>{{.Name}}</td>
+    <td>{{.Class}}</td>
+    <td>{{.Blobs}} blobs, {{byteToMB .Bytes}} MB</td>
+    <td>{{.MoveBlobs}} blobs, {{byteToMB .MoveBytes}} MB</td>
+  </tr>
+  {{end}}
+</table>
+<hr></hr>
+
 <br>
 {{if .Tractservers}}
 <table class="status tractservers">
@@ -248,11 +268,12 @@ type StatusData struct {
 	FreeSpace     uint64
 	Tractservers  []tractserverData
 
-	Reboot       time.Time
-	CtlRPC       map[string]string
-	SrvRPC       map[string]string
-	CuratorStats map[string]interface{}
-	Now          time.Time
+	Reboot        time.Time
+	CtlRPC        map[string]string
+	SrvRPC        map[string]string
+	CuratorStats  map[string]interface{}
+	StoragePolicy StoragePolicyStatus
+	Now           time.Time
 }
 
 var (
@@ -323,6 +344,7 @@ func (s *Server) genStatus() StatusData {
 		CtlRPC:        s.ctlHandler.rpcStats(),
 		SrvRPC:        s.srvHandler.rpcStats(),
 		CuratorStats:  s.curator.stats(),
+		StoragePolicy: s.curator.storagePolicyStatus(),
 		Now:           time.Now(),
 	}
 }
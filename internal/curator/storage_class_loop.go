@@ -9,6 +9,7 @@ import (
 
 	log "github.com/golang/glog"
 	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/curator/durable/state"
 	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
 	"github.com/westerndigitalcorporation/blb/internal/curator/storageclass"
 )
@@ -25,26 +26,6 @@ const (
 	REPLICATED = core.StorageClass_REPLICATED
 )
 
-// Picks a storage class for the blob. We can change this over time.
-func targetClass(blob *pb.Blob, now int64, delay time.Duration) core.StorageClass {
-	// If the blob has been created or written to recently, keep it replicated.
-	if now-blob.GetMtime() < int64(delay) {
-		return REPLICATED
-	}
-
-	// Otherwise, look at the hint.
-	switch blob.GetHint() {
-	case core.StorageHint_HOT:
-		return REPLICATED
-	case core.StorageHint_WARM:
-		return core.StorageClass_RS_6_3
-	case core.StorageHint_COLD:
-		return core.StorageClass_RS_8_3
-	}
-
-	return REPLICATED
-}
-
 // Looks for blobs that should change storage class and migrates them.
 func (c *Curator) storageClassLoop() {
 	for {
@@ -55,12 +36,33 @@ func (c *Curator) storageClassLoop() {
 		var wg sync.WaitGroup
 		now := time.Now().UnixNano()
 		term := c.stateHandler.GetTerm()
+		c.syncStorageClasses(term)
 		packers := c.makePackers(term)
 		var cleanedUp, alreadyDone, committed int
+		var unpacked int32
+		var transcode []transcodeBlob
+		policy := c.storagePolicy()
+		stats := newPolicyStats(policy)
 
 		c.stateHandler.ForEachBlob(false, func(id core.BlobID, blob *pb.Blob) {
+			if state.IsShared(blob) {
+				// Shared tracts are left alone until the blobs sharing them
+				// have their own copies.
+				return
+			}
 			current := blob.GetStorage()
-			target := targetClass(blob, now, c.config.WriteDelay)
+			target, rule := targetClass(blob, now, c.config.WriteDelay, policy)
+			stats.add(rule, blob)
+			if storageclass.Get(target) == nil {
+				// The policy was checked against the config, but the class
+				// hasn't made it into the state yet.
+				target = current
+			}
+			if policy.DryRun {
+				// Just count what the policy would do. Leftover storage is
+				// still cleaned up below.
+				target = current
+			}
 			if current == target {
 				// The blob is stored correctly. We might need to clean up old
 				// tract storage.
@@ -82,13 +84,23 @@ func (c *Curator) storageClassLoop() {
 				c.addTractsToPacker(id, blob, packers[target])
 			} else if current != REPLICATED && target == REPLICATED {
 				// Migrating from RS back to replicated.
-				log.Infof("migrating from RS to replicated is not implemented yet (%s)", id)
+				wg.Add(1)
+				go c.unpackBlob(id, blob, &unpacked, &wg, term)
 			} else {
-				// Migrating from one RS class to another.
-				log.Infof("migrating from RS to RS is not implemented yet (%s)", id)
+				// Migrating from one RS class to another. Finding where the
+				// tracts are needs another transaction, so do it afterwards.
+				transcode = append(transcode, makeTranscodeBlob(id, blob, target))
 			}
 		}, c.stateHandler.IsLeader)
 
+		c.lock.Lock()
+		c.policyStatus = StoragePolicyStatus{DryRun: policy.DryRun, Rules: stats}
+		c.lock.Unlock()
+
+		for _, tb := range transcode {
+			c.addRSTractsToPacker(tb, packers[tb.target])
+		}
+
 		// Kick off all RS encode operations.
 		for _, p := range packers {
 			if p != nil {
@@ -103,35 +115,70 @@ func (c *Curator) storageClassLoop() {
 				committed += p.waitForPacking()
 			}
 		}
-		// Also wait for updateStorageClass calls to finish.
+		// Also wait for updateStorageClass and unpackBlob calls to finish.
 		wg.Wait()
 
 		op.End()
 
-		if cleanedUp+alreadyDone+committed == 0 {
+		if cleanedUp+alreadyDone+committed+int(unpacked) == 0 {
 			// If we didn't do anything, sleep a little so we don't spin.
 			time.Sleep(10 * time.Second)
 		} else {
-			log.Infof("storage class loop: committed %d rs chunks, "+
-				"updated storage class of %d, cleaned up %d", committed, alreadyDone, cleanedUp)
+			log.Infof("storage class loop: committed %d rs chunks, unpacked %d tracts, "+
+				"updated storage class of %d, cleaned up %d", committed, unpacked, alreadyDone, cleanedUp)
 		}
 	}
 }
 
-func (c *Curator) makePackers(term uint64) (ps []*tractPacker) {
-	ctx := &curatorTPContext{c: c, term: term}
-	ps = make([]*tractPacker, len(core.StorageClass_name))
-	for _, cls := range storageclass.AllRS {
+func (c *Curator) makePackers(term uint64) map[core.StorageClass]*tractPacker {
+	ps := make(map[core.StorageClass]*tractPacker)
+	for _, cls := range storageclass.AllRS() {
 		N, M := cls.RSParams()
-		ps[cls.ID()] = makeTractPacker(ctx, c.internalOpM, cls.ID(), N, M, RSPieceLength)
+		ctx := &curatorTPContext{c: c, term: term, maxPerDomain: cls.Def().MaxPerDomain}
+		ps[cls.ID()] = makeTractPacker(ctx, c.internalOpM, cls.ID(), N, M, pieceLength(cls))
+	}
+	return ps
+}
+
+// pieceLength returns the length of the pieces of chunks of class 'cls'.
+func pieceLength(cls storageclass.Class) int {
+	if l := cls.Def().PieceLength; l > 0 {
+		return l
 	}
-	return
+	return RSPieceLength
+}
+
+// syncStorageClasses adds the storage classes in the config that the durable
+// state doesn't have yet, or has different definitions of, and then starts
+// using the classes in the state.
+func (c *Curator) syncStorageClasses(term uint64) {
+	have := make(map[core.StorageClass]pb.StorageClassDef)
+	for _, def := range c.stateHandler.GetStorageClasses() {
+		have[def.Id] = *def
+	}
+	for _, def := range c.config.StorageClasses {
+		if old, ok := have[def.Id]; ok && old == *def {
+			continue
+		}
+		if err := c.stateHandler.AddStorageClass(def, term); err != core.NoError {
+			log.Errorf("couldn't add storage class %s: %s", def.Name, err)
+		} else {
+			log.Infof("added storage class %+v", *def)
+		}
+	}
+
+	reg, err := storageclass.NewRegistry(c.stateHandler.GetStorageClasses())
+	if err != nil {
+		log.Errorf("invalid storage classes in state: %s", err)
+		return
+	}
+	storageclass.Install(reg)
 }
 
 // Returns true if any tract in the blob has storage that it doesn't need.
 func hasExtraStorage(blob *pb.Blob, cls core.StorageClass) bool {
 	for _, tract := range blob.Tracts {
-		for _, c := range storageclass.All {
+		for _, c := range storageclass.All() {
 			if c.ID() != cls && c.Has(tract) {
 				return true
 			}
@@ -152,7 +199,7 @@ func tractsAreStoredAsClass(blob *pb.Blob, cls core.StorageClass) bool {
 }
 
 func (c *Curator) updateStorageClass(id core.BlobID, target core.StorageClass, wg *sync.WaitGroup, term uint64) {
-	err := c.stateHandler.UpdateStorageClass(id, target, term)
+	err := c.stateHandler.UpdateStorageClass(id, target, time.Now().UnixNano(), term)
 	if err != core.NoError {
 		log.Errorf("error updating storage class of %s to %s: %s", id, target, err)
 	} else {
@@ -161,6 +208,48 @@ func (c *Curator) updateStorageClass(id core.BlobID, target core.StorageClass, w
 	wg.Done()
 }
 
+// transcodeBlob is a blob that's moving from one RS class to another.
+type transcodeBlob struct {
+	id     core.BlobID
+	target core.StorageClass
+	tracts []int // indexes of the tracts that aren't stored as target yet
+}
+
+func makeTranscodeBlob(id core.BlobID, blob *pb.Blob, target core.StorageClass) transcodeBlob {
+	tb := transcodeBlob{id: id, target: target}
+	cls := storageclass.Get(target)
+	for i, t := range blob.Tracts {
+		if !cls.Has(t) {
+			tb.tracts = append(tb.tracts, i)
+		}
+	}
+	return tb
+}
+
+// addRSTractsToPacker adds the tracts of a blob that's moving to another RS
+// class to 'packer'. The packer reads them straight out of the data pieces of
+// their current chunks. Once they're all in chunks of the new class, updating
+// the storage class removes them from their old chunks, and old chunks with
+// nothing left in them are released.
+func (c *Curator) addRSTractsToPacker(tb transcodeBlob, packer *tractPacker) {
+	tracts, _, err := c.stateHandler.GetTracts(tb.id, 0, core.MaxBlobSize)
+	if err != core.NoError {
+		log.Errorf("couldn't get tracts of %s to transcode: %s", tb.id, err)
+		return
+	}
+	for _, i := range tb.tracts {
+		if i >= len(tracts) || !tracts[i].RS.Present() {
+			continue // Changed since we looked, try again next time.
+		}
+		t := tracts[i]
+		from := c.tsMon.makeTSAddrs([]core.TractserverID{t.RS.TSID})
+		if len(from) == 0 {
+			continue
+		}
+		packer.addRSTract(t.Tract, t.RS.Chunk, from[0], int(t.RS.Offset), int(t.RS.Length), t.Version, t.Checksum)
+	}
+}
+
 func (c *Curator) addTractsToPacker(id core.BlobID, blob *pb.Blob, packer *tractPacker) {
 	cls := storageclass.Get(packer.cls)
 	for i, t := range blob.Tracts {
@@ -169,6 +258,7 @@ func (c *Curator) addTractsToPacker(id core.BlobID, blob *pb.Blob, packer *tract
 		}
 		tid := core.TractIDFromParts(id, core.TractKey(i))
 		from := c.tsMon.makeTSAddrs(t.Hosts)
-		packer.addTract(tid, from, t.Version)
+		checksum := core.TractChecksum{CRC: t.GetChecksum(), Length: int(t.GetChecksumLength())}
+		packer.addTract(tid, from, t.Version, checksum)
 	}
 }
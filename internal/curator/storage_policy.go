@@ -0,0 +1,286 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package curator
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
+	"github.com/westerndigitalcorporation/blb/internal/curator/storageclass"
+)
+
+// The storage policy decides which storage class each blob should have. It's a
+// list of rules that are tried in order: the first rule that matches a blob
+// picks its class, and blobs that no rule matches are replicated. The curator
+// starts with Config.StoragePolicy, and it can be replaced at any time through
+// DyConfig.StoragePolicy.
+//
+// Whatever the rules say, blobs that might still be written to stay
+// replicated: write-once blobs that aren't sealed yet, and blobs that were
+// written within Config.WriteDelay.
+//
+// Rules can look at how long it's been since a blob was last read (atime), so
+// data that nobody uses can be moved to cheaper storage even if it wasn't
+// created with a COLD hint. To see what a new policy would do before letting
+// it move data, set DryRun: the storage class loop then only counts how many
+// blobs and bytes each rule would move, which is shown on the status page.
+//
+// Reading a blob that was moved for being idle makes it active again, so it
+// would be moved back on the next pass, and moved again once it's idle. To
+// keep blobs that are only read now and then from moving back and forth, a
+// rule can set MinTimeInClass: blobs it moved keep matching it for that long,
+// however recently they were read.
+
+// StoragePolicy is an ordered list of rules that pick storage classes.
+type StoragePolicy struct {
+	Rules []StorageRule
+
+	// If true, the storage class loop evaluates the rules but doesn't change
+	// the storage class of any blob.
+	DryRun bool
+}
+
+// StorageRule picks a storage class for the blobs that match it. A blob matches
+// if it satisfies all the conditions that are set; a rule without conditions
+// matches every blob.
+type StorageRule struct {
+	// Name of the rule, for the status page.
+	Name string
+
+	// The hint of the blob is one of these.
+	Hints []core.StorageHint
+
+	// The blob hasn't been written to for at least this long.
+	MinAge time.Duration
+
+	// The blob hasn't been read or written to for at least this long.
+	MinIdle time.Duration
+
+	// If the blob has been stored as Class for less than this long, MinIdle
+	// isn't checked.
+	MinTimeInClass time.Duration
+
+	// The blob is at least, and if MaxSize is not zero at most, this many
+	// bytes. Blobs are measured in whole tracts, like for quotas.
+	MinSize, MaxSize int64
+
+	// The blob is owned by this tenant.
+	Owner string
+
+	// The metadata of the blob has all of these keys, with these values. An
+	// empty value matches any value.
+	Tags map[string]string
+
+	// Class to store matching blobs as.
+	Class core.StorageClass
+}
+
+// DefaultStoragePolicy is used if Config.StoragePolicy is nil. It only looks at
+// the storage hint of blobs.
+var DefaultStoragePolicy = StoragePolicy{
+	Rules: []StorageRule{
+		{Name: "warm", Hints: []core.StorageHint{core.StorageHint_WARM}, Class: core.StorageClass_RS_6_3},
+		{Name: "cold", Hints: []core.StorageHint{core.StorageHint_COLD}, Class: core.StorageClass_RS_8_3},
+	},
+}
+
+// Validate returns an error if any rule of the policy can't be used.
+func (p *StoragePolicy) Validate() error {
+	return p.validate(storageclass.Current())
+}
+
+// validate checks the policy against the storage classes in 'reg'.
+func (p *StoragePolicy) validate(reg *storageclass.Registry) error {
+	for i, r := range p.Rules {
+		if err := r.validate(reg); err != nil {
+			return fmt.Errorf("storage rule %d (%q): %s", i, r.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *StorageRule) validate(reg *storageclass.Registry) error {
+	if reg.Get(r.Class) == nil {
+		return fmt.Errorf("unknown storage class %d", r.Class)
+	}
+	for _, h := range r.Hints {
+		if _, ok := core.StorageHint_name[int32(h)]; !ok {
+			return fmt.Errorf("unknown storage hint %d", h)
+		}
+	}
+	if r.MinAge < 0 || r.MinIdle < 0 || r.MinTimeInClass < 0 || r.MinSize < 0 || r.MaxSize < 0 {
+		return fmt.Errorf("negative limit")
+	}
+	if r.MaxSize != 0 && r.MaxSize < r.MinSize {
+		return fmt.Errorf("MaxSize %d is less than MinSize %d", r.MaxSize, r.MinSize)
+	}
+	return nil
+}
+
+// match returns the index of the first rule that matches 'blob' at time 'now',
+// or -1 if no rule does.
+func (p *StoragePolicy) match(blob *pb.Blob, now int64) int {
+	for i := range p.Rules {
+		if p.Rules[i].matches(blob, now) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *StorageRule) matches(blob *pb.Blob, now int64) bool {
+	if len(r.Hints) > 0 && !hasHint(r.Hints, blob.GetHint()) {
+		return false
+	}
+
+	mtime := blob.GetMtime()
+	if r.MinAge > 0 && now-mtime < int64(r.MinAge) {
+		return false
+	}
+	lastUse := blob.GetAtime()
+	if mtime > lastUse {
+		lastUse = mtime
+	}
+	if r.MinIdle > 0 && now-lastUse < int64(r.MinIdle) && !r.recentlyMoved(blob, now) {
+		return false
+	}
+
+	size := int64(len(blob.Tracts)) * core.TractLength
+	if size < r.MinSize || (r.MaxSize != 0 && size > r.MaxSize) {
+		return false
+	}
+
+	if r.Owner != "" && blob.GetOwner() != r.Owner {
+		return false
+	}
+	for k, v := range r.Tags {
+		if value, ok := blob.Metadata[k]; !ok || (v != "" && value != v) {
+			return false
+		}
+	}
+	return true
+}
+
+// recentlyMoved returns true if 'blob' has been stored as r.Class for less than
+// r.MinTimeInClass.
+func (r *StorageRule) recentlyMoved(blob *pb.Blob, now int64) bool {
+	return blob.GetStorage() == r.Class && blob.ClassTime != nil && now-blob.GetClassTime() < int64(r.MinTimeInClass)
+}
+
+func hasHint(hints []core.StorageHint, hint core.StorageHint) bool {
+	for _, h := range hints {
+		if h == hint {
+			return true
+		}
+	}
+	return false
+}
+
+// targetClass picks a storage class for the blob. It also returns the index of
+// the rule of 'policy' that picked it, or -1 if the blob should be replicated
+// because no rule matches, or because it might still be written to.
+func targetClass(blob *pb.Blob, now int64, delay time.Duration, policy *StoragePolicy) (core.StorageClass, int) {
+	// Write-once blobs stay replicated until they're sealed, and can't be
+	// written after that, so there's no reason to wait.
+	if blob.Sealed != nil && !blob.GetSealed() {
+		return REPLICATED, -1
+	}
+
+	// If the blob has been created or written to recently, keep it replicated.
+	if !blob.GetSealed() && now-blob.GetMtime() < int64(delay) {
+		return REPLICATED, -1
+	}
+
+	if i := policy.match(blob, now); i >= 0 {
+		return policy.Rules[i].Class, i
+	}
+	return REPLICATED, -1
+}
+
+// RuleStats is how much a storage rule matched in the last pass of the storage
+// class loop.
+type RuleStats struct {
+	Name  string
+	Class core.StorageClass
+
+	// Blobs that the rule matched, and how many bytes they have.
+	Blobs int
+	Bytes uint64
+
+	// The ones that aren't stored as Class yet.
+	MoveBlobs int
+	MoveBytes uint64
+}
+
+// policyStats counts what each rule of a policy matches. The last entry is for
+// blobs that no rule matched.
+type policyStats []RuleStats
+
+func newPolicyStats(policy *StoragePolicy) policyStats {
+	stats := make(policyStats, len(policy.Rules)+1)
+	for i, r := range policy.Rules {
+		stats[i] = RuleStats{Name: r.Name, Class: r.Class}
+	}
+	stats[len(policy.Rules)] = RuleStats{Name: "(no rule)", Class: REPLICATED}
+	return stats
+}
+
+// add counts 'blob', which rule 'rule' matched (-1 for none).
+func (s policyStats) add(rule int, blob *pb.Blob) {
+	if rule < 0 {
+		rule = len(s) - 1
+	}
+	st := &s[rule]
+	size := uint64(len(blob.Tracts)) * core.TractLength
+	st.Blobs++
+	st.Bytes += size
+	if blob.GetStorage() != st.Class {
+		st.MoveBlobs++
+		st.MoveBytes += size
+	}
+}
+
+// storagePolicy returns the storage policy in effect.
+func (c *Curator) storagePolicy() *StoragePolicy {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.policy != nil {
+		return c.policy
+	}
+	if c.config.StoragePolicy != nil {
+		return c.config.StoragePolicy
+	}
+	return &DefaultStoragePolicy
+}
+
+// setStoragePolicy replaces the storage policy from the config with 'policy',
+// or goes back to it if 'policy' is nil.
+func (c *Curator) setStoragePolicy(policy *StoragePolicy) {
+	if policy != nil {
+		if err := policy.Validate(); err != nil {
+			log.Errorf("ignoring invalid storage policy: %s", err)
+			return
+		}
+	}
+	c.lock.Lock()
+	c.policy = policy
+	c.lock.Unlock()
+}
+
+// StoragePolicyStatus is the storage policy part of the status page.
+type StoragePolicyStatus struct {
+	DryRun bool
+	Rules  []RuleStats
+}
+
+// storagePolicyStatus returns the stats from the last pass of the storage class
+// loop.
+func (c *Curator) storagePolicyStatus() StoragePolicyStatus {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.policyStatus
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package curator
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
+)
+
+func testPolicyBlob(hint core.StorageHint, mtime, atime int64, tracts int, owner string, md map[string]string) *pb.Blob {
+	b := &pb.Blob{
+		Hint:     hint.Enum(),
+		Mtime:    &mtime,
+		Atime:    &atime,
+		Tracts:   make([]*pb.Tract, tracts),
+		Metadata: md,
+	}
+	if owner != "" {
+		b.Owner = &owner
+	}
+	return b
+}
+
+// The default policy does what the hard-coded mapping from hints used to do.
+func TestDefaultStoragePolicy(t *testing.T) {
+	const delay = time.Hour
+	now := int64(10 * delay)
+	old := now - int64(2*delay)
+
+	for hint, exp := range map[core.StorageHint]core.StorageClass{
+		core.StorageHint_DEFAULT: REPLICATED,
+		core.StorageHint_HOT:     REPLICATED,
+		core.StorageHint_WARM:    core.StorageClass_RS_6_3,
+		core.StorageHint_COLD:    core.StorageClass_RS_8_3,
+	} {
+		b := testPolicyBlob(hint, old, old, 1, "", nil)
+		if cls, _ := targetClass(b, now, delay, &DefaultStoragePolicy); cls != exp {
+			t.Errorf("%s: expected %s, got %s", hint, exp, cls)
+		}
+		// Recently written blobs stay replicated.
+		b = testPolicyBlob(hint, now-int64(delay/2), old, 1, "", nil)
+		if cls, rule := targetClass(b, now, delay, &DefaultStoragePolicy); cls != REPLICATED || rule != -1 {
+			t.Errorf("%s: recent blob got %s from rule %d", hint, cls, rule)
+		}
+	}
+
+	// As do unsealed write-once blobs.
+	b := testPolicyBlob(core.StorageHint_COLD, old, old, 1, "", nil)
+	b.Sealed = new(bool)
+	if cls, _ := targetClass(b, now, delay, &DefaultStoragePolicy); cls != REPLICATED {
+		t.Errorf("unsealed blob got %s", cls)
+	}
+}
+
+func TestStoragePolicyRules(t *testing.T) {
+	const day = int64(24 * time.Hour)
+	now := 100 * day
+	p := StoragePolicy{Rules: []StorageRule{
+		{Name: "logs", Tags: map[string]string{"type": "log"}, MinAge: 7 * 24 * time.Hour, Class: core.StorageClass_RS_12_5},
+		{Name: "tenant", Owner: "archive", Class: core.StorageClass_RS_10_3},
+		{Name: "big idle", MinSize: 2 * core.TractLength, MinIdle: 30 * 24 * time.Hour, Class: core.StorageClass_RS_8_3},
+		{Name: "small", MaxSize: core.TractLength, Hints: []core.StorageHint{core.StorageHint_WARM, core.StorageHint_COLD}, Class: core.StorageClass_RS_6_3},
+	}}
+	if err := p.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, test := range []struct {
+		blob *pb.Blob
+		rule int
+	}{
+		{testPolicyBlob(core.StorageHint_HOT, now-10*day, now, 1, "", map[string]string{"type": "log"}), 0},
+		{testPolicyBlob(core.StorageHint_HOT, now-3*day, now-3*day, 1, "", map[string]string{"type": "log"}), -1},
+		{testPolicyBlob(core.StorageHint_HOT, now-10*day, now, 1, "", map[string]string{"type": "img"}), -1},
+		{testPolicyBlob(core.StorageHint_HOT, now, now, 1, "archive", nil), 1},
+		{testPolicyBlob(core.StorageHint_HOT, now-40*day, now-31*day, 2, "", nil), 2},
+		{testPolicyBlob(core.StorageHint_HOT, now-40*day, now-29*day, 2, "", nil), -1},
+		{testPolicyBlob(core.StorageHint_HOT, now-40*day, now-31*day, 1, "", nil), -1},
+		{testPolicyBlob(core.StorageHint_COLD, now, now, 1, "", nil), 3},
+		{testPolicyBlob(core.StorageHint_COLD, now, now, 0, "", nil), 3},
+		{testPolicyBlob(core.StorageHint_COLD, now, now, 2, "", nil), -1},
+		{testPolicyBlob(core.StorageHint_DEFAULT, now, now, 1, "", nil), -1},
+	} {
+		if rule := p.match(test.blob, now); rule != test.rule {
+			t.Errorf("case %d: expected rule %d, got %d", i, test.rule, rule)
+		}
+	}
+}
+
+// A blob that was moved for being idle isn't moved back right away if it's read.
+func TestStoragePolicyMinTimeInClass(t *testing.T) {
+	const day = int64(24 * time.Hour)
+	now := 100 * day
+	p := StoragePolicy{Rules: []StorageRule{
+		{Name: "idle", MinIdle: 30 * 24 * time.Hour, MinTimeInClass: 10 * 24 * time.Hour, Class: core.StorageClass_RS_8_3},
+	}}
+
+	read := testPolicyBlob(core.StorageHint_DEFAULT, now-40*day, now-day, 1, "", nil)
+	if rule := p.match(read, now); rule != -1 {
+		t.Errorf("active replicated blob matched rule %d", rule)
+	}
+
+	// Moved five days ago, and read since.
+	read.Storage = core.StorageClass_RS_8_3.Enum()
+	read.ClassTime = proto.Int64(now - 5*day)
+	if rule := p.match(read, now); rule != 0 {
+		t.Errorf("recently moved blob matched rule %d", rule)
+	}
+
+	// Moved long enough ago.
+	read.ClassTime = proto.Int64(now - 11*day)
+	if rule := p.match(read, now); rule != -1 {
+		t.Errorf("blob moved long ago matched rule %d", rule)
+	}
+
+	// Moved to another class recently.
+	read.Storage = core.StorageClass_RS_6_3.Enum()
+	read.ClassTime = proto.Int64(now - 5*day)
+	if rule := p.match(read, now); rule != -1 {
+		t.Errorf("blob in another class matched rule %d", rule)
+	}
+}
+
+func TestStoragePolicyValidate(t *testing.T) {
+	for _, bad := range []StorageRule{
+		{Class: core.StorageClass(100)},
+		{Class: REPLICATED, Hints: []core.StorageHint{core.StorageHint(100)}},
+		{Class: REPLICATED, MinIdle: -time.Hour},
+		{Class: REPLICATED, MinTimeInClass: -time.Hour},
+		{Class: REPLICATED, MinSize: 10, MaxSize: 5},
+	} {
+		p := StoragePolicy{Rules: []StorageRule{bad}}
+		if p.Validate() == nil {
+			t.Errorf("expected %+v to be rejected", bad)
+		}
+	}
+}
+
+// Policies in config files can use the names of hints and classes.
+func TestStoragePolicyJSON(t *testing.T) {
+	var p StoragePolicy
+	err := json.Unmarshal([]byte(`{"DryRun": true, "Rules": [{"Name": "cold", "Hints": ["COLD"], "Class": "RS_8_3"}]}`), &p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.DryRun || len(p.Rules) != 1 || p.Rules[0].Class != core.StorageClass_RS_8_3 ||
+		len(p.Rules[0].Hints) != 1 || p.Rules[0].Hints[0] != core.StorageHint_COLD {
+		t.Errorf("wrong policy: %+v", p)
+	}
+}
+
+func TestPolicyStats(t *testing.T) {
+	p := StoragePolicy{Rules: []StorageRule{{Name: "cold", Class: core.StorageClass_RS_8_3}}}
+	stats := newPolicyStats(&p)
+
+	moved := testPolicyBlob(core.StorageHint_COLD, 0, 0, 2, "", nil)
+	moved.Storage = core.StorageClass_RS_8_3.Enum()
+	stats.add(0, moved)
+	stats.add(0, testPolicyBlob(core.StorageHint_COLD, 0, 0, 1, "", nil))
+	stats.add(-1, testPolicyBlob(core.StorageHint_HOT, 0, 0, 3, "", nil))
+
+	if s := stats[0]; s.Blobs != 2 || s.Bytes != 3*core.TractLength || s.MoveBlobs != 1 || s.MoveBytes != core.TractLength {
+		t.Errorf("wrong stats for rule: %+v", s)
+	}
+	// The blob that no rule matched is replicated already.
+	if s := stats[1]; s.Blobs != 1 || s.Bytes != 3*core.TractLength || s.MoveBlobs != 0 {
+		t.Errorf("wrong stats for no rule: %+v", s)
+	}
+}
@@ -5,17 +5,26 @@ package storageclass
 
 import (
 	"fmt"
-	"reflect"
+	"sort"
+	"sync/atomic"
 
 	"github.com/westerndigitalcorporation/blb/internal/core"
 	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
 )
 
+// Storage classes are defined by data (pb.StorageClassDef) that the curator
+// keeps in its state, instead of by code. A tract records which chunk it's in
+// for each class with a pb.ChunkPointer, and each chunk records its class, so
+// adding a class only means adding a definition. The definitions in Builtin are
+// always present.
+
 // Class is a representation of a storage class that allows manipulation of the
 // tract metadata for that class.
 type Class interface {
 	// ID returns the enum for this class.
 	ID() core.StorageClass
+	// Def returns the definition of this class. It must not be modified.
+	Def() *pb.StorageClassDef
 	// Has returns true if the tract is stored as this class.
 	Has(tract *pb.Tract) bool
 	// Clear removes this class' data for the tract.
@@ -25,32 +34,109 @@ type Class interface {
 
 	// Set sets the RS pointer for this storage class.
 	Set(tract *pb.Tract, key []byte) core.Error
-	// RSParams returns the RS parameters for this class.
+	// RSParams returns the RS parameters for this class. For locally
+	// repairable codes, m counts both the local and global parity pieces.
 	RSParams() (n int, m int)
+	// LRCParams returns the parameters of this class if it's a locally
+	// repairable code, and false if it isn't.
+	LRCParams() (core.LRCParams, bool)
 	// GetRS gets the RS pointer for this storage class, or nil if not present.
 	GetRS(tract *pb.Tract) []byte
 }
 
-// All returns a slice of all known storage classes, including REPLICATED.
-var All = makeAll()
-
-func makeAll() []Class {
-	out := []Class{repl{}} // make sure REPLICATED goes first
-	for name, id := range core.StorageClass_value {
-		var n, m int
-		if items, err := fmt.Sscanf(name, "RS_%d_%d", &n, &m); err == nil && items == 2 {
-			out = append(out, makeRS(core.StorageClass(id), n, m))
+// Builtin has the classes that every curator knows about. Their IDs are the
+// values of core.StorageClass, and tracts from before classes were defined in
+// state are migrated to them.
+var Builtin = []*pb.StorageClassDef{
+	{Id: core.StorageClass_REPLICATED, Name: "REPLICATED", Family: pb.StorageClassDef_REPLICATED},
+	{Id: core.StorageClass_RS_6_3, Name: "RS_6_3", Family: pb.StorageClassDef_RS, N: 6, M: 3},
+	{Id: core.StorageClass_RS_8_3, Name: "RS_8_3", Family: pb.StorageClassDef_RS, N: 8, M: 3},
+	{Id: core.StorageClass_RS_10_3, Name: "RS_10_3", Family: pb.StorageClassDef_RS, N: 10, M: 3},
+	{Id: core.StorageClass_RS_12_5, Name: "RS_12_5", Family: pb.StorageClassDef_RS, N: 12, M: 5},
+	{Id: core.StorageClass_LRC_12_2_2, Name: "LRC_12_2_2", Family: pb.StorageClassDef_LRC, N: 12, M: 4, LocalGroups: 2},
+}
+
+// Validate returns an error if 'def' doesn't define a usable class.
+func Validate(def *pb.StorageClassDef) error {
+	if def.Name == "" {
+		return fmt.Errorf("class %d has no name", def.Id)
+	}
+	// Blob events record the class in one byte.
+	if def.Id < 0 || def.Id > 255 {
+		return fmt.Errorf("%s: id %d is out of range", def.Name, def.Id)
+	}
+	if (def.Id == core.StorageClass_REPLICATED) != (def.Family == pb.StorageClassDef_REPLICATED) {
+		return fmt.Errorf("%s: only class %d can be replicated", def.Name, core.StorageClass_REPLICATED)
+	}
+	if def.PieceLength < 0 || (def.PieceLength > 0 && def.PieceLength < core.TractLength) {
+		return fmt.Errorf("%s: pieces must hold at least one tract", def.Name)
+	}
+	if def.MaxPerDomain < 0 {
+		return fmt.Errorf("%s: negative MaxPerDomain", def.Name)
+	}
+
+	switch def.Family {
+	case pb.StorageClassDef_REPLICATED:
+		return nil
+	case pb.StorageClassDef_RS:
+		if def.LocalGroups != 0 {
+			return fmt.Errorf("%s: RS classes don't have local groups", def.Name)
+		}
+	case pb.StorageClassDef_LRC:
+		l := def.LocalGroups
+		if l <= 0 || def.N%l != 0 || def.M <= l {
+			return fmt.Errorf("%s: LRC needs local groups that divide the data, and global parity", def.Name)
 		}
+	default:
+		return fmt.Errorf("%s: unknown family %d", def.Name, def.Family)
 	}
-	return out
+	if def.N <= 0 || def.M <= 0 || def.N+def.M > 256 {
+		return fmt.Errorf("%s: bad number of pieces %d+%d", def.Name, def.N, def.M)
+	}
+	return nil
 }
 
-// AllRS returns a slice of all RS storage classes.
-var AllRS = All[1:]
+// Registry is a set of storage classes.
+type Registry struct {
+	all []Class // sorted by ID, so REPLICATED goes first
+}
 
-// Get returns the Class with the given id.
-func Get(id core.StorageClass) Class {
-	for _, c := range All {
+// NewRegistry returns a Registry with the classes in 'defs', which must include
+// REPLICATED.
+func NewRegistry(defs []*pb.StorageClassDef) (*Registry, error) {
+	r := &Registry{}
+	for _, def := range defs {
+		if err := Validate(def); err != nil {
+			return nil, err
+		}
+		r.all = append(r.all, class{def})
+	}
+	sort.Slice(r.all, func(i, j int) bool { return r.all[i].ID() < r.all[j].ID() })
+
+	if len(r.all) == 0 || r.all[0].ID() != core.StorageClass_REPLICATED {
+		return nil, fmt.Errorf("missing REPLICATED class")
+	}
+	for i, a := range r.all[1:] {
+		if a.ID() == r.all[i].ID() {
+			return nil, fmt.Errorf("two classes with id %d", a.ID())
+		}
+	}
+	return r, nil
+}
+
+// All returns a slice of all the classes, including REPLICATED.
+func (r *Registry) All() []Class {
+	return r.all
+}
+
+// AllRS returns a slice of all RS classes.
+func (r *Registry) AllRS() []Class {
+	return r.all[1:]
+}
+
+// Get returns the Class with the given id, or nil if there's none.
+func (r *Registry) Get(id core.StorageClass) Class {
+	for _, c := range r.all {
 		if c.ID() == id {
 			return c
 		}
@@ -58,78 +144,125 @@ func Get(id core.StorageClass) Class {
 	return nil
 }
 
-// implements Class
-type repl struct{}
+// Defs returns the definitions of all the classes.
+func (r *Registry) Defs() []*pb.StorageClassDef {
+	defs := make([]*pb.StorageClassDef, len(r.all))
+	for i, c := range r.all {
+		defs[i] = c.Def()
+	}
+	return defs
+}
+
+// The registry used by the functions below. The curator replaces it with the
+// classes from its state.
+var current atomic.Value
 
-func (r repl) ID() core.StorageClass {
-	return core.StorageClass_REPLICATED
+func init() {
+	r, err := NewRegistry(Builtin)
+	if err != nil {
+		panic(err)
+	}
+	current.Store(r)
 }
 
-func (r repl) Has(tract *pb.Tract) bool {
-	return len(tract.Hosts) >= 1
+// Current returns the registry in use.
+func Current() *Registry {
+	return current.Load().(*Registry)
 }
 
-func (r repl) Clear(tract *pb.Tract) {
-	tract.Hosts = nil
+// Install makes 'r' the registry in use.
+func Install(r *Registry) {
+	current.Store(r)
 }
 
-func (r repl) Set(tract *pb.Tract, key []byte) core.Error {
-	return core.ErrInvalidArgument
+// All returns a slice of all known storage classes, including REPLICATED.
+func All() []Class {
+	return Current().All()
 }
 
-func (r repl) RSParams() (int, int) {
-	return -1, -1
+// AllRS returns a slice of all RS storage classes.
+func AllRS() []Class {
+	return Current().AllRS()
 }
 
-func (r repl) GetRS(tract *pb.Tract) []byte {
-	return nil
+// Get returns the Class with the given id.
+func Get(id core.StorageClass) Class {
+	return Current().Get(id)
 }
 
 // implements Class
-type rs struct {
-	id    core.StorageClass // the storage class ID
-	n, m  int               // RS parameters
-	field int               // index of RsNMChunk field in pb.Tract
+type class struct {
+	def *pb.StorageClassDef
 }
 
-func makeRS(id core.StorageClass, n, m int) rs {
-	name := fmt.Sprintf("Rs%d%dChunk", n, m)
-	sf, ok := reflect.TypeOf(pb.Tract{}).FieldByName(name)
-	if !ok || len(sf.Index) != 1 {
-		panic(fmt.Sprintf("missing %s field in Tract proto message, or len(Index) != 1", name))
-	}
-	return rs{id, n, m, sf.Index[0]}
+func (c class) ID() core.StorageClass {
+	return c.def.Id
 }
 
-func (r rs) ID() core.StorageClass {
-	return r.id
+func (c class) Def() *pb.StorageClassDef {
+	return c.def
 }
 
-func (r rs) val(tract *pb.Tract) reflect.Value {
-	return reflect.ValueOf(tract).Elem().Field(r.field)
+func (c class) replicated() bool {
+	return c.def.Family == pb.StorageClassDef_REPLICATED
 }
 
-func (r rs) Has(tract *pb.Tract) bool {
-	return !r.val(tract).IsNil()
+func (c class) pointer(tract *pb.Tract) int {
+	for i, p := range tract.Chunks {
+		if p.Class == c.def.Id {
+			return i
+		}
+	}
+	return -1
 }
 
-func (r rs) Clear(tract *pb.Tract) {
-	r.val(tract).SetBytes(nil)
+func (c class) Has(tract *pb.Tract) bool {
+	if c.replicated() {
+		return len(tract.Hosts) >= 1
+	}
+	return c.pointer(tract) >= 0
 }
 
-func (r rs) Set(tract *pb.Tract, key []byte) core.Error {
-	v := r.val(tract)
-	if !v.IsNil() {
+func (c class) Clear(tract *pb.Tract) {
+	if c.replicated() {
+		tract.Hosts = nil
+	} else if i := c.pointer(tract); i >= 0 {
+		tract.Chunks = append(tract.Chunks[:i], tract.Chunks[i+1:]...)
+		if len(tract.Chunks) == 0 {
+			tract.Chunks = nil
+		}
+	}
+}
+
+func (c class) Set(tract *pb.Tract, key []byte) core.Error {
+	if c.replicated() {
+		return core.ErrInvalidArgument
+	}
+	if c.pointer(tract) >= 0 {
 		return core.ErrConflictingState
 	}
-	v.SetBytes(key)
+	tract.Chunks = append(tract.Chunks, &pb.ChunkPointer{Class: c.def.Id, Chunk: key})
 	return core.NoError
 }
 
-func (r rs) RSParams() (int, int) {
-	return r.n, r.m
+func (c class) RSParams() (int, int) {
+	if c.replicated() {
+		return -1, -1
+	}
+	return c.def.N, c.def.M
+}
+
+func (c class) LRCParams() (core.LRCParams, bool) {
+	if c.def.Family != pb.StorageClassDef_LRC {
+		return core.LRCParams{}, false
+	}
+	l := c.def.LocalGroups
+	return core.LRCParams{K: c.def.N, L: l, R: c.def.M - l}, true
 }
 
-func (r rs) GetRS(tract *pb.Tract) []byte {
-	return r.val(tract).Bytes()
+func (c class) GetRS(tract *pb.Tract) []byte {
+	if i := c.pointer(tract); i >= 0 && !c.replicated() {
+		return tract.Chunks[i].Chunk
+	}
+	return nil
 }
@@ -0,0 +1,61 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package storageclass
+
+import (
+	"testing"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
+)
+
+func TestNewRegistry(t *testing.T) {
+	rs := func(id core.StorageClass, n, m int) *pb.StorageClassDef {
+		return &pb.StorageClassDef{Id: id, Name: "test", Family: pb.StorageClassDef_RS, N: n, M: m}
+	}
+	with := func(defs ...*pb.StorageClassDef) []*pb.StorageClassDef {
+		return append(append([]*pb.StorageClassDef(nil), Builtin...), defs...)
+	}
+	lrc := &pb.StorageClassDef{Id: 22, Name: "LRC_6_3_2", Family: pb.StorageClassDef_LRC, N: 6, M: 5, LocalGroups: 3}
+	badLRC := &pb.StorageClassDef{Id: 20, Name: "LRC_6_4_2", Family: pb.StorageClassDef_LRC, N: 6, M: 6, LocalGroups: 4}
+	small := rs(20, 4, 2)
+	small.PieceLength = 1024
+
+	for i, test := range []struct {
+		defs []*pb.StorageClassDef
+		ok   bool
+	}{
+		{Builtin, true},
+		{with(rs(20, 4, 2)), true},
+		{with(lrc), true},
+		{Builtin[1:], false},            // no REPLICATED
+		{with(rs(20, 12, 4)), true},     // same pieces as LRC_12_2_2
+		{with(rs(1, 4, 2)), false},      // same id as RS_6_3
+		{with(rs(300, 4, 2)), false},    // id too large
+		{with(rs(20, 0, 2)), false},     // no data
+		{with(rs(20, 200, 100)), false}, // too many pieces
+		{with(badLRC), false},           // groups don't divide the data
+		{with(small), false},            // pieces smaller than a tract
+	} {
+		r, err := NewRegistry(test.defs)
+		if test.ok != (err == nil) {
+			t.Errorf("%d: expected ok=%v, got %v", i, test.ok, err)
+			continue
+		}
+		if err == nil && len(r.All()) != len(test.defs) {
+			t.Errorf("%d: registry has %d classes", i, len(r.All()))
+		}
+	}
+
+	r, _ := NewRegistry(with(rs(20, 4, 2), lrc))
+	if code, ok := r.Get(20).LRCParams(); ok {
+		t.Errorf("RS class has LRC params %v", code)
+	}
+	if code, ok := r.Get(22).LRCParams(); !ok || code != (core.LRCParams{K: 6, L: 3, R: 2}) {
+		t.Errorf("wrong LRC params %v", code)
+	}
+	if r.Get(21) != nil {
+		t.Errorf("found a class that isn't there")
+	}
+}
@@ -64,6 +64,35 @@ func (c *Curator) allocateTS(num int, existing []core.TractserverID, down []core
 	return
 }
 
+// allocateTSSpread is like allocateTS, but for the pieces of an RS chunk. If
+// 'maxPerDomain' is non-zero, it fails when the picked tractservers and
+// 'existing' would have more than that many in one top-level failure domain.
+func (c *Curator) allocateTSSpread(num, maxPerDomain int, existing []core.TractserverID, down []core.TractserverID) (addrs []string, ids []core.TractserverID) {
+	if addrs, ids = c.allocateTS(num, existing, down); addrs == nil || maxPerDomain == 0 {
+		return
+	}
+	existingAddrs, _ := c.tsMon.getTractserverAddrs(existing)
+	used := append(existingAddrs, addrs...)
+
+	reverseIndex := c.tsMon.getFailureDomainToFreeTS()
+	if len(reverseIndex) == 0 {
+		return
+	}
+	for domain, hosts := range reverseIndex[len(reverseIndex)-1] {
+		count := 0
+		for _, addr := range used {
+			if slices.ContainsString(hosts, addr) {
+				count++
+			}
+		}
+		if count > maxPerDomain {
+			log.Errorf("can't put more than %d pieces in %s, would put %d", maxPerDomain, domain, count)
+			return nil, nil
+		}
+	}
+	return
+}
+
 // pickNFromDomain picks at most 'num' tractservers from 'domainToHosts' to
 // store replicas of a tract. 'domainToHosts' is a mapping from domains to hosts
 // belonging to them.
@@ -17,6 +17,16 @@ type TractserverTalker interface {
 	// version 'version' from the source hosts 'from', each of which should own a copy of the tract.
 	PullTract(addr string, tsid core.TractserverID, from []string, id core.TractID, version int) core.Error
 
+	// CopyTract asks the tractserver at 'addr' with id 'tsid' to pull the tract with id 'src' and
+	// version 'srcVersion' from the source hosts 'from', and store it as the tract with id 'id'
+	// and version 'version'.
+	CopyTract(addr string, tsid core.TractserverID, from []string, src core.TractID, srcVersion int, id core.TractID, version int) core.Error
+
+	// UnpackTract asks the tractserver at 'addr' with id 'tsid' to read 'length' bytes at 'offset'
+	// in the RS chunk piece 'piece' from the source hosts 'from', and store them as the tract with
+	// id 'id' and version 'version'.
+	UnpackTract(addr string, tsid core.TractserverID, from []string, piece core.RSChunkID, offset, length int, id core.TractID, version int) core.Error
+
 	// CheckTracts asks the tractserver at 'addr' with id 'tsid' if it has the tracts 'tracts'.
 	CheckTracts(addr string, tsid core.TractserverID, tracts []core.TractState) core.Error
 
@@ -37,6 +47,7 @@ type TractserverTalker interface {
 	PackTracts(addr string, tsid core.TractserverID, length int, tracts []*core.PackTractSpec, id core.RSChunkID) core.Error
 
 	// RSEncode asks the tractserver to pull N chunks from other tractservers, compute M
-	// parity chunks, and write the parity chunks to other tractservers.
-	RSEncode(addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs, dests []core.TSAddr, indexMap []int) core.Error
+	// parity chunks, and write the parity chunks to other tractservers. If 'code' is
+	// set, the chunk uses that locally repairable code instead of Reed-Solomon.
+	RSEncode(addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs, dests []core.TSAddr, indexMap []int, code core.LRCParams) core.Error
 }
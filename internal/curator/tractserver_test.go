@@ -96,6 +96,39 @@ func (tt *testTractserverTalker) PullTract(addr string, tsid core.TractserverID,
 	return ret
 }
 
+func (tt *testTractserverTalker) CopyTract(addr string, tsid core.TractserverID, from []string, src core.TractID, srcVersion int, id core.TractID, version int) core.Error {
+	tt.lock.Lock()
+	defer tt.lock.Unlock()
+
+	msg := core.PullTractReq{From: from, ID: id, Version: version, SrcID: src, SrcVersion: srcVersion}
+	tt.pullTractCalls[addr] = append(tt.pullTractCalls[addr], msg)
+
+	if len(tt.pullTractReplies[addr]) == 0 {
+		return core.ErrRPC
+	}
+
+	ret := tt.pullTractReplies[addr][0]
+	tt.pullTractReplies[addr] = tt.pullTractReplies[addr][1:]
+	return ret
+}
+
+func (tt *testTractserverTalker) UnpackTract(addr string, tsid core.TractserverID, from []string, piece core.RSChunkID, offset, length int, id core.TractID, version int) core.Error {
+	tt.lock.Lock()
+	defer tt.lock.Unlock()
+
+	msg := core.PullTractReq{From: from, ID: id, Version: version,
+		SrcID: piece.ToTractID(), SrcVersion: core.RSChunkVersion, SrcOffset: offset, SrcLength: length}
+	tt.pullTractCalls[addr] = append(tt.pullTractCalls[addr], msg)
+
+	if len(tt.pullTractReplies[addr]) == 0 {
+		return core.ErrRPC
+	}
+
+	ret := tt.pullTractReplies[addr][0]
+	tt.pullTractReplies[addr] = tt.pullTractReplies[addr][1:]
+	return ret
+}
+
 func (tt *testTractserverTalker) GCTract(addr string, tsid core.TractserverID, old []core.TractState, gone []core.TractID) core.Error {
 	tt.lock.Lock()
 	defer tt.lock.Unlock()
@@ -123,11 +156,11 @@ func (tt *testTractserverTalker) PackTracts(addr string, tsid core.TractserverID
 	return core.ErrNotYetImplemented
 }
 
-func (tt *testTractserverTalker) RSEncode(addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs, dests []core.TSAddr, im []int) core.Error {
+func (tt *testTractserverTalker) RSEncode(addr string, tsid core.TractserverID, id core.RSChunkID, length int, srcs, dests []core.TSAddr, im []int, code core.LRCParams) core.Error {
 	tt.lock.Lock()
 	defer tt.lock.Unlock()
 
-	msg := core.RSEncodeReq{TSID: tsid, ChunkID: id, Length: length, Srcs: srcs, Dests: dests, IndexMap: im}
+	msg := core.RSEncodeReq{TSID: tsid, ChunkID: id, Length: length, Srcs: srcs, Dests: dests, IndexMap: im, LRC: code}
 	tt.rsEncodeCalls[addr] = append(tt.rsEncodeCalls[addr], msg)
 
 	if len(tt.rsEncodeReplies[addr]) == 0 {
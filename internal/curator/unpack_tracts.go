@@ -0,0 +1,102 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package curator
+
+import (
+	"sync"
+	"sync/atomic"
+
+	log "github.com/golang/glog"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	pb "github.com/westerndigitalcorporation/blb/internal/curator/durable/state/statepb"
+)
+
+// Moving a blob from an RS class back to REPLICATED is done one tract at a
+// time: new tractservers are picked for each tract, and each of them copies
+// the tract's data out of the RS data piece that holds it. Once every tract of
+// the blob is replicated again, the storage class loop updates the class,
+// which also takes the tracts out of their RS chunks. Chunks with no tracts
+// left are forgotten, and tractservers garbage collect their pieces.
+//
+// The copies use the same bandwidth budget as RS encoding. If a tract can't be
+// copied, for example because the piece with its data is lost and hasn't been
+// reconstructed yet, we move on to the next blob and try again on the next
+// pass. Tracts that were already copied stay that way.
+
+// unpackBlob makes replicated copies of the tracts of 'id' that are only stored
+// in RS chunks. It adds the number of tracts copied to 'unpacked'.
+func (c *Curator) unpackBlob(id core.BlobID, blob *pb.Blob, unpacked *int32, wg *sync.WaitGroup, term uint64) {
+	defer wg.Done()
+
+	repl := int(blob.GetRepl())
+	for i, t := range blob.Tracts {
+		if len(t.Hosts) > 0 {
+			continue // Already replicated.
+		}
+		tid := core.TractIDFromParts(id, core.TractKey(i))
+		if err := c.unpackTract(tid, repl, term); err != core.NoError {
+			log.Errorf("couldn't unpack %s, will try again later: %s", tid, err)
+			return
+		}
+		atomic.AddInt32(unpacked, 1)
+	}
+}
+
+// unpackTract copies the tract 'id' out of its RS chunk to 'repl' new
+// tractservers, and records them as its replicas.
+func (c *Curator) unpackTract(id core.TractID, repl int, term uint64) core.Error {
+	c.lockMgr.LockTract(id)
+	defer c.lockMgr.UnlockTract(id)
+
+	info, err := c.stateHandler.GetTract(id)
+	if err != core.NoError {
+		return err
+	}
+	if !info.RS.Present() {
+		log.Errorf("%v isn't stored in an RS chunk", id)
+		return core.ErrInvalidState
+	}
+
+	from, missing := c.tsMon.getTractserverAddrs([]core.TractserverID{info.RS.TSID})
+	if missing > 0 {
+		return core.ErrHostNotExist
+	}
+
+	addrs, ids := c.allocateTS(repl, nil, nil)
+	if addrs == nil {
+		log.Errorf("%v couldn't allocate %d TSs to unpack to", id, repl)
+		return core.ErrAllocHost
+	}
+
+	length := int(info.RS.Length)
+	c.rsEncodeBwLim.Take(float32(length * repl))
+
+	// The copies get a new version, see state.Txn.UnpackTract. The channel is
+	// buffered so that we can return at the first error without blocking the
+	// rest.
+	newVersion := info.Version + 1
+	errorChan := make(chan core.Error, len(addrs))
+	for i := range addrs {
+		go func(addr string, tsid core.TractserverID) {
+			err := c.tt.UnpackTract(addr, tsid, from, info.RS.Chunk, int(info.RS.Offset), length, id, newVersion)
+			if err != core.NoError {
+				log.Errorf("%v unpacking from %s (%v) to (%d at %s) failed: %s", id, info.RS.Chunk, from, tsid, addr, err)
+			}
+			errorChan <- err
+		}(addrs[i], ids[i])
+	}
+	for range addrs {
+		if res := <-errorChan; res != core.NoError {
+			// The errors are logged in the goroutine started above.
+			return res
+		}
+	}
+
+	if err = c.stateHandler.UnpackTract(id, newVersion, ids, term); err != core.NoError {
+		log.Errorf("%v couldn't commit unpacked replicas: %s", id, err)
+		return err
+	}
+	log.V(1).Infof("unpacked %v to %v", id, ids)
+	return core.NoError
+}
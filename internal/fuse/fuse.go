@@ -3,6 +3,8 @@
 //
 // This package implements a basic interface to Blb as a filesystem using FUSE.
 // Creating, listing, reading, writing, and removing blobs are supported.
+// Blobs are listed by ID under "blobs", and by name under "names", where
+// directories correspond to the blob namespace.
 //
 // This is not for production use! It's intended for diagnostics only.
 
@@ -12,6 +14,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"sync/atomic"
 
 	"bazil.org/fuse"
@@ -111,6 +114,8 @@ func (r *rootDir) Lookup(ctx context.Context, name string) (fs.Node, error) {
 		return (*blobDir)(r), nil
 	case "meta":
 		return (*metaDir)(r), nil
+	case "names":
+		return &nameDir{c: r.c}, nil
 	}
 	return nil, fuse.ENOENT
 }
@@ -119,6 +124,7 @@ func (r *rootDir) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
 	return []fuse.Dirent{
 		{Inode: 2, Name: "meta", Type: fuse.DT_Dir},
 		{Inode: 3, Name: "blobs", Type: fuse.DT_Dir},
+		{Inode: 5, Name: "names", Type: fuse.DT_Dir},
 	}, nil
 }
 
@@ -210,6 +216,95 @@ func (b *blobDir) Remove(ctx context.Context, req *fuse.RemoveRequest) error {
 	return translateError(err)
 }
 
+// nameDir is a directory in the blob namespace. The top-level one has an empty
+// prefix, others have a prefix ending with a separator.
+type nameDir struct {
+	c      *client.Client
+	prefix string
+}
+
+func (d *nameDir) Attr(ctx context.Context, a *fuse.Attr) error {
+	if d.prefix == "" {
+		a.Inode = 5
+	}
+	a.Mode = os.ModeDir | 0755
+	return nil
+}
+
+func (d *nameDir) ReadDirAll(ctx context.Context) (out []fuse.Dirent, err error) {
+	ni := d.c.ListPrefix(ctx, d.prefix)
+	for {
+		var entries []client.NameEntry
+		entries, err = ni()
+		if err != nil || entries == nil {
+			return
+		}
+		for _, e := range entries {
+			name := strings.TrimPrefix(e.Name, d.prefix)
+			if e.IsDir() {
+				out = append(out, fuse.Dirent{Name: strings.TrimSuffix(name, "/"), Type: fuse.DT_Dir})
+			} else {
+				// See blobDir.ReadDirAll about inodes.
+				out = append(out, fuse.Dirent{Inode: uint64(e.Blob), Name: name, Type: fuse.DT_File})
+			}
+		}
+	}
+}
+
+func (d *nameDir) Lookup(ctx context.Context, name string) (fs.Node, error) {
+	id, err := d.c.LookupName(ctx, d.prefix+name)
+	if err == nil {
+		blob, err := d.c.Open(id, "rws", client.OpenContext(ctx))
+		if err != nil {
+			return nil, translateError(err)
+		}
+		return (*blobNode)(blob), nil
+	} else if !core.ErrNoSuchName.Is(err) {
+		return nil, translateError(err)
+	}
+
+	// Directories are implicit, so this is one if anything is in it.
+	sub := &nameDir{c: d.c, prefix: d.prefix + name + "/"}
+	entries, err := d.c.ListPrefix(ctx, sub.prefix)()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if len(entries) == 0 {
+		return nil, fuse.ENOENT
+	}
+	return sub, nil
+}
+
+func (d *nameDir) Create(ctx context.Context, req *fuse.CreateRequest, resp *fuse.CreateResponse) (fs.Node, fs.Handle, error) {
+	blob, err := d.c.CreateNamed(d.prefix+req.Name, client.CreateContext(ctx))
+	if err != nil {
+		return nil, nil, translateError(err)
+	}
+	return (*blobNode)(blob), (*blobNode)(blob), nil
+}
+
+func (d *nameDir) Remove(ctx context.Context, req *fuse.RemoveRequest) error {
+	if req.Dir {
+		// Directories disappear when their last entry is removed.
+		return fuse.EPERM
+	}
+	return translateError(d.c.DeleteNamed(ctx, d.prefix+req.Name))
+}
+
+func (d *nameDir) Rename(ctx context.Context, req *fuse.RenameRequest, newDir fs.Node) error {
+	nd, ok := newDir.(*nameDir)
+	if !ok {
+		return fuse.EPERM
+	}
+	from, to := d.prefix+req.OldName, nd.prefix+req.NewName
+	err := d.c.Rename(ctx, from, to)
+	if core.ErrNoSuchName.Is(err) {
+		// Maybe it's a directory.
+		err = d.c.Rename(ctx, from+core.NameSeparator, to+core.NameSeparator)
+	}
+	return translateError(err)
+}
+
 // We use *client.Blob as both Node and Handle. There's basically no state kept
 // for "open" blobs, so this keeps things simpler.
 type blobNode client.Blob
@@ -261,10 +356,25 @@ func (n *blobNode) Write(ctx context.Context, req *fuse.WriteRequest, resp *fuse
 	return nil
 }
 
+// Setattr only supports changing the size, which truncates or extends the
+// blob. Other changes are ignored.
+func (n *blobNode) Setattr(ctx context.Context, req *fuse.SetattrRequest, resp *fuse.SetattrResponse) error {
+	blob := (*client.Blob)(n)
+	if req.Valid.Size() {
+		if err := blob.Truncate(int64(req.Size)); err != nil {
+			return translateError(err)
+		}
+	}
+	return n.Attr(ctx, &resp.Attr)
+}
+
 func translateError(err error) error {
 	// translate some errors specially for FUSE
-	if core.ErrNoSuchBlob.Is(err) {
+	if core.ErrNoSuchBlob.Is(err) || core.ErrNoSuchName.Is(err) {
 		return fuse.ENOENT
 	}
+	if core.ErrAlreadyExists.Is(err) {
+		return fuse.EEXIST
+	}
 	return err
 }
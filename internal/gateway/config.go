@@ -0,0 +1,29 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package gateway
+
+import "time"
+
+// Config specifies various parameters.
+type Config struct {
+	// Cluster to find masters and curators, defaults to local cluster.
+	Cluster string
+
+	// Address to serve S3 requests on.
+	Addr string
+
+	// The largest number of keys returned from one listing, regardless of
+	// what the client asks for.
+	MaxKeys int
+
+	// How long a multipart upload may take. After that its parts expire.
+	UploadExpiry time.Duration
+}
+
+// DefaultConfig are default configuration parameters.
+var DefaultConfig = Config{
+	Addr:         ":4080",
+	MaxKeys:      1000,
+	UploadExpiry: 7 * 24 * time.Hour,
+}
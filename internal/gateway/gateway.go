@@ -0,0 +1,369 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package gateway
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+
+	client "github.com/westerndigitalcorporation/blb/client/blb"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/server"
+)
+
+// The gateway serves a subset of the S3 REST API, with path-style addressing
+// only (http://host/bucket/key). Objects are blobs named "bucket/key" in the
+// blb namespace, so keys have to be valid blob names. Buckets are the
+// top-level directories of the namespace. Like directories, they exist as
+// long as they have objects in them, so creating one does nothing.
+//
+// Requests aren't authenticated; clients may sign them, but the signatures
+// are ignored. The gateway presents one token to the cluster for every
+// request, so blob ACLs can't tell its callers apart: every S3 client can do
+// whatever the gateway's identity can.
+//
+// Objects are written to a temporary name and renamed into place when they're
+// complete, atomically replacing any existing object.
+
+const (
+	// Names used by the gateway itself. They don't look like buckets, since
+	// bucket names can't start with ".".
+	tmpDir     = ".gateway/tmp/"
+	uploadsDir = ".gateway/uploads/"
+
+	// Blob metadata keys for S3 object metadata. User metadata from
+	// x-amz-meta-* headers is stored with userMetaPrefix.
+	etagKey        = "s3.etag"
+	sizeKey        = "s3.size"
+	contentTypeKey = "s3.content-type"
+	userMetaPrefix = "s3.meta."
+
+	// The header prefix for user metadata.
+	amzMetaPrefix = "X-Amz-Meta-"
+
+	// How many times we try to replace an object that other writers keep
+	// replacing at the same time.
+	maxReplaceAttempts = 3
+)
+
+var validBucket = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+// Gateway is an http.Handler that serves S3 requests from blb.
+type Gateway struct {
+	cfg Config
+	cli *client.Client
+	opm *server.OpMetric
+}
+
+// NewGateway returns a Gateway that uses 'cli' to talk to blb.
+func NewGateway(cfg Config, cli *client.Client) *Gateway {
+	return &Gateway{
+		cfg: cfg,
+		cli: cli,
+		opm: server.NewOpMetric("gateway_ops", "op"),
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	op, handler := g.route(w, r)
+	m := g.opm.Start(op)
+	defer m.End()
+	if err := handler(); err != nil {
+		m.Failed()
+		log.Infof("%s %s: %s", r.Method, r.URL, err)
+		writeError(w, r, err)
+	}
+}
+
+// route returns the name of the S3 operation that 'r' asks for, and a function
+// that does it.
+func (g *Gateway) route(w http.ResponseWriter, r *http.Request) (string, func() error) {
+	bucket, key := splitPath(r.URL.Path)
+	q := r.URL.Query()
+	fail := func(err error) func() error { return func() error { return err } }
+
+	switch {
+	case bucket == "" && r.Method == "GET":
+		return "ListBuckets", func() error { return g.listBuckets(w, r) }
+	case bucket == "":
+		return "Unknown", fail(errMethodNotAllowed)
+	case !validBucket.MatchString(bucket):
+		return "Unknown", fail(errInvalidBucketName)
+	case key == "" && r.Method == "GET":
+		return "ListObjects", func() error { return g.listObjects(w, r, bucket) }
+	case key == "" && (r.Method == "PUT" || r.Method == "HEAD"):
+		// Buckets always exist.
+		return "Bucket", fail(nil)
+	case key == "" && r.Method == "DELETE":
+		return "DeleteBucket", func() error { return g.deleteBucket(w, r, bucket) }
+	case key == "":
+		return "Unknown", fail(errMethodNotAllowed)
+	case !core.ValidName(bucket + core.NameSeparator + key):
+		return "Unknown", fail(errInvalidKey)
+	case r.Method == "PUT" && q.Get("uploadId") != "":
+		return "UploadPart", func() error { return g.uploadPart(w, r, bucket, key) }
+	case r.Method == "PUT":
+		return "PutObject", func() error { return g.putObject(w, r, bucket, key) }
+	case r.Method == "GET" || r.Method == "HEAD":
+		return "GetObject", func() error { return g.getObject(w, r, bucket, key) }
+	case r.Method == "DELETE" && q.Get("uploadId") != "":
+		return "AbortMultipartUpload", func() error { return g.abortUpload(w, r, bucket, key) }
+	case r.Method == "DELETE":
+		return "DeleteObject", func() error { return g.deleteObject(w, r, bucket, key) }
+	case r.Method == "POST" && q["uploads"] != nil:
+		return "CreateMultipartUpload", func() error { return g.createUpload(w, r, bucket, key) }
+	case r.Method == "POST" && q.Get("uploadId") != "":
+		return "CompleteMultipartUpload", func() error { return g.completeUpload(w, r, bucket, key) }
+	}
+	return "Unknown", fail(errMethodNotAllowed)
+}
+
+// deleteBucket deletes a bucket, which only succeeds if it's empty.
+func (g *Gateway) deleteBucket(w http.ResponseWriter, r *http.Request, bucket string) error {
+	entries, err := g.cli.ListPrefix(r.Context(), bucket+core.NameSeparator)()
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return errBucketNotEmpty
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// putObject writes the request body to an object.
+func (g *Gateway) putObject(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	md, err := requestMetadata(r)
+	if err != nil {
+		return err
+	}
+	etag, err := g.writeBlob(r.Context(), bucket+core.NameSeparator+key, r.Body, md, time.Time{}, r.Header.Get("Content-MD5"))
+	if err != nil {
+		return err
+	}
+	w.Header().Set("ETag", strconv.Quote(etag))
+	return nil
+}
+
+// getObject serves an object, or part of it if the request has a Range
+// header.
+func (g *Gateway) getObject(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	blob, err := g.cli.OpenNamed(bucket+core.NameSeparator+key, "r", client.OpenContext(r.Context()))
+	if err != nil {
+		return err
+	}
+	info, err := blob.Stat()
+	if err != nil {
+		return err
+	}
+
+	h := w.Header()
+	h.Set("ETag", strconv.Quote(info.Metadata[etagKey]))
+	h.Set("Content-Type", "binary/octet-stream")
+	for k, v := range info.Metadata {
+		if k == contentTypeKey {
+			h.Set("Content-Type", v)
+		} else if strings.HasPrefix(k, userMetaPrefix) {
+			h.Set(amzMetaPrefix+strings.TrimPrefix(k, userMetaPrefix), v)
+		}
+	}
+
+	// ServeContent handles ranges, conditional requests, and HEAD.
+	content := io.ReadSeeker(blob)
+	if r.Method == "GET" {
+		sr := client.NewStreamReader(blob)
+		defer sr.Close()
+		content = sr
+	}
+	http.ServeContent(w, r, "", info.MTime, content)
+	return nil
+}
+
+// deleteObject deletes an object. Like S3, it succeeds if the object doesn't
+// exist.
+func (g *Gateway) deleteObject(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	if err := g.cli.DeleteNamed(r.Context(), bucket+core.NameSeparator+key); err != nil && !core.ErrNoSuchName.Is(err) {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// writeBlob writes 'body' to a new blob with metadata 'md', and then binds
+// 'name' to it. If 'expires' isn't zero, the blob expires then. If
+// 'contentMD5' isn't empty, the data must match it. It returns the hex MD5 of
+// the data.
+func (g *Gateway) writeBlob(ctx context.Context, name string, body io.Reader, md map[string]string, expires time.Time, contentMD5 string) (string, error) {
+	tmp := tmpDir + newID()
+	blob, err := g.cli.CreateNamed(tmp, client.CreateContext(ctx), client.WithMetadata(md), client.WithExpires(expires))
+	if err != nil {
+		return "", err
+	}
+
+	h := md5.New()
+	sw := client.NewStreamWriter(blob)
+	n, err := io.Copy(io.MultiWriter(sw, h), body)
+	if cerr := sw.Close(); err == nil {
+		err = cerr
+	}
+	sum := h.Sum(nil)
+	if err == nil && contentMD5 != "" && contentMD5 != base64.StdEncoding.EncodeToString(sum) {
+		err = errBadDigest
+	}
+	etag := hex.EncodeToString(sum)
+	if err == nil {
+		err = g.cli.SetMetadata(ctx, blob.ID(), core.BlobInfo{Metadata: map[string]string{
+			etagKey: etag,
+			sizeKey: strconv.FormatInt(n, 10),
+		}})
+	}
+	if err == nil {
+		err = g.replace(ctx, tmp, name)
+	}
+	if err != nil {
+		if derr := g.cli.DeleteNamed(ctx, tmp); derr != nil {
+			log.Errorf("couldn't delete temporary blob %q: %s", tmp, derr)
+		}
+		return "", err
+	}
+	return etag, nil
+}
+
+// replace renames 'from' to 'to', replacing the blob 'to' was bound to.
+func (g *Gateway) replace(ctx context.Context, from, to string) error {
+	for i := 0; ; i++ {
+		err := g.cli.Replace(ctx, from, to)
+		if !core.ErrAlreadyExists.Is(err) && !core.ErrConflictingState.Is(err) || i == maxReplaceAttempts {
+			return err
+		}
+	}
+}
+
+// requestMetadata returns the blob metadata for the object metadata in the
+// headers of 'r'.
+func requestMetadata(r *http.Request) (map[string]string, error) {
+	md := make(map[string]string)
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		md[contentTypeKey] = ct
+	}
+	for k, v := range r.Header {
+		if !strings.HasPrefix(k, amzMetaPrefix) || len(v) == 0 {
+			continue
+		}
+		mk := userMetaPrefix + strings.ToLower(strings.TrimPrefix(k, amzMetaPrefix))
+		if !core.ValidMetadataKey(mk) {
+			return nil, errInvalidArgument
+		}
+		md[mk] = v[0]
+	}
+	return md, nil
+}
+
+// splitPath splits a request path into a bucket and a key.
+func splitPath(path string) (bucket, key string) {
+	path = strings.TrimPrefix(path, "/")
+	if i := strings.Index(path, "/"); i >= 0 {
+		return path[:i], path[i+1:]
+	}
+	return path, ""
+}
+
+// newID returns a random ID for temporary names and uploads.
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		log.Fatalf("couldn't generate an ID: %s", err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// s3Error is an error in the form S3 returns it.
+type s3Error struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *s3Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+var (
+	errBadDigest         = &s3Error{http.StatusBadRequest, "BadDigest", "The Content-MD5 you specified did not match what we received."}
+	errBucketNotEmpty    = &s3Error{http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty."}
+	errInternal          = &s3Error{http.StatusInternalServerError, "InternalError", "We encountered an internal error. Please try again."}
+	errInvalidArgument   = &s3Error{http.StatusBadRequest, "InvalidArgument", "Invalid argument."}
+	errInvalidBucketName = &s3Error{http.StatusBadRequest, "InvalidBucketName", "The specified bucket is not valid."}
+	errInvalidKey        = &s3Error{http.StatusBadRequest, "InvalidArgument", "The specified key can't be stored."}
+	errInvalidPart       = &s3Error{http.StatusBadRequest, "InvalidPart", "One or more of the specified parts could not be found."}
+	errInvalidPartOrder  = &s3Error{http.StatusBadRequest, "InvalidPartOrder", "The list of parts was not in ascending order."}
+	errMalformedXML      = &s3Error{http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed."}
+	errMetadataTooLarge  = &s3Error{http.StatusBadRequest, "MetadataTooLarge", "Your metadata headers exceed the maximum allowed metadata size."}
+	errMethodNotAllowed  = &s3Error{http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource."}
+	errNoSuchKey         = &s3Error{http.StatusNotFound, "NoSuchKey", "The specified key does not exist."}
+	errNoSuchUpload      = &s3Error{http.StatusNotFound, "NoSuchUpload", "The specified multipart upload does not exist."}
+	errNotImplemented    = &s3Error{http.StatusNotImplemented, "NotImplemented", "A header you provided implies functionality that is not implemented."}
+	errServiceBusy       = &s3Error{http.StatusServiceUnavailable, "SlowDown", "Please reduce your request rate."}
+)
+
+// toS3Error converts an error from the client into an s3Error.
+func toS3Error(err error) *s3Error {
+	if e, ok := err.(*s3Error); ok {
+		return e
+	}
+	berr, ok := core.BlbError(err)
+	if !ok {
+		return errInternal
+	}
+	switch berr {
+	case core.ErrNoSuchName, core.ErrNoSuchBlob:
+		return errNoSuchKey
+	case core.ErrInvalidArgument, core.ErrAlreadyExists:
+		return errInvalidArgument
+	case core.ErrMetadataTooLarge:
+		return errMetadataTooLarge
+	case core.ErrTooBusy:
+		return errServiceBusy
+	}
+	return errInternal
+}
+
+type errorResponse struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string
+	Message  string
+	Resource string
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	e := toS3Error(err)
+	if r.Method == "HEAD" {
+		w.WriteHeader(e.status)
+		return
+	}
+	writeXML(w, e.status, errorResponse{Code: e.code, Message: e.message, Resource: r.URL.Path})
+}
+
+func writeXML(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	io.WriteString(w, xml.Header)
+	if err := xml.NewEncoder(w).Encode(v); err != nil {
+		log.Errorf("couldn't write response: %s", err)
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package gateway
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	client "github.com/westerndigitalcorporation/blb/client/blb"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/server"
+)
+
+// Listing walks the namespace under the bucket in order. Since directory
+// names end with the separator, walking each directory's entries in order and
+// descending into subdirectories as we reach them returns keys in the same
+// order as S3 does. Continuing a listing walks again from the start, but skips
+// directories that are entirely before where it continues from.
+
+// The time we report as the creation time of buckets, since they don't have
+// one.
+var bucketTime = time.Unix(0, 0).UTC()
+
+type bucketEntry struct {
+	Name         string
+	CreationDate time.Time
+}
+
+type listBucketsResult struct {
+	XMLName xml.Name      `xml:"ListAllMyBucketsResult"`
+	Buckets []bucketEntry `xml:"Buckets>Bucket"`
+}
+
+// listBuckets lists the top-level directories that are valid bucket names.
+func (g *Gateway) listBuckets(w http.ResponseWriter, r *http.Request) error {
+	var res listBucketsResult
+	iter := g.cli.ListPrefix(r.Context(), "")
+	for {
+		entries, err := iter()
+		if err != nil {
+			return err
+		}
+		if entries == nil {
+			break
+		}
+		for _, e := range entries {
+			name := strings.TrimSuffix(e.Name, core.NameSeparator)
+			if e.IsDir() && validBucket.MatchString(name) {
+				res.Buckets = append(res.Buckets, bucketEntry{Name: name, CreationDate: bucketTime})
+			}
+		}
+	}
+	writeXML(w, http.StatusOK, res)
+	return nil
+}
+
+type objectEntry struct {
+	Key          string
+	LastModified time.Time
+	ETag         string
+	Size         int64
+	StorageClass string
+
+	id client.BlobID
+}
+
+type commonPrefix struct {
+	Prefix string
+}
+
+type listObjectsResult struct {
+	XMLName               xml.Name `xml:"ListBucketResult"`
+	Name                  string
+	Prefix                string
+	Delimiter             string `xml:",omitempty"`
+	Marker                string `xml:",omitempty"`
+	StartAfter            string `xml:",omitempty"`
+	ContinuationToken     string `xml:",omitempty"`
+	MaxKeys               int
+	KeyCount              int `xml:",omitempty"`
+	IsTruncated           bool
+	NextMarker            string         `xml:",omitempty"`
+	NextContinuationToken string         `xml:",omitempty"`
+	Contents              []objectEntry  `xml:",omitempty"`
+	CommonPrefixes        []commonPrefix `xml:",omitempty"`
+}
+
+// errListFull stops a walk when we have enough keys.
+var errListFull = errors.New("listing is full")
+
+// lister collects the results of one listing.
+type lister struct {
+	cli    *client.Client
+	ctx    context.Context
+	bucket string
+	prefix string
+	delim  string
+	after  string
+	max    int
+
+	res  *listObjectsResult
+	last string
+}
+
+// listObjects lists the objects in a bucket, with either ListObjects or
+// ListObjectsV2 parameters.
+func (g *Gateway) listObjects(w http.ResponseWriter, r *http.Request, bucket string) error {
+	q := r.URL.Query()
+	v2 := q.Get("list-type") == "2"
+	res := &listObjectsResult{
+		Name:      bucket,
+		Prefix:    q.Get("prefix"),
+		Delimiter: q.Get("delimiter"),
+		MaxKeys:   g.cfg.MaxKeys,
+	}
+	if res.Delimiter != "" && res.Delimiter != core.NameSeparator {
+		return errNotImplemented
+	}
+	if s := q.Get("max-keys"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return errInvalidArgument
+		}
+		if n < res.MaxKeys {
+			res.MaxKeys = n
+		}
+	}
+
+	l := &lister{
+		cli:    g.cli,
+		ctx:    r.Context(),
+		bucket: bucket,
+		prefix: res.Prefix,
+		delim:  res.Delimiter,
+		max:    res.MaxKeys,
+		res:    res,
+	}
+	if v2 {
+		res.StartAfter = q.Get("start-after")
+		res.ContinuationToken = q.Get("continuation-token")
+		l.after = res.StartAfter
+		if res.ContinuationToken != "" {
+			l.after = res.ContinuationToken
+		}
+	} else {
+		res.Marker = q.Get("marker")
+		l.after = res.Marker
+	}
+
+	if l.max > 0 {
+		if err := l.walk(bucket + core.NameSeparator); err == errListFull {
+			res.IsTruncated = true
+		} else if err != nil {
+			return err
+		}
+	}
+	if err := l.stat(); err != nil {
+		return err
+	}
+
+	if res.IsTruncated {
+		if v2 {
+			res.NextContinuationToken = l.last
+		} else if res.Delimiter != "" {
+			res.NextMarker = l.last
+		}
+	}
+	if v2 {
+		res.KeyCount = len(res.Contents) + len(res.CommonPrefixes)
+	}
+	writeXML(w, http.StatusOK, res)
+	return nil
+}
+
+// walk adds the keys under directory 'dir' to the results.
+func (l *lister) walk(dir string) error {
+	iter := l.cli.ListPrefix(l.ctx, dir)
+	for {
+		entries, err := iter()
+		if err != nil {
+			return err
+		}
+		if entries == nil {
+			return nil
+		}
+		for _, e := range entries {
+			key := strings.TrimPrefix(e.Name, l.bucket+core.NameSeparator)
+			if !e.IsDir() {
+				if strings.HasPrefix(key, l.prefix) && key > l.after {
+					if err := l.addObject(objectEntry{Key: key, id: e.Blob}); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			if l.delim != "" && strings.HasPrefix(key, l.prefix) && key != l.prefix {
+				// Everything in this directory rolls up into one prefix.
+				if key > l.after {
+					if err := l.addPrefix(key); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			inPrefix := strings.HasPrefix(key, l.prefix) || strings.HasPrefix(l.prefix, key)
+			if !inPrefix || (key < l.after && !strings.HasPrefix(l.after, key)) {
+				continue
+			}
+			if err := l.walk(e.Name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (l *lister) full() bool {
+	return len(l.res.Contents)+len(l.res.CommonPrefixes) == l.max
+}
+
+func (l *lister) addObject(o objectEntry) error {
+	if l.full() {
+		return errListFull
+	}
+	l.res.Contents = append(l.res.Contents, o)
+	l.last = o.Key
+	return nil
+}
+
+func (l *lister) addPrefix(prefix string) error {
+	if l.full() {
+		return errListFull
+	}
+	l.res.CommonPrefixes = append(l.res.CommonPrefixes, commonPrefix{Prefix: prefix})
+	l.last = prefix
+	return nil
+}
+
+// stat fills in the details of the objects we found, in parallel. Objects
+// that have been deleted since we found them are dropped.
+func (l *lister) stat() error {
+	var lock sync.Mutex
+	var firstErr error
+	found := make([]bool, len(l.res.Contents))
+	sem := server.NewSemaphore(client.ParallelRPCs)
+	var wg sync.WaitGroup
+	for i := range l.res.Contents {
+		wg.Add(1)
+		go func(o *objectEntry, found *bool) {
+			defer wg.Done()
+			sem.Acquire()
+			defer sem.Release()
+
+			blob, err := l.cli.Open(o.id, "s", client.OpenContext(l.ctx))
+			var info core.BlobInfo
+			if err == nil {
+				info, err = blob.Stat()
+			}
+			if core.ErrNoSuchBlob.Is(err) {
+				return
+			} else if err != nil {
+				lock.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				lock.Unlock()
+				return
+			}
+			o.LastModified = info.MTime.UTC()
+			o.ETag = strconv.Quote(info.Metadata[etagKey])
+			o.Size, _ = strconv.ParseInt(info.Metadata[sizeKey], 10, 64)
+			o.StorageClass = "STANDARD"
+			*found = true
+		}(&l.res.Contents[i], &found[i])
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+
+	contents := l.res.Contents[:0]
+	for i, o := range l.res.Contents {
+		if found[i] {
+			contents = append(contents, o)
+		}
+	}
+	l.res.Contents = contents
+	return nil
+}
@@ -0,0 +1,304 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package gateway
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+
+	client "github.com/westerndigitalcorporation/blb/client/blb"
+	"github.com/westerndigitalcorporation/blb/internal/core"
+)
+
+// Multipart uploads keep their state in the namespace. Each upload is a
+// directory under uploadsDir named by the upload ID. It holds an "info" blob,
+// whose metadata is the metadata for the object plus the name it will have,
+// and a blob for each part that has been uploaded. Completing the upload
+// concatenates the parts into a new blob.
+//
+// The info blob and the parts expire Config.UploadExpiry after the upload is
+// created, so the data of uploads that are never completed or aborted is
+// deleted by the curators. Their names are removed the next time the upload
+// is used.
+
+const (
+	// Blob metadata key for the name of the object an upload is for.
+	uploadNameKey = "s3.upload-name"
+
+	// S3 part numbers go from 1 to 10000.
+	maxPartNumber = 10000
+)
+
+var validUploadID = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+func uploadDir(id string) string {
+	return uploadsDir + id + core.NameSeparator
+}
+
+func uploadInfoName(id string) string {
+	return uploadDir(id) + "info"
+}
+
+func partName(id string, part int) string {
+	return fmt.Sprintf("%spart-%05d", uploadDir(id), part)
+}
+
+type createUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Bucket   string
+	Key      string
+	UploadId string
+}
+
+type completeUploadRequest struct {
+	XMLName xml.Name `xml:"CompleteMultipartUpload"`
+	Parts   []struct {
+		PartNumber int
+		ETag       string
+	} `xml:"Part"`
+}
+
+type completeUploadResult struct {
+	XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+	Location string
+	Bucket   string
+	Key      string
+	ETag     string
+}
+
+// createUpload starts a multipart upload.
+func (g *Gateway) createUpload(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	md, err := requestMetadata(r)
+	if err != nil {
+		return err
+	}
+	md[uploadNameKey] = bucket + core.NameSeparator + key
+
+	id := newID()
+	expires := time.Now().Add(g.cfg.UploadExpiry)
+	if _, err := g.cli.CreateNamed(uploadInfoName(id), client.CreateContext(r.Context()), client.WithMetadata(md), client.WithExpires(expires)); err != nil {
+		return err
+	}
+	writeXML(w, http.StatusOK, createUploadResult{Bucket: bucket, Key: key, UploadId: id})
+	return nil
+}
+
+// uploadPart writes one part of a multipart upload. Uploading a part again
+// replaces it.
+func (g *Gateway) uploadPart(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	q := r.URL.Query()
+	id := q.Get("uploadId")
+	part, err := strconv.Atoi(q.Get("partNumber"))
+	if err != nil || part < 1 || part > maxPartNumber {
+		return errInvalidArgument
+	}
+	info, err := g.uploadInfo(r.Context(), id, bucket+core.NameSeparator+key)
+	if err != nil {
+		return err
+	}
+
+	// Parts expire with the upload.
+	etag, err := g.writeBlob(r.Context(), partName(id, part), r.Body, nil, info.Expires, r.Header.Get("Content-MD5"))
+	if err != nil {
+		return err
+	}
+	w.Header().Set("ETag", strconv.Quote(etag))
+	return nil
+}
+
+// completeUpload puts the parts of a multipart upload together into an object,
+// and removes the upload.
+func (g *Gateway) completeUpload(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	ctx := r.Context()
+	id := r.URL.Query().Get("uploadId")
+	name := bucket + core.NameSeparator + key
+	info, err := g.uploadInfo(ctx, id, name)
+	if err != nil {
+		return err
+	}
+	md := info.Metadata
+
+	var req completeUploadRequest
+	if err := xml.NewDecoder(r.Body).Decode(&req); err != nil || len(req.Parts) == 0 {
+		return errMalformedXML
+	}
+
+	// Check the parts, and work out the ETag the way S3 does.
+	ids := make([]client.BlobID, len(req.Parts))
+	sizes := make([]int64, len(req.Parts))
+	h := md5.New()
+	for i, p := range req.Parts {
+		if i > 0 && p.PartNumber <= req.Parts[i-1].PartNumber {
+			return errInvalidPartOrder
+		}
+		if p.PartNumber < 1 || p.PartNumber > maxPartNumber {
+			return errInvalidPart
+		}
+		blob, err := g.cli.OpenNamed(partName(id, p.PartNumber), "s", client.OpenContext(ctx))
+		if core.ErrNoSuchName.Is(err) {
+			return errInvalidPart
+		} else if err != nil {
+			return err
+		}
+		info, err := blob.Stat()
+		if err != nil {
+			return err
+		}
+		etag := info.Metadata[etagKey]
+		if strings.Trim(p.ETag, `"`) != etag {
+			return errInvalidPart
+		}
+		sum, _ := hex.DecodeString(etag)
+		h.Write(sum)
+		ids[i] = blob.ID()
+		sizes[i], _ = strconv.ParseInt(info.Metadata[sizeKey], 10, 64)
+	}
+	etag := fmt.Sprintf("%s-%d", hex.EncodeToString(h.Sum(nil)), len(req.Parts))
+
+	delete(md, uploadNameKey)
+	tmp := tmpDir + newID()
+	blob, err := g.cli.CreateNamed(tmp, client.CreateContext(ctx), client.WithMetadata(md))
+	if err != nil {
+		return err
+	}
+	size, err := g.concat(ctx, blob, ids, sizes)
+	if err == nil {
+		err = g.cli.SetMetadata(ctx, blob.ID(), core.BlobInfo{Metadata: map[string]string{
+			etagKey: etag,
+			sizeKey: strconv.FormatInt(size, 10),
+		}})
+	}
+	if err == nil {
+		err = g.replace(ctx, tmp, name)
+	}
+	if err != nil {
+		if derr := g.cli.DeleteNamed(ctx, tmp); derr != nil {
+			log.Errorf("couldn't delete temporary blob %q: %s", tmp, derr)
+		}
+		return err
+	}
+
+	if err := g.removeUpload(ctx, id); err != nil {
+		log.Errorf("couldn't remove completed upload %s: %s", id, err)
+	}
+	writeXML(w, http.StatusOK, completeUploadResult{
+		Location: "/" + name,
+		Bucket:   bucket,
+		Key:      key,
+		ETag:     strconv.Quote(etag),
+	})
+	return nil
+}
+
+// abortUpload removes a multipart upload and its parts.
+func (g *Gateway) abortUpload(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	id := r.URL.Query().Get("uploadId")
+	if _, err := g.uploadInfo(r.Context(), id, bucket+core.NameSeparator+key); err != nil {
+		return err
+	}
+	if err := g.removeUpload(r.Context(), id); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// uploadInfo returns the info blob of upload 'id', which must be for the
+// object 'name'. If the upload has expired, it removes what's left of it.
+func (g *Gateway) uploadInfo(ctx context.Context, id, name string) (core.BlobInfo, error) {
+	if !validUploadID.MatchString(id) {
+		return core.BlobInfo{}, errNoSuchUpload
+	}
+	blob, err := g.cli.OpenNamed(uploadInfoName(id), "s", client.OpenContext(ctx))
+	if core.ErrNoSuchBlob.Is(err) {
+		if err := g.removeUpload(ctx, id); err != nil {
+			log.Errorf("couldn't remove expired upload %s: %s", id, err)
+		}
+		return core.BlobInfo{}, errNoSuchUpload
+	} else if core.ErrNoSuchName.Is(err) {
+		return core.BlobInfo{}, errNoSuchUpload
+	} else if err != nil {
+		return core.BlobInfo{}, err
+	}
+	info, err := blob.Stat()
+	if err != nil {
+		return core.BlobInfo{}, err
+	}
+	if info.Metadata[uploadNameKey] != name {
+		return core.BlobInfo{}, errNoSuchUpload
+	}
+	return info, nil
+}
+
+// concat copies the parts 'ids', of lengths 'sizes', into 'blob', and returns
+// the total length. If all the parts but the last are whole tracts, the
+// curators can copy them for us with AppendAligned. Otherwise the parts
+// wouldn't be contiguous, so we copy the data ourselves.
+func (g *Gateway) concat(ctx context.Context, blob *client.Blob, ids []client.BlobID, sizes []int64) (int64, error) {
+	var total int64
+	aligned := true
+	for i, size := range sizes {
+		total += size
+		if i < len(sizes)-1 && size%core.TractLength != 0 {
+			aligned = false
+		}
+	}
+	if aligned {
+		_, err := g.cli.AppendAligned(ctx, blob.ID(), ids...)
+		return total, err
+	}
+
+	sw := client.NewStreamWriter(blob)
+	for _, id := range ids {
+		src, err := g.cli.Open(id, "r", client.OpenContext(ctx))
+		if err != nil {
+			return 0, err
+		}
+		sr := client.NewStreamReader(src)
+		_, err = io.Copy(sw, sr)
+		sr.Close()
+		if err != nil {
+			return 0, err
+		}
+	}
+	return total, sw.Close()
+}
+
+// removeUpload deletes the parts of upload 'id', and then the upload itself.
+func (g *Gateway) removeUpload(ctx context.Context, id string) error {
+	var parts []string
+	iter := g.cli.ListPrefix(ctx, uploadDir(id))
+	for {
+		entries, err := iter()
+		if err != nil {
+			return err
+		}
+		if entries == nil {
+			break
+		}
+		for _, e := range entries {
+			if e.Name != uploadInfoName(id) {
+				parts = append(parts, e.Name)
+			}
+		}
+	}
+	// The blobs are already gone if the upload expired.
+	for _, name := range append(parts, uploadInfoName(id)) {
+		if err := g.cli.DeleteNamed(ctx, name); err != nil && !core.ErrNoSuchName.Is(err) && !core.ErrNoSuchBlob.Is(err) {
+			return err
+		}
+	}
+	return nil
+}
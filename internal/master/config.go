@@ -14,6 +14,13 @@ type Config struct {
 	ConsistencyCheckInterval time.Duration // How often to do a consistency check
 
 	RaftACSpec string // Spec for raft autoconfig.
+
+	// --- Authentication ---
+	// File with the tokens that peers may present. If empty, there's no
+	// authentication.
+	TokensFile string
+	// File with the token we present to other servers.
+	TokenFile string
 }
 
 // DefaultConfig includes default values for master server.
@@ -62,6 +62,12 @@ type SetReadOnlyModeCmd struct {
 	ReadOnly bool
 }
 
+// SetQuotaCmd sets the quota of a blob owner. A zero Limit removes the quota.
+type SetQuotaCmd struct {
+	Owner string
+	Limit core.TenantUsage
+}
+
 // cmdToBytes wraps 'cmd' in Command and serializes it into bytes. It dies if it
 // fails.
 func cmdToBytes(cmd interface{}) []byte {
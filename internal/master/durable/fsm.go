@@ -22,6 +22,7 @@ func init() {
 	gob.Register(ChecksumRequestCmd{})
 	gob.Register(ChecksumVerifyCmd{})
 	gob.Register(SetReadOnlyModeCmd{})
+	gob.Register(SetQuotaCmd{})
 }
 
 //-------------------- raft.FSM implementation --------------------//
@@ -62,6 +63,8 @@ func (h *StateHandler) Apply(ent raft.Entry) interface{} {
 		return c.apply(h.state)
 	case NewPartitionCmd:
 		return c.apply(h.state)
+	case SetQuotaCmd:
+		return c.apply(h.state)
 	}
 
 	log.Fatalf("applying unknown command %v", cmd)
@@ -175,6 +178,28 @@ func (cmd RegisterTractserverCmd) apply(s *State) core.TractserverID {
 	return tractserverID
 }
 
+// Set or remove the quota of an owner.
+func (cmd SetQuotaCmd) apply(s *State) core.Error {
+	if cmd.Limit == (core.TenantUsage{}) {
+		delete(s.Quotas, cmd.Owner)
+		return core.NoError
+	}
+	if s.Quotas == nil {
+		s.Quotas = make(map[string]core.TenantUsage)
+	}
+	s.Quotas[cmd.Owner] = cmd.Limit
+	return core.NoError
+}
+
+// getQuotas returns a copy of the quotas of all owners.
+func (s *State) getQuotas() map[string]core.TenantUsage {
+	quotas := make(map[string]core.TenantUsage, len(s.Quotas))
+	for owner, limit := range s.Quotas {
+		quotas[owner] = limit
+	}
+	return quotas
+}
+
 // getPartitions returns the partitions assigned for the given curator, or all
 // if curatorID is zero.
 func (s *State) getPartitions(query core.CuratorID) (res []core.PartitionID) {
@@ -194,3 +194,30 @@ func TestLookup(t *testing.T) {
 		t.Fatal("lookup should have failed")
 	}
 }
+
+// Test setting and removing quotas.
+func TestSetQuota(t *testing.T) {
+	s := newState()
+
+	limit := core.TenantUsage{Bytes: 1 << 30, Blobs: 100}
+	if err := (SetQuotaCmd{Owner: "a", Limit: limit}).apply(s); err != core.NoError {
+		t.Fatal(err)
+	}
+	SetQuotaCmd{Owner: "b", Limit: core.TenantUsage{Blobs: 5}}.apply(s)
+	quotas := s.getQuotas()
+	if len(quotas) != 2 || quotas["a"] != limit || quotas["b"].Blobs != 5 {
+		t.Fatalf("unexpected quotas %+v", quotas)
+	}
+
+	// The copy is separate from the state.
+	delete(quotas, "a")
+	if _, ok := s.Quotas["a"]; !ok {
+		t.Fatalf("getQuotas didn't copy")
+	}
+
+	// A zero limit removes the quota.
+	SetQuotaCmd{Owner: "a"}.apply(s)
+	if quotas := s.getQuotas(); len(quotas) != 1 || quotas["b"].Blobs != 5 {
+		t.Fatalf("unexpected quotas %+v", quotas)
+	}
+}
@@ -248,6 +248,42 @@ func (h *StateHandler) Lookup(id core.PartitionID) (core.CuratorID, core.Error)
 	return h.state.lookup(id)
 }
 
+// SetQuota sets the quota of blob owner 'owner' to 'limit', or removes it if
+// 'limit' is zero.
+func (h *StateHandler) SetQuota(owner string, limit core.TenantUsage) core.Error {
+	pending := h.raft.Propose(cmdToBytes(SetQuotaCmd{Owner: owner, Limit: limit}))
+	select {
+	case <-time.After(core.ProposalTimeout):
+		return core.ErrRaftTimeout
+	case <-pending.Done:
+	}
+	if nil != pending.Err {
+		return core.FromRaftError(pending.Err)
+	}
+	return pending.Res.(core.Error)
+}
+
+// GetQuotas returns the quotas of all blob owners that have one.
+func (h *StateHandler) GetQuotas() (map[string]core.TenantUsage, core.Error) {
+	pending := h.raft.VerifyRead()
+	select {
+	case <-time.After(core.ProposalTimeout):
+		return nil, core.ErrRaftTimeout
+
+	case <-pending.Done:
+		// fallthrough
+	}
+
+	if nil != pending.Err {
+		return nil, core.FromRaftError(pending.Err)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.state.getQuotas(), core.NoError
+}
+
 // ValidateCuratorID returns core.NoError if the given curator id is valid.
 func (h *StateHandler) ValidateCuratorID(curatorID core.CuratorID) core.Error {
 	pending := h.raft.VerifyRead()
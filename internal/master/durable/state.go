@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"hash/crc32"
+	"sort"
 
 	log "github.com/golang/glog"
 
@@ -49,6 +50,9 @@ type State struct {
 
 	// Are we currently read-only?
 	ReadOnly bool
+
+	// Quotas of blob owners. Owners without a quota aren't in the map.
+	Quotas map[string]core.TenantUsage
 }
 
 // newState creates a new State. All the IDs starts from 1.
@@ -93,6 +97,18 @@ func (s *State) checksum() (checksum uint32) {
 		checksum = crc32UpdateUint64(checksum, 1)
 	}
 
+	// Write s.Quotas in a consistent order.
+	owners := make([]string, 0, len(s.Quotas))
+	for owner := range s.Quotas {
+		owners = append(owners, owner)
+	}
+	sort.Strings(owners)
+	for _, owner := range owners {
+		checksum = crc32.Update(checksum, crc32Table, []byte(owner))
+		checksum = crc32UpdateUint64(checksum, uint64(s.Quotas[owner].Bytes))
+		checksum = crc32UpdateUint64(checksum, uint64(s.Quotas[owner].Blobs))
+	}
+
 	return
 }
 
@@ -41,4 +41,14 @@ func TestChecksum(t *testing.T) {
 	if s.checksum() != c4 {
 		t.Errorf("checksum is not deterministic")
 	}
+
+	SetQuotaCmd{Owner: "a", Limit: core.TenantUsage{Blobs: 10}}.apply(s)
+	c5 := s.checksum()
+	if c4 == c5 {
+		t.Errorf("Quotas not included in checksum")
+	}
+	SetQuotaCmd{Owner: "b", Limit: core.TenantUsage{Bytes: 1 << 40}}.apply(s)
+	if s.checksum() != s.checksum() {
+		t.Errorf("checksum is not deterministic")
+	}
 }
@@ -63,6 +63,10 @@ type CuratorInfo struct {
 	// quota drops to 0, 'newPartition' will fail. The quota will be
 	// refreshed regularly.
 	NewPartitionQuota uint32
+
+	// How much space the blobs of each owner use on this curator, as of the
+	// last heartbeat.
+	Usage map[string]core.TenantUsage
 }
 
 // TractserverInfo contains information about the last heartbeat received from a TS.
@@ -234,6 +238,89 @@ func (m *Master) curatorHeartbeat(curatorID core.CuratorID, addr string) ([]core
 	return m.stateHandler.GetPartitions(curatorID)
 }
 
+// curatorUsage records the usage of each blob owner reported by a curator in
+// its heartbeat. It returns the quotas of all owners and their usage on the
+// other curators, which the curator needs to enforce the quotas.
+//
+// Like the curator information, usage isn't persisted: a new leader doesn't
+// know about a curator's usage until it hears a heartbeat from it, and
+// curators can briefly let owners go over their quotas.
+func (m *Master) curatorUsage(curatorID core.CuratorID, usage map[string]core.TenantUsage) (quotas, other map[string]core.TenantUsage, err core.Error) {
+	// Reject if we are not the leader.
+	if !m.stateHandler.IsLeader() {
+		return nil, nil, core.FromRaftError(raft.ErrNodeNotLeader)
+	}
+
+	if quotas, err = m.stateHandler.GetQuotas(); err != core.NoError {
+		return nil, nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	other = make(map[string]core.TenantUsage)
+	for i := range m.curators {
+		if m.curators[i].ID == curatorID {
+			m.curators[i].Usage = usage
+			continue
+		}
+		for owner, u := range m.curators[i].Usage {
+			if _, ok := quotas[owner]; ok {
+				other[owner] = other[owner].Add(u)
+			}
+		}
+	}
+	return quotas, other, core.NoError
+}
+
+// getQuotas returns the quota and total usage of all blob owners that have
+// either, sorted by owner.
+func (m *Master) getQuotas() ([]core.TenantQuota, core.Error) {
+	// Reject if we are not the leader.
+	if !m.stateHandler.IsLeader() {
+		return nil, core.FromRaftError(raft.ErrNodeNotLeader)
+	}
+
+	limits, err := m.stateHandler.GetQuotas()
+	if err != core.NoError {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	used := make(map[string]core.TenantUsage)
+	for _, c := range m.curators {
+		for owner, u := range c.Usage {
+			used[owner] = used[owner].Add(u)
+		}
+	}
+	m.lock.Unlock()
+
+	var quotas []core.TenantQuota
+	for owner, limit := range limits {
+		quotas = append(quotas, core.TenantQuota{Owner: owner, Limit: limit, Used: used[owner]})
+	}
+	for owner, u := range used {
+		if _, ok := limits[owner]; !ok {
+			quotas = append(quotas, core.TenantQuota{Owner: owner, Used: u})
+		}
+	}
+	sort.Slice(quotas, func(i, j int) bool { return quotas[i].Owner < quotas[j].Owner })
+	return quotas, core.NoError
+}
+
+// setQuota sets the quota of blob owner 'owner', or removes it if 'limit' is
+// zero. Curators start enforcing it after their next heartbeat.
+func (m *Master) setQuota(owner string, limit core.TenantUsage) core.Error {
+	// Reject if we are not the leader.
+	if !m.stateHandler.IsLeader() {
+		return core.FromRaftError(raft.ErrNodeNotLeader)
+	}
+	if owner == "" || limit.Bytes < 0 || limit.Blobs < 0 {
+		return core.ErrInvalidArgument
+	}
+	return m.stateHandler.SetQuota(owner, limit)
+}
+
 // newPartition allocates a partition of the BlobID space to the curator with the provided
 // ID. This allocation is persisted.
 func (m *Master) newPartition(curatorID core.CuratorID) (core.PartitionID, core.Error) {
@@ -47,17 +47,17 @@ func (s *Server) Start() (err error) {
 	//http.HandleFunc("/loglevel", health.HandleLogsLevel)
 
 	// Endpoint for shutting down the master.
-	http.HandleFunc("/_quit", server.QuitHandler)
+	http.HandleFunc("/_quit", rpc.AuthHandler(rpc.RoleAdmin, server.QuitHandler))
 
 	// Endpoints for Raft membership reconfiguration.
 	ac := server.NewAutoConfig(s.cfg.RaftACSpec, s.master.stateHandler)
-	http.Handle("/reconfig/", http.StripPrefix("/reconfig", ac.HTTPHandlers()))
+	http.Handle("/reconfig/", rpc.AuthHandler(rpc.RoleAdmin, http.StripPrefix("/reconfig", ac.HTTPHandlers()).ServeHTTP))
 	ac.WatchDiscovery()
 
-	http.HandleFunc("/readonly", s.readOnlyHandler)
+	http.HandleFunc("/readonly", rpc.AuthHandler(rpc.RoleAdmin, s.readOnlyHandler))
 
 	// Expose administrative endpoints.
-	http.Handle("/raft/", http.StripPrefix("/raft", server.RaftAdminHandler(s.raft, s.raftStorage)))
+	http.Handle("/raft/", rpc.AuthHandler(rpc.RoleAdmin, http.StripPrefix("/raft", server.RaftAdminHandler(s.raft, s.raftStorage)).ServeHTTP))
 
 	// Set up RPC mechanisms.
 	opm := server.NewOpMetric("master_rpc", "rpc")
@@ -65,7 +65,7 @@ func (s *Server) Start() (err error) {
 		master: s.master,
 		opm:    opm,
 	}
-	if err = rpc.RegisterName("MasterCtlHandler", s.ctlHandler); nil != err {
+	if err = rpc.RegisterName("MasterCtlHandler", s.ctlHandler, rpc.RoleControl); nil != err {
 		return err
 	}
 
@@ -74,9 +74,11 @@ func (s *Server) Start() (err error) {
 		pendingSem: server.NewSemaphore(s.cfg.RejectReqThreshold),
 		opm:        opm,
 	}
-	if err = rpc.RegisterName("MasterSrvHandler", s.srvHandler); nil != err {
+	if err = rpc.RegisterName("MasterSrvHandler", s.srvHandler, rpc.RoleClient); nil != err {
 		return err
 	}
+	rpc.RequireRole(rpc.RoleAdmin, core.SetQuotaMethod)
+	rpc.RegisterWireServices(core.WireServices)
 
 	log.Infof("listening on address %s", s.cfg.Addr)
 	err = http.ListenAndServe(s.cfg.Addr, nil) // this blocks forever
@@ -121,6 +123,9 @@ func (h *MasterCtlHandler) CuratorHeartbeat(req core.CuratorHeartbeatReq, reply
 	defer op.EndWithBlbError(&reply.Err)
 
 	reply.Partitions, reply.Err = h.master.curatorHeartbeat(req.CuratorID, req.Addr)
+	if reply.Err == core.NoError {
+		reply.Quotas, reply.OtherUsage, reply.Err = h.master.curatorUsage(req.CuratorID, req.Usage)
+	}
 
 	log.Infof("CuratorHeartbeat: req %+v reply %+v", req, *reply)
 
@@ -203,6 +208,8 @@ func (h *MasterSrvHandler) rpcStats() map[string]string {
 		"Lookup",
 		"ListPartitions",
 		"GetTractserverInfo",
+		"GetQuotas",
+		"SetQuota",
 	)
 }
 
@@ -295,3 +302,35 @@ func (h *MasterSrvHandler) GetTractserverInfo(req core.GetTractserverInfoReq, re
 	reply.Info = h.master.getTractserverInfo()
 	return nil
 }
+
+// GetQuotas returns the quotas and usage of blob owners.
+func (h *MasterSrvHandler) GetQuotas(req core.GetQuotasReq, reply *core.GetQuotasReply) error {
+	op := h.opm.Start("GetQuotas")
+	defer op.EndWithBlbError(&reply.Err)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	reply.Quotas, reply.Err = h.master.getQuotas()
+	return nil
+}
+
+// SetQuota sets the quota of a blob owner.
+func (h *MasterSrvHandler) SetQuota(req core.SetQuotaReq, reply *core.SetQuotaReply) error {
+	op := h.opm.Start("SetQuota")
+	defer op.EndWithBlbError(&reply.Err)
+
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	reply.Err = h.master.setQuota(req.Owner, req.Limit)
+	log.Infof("SetQuota: req %+v reply %+v", req, *reply)
+
+	return nil
+}
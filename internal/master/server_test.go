@@ -0,0 +1,75 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package master
+
+import (
+	"testing"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/server"
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
+	"github.com/westerndigitalcorporation/blb/pkg/rpc/rpctest"
+)
+
+// TestServerCodecs calls the master's RPC handlers with both gob and protocol
+// buffers, and checks that they get the same results.
+func TestServerCodecs(t *testing.T) {
+	m := newTestMaster()
+	cid, err := m.registerCurator(testAddr)
+	if err != core.NoError {
+		t.Fatal(err)
+	}
+	pid, err := m.newPartition(cid)
+	if err != core.NoError {
+		t.Fatal(err)
+	}
+	tsid, err := m.registerTractserver("tsaddr")
+	if err != core.NoError {
+		t.Fatal(err)
+	}
+	if _, err := m.tractserverHeartbeat(tsid, "tsaddr", []core.FsStatus{{}}); err != core.NoError {
+		t.Fatal(err)
+	}
+	if err := m.setQuota("owner", core.TenantUsage{Blobs: 10}); err != core.NoError {
+		t.Fatal(err)
+	}
+
+	h := &MasterSrvHandler{
+		master:     m,
+		pendingSem: server.NewSemaphore(100),
+		opm:        server.NewOpMetric("test_master_rpc", "rpc"),
+	}
+	if err := rpc.RegisterName("MasterSrvHandler", h, rpc.RoleClient); err != nil {
+		t.Fatal(err)
+	}
+	rpc.RegisterWireServices(core.WireServices)
+	ct := rpctest.NewCodecTester(t)
+	defer ct.Close()
+
+	var lookup core.LookupCuratorReply
+	ct.Call(core.LookupCuratorMethod, core.LookupCuratorReq{Blob: core.BlobIDFromParts(pid, 1)}, &lookup)
+	if lookup.Err != core.NoError || len(lookup.Replicas) != 1 || lookup.Replicas[0] != testAddr {
+		t.Errorf("bad LookupCurator reply %+v", lookup)
+	}
+	ct.Call(core.LookupPartitionMethod, core.LookupPartitionReq{Partition: pid}, new(core.LookupPartitionReply))
+	ct.Call(core.LookupPartitionMethod, core.LookupPartitionReq{Partition: pid + 100}, new(core.LookupPartitionReply))
+
+	var parts core.ListPartitionsReply
+	ct.Call(core.ListPartitionsMethod, core.ListPartitionsReq{}, &parts)
+	if parts.Err != core.NoError || len(parts.Partitions) != 1 {
+		t.Errorf("bad ListPartitions reply %+v", parts)
+	}
+
+	var info core.GetTractserverInfoReply
+	ct.Call(core.GetTractserverInfoMethod, core.GetTractserverInfoReq{}, &info)
+	if info.Err != core.NoError || len(info.Info) != 1 || info.Info[0].ID != tsid {
+		t.Errorf("bad GetTractserverInfo reply %+v", info)
+	}
+
+	var quotas core.GetQuotasReply
+	ct.Call(core.GetQuotasMethod, core.GetQuotasReq{}, &quotas)
+	if quotas.Err != core.NoError || len(quotas.Quotas) != 1 {
+		t.Errorf("bad GetQuotas reply %+v", quotas)
+	}
+}
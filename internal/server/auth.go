@@ -0,0 +1,36 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package server
+
+import (
+	log "github.com/golang/glog"
+
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
+)
+
+// SetupAuth sets up authentication for a server. 'tokensFile' lists the tokens
+// that peers may present (see rpc.LoadTokens); if it's empty, anyone can call
+// anything. 'tokenFile' holds the token that this server presents to others,
+// which needs the control role.
+//
+// Tokens are sent in the clear, so they're only safe on a network that peers
+// can't snoop on, or over TLS tunnels between the processes.
+func SetupAuth(tokensFile, tokenFile string) error {
+	if tokenFile != "" {
+		token, err := rpc.ReadToken(tokenFile)
+		if err != nil {
+			return err
+		}
+		rpc.SetToken(token)
+	}
+	if tokensFile != "" {
+		tokens, err := rpc.LoadTokens(tokensFile)
+		if err != nil {
+			return err
+		}
+		rpc.SetTokens(tokens)
+		log.Infof("authentication enabled with %d tokens", len(tokens))
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package testblb
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/gateway"
+)
+
+// gatewayClient makes S3 requests to a gateway.
+type gatewayClient struct {
+	url string
+}
+
+// do makes a request and returns the response status and body. It fails
+// unless the status is 'expect'.
+func (g gatewayClient) do(expect int, method, path string, body []byte, hdr map[string]string) (http.Header, []byte, error) {
+	req, err := http.NewRequest(method, g.url+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range hdr {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode != expect {
+		return nil, nil, fmt.Errorf("%s %s: expected status %d, got %d: %s", method, path, expect, resp.StatusCode, data)
+	}
+	return resp.Header, data, nil
+}
+
+// multipart does a multipart upload of 'parts' to 'path'.
+func (g gatewayClient) multipart(path string, parts ...[]byte) error {
+	_, body, err := g.do(http.StatusOK, "POST", path+"?uploads", nil, nil)
+	if err != nil {
+		return err
+	}
+	var created struct{ UploadId string }
+	if err := xml.Unmarshal(body, &created); err != nil {
+		return err
+	}
+
+	var complete bytes.Buffer
+	complete.WriteString("<CompleteMultipartUpload>")
+	for i, part := range parts {
+		q := fmt.Sprintf("?partNumber=%d&uploadId=%s", i+1, created.UploadId)
+		hdr, _, err := g.do(http.StatusOK, "PUT", path+q, part, nil)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&complete, "<Part><PartNumber>%d</PartNumber><ETag>%s</ETag></Part>", i+1, hdr.Get("ETag"))
+	}
+	complete.WriteString("</CompleteMultipartUpload>")
+	_, _, err = g.do(http.StatusOK, "POST", path+"?uploadId="+created.UploadId, complete.Bytes(), nil)
+	return err
+}
+
+type gatewayListing struct {
+	IsTruncated           bool
+	NextContinuationToken string
+	Contents              []struct {
+		Key  string
+		Size int64
+	}
+	CommonPrefixes []struct {
+		Prefix string
+	}
+}
+
+// list lists a bucket and returns the keys and common prefixes.
+func (g gatewayClient) list(bucket, query string) (keys []string, prefixes []string, l gatewayListing, err error) {
+	_, body, err := g.do(http.StatusOK, "GET", "/"+bucket+"?list-type=2&"+query, nil, nil)
+	if err != nil {
+		return nil, nil, l, err
+	}
+	if err := xml.Unmarshal(body, &l); err != nil {
+		return nil, nil, l, err
+	}
+	for _, c := range l.Contents {
+		keys = append(keys, c.Key)
+	}
+	for _, p := range l.CommonPrefixes {
+		prefixes = append(prefixes, p.Prefix)
+	}
+	return keys, prefixes, l, nil
+}
+
+// checkExpires checks whether the blob bound to 'name' has an expiry time.
+func checkExpires(tc *TestCase, name string, expires bool) error {
+	blob, err := tc.c.OpenNamed(name, "s")
+	if err != nil {
+		return err
+	}
+	info, err := blob.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Expires.IsZero() == expires {
+		return fmt.Errorf("%s: expected expiring %t, got expiry time %s", name, expires, info.Expires)
+	}
+	return nil
+}
+
+// TestGateway exercises the S3 gateway.
+func (tc *TestCase) TestGateway() error {
+	server := httptest.NewServer(gateway.NewGateway(gateway.DefaultConfig, tc.c))
+	defer server.Close()
+	g := gatewayClient{url: server.URL}
+
+	// Write an object and read it back.
+	data := []byte("hello, world")
+	hdr, _, err := g.do(http.StatusOK, "PUT", "/bkt/dir/a.txt", data, map[string]string{
+		"Content-Type":     "text/plain",
+		"X-Amz-Meta-Owner": "bob",
+	})
+	if err != nil {
+		return err
+	}
+	sum := md5.Sum(data)
+	if etag := strconv.Quote(hex.EncodeToString(sum[:])); hdr.Get("ETag") != etag {
+		return fmt.Errorf("expected ETag %s, got %s", etag, hdr.Get("ETag"))
+	}
+	hdr, body, err := g.do(http.StatusOK, "GET", "/bkt/dir/a.txt", nil, nil)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(body, data) || hdr.Get("Content-Type") != "text/plain" || hdr.Get("X-Amz-Meta-Owner") != "bob" {
+		return fmt.Errorf("wrong object: %q, %v", body, hdr)
+	}
+	if _, body, err = g.do(http.StatusPartialContent, "GET", "/bkt/dir/a.txt", nil, map[string]string{"Range": "bytes=7-"}); err != nil {
+		return err
+	}
+	if string(body) != "world" {
+		return fmt.Errorf("wrong range: %q", body)
+	}
+	if hdr, _, err = g.do(http.StatusOK, "HEAD", "/bkt/dir/a.txt", nil, nil); err != nil {
+		return err
+	}
+	if hdr.Get("Content-Length") != strconv.Itoa(len(data)) {
+		return fmt.Errorf("wrong length from HEAD: %s", hdr.Get("Content-Length"))
+	}
+
+	// Replace it.
+	data = makeRandom(3 * mb)
+	if _, _, err = g.do(http.StatusOK, "PUT", "/bkt/dir/a.txt", data, nil); err != nil {
+		return err
+	}
+	if _, body, err = g.do(http.StatusOK, "GET", "/bkt/dir/a.txt", nil, nil); err != nil {
+		return err
+	}
+	if !bytes.Equal(body, data) {
+		return fmt.Errorf("wrong data after replacing object")
+	}
+
+	// List.
+	for _, key := range []string{"dir/b.txt", "top.txt"} {
+		if _, _, err = g.do(http.StatusOK, "PUT", "/bkt/"+key, []byte(key), nil); err != nil {
+			return err
+		}
+	}
+	keys, prefixes, _, err := g.list("bkt", "delimiter=/")
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(keys, []string{"top.txt"}) || !reflect.DeepEqual(prefixes, []string{"dir/"}) {
+		return fmt.Errorf("wrong listing: %v, %v", keys, prefixes)
+	}
+	keys, _, l, err := g.list("bkt", "prefix=dir/&max-keys=1")
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(keys, []string{"dir/a.txt"}) || !l.IsTruncated || l.Contents[0].Size != int64(len(data)) {
+		return fmt.Errorf("wrong first page: %+v", l)
+	}
+	if keys, _, l, err = g.list("bkt", "prefix=dir/&continuation-token="+l.NextContinuationToken); err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(keys, []string{"dir/b.txt"}) || l.IsTruncated {
+		return fmt.Errorf("wrong second page: %+v", l)
+	}
+
+	// Multipart uploads, with parts that the curators can concatenate, and
+	// with parts that they can't.
+	for _, parts := range [][][]byte{
+		{makeRandom(core.TractLength), makeRandom(mb)},
+		{makeRandom(mb + 1), makeRandom(mb), makeRandom(10)},
+	} {
+		if err := g.multipart("/bkt/multi", parts...); err != nil {
+			return err
+		}
+		if _, body, err = g.do(http.StatusOK, "GET", "/bkt/multi", nil, nil); err != nil {
+			return err
+		}
+		if !bytes.Equal(body, bytes.Join(parts, nil)) {
+			return fmt.Errorf("wrong data from multipart upload of %d parts", len(parts))
+		}
+	}
+	if err := checkExpires(tc, "bkt/multi", false); err != nil {
+		return err
+	}
+
+	// The parts of an upload expire, so abandoned uploads don't keep data.
+	_, body, err = g.do(http.StatusOK, "POST", "/bkt/abandoned?uploads", nil, nil)
+	if err != nil {
+		return err
+	}
+	var created struct{ UploadId string }
+	if err := xml.Unmarshal(body, &created); err != nil {
+		return err
+	}
+	if _, _, err = g.do(http.StatusOK, "PUT", "/bkt/abandoned?partNumber=1&uploadId="+created.UploadId, data, nil); err != nil {
+		return err
+	}
+	for _, name := range []string{"info", "part-00001"} {
+		if err := checkExpires(tc, ".gateway/uploads/"+created.UploadId+"/"+name, true); err != nil {
+			return err
+		}
+	}
+
+	// Delete.
+	if _, _, err = g.do(http.StatusConflict, "DELETE", "/bkt", nil, nil); err != nil {
+		return err
+	}
+	if _, _, err = g.do(http.StatusNoContent, "DELETE", "/bkt/dir/a.txt", nil, nil); err != nil {
+		return err
+	}
+	if _, _, err = g.do(http.StatusNotFound, "GET", "/bkt/dir/a.txt", nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
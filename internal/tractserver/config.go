@@ -55,6 +55,13 @@ type Config struct {
 	// --- Reed-Solomon ---
 	EncodeIncrementSize int
 
+	// --- Authentication ---
+	// File with the tokens that peers may present. If empty, there's no
+	// authentication.
+	TokensFile string
+	// File with the token we present to other servers.
+	TokenFile string
+
 	// If OverrideID.IsValid, will skip contacting the master for an ID and just use this.
 	// The default value is not valid.
 	OverrideID int
@@ -44,6 +44,10 @@ type Disk interface {
 	// Size returns the size of the provided tract.
 	Size(f interface{}) (int64, core.Error)
 
+	// Truncate changes the size of the provided tract to 'size', padding it
+	// with zeros if it grows.
+	Truncate(ctx context.Context, f interface{}, size int64) core.Error
+
 	// Delete removes the provided tract.
 	Delete(id core.TractID) core.Error
 
@@ -244,6 +248,29 @@ func (m *MemDisk) Size(f interface{}) (int64, core.Error) {
 	return int64(len(m.files[fd])), core.NoError
 }
 
+// Truncate changes the size of a tract in the MemDisk.
+func (m *MemDisk) Truncate(_ context.Context, f interface{}, size int64) core.Error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.fds == nil {
+		return core.ErrDiskRemoved
+	}
+
+	fd := f.(uint32)
+	if _, ok := m.open[fd]; !ok {
+		return core.ErrInvalidArgument
+	}
+	if size < 0 {
+		return core.ErrInvalidArgument
+	}
+
+	data := make([]byte, size)
+	copy(data, m.files[fd])
+	m.files[fd] = data
+	return core.NoError
+}
+
 // Delete removes a tract from the MemDisk.
 func (m *MemDisk) Delete(id core.TractID) core.Error {
 	m.lock.Lock()
@@ -417,6 +444,11 @@ func (b *simpleDisk) Size(interface{}) (int64, core.Error) {
 	return 0, b.err
 }
 
+// Truncate returns an error.
+func (b *simpleDisk) Truncate(context.Context, interface{}, int64) core.Error {
+	return b.err
+}
+
 // Delete returns an error.
 func (b *simpleDisk) Delete(core.TractID) core.Error {
 	return b.err
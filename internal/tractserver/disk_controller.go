@@ -10,6 +10,8 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
 )
 
 type diskController struct {
@@ -35,7 +37,7 @@ func NewDiskController(s *Store) *diskController {
 	}
 
 	m := http.NewServeMux()
-	m.HandleFunc("/disk", c.disk)
+	m.HandleFunc("/disk", rpc.AuthHandler(rpc.RoleAdmin, c.disk))
 	go http.Serve(l, m)
 
 	return c
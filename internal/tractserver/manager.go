@@ -253,6 +253,12 @@ func (m *Manager) Size(f interface{}) (int64, core.Error) {
 	return 0, err
 }
 
+// Truncate changes the size of the data stored in the open tract 'f' to 'size'.
+func (m *Manager) Truncate(ctx context.Context, f interface{}, size int64) core.Error {
+	_, err := m.schedule(ctx, truncateRequest{f: f.(*disk.ChecksumFile), size: size})
+	return err
+}
+
 // Getxattr returns the value of the xattr named 'name' in the open tract 'f'.
 func (m *Manager) Getxattr(f interface{}, name string) ([]byte, core.Error) {
 	op, err := m.schedule(context.TODO(), getxattrRequest{f: f.(*disk.ChecksumFile), name: name})
@@ -552,6 +558,9 @@ func (m *Manager) execute(generic request) reply {
 		defer opm.Start(m.name, "write").End()
 		n, e := req.f.WriteAt(req.b, req.off)
 		return reply{e, writeReply{n}}
+	case truncateRequest:
+		defer opm.Start(m.name, "truncate").End()
+		return reply{req.f.Truncate(req.size), nil}
 	case scrubRequest:
 		defer opm.Start(m.name, "scrub").End()
 		return m.executeScrub(req)
@@ -87,6 +87,11 @@ type writeRequest struct {
 	off int64
 }
 
+type truncateRequest struct {
+	f    *disk.ChecksumFile
+	size int64
+}
+
 type statRequest struct {
 	f *disk.ChecksumFile
 }
@@ -142,6 +147,8 @@ func (r request) String() string {
 		buf.WriteString(fmt.Sprintf("read %p len=%d off=%d", specific.f, len(specific.b), specific.off))
 	case writeRequest:
 		buf.WriteString(fmt.Sprintf("write %p len=%d off=%d", specific.f, len(specific.b), specific.off))
+	case truncateRequest:
+		buf.WriteString(fmt.Sprintf("truncate %p size=%d", specific.f, specific.size))
 	case getxattrRequest:
 		buf.WriteString(fmt.Sprintf("getxattr %p name=%s", specific.f, specific.name))
 	case setxattrRequest:
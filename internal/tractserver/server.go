@@ -79,7 +79,7 @@ func (s *Server) Start() (err error) {
 	//http.HandleFunc("/loglevel", health.HandleLogsLevel)
 
 	// Endpoint for shutting down the tractserver.
-	http.HandleFunc("/_quit", server.QuitHandler)
+	http.HandleFunc("/_quit", rpc.AuthHandler(rpc.RoleAdmin, server.QuitHandler))
 
 	// Create control/service handlers.
 	opm := server.NewOpMetric("tractserver_rpc", "rpc")
@@ -87,12 +87,16 @@ func (s *Server) Start() (err error) {
 	s.srvHandler = newTSSrvHandler(s, opm)
 
 	// Register the rpc handlers.
-	if err = rpc.RegisterName("TSCtlHandler", s.ctlHandler); nil != err {
+	if err = rpc.RegisterName("TSCtlHandler", s.ctlHandler, rpc.RoleControl); nil != err {
 		return err
 	}
-	if err = rpc.RegisterName("TSSrvHandler", s.srvHandler); err != nil {
+	// Blob ACLs are checked by curators, not here: any client may read or
+	// write a tract it knows the ID of.
+	if err = rpc.RegisterName("TSSrvHandler", s.srvHandler, rpc.RoleClient); err != nil {
 		return err
 	}
+	rpc.RequireRole(rpc.RoleAdmin, core.SetControlFlagsMethod)
+	rpc.RegisterWireServices(core.WireServices)
 
 	go s.masterHeartbeatLoop()
 	go s.curatorHeartbeatLoop()
@@ -423,7 +427,13 @@ func (h *TSCtlHandler) PullTract(req core.PullTractReq, reply *core.Error) error
 	}
 
 	ctx := controlContext()
-	*reply = h.store.PullTract(ctx, req.From, req.ID, req.Version)
+	if req.SrcID.IsRS() {
+		*reply = h.store.CopyTractRange(ctx, req.From, req.SrcID, req.SrcVersion, req.SrcOffset, req.SrcLength, req.ID, req.Version)
+	} else if req.SrcID != (core.TractID{}) {
+		*reply = h.store.CopyTract(ctx, req.From, req.SrcID, req.SrcVersion, req.ID, req.Version)
+	} else {
+		*reply = h.store.PullTract(ctx, req.From, req.ID, req.Version)
+	}
 	log.Infof("PullTract: req %+v reply %+v", req, *reply)
 
 	return nil
@@ -575,7 +585,7 @@ func (h *TSCtlHandler) RSEncode(req core.RSEncodeReq, reply *core.Error) error {
 	}
 
 	ctx := controlContext()
-	*reply = h.store.RSEncode(ctx, req.ChunkID, req.Length, req.Srcs, req.Dests, req.IndexMap)
+	*reply = h.store.RSEncode(ctx, req.ChunkID, req.Length, req.Srcs, req.Dests, req.IndexMap, req.LRC)
 	log.Infof("RSEncode: req %+v reply %+v", req, *reply)
 
 	return nil
@@ -640,7 +650,7 @@ func (h *TSCtlHandler) CtlWrite(req core.WriteReq, reply *core.Error) error {
 	// (Without adding a whole new CtlCreate path?)
 	ctx := controlContext()
 	if req.Off == 0 {
-		*reply = h.store.Create(ctx, req.ID, req.B, req.Off)
+		*reply = h.store.Create(ctx, req.ID, 0, req.B, req.Off)
 	} else {
 		*reply = h.store.Write(ctx, req.ID, req.Version, req.B, req.Off)
 	}
@@ -775,7 +785,7 @@ func (h *TSSrvHandler) CreateTract(req core.CreateTractReq, reply *core.Error) e
 	}
 
 	ctx := contextWithPriority(context.Background(), mapPriority(req.Pri))
-	*reply = h.store.Create(ctx, req.ID, req.B, req.Off)
+	*reply = h.store.Create(ctx, req.ID, req.Version, req.B, req.Off)
 
 	lenB := len(req.B)
 	rpc.PutBuffer(req.Get())
@@ -861,6 +871,93 @@ func (h *TSSrvHandler) Read(req core.ReadReq, reply *core.ReadReply) error {
 	return nil
 }
 
+// ReadV does several reads, possibly from different tracts, in one request.
+func (h *TSSrvHandler) ReadV(req core.ReadVReq, reply *core.ReadVReply) error {
+	op := h.opm.Start("ReadV")
+	defer op.EndWithBlbError(&reply.Err)
+
+	// Check failure service.
+	if err := h.getFailure("ReadV"); err != core.NoError {
+		log.Errorf("ReadV: failure service override, returning %s", err)
+		*reply = core.ReadVReply{Err: err}
+		return nil
+	}
+
+	total := 0
+	for _, r := range req.Reads {
+		if r.Len < 0 {
+			reply.Err = core.ErrInvalidArgument
+			return nil
+		}
+		total += r.Len
+	}
+	if total > core.MaxReadVLength {
+		log.Errorf("ReadV: %d bytes in %d reads is too much", total, len(req.Reads))
+		reply.Err = core.ErrTooBig
+		return nil
+	}
+
+	// Check pending request limit.
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		log.Errorf("ReadV: too busy, rejecting req")
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	// Make sure the client can cancel this op by adding it to the in-flight table.
+	ctx := h.inFlight.start(req.ReqID)
+	if ctx == nil {
+		log.Errorf("ReadV: new request w/existing ReqID, rejecting.  req: %+v", req)
+		return errBusy
+	}
+	defer h.inFlight.end(req.ReqID)
+
+	// Do the reads one at a time and gather the results in one buffer.
+	ctx = contextWithPriority(ctx, mapPriority(req.Pri))
+	buf := rpc.GetBuffer(total)[:0]
+	reply.N = make([]int, len(req.Reads))
+	reply.Errs = make([]core.Error, len(req.Reads))
+	for i, r := range req.Reads {
+		var b []byte
+		b, reply.Errs[i] = h.store.Read(ctx, r.ID, r.Version, r.Len, r.Off)
+		reply.N[i] = len(b)
+		buf = append(buf, b...)
+		rpc.PutBuffer(b, true)
+	}
+	reply.Set(buf, true)
+
+	log.Infof("ReadV: %d reads, reply len %d Errs %v", len(req.Reads), len(buf), reply.Errs)
+	return nil
+}
+
+// Truncate changes the length of a tract.
+func (h *TSSrvHandler) Truncate(req core.TruncateReq, reply *core.Error) error {
+	op := h.opm.Start("Truncate")
+	defer op.EndWithBlbError(reply)
+
+	// Check failure service.
+	if err := h.getFailure("Truncate"); err != core.NoError {
+		log.Errorf("Truncate: failure service override, returning %s", err)
+		*reply = err
+		return nil
+	}
+
+	// Check pending request limit.
+	if !h.pendingSem.TryAcquire() {
+		op.TooBusy()
+		log.Errorf("Truncate: too busy, rejecting req")
+		return errBusy
+	}
+	defer h.pendingSem.Release()
+
+	ctx := contextWithPriority(context.Background(), mapPriority(req.Pri))
+	*reply = h.store.Truncate(ctx, req.ID, req.Version, req.Size)
+
+	log.Infof("Truncate: req %+v reply %+v", req, *reply)
+	return nil
+}
+
 // StatTract returns the size of a tract.
 func (h *TSSrvHandler) StatTract(req core.StatTractReq, reply *core.StatTractReply) error {
 	op := h.opm.Start("StatTract")
@@ -959,7 +1056,9 @@ func (h *TSSrvHandler) rpcStats() map[string]string {
 	return h.opm.Strings(
 		"CreateTract",
 		"Read",
+		"ReadV",
 		"Write",
+		"Truncate",
 		"StatTract",
 		"GetDiskInfo",
 		"SetControlFlags",
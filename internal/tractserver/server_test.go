@@ -4,7 +4,13 @@
 package tractserver
 
 import (
+	"bytes"
 	"testing"
+
+	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/internal/server"
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
+	"github.com/westerndigitalcorporation/blb/pkg/rpc/rpctest"
 )
 
 // Test basic operation registration and cancellation.
@@ -50,3 +56,57 @@ func TestOpTrack(t *testing.T) {
 	}
 	ot.end("foo")
 }
+
+// TestServerCodecs calls the tractserver's RPC handlers with both gob and
+// protocol buffers, and checks that they get the same results.
+func TestServerCodecs(t *testing.T) {
+	store := getTestStoreDefault(t)
+	if _, err := store.SetID(1); err != core.NoError {
+		t.Fatal(err)
+	}
+	cfg := DefaultTestConfig
+	cfg.UseFailure = false
+	s := NewServer(store, nil, nil, &cfg)
+	h := newTSSrvHandler(s, server.NewOpMetric("test_tractserver_rpc", "rpc"))
+	if err := rpc.RegisterName("TSSrvHandler", h, rpc.RoleClient); err != nil {
+		t.Fatal(err)
+	}
+	rpc.RegisterWireServices(core.WireServices)
+	ct := rpctest.NewCodecTester(t)
+	defer ct.Close()
+
+	// Creating isn't idempotent, so only check that a bad request fails the
+	// same way.
+	id := core.TractID{Blob: 1, Index: 0}
+	data := []byte("some data")
+	if err := store.Create(BG, id, 1, data, 0); err != core.NoError {
+		t.Fatal(err)
+	}
+	ct.Call(core.CreateTractMethod, core.CreateTractReq{TSID: 2, ID: id}, new(core.Error))
+
+	var read core.ReadReply
+	ct.Call(core.ReadMethod, core.ReadReq{ID: id, Version: 1, Len: len(data)}, &read)
+	if read.Err != core.NoError || !bytes.Equal(read.B, data) {
+		t.Errorf("bad Read reply %+v", read)
+	}
+	ct.Call(core.ReadMethod, core.ReadReq{ID: id, Version: 2, Len: len(data)}, new(core.ReadReply))
+
+	var readv core.ReadVReply
+	ranges := []core.ReadVRange{{ID: id, Version: 1, Len: 4}, {ID: id, Version: 1, Len: 4, Off: 5}}
+	ct.Call(core.ReadVMethod, core.ReadVReq{Reads: ranges}, &readv)
+	if readv.Err != core.NoError || string(readv.B) != "somedata" {
+		t.Errorf("bad ReadV reply %+v", readv)
+	}
+
+	var stat core.StatTractReply
+	ct.Call(core.StatTractMethod, core.StatTractReq{ID: id, Version: 1}, &stat)
+	if stat.Err != core.NoError || stat.Size != int64(len(data)) {
+		t.Errorf("bad StatTract reply %+v", stat)
+	}
+
+	var disks core.GetDiskInfoReply
+	ct.Call(core.GetDiskInfoMethod, core.GetDiskInfoReq{}, &disks)
+	if disks.Err != core.NoError || len(disks.Disks) != 1 {
+		t.Errorf("bad GetDiskInfo reply %+v", disks)
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	log "github.com/golang/glog"
 	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/pkg/lrc"
 	"github.com/westerndigitalcorporation/blb/pkg/rpc"
 )
 
@@ -496,25 +497,41 @@ func (s *Store) SetControlFlags(root string, flags core.DiskControlFlags) (err c
 }
 
 // Create creates a tract. This involves creating an empty file on disk, then
-// adding a version for it.
-func (s *Store) Create(ctx context.Context, id core.TractID, b []byte, off int64) core.Error {
+// adding a version for it. If 'version' is zero, the tract starts at the usual
+// initial version.
+func (s *Store) Create(ctx context.Context, id core.TractID, version int, b []byte, off int64) core.Error {
 	// Mark the tract as busy.
 	if !s.tryLockTract(id, WRITE) {
 		return core.ErrTooBusy
 	}
 	defer s.unlock(id, WRITE)
-	initialVersion := 1
-	if id.Blob.Partition().Type() == core.RSPartition {
-		initialVersion = core.RSChunkVersion
+	if version == 0 {
+		version = 1
+		if id.Blob.Partition().Type() == core.RSPartition {
+			version = core.RSChunkVersion
+		}
 	}
-	err := s.doCreate(ctx, id, initialVersion, b, off)
+	err := s.doCreate(ctx, id, version, b, off)
 	if err == core.ErrAlreadyExists {
+		t := s.openExistingTract(ctx, id, os.O_RDONLY)
+		v := t.getVersion()
+		s.closeErrTract(t)
+		if t.err == core.NoError && v < version {
+			// This is a copy left over from before the blob was truncated,
+			// and the curator gave the new tract a higher version so that we
+			// could tell. Replace it rather than writing over stale bytes.
+			log.Infof("replacing tract %s, old: %d < %d", id, v, version)
+			if err = s.removeTract(id); err == core.NoError {
+				err = s.doCreate(ctx, id, version, b, off)
+			}
+			return err
+		}
 		// This can happen if an AckExtendBlob to a curator fails and the client
 		// retries. We can convert it to a write with the initial version. If it
 		// was the original client retrying, it'll get what it wants. If it was
 		// a different client, we'll clobber their data, but we don't guarantee
 		// anything for concurrent writes anyway.
-		err = s.doWrite(ctx, id, initialVersion, b, off)
+		err = s.doWrite(ctx, id, version, b, off)
 	}
 	return err
 }
@@ -649,6 +666,24 @@ func (s *Store) doWrite(ctx context.Context, id core.TractID, version int, b []b
 	return t.err
 }
 
+// Truncate is called from the RPC layer to change the length of a tract.
+func (s *Store) Truncate(ctx context.Context, id core.TractID, version int, size int64) core.Error {
+	if size < 0 || size > core.TractLength {
+		return core.ErrInvalidArgument
+	}
+	if !s.tryLockTract(id, WRITE) {
+		return core.ErrTooBusy
+	}
+	defer s.unlock(id, WRITE)
+
+	t := s.openExistingTractAndBumpStamp(ctx, id, os.O_RDWR)
+	t.checkVersion(version)
+	t.truncate(size)
+	s.closeErrTract(t)
+
+	return t.err
+}
+
 // Read is called from the RPC layer to perform a read.
 func (s *Store) Read(ctx context.Context, id core.TractID, version int, length int, off int64) ([]byte, core.Error) {
 	if !s.tryLockTract(id, READ) {
@@ -713,14 +748,34 @@ func (s *Store) SetVersion(id core.TractID, newVersion int, conditionalStamp uin
 // PullTract attempts to read the tract (id, version) from the given 'sources'.
 // It tries each source sequentially and stops if it succeeds early. It returns
 // the latest error if pulling from all source hosts fails.
-func (s *Store) PullTract(ctx context.Context, sources []string, id core.TractID, version int) (err core.Error) {
+func (s *Store) PullTract(ctx context.Context, sources []string, id core.TractID, version int) core.Error {
+	return s.CopyTract(ctx, sources, id, version, id, version)
+}
+
+// CopyTract is like PullTract, but reads the tract (src, srcVersion) and
+// stores the data as the tract (id, version).
+func (s *Store) CopyTract(ctx context.Context, sources []string, src core.TractID, srcVersion int, id core.TractID, version int) core.Error {
+	return s.copyTract(ctx, sources, src, srcVersion, 0, core.TractLength, id, version)
+}
+
+// CopyTractRange is like CopyTract, but only copies 'length' bytes starting at
+// 'offset' in the source. The curator uses this to copy tracts out of RS chunk
+// pieces.
+func (s *Store) CopyTractRange(ctx context.Context, sources []string, src core.TractID, srcVersion int, offset, length int, id core.TractID, version int) core.Error {
+	if offset < 0 || length < 0 || length > core.TractLength {
+		return core.ErrInvalidArgument
+	}
+	return s.copyTract(ctx, sources, src, srcVersion, offset, length, id, version)
+}
+
+func (s *Store) copyTract(ctx context.Context, sources []string, src core.TractID, srcVersion int, offset, length int, id core.TractID, version int) (err core.Error) {
 	if !s.tryLockTract(id, LONG_WRITE) {
 		return core.ErrTooBusy
 	}
 	defer s.unlock(id, LONG_WRITE)
 
 	for _, from := range sources {
-		if err = s.pullTractOnce(ctx, from, id, version); core.NoError == err {
+		if err = s.pullTractOnce(ctx, from, src, srcVersion, offset, length, id, version); core.NoError == err {
 			return
 		}
 		log.Errorf("failed to pull tract from %s: %s", from, err)
@@ -729,8 +784,8 @@ func (s *Store) PullTract(ctx context.Context, sources []string, id core.TractID
 	return
 }
 
-// pullTractOnce reads the tract (id, version) from the tractserver serving on 'from' and copies
-// it to this store, making it available for serving. Returns core.NoError on success, another error otherwise.
+// pullTractOnce reads 'length' bytes at 'offset' in the tract (src, srcVersion) from the tractserver serving on 'from' and copies
+// them to this store as (id, version), making it available for serving. Returns core.NoError on success, another error otherwise.
 //
 // The flow is described as follows:
 // (1) Check if the file already exists:
@@ -747,7 +802,7 @@ func (s *Store) PullTract(ctx context.Context, sources []string, id core.TractID
 // (3) Remove the existing file.
 // (4) Create a new file, pull data from remote host and write to the new file.
 // (5) Create a version for the new file.
-func (s *Store) pullTractOnce(ctx context.Context, from string, id core.TractID, version int) core.Error {
+func (s *Store) pullTractOnce(ctx context.Context, from string, src core.TractID, srcVersion int, offset, length int, id core.TractID, version int) core.Error {
 	// See if the tract exists already.
 	if _, disk, cfg, ok := s.lookup(id); ok {
 		// If the tract exists on this server already we look at its version.
@@ -778,9 +833,10 @@ func (s *Store) pullTractOnce(ctx context.Context, from string, id core.TractID,
 	}
 
 	// Read the data from the other tractserver.  EOF is fine -- the tract can be half-written.
-	data, err := s.tt.CtlRead(ctx, from, id, version, core.TractLength, 0)
+	// RS chunk pieces are always complete, though.
+	data, err := s.tt.CtlRead(ctx, from, src, srcVersion, length, int64(offset))
 	defer rpc.PutBuffer(data, true)
-	if err != core.NoError && err != core.ErrEOF {
+	if err != core.NoError && (err != core.ErrEOF || src.IsRS()) {
 		return err
 	}
 
@@ -953,14 +1009,25 @@ SourceLoop:
 		if t.err != core.NoError {
 			break // avoid extra work if we've already failed
 		}
+		// Ask for core.TractLength and compare the length with src.Length so that we
+		// catch tracts that are an unexpected length. Tracts in RS pieces are followed
+		// by other data, so we can only ask for what we expect.
+		id, version, length, off := src.ID, src.Version, core.TractLength, int64(0)
+		if src.Piece.IsValid() {
+			id, version, length, off = src.Piece.ToTractID(), core.RSChunkVersion, src.Length, int64(src.PieceOffset)
+		}
 		for _, from := range src.From {
-			// Ask for core.TractLength and compare the length with src.Length so that we
-			// catch tracts that are an unexpected length.
-			b, err := s.tt.CtlRead(ctx, from.Host, src.ID, src.Version, core.TractLength, 0)
+			b, err := s.tt.CtlRead(ctx, from.Host, id, version, length, off)
 			if (err == core.NoError || err == core.ErrEOF) && len(b) == src.Length {
-				t.write(b, int64(src.Offset))
-				rpc.PutBuffer(b, true)
-				continue SourceLoop
+				// Check the end-to-end checksum too, if the client gave us one,
+				// so that we don't encode data that's different from what
+				// was written.
+				if !src.Checksum.Present() || src.Checksum.Verify(b) {
+					t.write(b, int64(src.Offset))
+					rpc.PutBuffer(b, true)
+					continue SourceLoop
+				}
+				err = core.ErrChecksumMismatch
 			}
 			log.Errorf("failed to pull tract %s from %s: %s (len %d, expected %d)", src.ID, from.Host, err, len(b), src.Length)
 			rpc.PutBuffer(b, true)
@@ -1010,18 +1077,18 @@ func checkTractSpec(srcs []*core.PackTractSpec, length int) bool {
 }
 
 // RSEncode performs the RS parity computation, reading data and writing parity
-// to other tractservers.
-func (s *Store) RSEncode(ctx context.Context, baseid core.RSChunkID, length int, srcs, dests []core.TSAddr, indexMap []int) (err core.Error) {
-	N, M := len(srcs), len(dests)
+// to other tractservers. If 'code' is set, the chunk uses that locally
+// repairable code instead of Reed-Solomon.
+func (s *Store) RSEncode(ctx context.Context, baseid core.RSChunkID, length int, srcs, dests []core.TSAddr, indexMap []int, code core.LRCParams) (err core.Error) {
 	increment := s.Config().EncodeIncrementSize
 
-	if !baseid.IsValid() || !baseid.Add(N+M-1).IsValid() {
+	enc, e := newErasureCoder(len(srcs), len(dests), code)
+	if e != nil {
+		log.Errorf("couldn't create encoder: %s", e)
 		return core.ErrInvalidArgument
 	}
 
-	enc, e := reedsolomon.New(N, M)
-	if e != nil {
-		log.Errorf("couldn't create RS encoder: %s", e)
+	if !baseid.IsValid() || !baseid.Add(enc.pieces()-1).IsValid() {
 		return core.ErrInvalidArgument
 	}
 
@@ -1039,10 +1106,10 @@ func (s *Store) RSEncode(ctx context.Context, baseid core.RSChunkID, length int,
 	return err
 }
 
-func (s *Store) rsEncodeOne(ctx context.Context, baseid core.RSChunkID, offset, length int, srcs, dests []core.TSAddr, indexMap []int, enc reedsolomon.Encoder) core.Error {
+func (s *Store) rsEncodeOne(ctx context.Context, baseid core.RSChunkID, offset, length int, srcs, dests []core.TSAddr, indexMap []int, enc erasureCoder) core.Error {
 	N, M := len(srcs), len(dests)
 
-	data := make([][]byte, N+M)
+	data := make([][]byte, enc.pieces())
 	errs := make([]core.Error, N+M)
 
 	defer func() {
@@ -1053,6 +1120,9 @@ func (s *Store) rsEncodeOne(ctx context.Context, baseid core.RSChunkID, offset,
 
 	encode := false
 	if len(indexMap) == 0 {
+		if N+M != enc.pieces() {
+			return core.ErrInvalidArgument
+		}
 		encode = true
 		// Use identity map for encoding.
 		indexMap = make([]int, N+M)
@@ -1062,6 +1132,11 @@ func (s *Store) rsEncodeOne(ctx context.Context, baseid core.RSChunkID, offset,
 	} else if len(indexMap) != N+M {
 		return core.ErrInvalidArgument
 	}
+	for i, dataI := range indexMap {
+		if dataI >= enc.pieces() || (dataI < 0 && i < N) {
+			return core.ErrInvalidArgument
+		}
+	}
 
 	// Pull all the data that we need.
 	var wg sync.WaitGroup
@@ -1099,7 +1174,13 @@ func (s *Store) rsEncodeOne(ctx context.Context, baseid core.RSChunkID, offset,
 		err = enc.Encode(data)
 	} else {
 		// For reconstruction, RS wants the missing pieces left as nil.
-		err = reconstructAndVerify(enc, data)
+		var want []int
+		for _, dataI := range indexMap[N:] {
+			if dataI >= 0 {
+				want = append(want, dataI)
+			}
+		}
+		err = enc.reconstruct(data, want)
 	}
 	if err != nil {
 		log.Errorf("RS encoding failed: %s", err)
@@ -1129,6 +1210,61 @@ func (s *Store) rsEncodeOne(ctx context.Context, baseid core.RSChunkID, offset,
 	return core.NoError
 }
 
+// erasureCoder is what RSEncode needs from a code.
+type erasureCoder interface {
+	// Encode computes the parity pieces from the data pieces.
+	Encode(shards [][]byte) error
+	// reconstruct fills in the missing pieces listed in 'want'.
+	reconstruct(shards [][]byte, want []int) error
+	// pieces returns the total number of pieces in a chunk.
+	pieces() int
+}
+
+// newErasureCoder returns a coder for 'code', or for Reed-Solomon with 'n' data
+// and 'm' parity pieces if 'code' is zero.
+func newErasureCoder(n, m int, code core.LRCParams) (erasureCoder, error) {
+	if code.K > 0 {
+		enc, err := lrc.New(code.K, code.L, code.R)
+		if err != nil {
+			return nil, err
+		}
+		return lrcCoder{enc}, nil
+	}
+	enc, err := reedsolomon.New(n, m)
+	if err != nil {
+		return nil, err
+	}
+	return rsCoder{enc, n + m}, nil
+}
+
+type rsCoder struct {
+	reedsolomon.Encoder
+	n int
+}
+
+// reconstruct rebuilds all missing pieces, so that it can verify the result.
+func (c rsCoder) reconstruct(shards [][]byte, want []int) error {
+	return reconstructAndVerify(c.Encoder, shards)
+}
+
+func (c rsCoder) pieces() int {
+	return c.n
+}
+
+type lrcCoder struct {
+	*lrc.Encoder
+}
+
+// reconstruct only rebuilds the pieces in 'want', since we might not have read
+// enough to do more (or to verify it).
+func (c lrcCoder) reconstruct(shards [][]byte, want []int) error {
+	return c.Reconstruct(shards, want)
+}
+
+func (c lrcCoder) pieces() int {
+	return c.Pieces()
+}
+
 func reconstructAndVerify(enc reedsolomon.Encoder, data [][]byte) error {
 	if err := enc.Reconstruct(data); err != nil {
 		return err
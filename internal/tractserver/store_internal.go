@@ -147,6 +147,13 @@ func (t *errTract) write(b []byte, off int64) {
 	_, t.err = t.disk.Write(t.ctx, t.f, b, off)
 }
 
+func (t *errTract) truncate(size int64) {
+	if t.err != core.NoError {
+		return
+	}
+	t.err = t.disk.Truncate(t.ctx, t.f, size)
+}
+
 func (t *errTract) read(length int, off int64) []byte {
 	if t.err != core.NoError {
 		return nil
@@ -8,12 +8,14 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"reflect"
 	"sync"
 	"testing"
 
 	"github.com/klauspost/reedsolomon"
 
 	"github.com/westerndigitalcorporation/blb/internal/core"
+	"github.com/westerndigitalcorporation/blb/pkg/lrc"
 )
 
 var BG = context.Background()
@@ -100,7 +102,7 @@ func TestSetVersion(t *testing.T) {
 	id := core.TractID{Blob: 123456, Index: 0}
 
 	// First, create the tract.
-	if err := s.Create(BG, id, nil, 0); err != core.NoError {
+	if err := s.Create(BG, id, 0, nil, 0); err != core.NoError {
 		t.Fatalf("failed to create the tract: %s", err)
 	}
 
@@ -138,7 +140,7 @@ func TestWriteRejected(t *testing.T) {
 	}
 
 	// Create the tract.  Versions start at 1.
-	if s.Create(BG, id, nil, 0) != core.NoError {
+	if s.Create(BG, id, 0, nil, 0) != core.NoError {
 		t.Fatal("failed to create the tract")
 	}
 
@@ -167,7 +169,7 @@ func TestWriteAccepted(t *testing.T) {
 	}
 
 	// Create the tract and write data.
-	if s.Create(BG, id, data, 0) != core.NoError {
+	if s.Create(BG, id, 0, data, 0) != core.NoError {
 		t.Fatal("failed to create the tract")
 	}
 
@@ -191,7 +193,7 @@ func TestWriteOldVersion(t *testing.T) {
 	id := core.TractID{Blob: 123456, Index: 0}
 
 	// Create the tract with initial version 1.
-	if s.Create(BG, id, nil, 0) != core.NoError {
+	if s.Create(BG, id, 0, nil, 0) != core.NoError {
 		t.Fatal("failed to create the tract")
 	}
 
@@ -206,6 +208,45 @@ func TestWriteOldVersion(t *testing.T) {
 	}
 }
 
+// Test cutting tracts short and extending them.
+func TestTruncate(t *testing.T) {
+	s := getTestStoreDefault(t)
+
+	id := core.TractID{Blob: 123456, Index: 0}
+	data := []byte("Hello, world!")
+
+	if s.Truncate(BG, id, 1, 5) != core.ErrNoSuchTract {
+		t.Fatal("truncate should fail before the tract is created")
+	}
+	if s.Create(BG, id, 0, data, 0) != core.NoError {
+		t.Fatal("failed to create the tract")
+	}
+	if s.Truncate(BG, id, 2, 5) != core.ErrVersionMismatch {
+		t.Fatal("truncate should fail with the wrong version")
+	}
+	if s.Truncate(BG, id, 1, core.TractLength+1) != core.ErrInvalidArgument {
+		t.Fatal("truncate should fail past the end of a tract")
+	}
+
+	if s.Truncate(BG, id, 1, 5) != core.NoError {
+		t.Fatal("failed to truncate the tract")
+	}
+	if size, _, err := s.Stat(BG, id, 1); err != core.NoError || size != 5 {
+		t.Fatalf("expected 5 bytes after truncating, got %d (%s)", size, err)
+	}
+	if out, err := s.Read(BG, id, 1, 5, 0); err != core.NoError || !bytes.Equal(out, data[:5]) {
+		t.Fatalf("unexpected data after truncating: %q (%s)", out, err)
+	}
+
+	// Growing pads with zeros.
+	if s.Truncate(BG, id, 1, 8) != core.NoError {
+		t.Fatal("failed to extend the tract")
+	}
+	if out, err := s.Read(BG, id, 1, 8, 0); err != core.NoError || !bytes.Equal(out, []byte("Hello\x00\x00\x00")) {
+		t.Fatalf("unexpected data after extending: %q (%s)", out, err)
+	}
+}
+
 // Test mod stamp semantics.
 func TestModStamp(t *testing.T) {
 	s := getTestStoreDefault(t)
@@ -216,7 +257,7 @@ func TestModStamp(t *testing.T) {
 	var err core.Error
 	var stamp1, stamp2, stamp3, stamp4 uint64
 
-	if s.Create(BG, id, data, 0) != core.NoError {
+	if s.Create(BG, id, 0, data, 0) != core.NoError {
 		t.Fatal("create failed")
 	}
 	if _, stamp1, err = s.Stat(BG, id, version); err != core.NoError {
@@ -278,6 +319,34 @@ func TestPullTract(t *testing.T) {
 	}
 }
 
+// Test that a tract can be copied from a tract with a different ID and version.
+func TestCopyTract(t *testing.T) {
+	s := getTestStoreDefault(t)
+
+	src := core.TractID{Blob: 123456, Index: 3}
+	id := core.TractID{Blob: 654321, Index: 0}
+	addr := []string{"somehost:someport"}
+
+	data := []byte("dark chili oil tastes good")
+	s.tt.(*memTractserverTalker).addCtlReadReply(addr[0], data, core.NoError)
+
+	if err := s.CopyTract(BG, addr, src, 4, id, 1); err != core.NoError {
+		t.Fatalf("failed to CopyTract: %s", err)
+	}
+
+	// The copy has the new ID and version.
+	readData, err := s.Read(BG, id, 1, len(data), 0)
+	if err != core.NoError {
+		t.Fatalf("read failed: %s", err)
+	}
+	if !bytes.Equal(readData, data) {
+		t.Fatalf("data in copytract reply wasn't data on disk")
+	}
+	if _, err := s.Read(BG, src, 4, len(data), 0); err != core.ErrNoSuchTract {
+		t.Fatalf("expected the source not to exist here, got %s", err)
+	}
+}
+
 // Test that pulling a tract will overwrite an existing one if we have old data.
 func TestPullTractOverwrite(t *testing.T) {
 	s := getTestStoreDefault(t)
@@ -286,7 +355,7 @@ func TestPullTractOverwrite(t *testing.T) {
 	addr := []string{"somehost:someport"}
 
 	// Create the file in the store, will have version 1.
-	if err := s.Create(BG, id, nil, 0); err != core.NoError {
+	if err := s.Create(BG, id, 0, nil, 0); err != core.NoError {
 		t.Fatalf("couldn't create file in the store: %s", err)
 	}
 
@@ -311,7 +380,7 @@ func TestPullTractFileExistOnDisk(t *testing.T) {
 	addr := []string{"somehost:someport"}
 
 	// Create the file on disk. This'll cause the pull below to fail.
-	if err := s.Create(BG, id, nil, 0); err != core.NoError {
+	if err := s.Create(BG, id, 0, nil, 0); err != core.NoError {
 		t.Fatalf("couldn't create file on disk: %s", err)
 	}
 
@@ -417,7 +486,7 @@ func TestGCTract(t *testing.T) {
 	s.maybeGCTract(ts)
 
 	// Create the tract with initial version 1.
-	if s.Create(BG, id, nil, 0) != core.NoError {
+	if s.Create(BG, id, 0, nil, 0) != core.NoError {
 		t.Fatal("failed to create the tract")
 	}
 
@@ -443,7 +512,7 @@ func TestGCTract(t *testing.T) {
 	id2 := core.TractID{Blob: 1, Index: 0}
 
 	// Create the tract id2 with initial version 1.
-	if s.Create(BG, id2, nil, 0) != core.NoError {
+	if s.Create(BG, id2, 0, nil, 0) != core.NoError {
 		t.Fatal("failed to create the tract")
 	}
 
@@ -456,6 +525,38 @@ func TestGCTract(t *testing.T) {
 	}
 }
 
+// Test that creating a tract at a higher version replaces an older copy, like
+// one left over from before a blob was truncated.
+func TestCreateReplacesOld(t *testing.T) {
+	s := getTestStoreDefault(t)
+
+	id := core.TractID{Blob: 123456, Index: 0}
+	if s.Create(BG, id, 0, []byte("stale bytes"), 0) != core.NoError {
+		t.Fatal("failed to create the tract")
+	}
+
+	// Retrying a create at the same version writes to it.
+	if s.Create(BG, id, 1, []byte("STALE"), 0) != core.NoError {
+		t.Fatal("failed to retry create")
+	}
+	if b, err := s.Read(BG, id, 1, 11, 0); err != core.NoError || string(b) != "STALE bytes" {
+		t.Fatalf("unexpected contents %q: %s", b, err)
+	}
+
+	// A higher version starts over.
+	if s.Create(BG, id, 2, []byte("new"), 0) != core.NoError {
+		t.Fatal("failed to replace the tract")
+	}
+	if size, _, err := s.Stat(BG, id, 2); err != core.NoError || size != 3 {
+		t.Fatalf("expected a fresh tract, got size %d: %s", size, err)
+	}
+
+	// A lower version doesn't.
+	if s.Create(BG, id, 1, []byte("old"), 0) != core.ErrVersionMismatch {
+		t.Fatal("expected create at an older version to fail")
+	}
+}
+
 // Test that create should succeed when there is at least one non-full disk and
 // fail when all disks are full.
 func TestNoSpace(t *testing.T) {
@@ -473,7 +574,7 @@ func TestNoSpace(t *testing.T) {
 	numTracts := 20
 	for i := 0; i < numTracts; i++ {
 		id := core.TractID{Blob: 123456, Index: core.TractKey(i)}
-		if err := s.Create(BG, id, nil, 0); core.NoError != err {
+		if err := s.Create(BG, id, 0, nil, 0); core.NoError != err {
 			t.Fatalf("failed to create tract %s: %s", id, err)
 		}
 	}
@@ -492,7 +593,7 @@ func TestHealthChecked(t *testing.T) {
 
 	// Tract creation shouldn't work.
 	id := core.TractID{Blob: 123456, Index: core.TractKey(0)}
-	if err := s.Create(BG, id, nil, 0); err == core.NoError {
+	if err := s.Create(BG, id, 0, nil, 0); err == core.NoError {
 		t.Fatalf("should have failed to create tract %s: %s", id, err)
 	}
 }
@@ -504,10 +605,10 @@ func TestCheck(t *testing.T) {
 	// Create two tracts, both will have v==1.
 	id0 := core.TractID{Blob: 123456, Index: 1}
 	id1 := core.TractID{Blob: 31337, Index: 21}
-	if s.Create(BG, id0, nil, 0) != core.NoError {
+	if s.Create(BG, id0, 0, nil, 0) != core.NoError {
 		t.Fatalf("failed to create %s", id0)
 	}
-	if s.Create(BG, id1, nil, 0) != core.NoError {
+	if s.Create(BG, id1, 0, nil, 0) != core.NoError {
 		t.Fatalf("failed to create %s", id1)
 	}
 
@@ -589,7 +690,7 @@ func TestChooseQuickerDisk(t *testing.T) {
 	ld.numClose = 0
 
 	id0 := core.TractID{Blob: 31337, Index: 21}
-	if s.Create(BG, id0, nil, 0) != core.NoError {
+	if s.Create(BG, id0, 0, nil, 0) != core.NoError {
 		t.Fatalf("couldn't create")
 	}
 
@@ -634,7 +735,7 @@ func TestAllocationByControlFlags(t *testing.T) {
 	d3.numClose = 0
 
 	for i := core.TractKey(0); i < 10; i++ {
-		if s.Create(BG, core.TractID{Blob: 54321, Index: i}, nil, 0) != core.NoError {
+		if s.Create(BG, core.TractID{Blob: 54321, Index: i}, 0, nil, 0) != core.NoError {
 			t.Fatalf("couldn't create")
 		}
 	}
@@ -746,6 +847,39 @@ func TestPackTracts(t *testing.T) {
 	}
 }
 
+// Test packing a tract out of a piece of another RS chunk.
+func TestPackTractsFromPiece(t *testing.T) {
+	s := getTestStoreDefault(t)
+	cid := core.RSChunkID{Partition: 0x80000555, ID: 5555}
+	piece := core.RSChunkID{Partition: 0x80000555, ID: 1234}
+	addrs := []core.TSAddr{{Host: "a1", ID: 1}}
+	tid := core.TractIDFromParts(core.BlobIDFromParts(1, 2), 0)
+
+	data := []byte("this is some data")
+	s.tt.(*memTractserverTalker).addCtlReadReply(addrs[0].Host, data, core.NoError)
+
+	err := s.PackTracts(BG, len(data)+2, []*core.PackTractSpec{
+		{ID: tid, From: addrs, Version: 1, Offset: 2, Length: len(data), Piece: piece, PieceOffset: 4096},
+	}, cid)
+	if err != core.NoError {
+		t.Fatalf("error from PackTracts: %s", err)
+	}
+
+	// It should have read just the tract from the piece.
+	exp := []core.ReadReq{{ID: piece.ToTractID(), Version: core.RSChunkVersion, Len: len(data), Off: 4096}}
+	if calls := s.tt.(*memTractserverTalker).ctlReadCalls[addrs[0].Host]; !reflect.DeepEqual(calls, exp) {
+		t.Errorf("expected reads %+v, got %+v", exp, calls)
+	}
+
+	b, err := s.Read(BG, cid.ToTractID(), core.RSChunkVersion, len(data)+2, 0)
+	if err != core.NoError {
+		t.Fatalf("error reading back packed tract: %s", err)
+	}
+	if !bytes.Equal(b, append([]byte{0, 0}, data...)) {
+		t.Errorf("wrong data: %v", b)
+	}
+}
+
 func TestRSEncode(t *testing.T) {
 	N, M := 3, 2
 	B := 12000
@@ -789,7 +923,7 @@ func TestRSEncode(t *testing.T) {
 	mtt.addCtlWriteReply(addrs[4].Host, core.NoError)
 
 	// do the call
-	err := s.RSEncode(BG, cid, B, addrs[:N], addrs[N:], nil)
+	err := s.RSEncode(BG, cid, B, addrs[:N], addrs[N:], nil, core.LRCParams{})
 	if err != core.NoError {
 		t.Fatalf("error from RSEncode: %s", err)
 	}
@@ -854,7 +988,7 @@ func TestRSReconstruct(t *testing.T) {
 	err := s.RSEncode(BG, cid, B,
 		[]core.TSAddr{addrs[0], addrs[2], addrs[4]},
 		[]core.TSAddr{addrs[1], addrs[3]},
-		[]int{0, 2, 4, 1, 3})
+		[]int{0, 2, 4, 1, 3}, core.LRCParams{})
 	if err != core.NoError {
 		t.Fatalf("error from RSEncode: %s", err)
 	}
@@ -877,3 +1011,57 @@ func TestRSReconstruct(t *testing.T) {
 		t.Fatalf("RS verify failed: %v, %v", e, ok)
 	}
 }
+
+// A single lost piece of a locally repairable code is rebuilt from its group.
+func TestLRCReconstruct(t *testing.T) {
+	code := core.LRCParams{K: 12, L: 2, R: 2}
+	B := 20000
+
+	s := getTestStoreDefault(t)
+
+	cid := core.RSChunkID{Partition: 0x80000005, ID: 5000}
+
+	addrs := make([]core.TSAddr, 16)
+	for i := range addrs {
+		addrs[i] = core.TSAddr{Host: fmt.Sprintf("addr%d", i), ID: core.TractserverID(i)}
+	}
+
+	enc, _ := lrc.New(code.K, code.L, code.R)
+	data := make([][]byte, enc.Pieces())
+	for i := range data {
+		data[i] = make([]byte, B)
+		rand.Read(data[i])
+	}
+	if enc.Encode(data) != nil {
+		t.Fatalf("LRC encode failed")
+	}
+
+	// We're missing 8, which is in the second group with 6..11 and 13.
+	read, _ := enc.RepairSet([]int{8})
+	var srcs []core.TSAddr
+	mtt := s.tt.(*memTractserverTalker)
+	for _, i := range read {
+		mtt.addCtlReadReply(addrs[i].Host, data[i], core.ErrEOF)
+		srcs = append(srcs, addrs[i])
+	}
+	mtt.addCtlWriteReply(addrs[8].Host, core.NoError)
+
+	err := s.RSEncode(BG, cid, B, srcs, []core.TSAddr{addrs[8]}, append(read, 8), code)
+	if err != core.NoError {
+		t.Fatalf("error from RSEncode: %s", err)
+	}
+
+	if len(srcs) != 6 {
+		t.Errorf("expected to read 6 pieces, read %d", len(srcs))
+	}
+	var got []byte
+	for _, reply := range mtt.ctlWriteCalls[addrs[8].Host] {
+		if reply.ID != cid.Add(8).ToTractID() {
+			t.Errorf("bad tract id: %v", reply.ID)
+		}
+		got = append(got, reply.B...)
+	}
+	if !bytes.Equal(got, data[8]) {
+		t.Errorf("reconstructed the wrong data")
+	}
+}
@@ -9,6 +9,7 @@ package disk
 
 import (
 	"errors"
+	"hash/crc32"
 	"io"
 	"os"
 	"path/filepath"
@@ -420,6 +421,43 @@ func (f *ChecksumFile) WriteAt(b []byte, off int64) (int, error) {
 	return n + int(changeLen), e
 }
 
+// Truncate changes the size of the file to 'size' bytes of data. Growing the
+// file pads it with 0s, like WriteAt does for holes. When shrinking, the block
+// that the file now ends in is rewritten with a new checksum before the rest
+// is cut off.
+func (f *ChecksumFile) Truncate(size int64) error {
+	cur, err := f.Size()
+	if err != nil {
+		log.Errorf("%s: error determining size in Truncate: %+v", f.path, err)
+		return err
+	}
+	if size < 0 {
+		return ErrInvalidOffset
+	} else if size >= cur {
+		return f.pad(int(size - cur))
+	}
+
+	blockNo := int(size / blockDataLength)
+	blockOff := int(size % blockDataLength)
+	if blockOff == 0 {
+		// Ends on a block boundary, just drop the blocks after it.
+		return f.file.Truncate(int64(headerLength + blockLength*blockNo))
+	}
+
+	block := getChecksumBlock()
+	defer returnChecksumBlock(block)
+
+	if err = f.readBlock(block, blockNo); err != nil {
+		return err
+	}
+	block.length = blockOff
+	block.cksum = crc32.Checksum(block.data(), crc32Table)
+	if _, err = f.writeBlock(block, blockNo); err != nil {
+		return err
+	}
+	return f.file.Truncate(int64(headerLength + blockLength*blockNo + blockOff + blockChecksumLength))
+}
+
 // Append len(b) bytes to the end of the file 'f'.  Returns the number of bytes
 // successfully written.
 //
@@ -155,6 +155,52 @@ func TestChecksumFileSize(t *testing.T) {
 	}
 }
 
+// Truncate files of various sizes and check that the data that's left is
+// intact and that the file can still be scrubbed and appended to.
+func TestChecksumFileTruncate(t *testing.T) {
+	sizes := []int64{0, 1, blockDataLength - 1, blockDataLength, blockDataLength + 1, 2*blockDataLength + 10}
+
+	for _, size := range sizes {
+		f := setupChecksumFile(int(2*blockDataLength+100), t)
+		if err := f.Truncate(size); err != nil {
+			t.Fatalf("truncate to %d failed: %s", size, err)
+		}
+		if fs, err := f.Size(); err != nil || fs != size {
+			t.Fatalf("truncated to %d, size reported as %d (%v)", size, fs, err)
+		}
+		if n, err := f.Scrub(); err != nil || n != size {
+			t.Fatalf("scrub after truncate to %d failed: %d, %v", size, n, err)
+		}
+		buf := make([]byte, size)
+		if n, err := f.ReadAt(buf, 0); err != nil || n != len(buf) {
+			t.Fatalf("read after truncate to %d failed: %d, %v", size, n, err)
+		}
+		if err := bufIsOK(buf, 0); err != nil {
+			t.Fatalf("bad data after truncate to %d: %s", size, err)
+		}
+		if n, err := f.WriteAt([]byte{1, 2, 3}, size); err != nil || n != 3 {
+			t.Fatalf("append after truncate to %d failed: %d, %v", size, n, err)
+		}
+		f.Close()
+	}
+
+	// Growing pads with zeros.
+	f := setupChecksumFile(10, t)
+	if err := f.Truncate(blockDataLength + 10); err != nil {
+		t.Fatalf("truncate failed: %s", err)
+	}
+	buf := bytes.Repeat([]byte{0xff}, blockDataLength)
+	if n, err := f.ReadAt(buf, 10); err != nil || n != len(buf) {
+		t.Fatalf("read failed: %d, %v", n, err)
+	}
+	for i, b := range buf {
+		if b != 0 {
+			t.Fatalf("expected zero at offset %d", i+10)
+		}
+	}
+	f.Close()
+}
+
 var appendTests = []struct {
 	// Create a file of this many bytes.  Fill it with a known repeating pattern.
 	size int
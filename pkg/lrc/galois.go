@@ -0,0 +1,101 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package lrc
+
+// Arithmetic in GF(2^8), with the same generating polynomial as the
+// Reed-Solomon library that computes the global parity, so that we can combine
+// its parity pieces with our own.
+const generatingPolynomial = 0x11d
+
+var (
+	gfExp [510]byte
+	gfLog [256]int
+)
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(x)
+		gfExp[i+255] = byte(x)
+		gfLog[x] = i
+		x <<= 1
+		if x >= 256 {
+			x ^= generatingPolynomial
+		}
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[gfLog[a]+gfLog[b]]
+}
+
+// gfInv returns the multiplicative inverse of 'a', which must not be zero.
+func gfInv(a byte) byte {
+	return gfExp[255-gfLog[a]]
+}
+
+// mulAdd adds c*in to out.
+func mulAdd(c byte, in, out []byte) {
+	switch c {
+	case 0:
+	case 1:
+		for i, b := range in {
+			out[i] ^= b
+		}
+	default:
+		var table [256]byte
+		for i := range table {
+			table[i] = gfMul(c, byte(i))
+		}
+		for i, b := range in {
+			out[i] ^= table[b]
+		}
+	}
+}
+
+// invert returns the inverse of the square matrix 'm', or nil if it's
+// singular. 'm' is not modified.
+func invert(m [][]byte) [][]byte {
+	n := len(m)
+	// Gauss-Jordan elimination on [m | I].
+	work := make([][]byte, n)
+	for i := range work {
+		work[i] = make([]byte, 2*n)
+		copy(work[i], m[i])
+		work[i][n+i] = 1
+	}
+	for col := 0; col < n; col++ {
+		pivot := -1
+		for row := col; row < n; row++ {
+			if work[row][col] != 0 {
+				pivot = row
+				break
+			}
+		}
+		if pivot < 0 {
+			return nil
+		}
+		work[col], work[pivot] = work[pivot], work[col]
+		scale := gfInv(work[col][col])
+		for j := range work[col] {
+			work[col][j] = gfMul(work[col][j], scale)
+		}
+		for row := 0; row < n; row++ {
+			if row != col && work[row][col] != 0 {
+				f := work[row][col]
+				for j := range work[row] {
+					work[row][j] ^= gfMul(f, work[col][j])
+				}
+			}
+		}
+	}
+	out := make([][]byte, n)
+	for i := range out {
+		out[i] = work[i][n:]
+	}
+	return out
+}
@@ -0,0 +1,452 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+// Package lrc implements locally repairable codes, as described in "Erasure
+// Coding in Windows Azure Storage" (Huang et al., 2012).
+//
+// A code with parameters (k, l, r) has k data pieces, split evenly into l local
+// groups. Each group has a local parity piece, which is the XOR of the data
+// pieces in the group, and there are r global parity pieces, computed from all
+// the data with Reed-Solomon (using a Cauchy matrix, which makes (12, 2, 2)
+// tolerate any three lost pieces). One lost piece in a group can be rebuilt from
+// the other pieces of its group, which takes k/l reads instead of the k that
+// Reed-Solomon needs. Anything else is decoded from all the pieces that are
+// left.
+//
+// Pieces are ordered data first, then local parity, then global parity.
+package lrc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/klauspost/reedsolomon"
+)
+
+var (
+	// ErrTooFewShards is returned if there aren't enough pieces left to
+	// rebuild the ones that were asked for.
+	ErrTooFewShards = errors.New("lrc: too few pieces to reconstruct")
+	// ErrShardSize is returned if the pieces don't all have the same size.
+	ErrShardSize = errors.New("lrc: pieces must all have the same size")
+)
+
+// Encoder encodes and decodes one shape of locally repairable code.
+type Encoder struct {
+	k, l, r int
+	global  reedsolomon.Encoder
+
+	// coef[i][j] is the coefficient of data piece j in piece i.
+	coef [][]byte
+}
+
+// New returns an encoder for 'k' data pieces in 'l' local groups, with 'r'
+// global parity pieces.
+func New(k, l, r int) (*Encoder, error) {
+	if k <= 0 || l <= 0 || r <= 0 || k%l != 0 {
+		return nil, fmt.Errorf("lrc: invalid parameters (%d, %d, %d)", k, l, r)
+	}
+	global, err := reedsolomon.New(k, r, reedsolomon.WithCauchyMatrix())
+	if err != nil {
+		return nil, err
+	}
+	e := &Encoder{k: k, l: l, r: r, global: global, coef: make([][]byte, k+l+r)}
+
+	for i := 0; i < k; i++ {
+		e.coef[i] = make([]byte, k)
+		e.coef[i][i] = 1
+	}
+	for g := 0; g < l; g++ {
+		e.coef[k+g] = make([]byte, k)
+		for _, d := range e.groupData(g) {
+			e.coef[k+g][d] = 1
+		}
+	}
+	// Reed-Solomon is linear, so encoding a single 1 in each data piece gives
+	// us the coefficients of the global parity.
+	for i := 0; i < r; i++ {
+		e.coef[k+l+i] = make([]byte, k)
+	}
+	for j := 0; j < k; j++ {
+		shards := make([][]byte, k+r)
+		for i := range shards {
+			shards[i] = make([]byte, 1)
+		}
+		shards[j][0] = 1
+		if err := global.Encode(shards); err != nil {
+			return nil, err
+		}
+		for i := 0; i < r; i++ {
+			e.coef[k+l+i][j] = shards[k+i][0]
+		}
+	}
+	return e, nil
+}
+
+// Pieces returns the total number of pieces in the code.
+func (e *Encoder) Pieces() int {
+	return e.k + e.l + e.r
+}
+
+// Group returns the local group of piece 'i', or -1 if it's a global parity
+// piece.
+func (e *Encoder) Group(i int) int {
+	if i < e.k {
+		return i / (e.k / e.l)
+	} else if i < e.k+e.l {
+		return i - e.k
+	}
+	return -1
+}
+
+// groupData returns the indexes of the data pieces in group 'g'.
+func (e *Encoder) groupData(g int) []int {
+	size := e.k / e.l
+	out := make([]int, size)
+	for i := range out {
+		out[i] = g*size + i
+	}
+	return out
+}
+
+// groupMembers returns the indexes of the pieces in group 'g', including its
+// local parity.
+func (e *Encoder) groupMembers(g int) []int {
+	return append(e.groupData(g), e.k+g)
+}
+
+// Encode computes the parity pieces of 'shards' from its data pieces. All
+// pieces must be allocated and have the same size.
+func (e *Encoder) Encode(shards [][]byte) error {
+	if _, err := e.checkShards(shards, true); err != nil {
+		return err
+	}
+	for g := 0; g < e.l; g++ {
+		p := shards[e.k+g]
+		for i := range p {
+			p[i] = 0
+		}
+		for _, d := range e.groupData(g) {
+			mulAdd(1, shards[d], p)
+		}
+	}
+	return e.global.Encode(e.globalShards(shards))
+}
+
+// Verify returns true if the parity pieces of 'shards' match its data.
+func (e *Encoder) Verify(shards [][]byte) (bool, error) {
+	size, err := e.checkShards(shards, true)
+	if err != nil {
+		return false, err
+	}
+	p := make([]byte, size)
+	for g := 0; g < e.l; g++ {
+		for i := range p {
+			p[i] = 0
+		}
+		for _, d := range e.groupData(g) {
+			mulAdd(1, shards[d], p)
+		}
+		if string(p) != string(shards[e.k+g]) {
+			return false, nil
+		}
+	}
+	return e.global.Verify(e.globalShards(shards))
+}
+
+// globalShards returns the data and global parity pieces of 'shards', for the
+// Reed-Solomon encoder.
+func (e *Encoder) globalShards(shards [][]byte) [][]byte {
+	out := make([][]byte, 0, e.k+e.r)
+	out = append(out, shards[:e.k]...)
+	return append(out, shards[e.k+e.l:]...)
+}
+
+// checkShards checks that the pieces in 'shards' have the same size, and
+// returns it. If 'full' is true, all pieces must be present.
+func (e *Encoder) checkShards(shards [][]byte, full bool) (int, error) {
+	if len(shards) != e.Pieces() {
+		return 0, fmt.Errorf("lrc: got %d pieces, want %d", len(shards), e.Pieces())
+	}
+	size := -1
+	for _, s := range shards {
+		if len(s) == 0 {
+			if full {
+				return 0, ErrShardSize
+			}
+			continue
+		}
+		if size < 0 {
+			size = len(s)
+		} else if len(s) != size {
+			return 0, ErrShardSize
+		}
+	}
+	if size < 0 {
+		return 0, ErrTooFewShards
+	}
+	return size, nil
+}
+
+// CanReconstruct returns true if the pieces in 'lost' can be rebuilt from the
+// rest.
+func (e *Encoder) CanReconstruct(lost []int) bool {
+	have := make([]bool, e.Pieces())
+	for i := range have {
+		have[i] = true
+	}
+	for _, i := range lost {
+		have[i] = false
+	}
+	return e.solver(have) != nil || e.missingData(have) == nil
+}
+
+// RepairSet returns the pieces that need to be read to rebuild the pieces in
+// 'lost', assuming all the others are available. Lost pieces that are alone in
+// their group only need the rest of their group. It returns false if the lost
+// pieces can't be rebuilt.
+func (e *Encoder) RepairSet(lost []int) ([]int, bool) {
+	if !e.CanReconstruct(lost) {
+		return nil, false
+	}
+	isLost := make([]bool, e.Pieces())
+	for _, i := range lost {
+		isLost[i] = true
+	}
+	lostIn := make([]int, e.l)
+	for _, i := range lost {
+		if g := e.Group(i); g >= 0 {
+			lostIn[g]++
+		}
+	}
+
+	read := make([]bool, e.Pieces())
+	all := false
+	for _, i := range lost {
+		g := e.Group(i)
+		if g >= 0 && lostIn[g] == 1 {
+			for _, j := range e.groupMembers(g) {
+				read[j] = j != i
+			}
+		} else if g < 0 {
+			// Global parity needs all the data. Lost data pieces need their
+			// groups, which is taken care of above if they're alone in them.
+			for d := 0; d < e.k; d++ {
+				if !isLost[d] {
+					read[d] = true
+				} else if lostIn[e.Group(d)] != 1 {
+					all = true
+				}
+			}
+		} else {
+			all = true
+		}
+	}
+
+	var out []int
+	for i := range read {
+		if !isLost[i] && (all || read[i]) {
+			out = append(out, i)
+		}
+	}
+	return out, true
+}
+
+// Reconstruct rebuilds the pieces of 'shards' listed in 'want' (or all missing
+// pieces if 'want' is nil) from the ones that are present. Missing pieces are
+// nil or have zero length; if they have enough capacity, it's used for the
+// result. Other missing pieces may be rebuilt along the way.
+func (e *Encoder) Reconstruct(shards [][]byte, want []int) error {
+	size, err := e.checkShards(shards, false)
+	if err != nil {
+		return err
+	}
+	if want == nil {
+		for i, s := range shards {
+			if len(s) == 0 {
+				want = append(want, i)
+			}
+		}
+	}
+	missing := func(i int) bool { return len(shards[i]) == 0 }
+	alloc := func(i int) []byte {
+		if cap(shards[i]) >= size {
+			shards[i] = shards[i][:size]
+			for j := range shards[i] {
+				shards[i][j] = 0
+			}
+		} else {
+			shards[i] = make([]byte, size)
+		}
+		return shards[i]
+	}
+
+	// Repair groups that lost a single piece with XOR.
+	for g := 0; g < e.l; g++ {
+		lost, n := -1, 0
+		for _, i := range e.groupMembers(g) {
+			if missing(i) {
+				lost = i
+				n++
+			}
+		}
+		if n != 1 {
+			continue
+		}
+		out := alloc(lost)
+		for _, i := range e.groupMembers(g) {
+			if i != lost {
+				mulAdd(1, shards[i], out)
+			}
+		}
+	}
+
+	done := true
+	for _, i := range want {
+		if missing(i) {
+			done = false
+		}
+	}
+	if done {
+		return nil
+	}
+
+	// Decode the remaining data from everything that's left.
+	have := make([]bool, len(shards))
+	for i := range shards {
+		have[i] = !missing(i)
+	}
+	if lostData := e.missingData(have); lostData != nil {
+		s := e.solver(have)
+		if s == nil {
+			return ErrTooFewShards
+		}
+		// The right-hand side of each equation is the parity piece minus the
+		// contribution of the data we have.
+		rhs := make([][]byte, len(s.rows))
+		for i, p := range s.rows {
+			rhs[i] = make([]byte, size)
+			copy(rhs[i], shards[p])
+			for d := 0; d < e.k; d++ {
+				if have[d] {
+					mulAdd(e.coef[p][d], shards[d], rhs[i])
+				}
+			}
+		}
+		for i, d := range lostData {
+			out := alloc(d)
+			for j := range rhs {
+				mulAdd(s.inv[i][j], rhs[j], out)
+			}
+		}
+	}
+
+	// Now all the data is present, recompute any parity that's still missing.
+	for _, i := range want {
+		if !missing(i) {
+			continue
+		}
+		if g := e.Group(i); g >= 0 {
+			out := alloc(i)
+			for _, d := range e.groupData(g) {
+				mulAdd(1, shards[d], out)
+			}
+			continue
+		}
+		// Global parity: reuse the Reed-Solomon encoder for all of them.
+		gs := e.globalShards(shards)
+		for j := e.k; j < len(gs); j++ {
+			if len(gs[j]) == 0 {
+				gs[j] = alloc(j + e.l)
+			}
+		}
+		if err := e.global.Encode(gs); err != nil {
+			return err
+		}
+		break
+	}
+	return nil
+}
+
+// missingData returns the indexes of the data pieces not in 'have', or nil if
+// there are none.
+func (e *Encoder) missingData(have []bool) (out []int) {
+	for d := 0; d < e.k; d++ {
+		if !have[d] {
+			out = append(out, d)
+		}
+	}
+	return
+}
+
+// solver holds how to decode missing data: the parity pieces to use, and the
+// inverse of their coefficients for the missing data.
+type solver struct {
+	rows []int
+	inv  [][]byte
+}
+
+// solver finds parity pieces in 'have' that are enough to decode the data
+// pieces that aren't. It returns nil if there aren't enough, or if no data is
+// missing.
+func (e *Encoder) solver(have []bool) *solver {
+	lost := e.missingData(have)
+	if lost == nil {
+		return nil
+	}
+
+	// Pick parity pieces greedily, keeping those that are independent of the
+	// ones picked before. 'reduced' holds the picked rows in echelon form.
+	var rows []int
+	var reduced [][]byte
+	var pivots []int
+	for p := e.k; p < len(have) && len(rows) < len(lost); p++ {
+		if !have[p] {
+			continue
+		}
+		row := make([]byte, len(lost))
+		for j, d := range lost {
+			row[j] = e.coef[p][d]
+		}
+		for i, r := range reduced {
+			if f := row[pivots[i]]; f != 0 {
+				for j := range row {
+					row[j] ^= gfMul(f, r[j])
+				}
+			}
+		}
+		pivot := -1
+		for j, c := range row {
+			if c != 0 {
+				pivot = j
+				break
+			}
+		}
+		if pivot < 0 {
+			continue // Depends on the rows we already have.
+		}
+		scale := gfInv(row[pivot])
+		for j := range row {
+			row[j] = gfMul(row[j], scale)
+		}
+		rows = append(rows, p)
+		reduced = append(reduced, row)
+		pivots = append(pivots, pivot)
+	}
+	if len(rows) < len(lost) {
+		return nil
+	}
+
+	m := make([][]byte, len(rows))
+	for i, p := range rows {
+		m[i] = make([]byte, len(lost))
+		for j, d := range lost {
+			m[i][j] = e.coef[p][d]
+		}
+	}
+	inv := invert(m)
+	if inv == nil {
+		return nil
+	}
+	return &solver{rows: rows, inv: inv}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package lrc
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func makeShards(t *testing.T, e *Encoder, size int) [][]byte {
+	shards := make([][]byte, e.Pieces())
+	for i := range shards {
+		shards[i] = make([]byte, size)
+		if i < e.k {
+			rand.Read(shards[i])
+		}
+	}
+	if err := e.Encode(shards); err != nil {
+		t.Fatal(err)
+	}
+	return shards
+}
+
+func copyShards(shards [][]byte) [][]byte {
+	out := make([][]byte, len(shards))
+	for i, s := range shards {
+		out[i] = append([]byte(nil), s...)
+	}
+	return out
+}
+
+func TestEncodeVerify(t *testing.T) {
+	e, err := New(12, 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shards := makeShards(t, e, 100)
+	if ok, err := e.Verify(shards); !ok || err != nil {
+		t.Fatalf("verify failed: %v %v", ok, err)
+	}
+
+	// Local parity is the XOR of its group.
+	x := make([]byte, 100)
+	for d := 6; d < 12; d++ {
+		mulAdd(1, shards[d], x)
+	}
+	if !bytes.Equal(x, shards[13]) {
+		t.Errorf("wrong local parity")
+	}
+
+	for _, i := range []int{3, 12, 15} {
+		bad := copyShards(shards)
+		bad[i][50] ^= 1
+		if ok, _ := e.Verify(bad); ok {
+			t.Errorf("verify didn't notice a change to piece %d", i)
+		}
+	}
+
+	if _, err := New(12, 5, 2); err == nil {
+		t.Errorf("groups must divide the data evenly")
+	}
+}
+
+// Every combination of up to four lost pieces that CanReconstruct accepts
+// should reconstruct correctly, and that should include all combinations of
+// three.
+func TestReconstruct(t *testing.T) {
+	e, _ := New(12, 2, 2)
+	shards := makeShards(t, e, 16)
+
+	counts := make([]int, 5)
+	var try func(start int, lost []int)
+	try = func(start int, lost []int) {
+		if len(lost) > 0 && e.CanReconstruct(lost) {
+			counts[len(lost)]++
+			work := copyShards(shards)
+			for _, i := range lost {
+				work[i] = nil
+			}
+			if err := e.Reconstruct(work, nil); err != nil {
+				t.Fatalf("lost %v: %s", lost, err)
+			}
+			for i := range work {
+				if !bytes.Equal(work[i], shards[i]) {
+					t.Fatalf("lost %v: piece %d is wrong", lost, i)
+				}
+			}
+		}
+		if len(lost) == 4 {
+			return
+		}
+		for i := start; i < e.Pieces(); i++ {
+			try(i+1, append(append([]int(nil), lost...), i))
+		}
+	}
+	try(0, nil)
+
+	if counts[1] != 16 || counts[2] != 120 || counts[3] != 560 || counts[4] < 1500 {
+		t.Errorf("recoverable combinations of 1-4 lost pieces: %v", counts[1:])
+	}
+	if e.CanReconstruct([]int{0, 1, 2, 3, 4}) {
+		t.Errorf("can't lose five pieces")
+	}
+}
+
+// Pieces that are alone in their group are rebuilt from just their group.
+func TestRepairSet(t *testing.T) {
+	e, _ := New(12, 2, 2)
+	shards := makeShards(t, e, 16)
+
+	for _, test := range []struct {
+		lost, read []int
+	}{
+		{[]int{2}, []int{0, 1, 3, 4, 5, 12}},
+		{[]int{13}, []int{6, 7, 8, 9, 10, 11}},
+		{[]int{2, 8}, []int{0, 1, 3, 4, 5, 6, 7, 9, 10, 11, 12, 13}},
+		{[]int{14}, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}},
+		{[]int{1, 2}, []int{0, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}},
+	} {
+		read, ok := e.RepairSet(test.lost)
+		if !ok || len(read) != len(test.read) {
+			t.Errorf("lost %v: expected to read %v, got %v", test.lost, test.read, read)
+			continue
+		}
+		for i := range read {
+			if read[i] != test.read[i] {
+				t.Errorf("lost %v: expected to read %v, got %v", test.lost, test.read, read)
+				break
+			}
+		}
+
+		// Only give it what it asked for.
+		work := make([][]byte, e.Pieces())
+		for _, i := range read {
+			work[i] = shards[i]
+		}
+		for _, i := range test.lost {
+			work[i] = make([]byte, 0, 16)
+		}
+		if err := e.Reconstruct(work, test.lost); err != nil {
+			t.Errorf("lost %v: %s", test.lost, err)
+			continue
+		}
+		for _, i := range test.lost {
+			if !bytes.Equal(work[i], shards[i]) {
+				t.Errorf("lost %v: piece %d is wrong", test.lost, i)
+			}
+		}
+	}
+}
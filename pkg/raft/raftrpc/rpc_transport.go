@@ -112,11 +112,11 @@ func NewRPCTransport(cfg raft.TransportConfig, rpcCfg RPCTransportConfig) (*RPCT
 		handler: make(chan raft.Msg, cfg.MsgChanCap),
 	}
 	// Register with Go RPC service.
-	if err := rpc.RegisterName(rpcCfg.RPCName, t.handler); nil != err {
+	if err := rpc.RegisterName(rpcCfg.RPCName, t.handler, rpc.RoleControl); nil != err {
 		log.Fatalf("[raft-transport] failed to register the rpc handler")
 		return nil, err
 	}
-	http.HandleFunc(t.snapshotEndpoint(), t.snapshotHandler)
+	http.HandleFunc(t.snapshotEndpoint(), rpc.AuthHandler(rpc.RoleControl, t.snapshotHandler))
 	return t, nil
 }
 
@@ -171,6 +171,7 @@ func (t *RPCTransport) sendSnapshot(snapshot *raft.InstallSnapshot) {
 		log.Errorf("Failed to create POST request for snapshot: %v", err)
 		return
 	}
+	rpc.SetAuthHeader(req)
 
 	var resp *http.Response
 	clt := &http.Client{}
@@ -0,0 +1,233 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	log "github.com/golang/glog"
+)
+
+// Authentication is by token. A process that serves RPCs can be given a set of
+// tokens with SetTokens; from then on, peers have to present one of them when
+// they connect, and calls are checked against the role of the identity that
+// their token maps to. A process presents the token given to SetToken when it
+// connects to others.
+//
+// Tokens are sent in the clear, in an HTTP header, and Blb doesn't serve TLS.
+// Anyone who can see the traffic can take a token and use it, so tokens need a
+// network that peers can't snoop on, or a TLS tunnel (like a service mesh
+// sidecar) between every pair of processes. Tokens are compared in constant
+// time, so that they can't be guessed by timing failed attempts.
+//
+// Until SetTokens is called, there's no authentication and every peer is
+// treated as an admin.
+
+// Role is what a peer is allowed to do. Each role can do everything that the
+// roles before it can.
+type Role int
+
+const (
+	// RoleNone can't call anything.
+	RoleNone Role = iota
+	// RoleClient is for clients: creating, reading, and writing blobs.
+	RoleClient
+	// RoleControl is for masters, curators, and tractservers talking to each
+	// other.
+	RoleControl
+	// RoleAdmin is for operators: changing disk flags and read-only mode,
+	// raft reconfiguration, quitting processes.
+	RoleAdmin
+)
+
+var roleNames = []string{"none", "client", "control", "admin"}
+
+func (r Role) String() string {
+	if r >= 0 && int(r) < len(roleNames) {
+		return roleNames[r]
+	}
+	return fmt.Sprintf("Role(%d)", int(r))
+}
+
+// ParseRole returns the Role named 's'.
+func ParseRole(s string) (Role, error) {
+	for i, name := range roleNames {
+		if s == name {
+			return Role(i), nil
+		}
+	}
+	return RoleNone, fmt.Errorf("unknown role %q", s)
+}
+
+// Identity is who a peer is, as established by their token.
+type Identity struct {
+	Name string
+	Role Role
+}
+
+// CallerAware can be implemented by receivers that need to know who's calling
+// them, for example to check access to individual objects. Each connection
+// gets its own receiver from ForCaller.
+type CallerAware interface {
+	ForCaller(caller Identity) interface{}
+}
+
+// anonymous is the identity of every peer when there's no authentication.
+var anonymous = Identity{Role: RoleAdmin}
+
+// tokenHash is the hash of a token. Peers' tokens are compared by their
+// hashes, which all have the same length, so the comparison doesn't leak the
+// length of any token.
+type tokenHash [sha256.Size]byte
+
+// knownToken is a token that peers may present.
+type knownToken struct {
+	hash tokenHash
+	id   Identity
+}
+
+var auth struct {
+	lock   sync.Mutex
+	tokens []knownToken // nil if there's no authentication
+	token  string       // what we present to others
+}
+
+// SetTokens turns on authentication. Peers will have to present one of the
+// keys of 'tokens', and get the identity it maps to. A nil map turns
+// authentication off.
+func SetTokens(tokens map[string]Identity) {
+	var known []knownToken
+	if tokens != nil {
+		known = make([]knownToken, 0, len(tokens))
+		for token, id := range tokens {
+			known = append(known, knownToken{hash: sha256.Sum256([]byte(token)), id: id})
+		}
+	}
+	auth.lock.Lock()
+	defer auth.lock.Unlock()
+	auth.tokens = known
+}
+
+// SetToken sets the token that this process presents when it connects to
+// others.
+func SetToken(token string) {
+	auth.lock.Lock()
+	defer auth.lock.Unlock()
+	auth.token = token
+}
+
+// LoadTokens reads tokens for SetTokens from the file at 'path'. Each line has
+// a token, the name of its identity, and its role, separated by spaces. Blank
+// lines and lines starting with # are ignored.
+func LoadTokens(path string) (map[string]Identity, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	tokens := make(map[string]Identity)
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%s:%d: want token, name, and role", path, n)
+		}
+		role, err := ParseRole(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %s", path, n, err)
+		}
+		if _, ok := tokens[fields[0]]; ok {
+			return nil, fmt.Errorf("%s:%d: duplicate token", path, n)
+		}
+		tokens[fields[0]] = Identity{Name: fields[1], Role: role}
+	}
+	return tokens, scanner.Err()
+}
+
+// ReadToken reads a token for SetToken from the file at 'path'.
+func ReadToken(path string) (string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// authorizationHeader is the header that tokens are sent in.
+const authorizationHeader = "Authorization"
+
+// bearer is the scheme for tokens in authorizationHeader.
+const bearer = "Bearer "
+
+// SetAuthHeader adds our token to 'req', for HTTP requests to endpoints that
+// are protected with AuthHandler.
+func SetAuthHeader(req *http.Request) {
+	if token := getToken(); token != "" {
+		req.Header.Set(authorizationHeader, bearer+token)
+	}
+}
+
+// getToken returns the token to present to others.
+func getToken() string {
+	auth.lock.Lock()
+	defer auth.lock.Unlock()
+	return auth.token
+}
+
+// authenticate returns the identity of whoever sent 'req'. It returns false if
+// they didn't present a valid token.
+func authenticate(req *http.Request) (Identity, bool) {
+	auth.lock.Lock()
+	defer auth.lock.Unlock()
+	if auth.tokens == nil {
+		return anonymous, true
+	}
+	h := req.Header.Get(authorizationHeader)
+	if !strings.HasPrefix(h, bearer) {
+		return Identity{}, false
+	}
+	// Look at every token, so that the time taken doesn't depend on which
+	// one matches.
+	hash := tokenHash(sha256.Sum256([]byte(strings.TrimPrefix(h, bearer))))
+	var id Identity
+	ok := 0
+	for _, t := range auth.tokens {
+		if subtle.ConstantTimeCompare(hash[:], t.hash[:]) == 1 {
+			id, ok = t.id, 1
+		}
+	}
+	return id, ok == 1
+}
+
+// AuthHandler wraps 'h' so that it only serves requests from peers with at
+// least 'role'.
+func AuthHandler(role Role, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		id, ok := authenticate(req)
+		if !ok {
+			log.Errorf("unauthenticated %s %s from %s", req.Method, req.URL.Path, req.RemoteAddr)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if id.Role < role {
+			log.Errorf("%s (%s) may not %s %s", id.Name, id.Role, req.Method, req.URL.Path)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		h(w, req)
+	}
+}
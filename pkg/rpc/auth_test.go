@@ -0,0 +1,134 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/rpc"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadTokens(t *testing.T) {
+	f, err := ioutil.TempFile("", "tokens")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("# comment\n\nt1 alice client\n  t2 curator control  \n")
+	f.Close()
+
+	tokens, err := LoadTokens(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := map[string]Identity{
+		"t1": {Name: "alice", Role: RoleClient},
+		"t2": {Name: "curator", Role: RoleControl},
+	}
+	if !reflect.DeepEqual(tokens, exp) {
+		t.Errorf("expected %+v, got %+v", exp, tokens)
+	}
+
+	for _, bad := range []string{"t1 alice\n", "t1 alice root\n", "t1 a client\nt1 b admin\n"} {
+		ioutil.WriteFile(f.Name(), []byte(bad), 0600)
+		if _, err := LoadTokens(f.Name()); err == nil {
+			t.Errorf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+func TestAuthHandler(t *testing.T) {
+	SetTokens(map[string]Identity{
+		"c": {Name: "client", Role: RoleClient},
+		"a": {Name: "admin", Role: RoleAdmin},
+	})
+	defer SetTokens(nil)
+
+	h := AuthHandler(RoleAdmin, func(w http.ResponseWriter, req *http.Request) {})
+	for token, exp := range map[string]int{
+		"":   http.StatusUnauthorized,
+		"x":  http.StatusUnauthorized,
+		"A":  http.StatusUnauthorized,
+		"aa": http.StatusUnauthorized,
+		"c":  http.StatusForbidden,
+		"a":  http.StatusOK,
+	} {
+		req := httptest.NewRequest("GET", "/_quit", nil)
+		if token != "" {
+			req.Header.Set(authorizationHeader, bearer+token)
+		}
+		w := httptest.NewRecorder()
+		h(w, req)
+		if w.Code != exp {
+			t.Errorf("token %q: expected %d, got %d", token, exp, w.Code)
+		}
+	}
+}
+
+func TestRequiredRole(t *testing.T) {
+	if err := RegisterName("AuthTest", &AuthTestSrv{}, RoleClient); err != nil {
+		t.Fatal(err)
+	}
+	RequireRole(RoleAdmin, "AuthTest.Admin")
+
+	for method, exp := range map[string]Role{
+		"AuthTest.Call":  RoleClient,
+		"AuthTest.Admin": RoleAdmin,
+		"Other.Call":     RoleNone,
+	} {
+		if role := requiredRole(method); role != exp {
+			t.Errorf("%s: expected %s, got %s", method, exp, role)
+		}
+	}
+}
+
+// TestWireOnly checks that only the methods of wire services can be called
+// with protocol buffers.
+func TestWireOnly(t *testing.T) {
+	if err := RegisterName("WireTest", &AuthTestSrv{}, RoleClient); err != nil {
+		t.Fatal(err)
+	}
+	RegisterWireServices([]WireService{{Name: "WireTest", Methods: []WireMethod{{Name: "Echo", Req: "", Reply: new(string)}}}})
+	srv := httptest.NewServer(http.DefaultServeMux)
+	defer srv.Close()
+
+	cli, err := DialProto(context.Background(), "tcp", srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	var reply string
+	if err := cli.Call("WireTest.Shout", "hi", &reply); err == nil || !strings.Contains(err.Error(), "can't be called") {
+		t.Errorf("expected an error calling a non-wire method, got %v", err)
+	}
+	// The connection still works after the error.
+	if err := cli.Call("WireTest.Echo", "hi", &reply); err != nil || reply != "hi" {
+		t.Errorf("failed to call a wire method: %q, %v", reply, err)
+	}
+
+	// Gob can call anything.
+	gob, err := rpc.DialHTTP("tcp", srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gob.Close()
+	if err := gob.Call("WireTest.Shout", "hi", &reply); err != nil {
+		t.Errorf("failed to call a non-wire method with gob: %s", err)
+	}
+}
+
+// AuthTestSrv is registered by TestRequiredRole and TestWireOnly.
+type AuthTestSrv struct{}
+
+func (s *AuthTestSrv) Call(req int, reply *int) error  { return nil }
+func (s *AuthTestSrv) Admin(req int, reply *int) error { return nil }
+
+func (s *AuthTestSrv) Echo(req string, reply *string) error  { *reply = req; return nil }
+func (s *AuthTestSrv) Shout(req string, reply *string) error { *reply = req + "!"; return nil }
@@ -14,22 +14,42 @@ import (
 )
 
 // dialHTTPContext is like rpc.DialHTTP but with a context and using the bulk codec.
-// Copied and tweaked from Go 1.5.3 implementation in net/rpc/client.go.
 func dialHTTPContext(ctx context.Context, network, address string) (*rpc.Client, error) {
+	return dialHTTPCodec(ctx, network, address, bulkRPCPath, func(conn io.ReadWriteCloser) rpc.ClientCodec {
+		return newBulkGobCodec(conn)
+	})
+}
+
+// DialProto connects to an RPC server at 'address' using the protocol buffer
+// codec. Only methods whose arguments and replies can go through MarshalWire
+// can be called this way.
+func DialProto(ctx context.Context, network, address string) (*rpc.Client, error) {
+	return dialHTTPCodec(ctx, network, address, protoRPCPath, func(conn io.ReadWriteCloser) rpc.ClientCodec {
+		return newBulkProtoCodec(conn)
+	})
+}
+
+// dialHTTPCodec connects to the RPC handler at 'path' and returns a client
+// with a codec from 'newCodec'.
+// Copied and tweaked from Go 1.5.3 implementation in net/rpc/client.go.
+func dialHTTPCodec(ctx context.Context, network, address, path string, newCodec func(io.ReadWriteCloser) rpc.ClientCodec) (*rpc.Client, error) {
 	var d net.Dialer
 	conn, err := d.DialContext(ctx, network, address)
 	if err != nil {
 		return nil, err
 	}
-	io.WriteString(conn, "CONNECT "+bulkRPCPath+" HTTP/1.0\n\n")
+	connect := "CONNECT " + path + " HTTP/1.0\n"
+	if token := getToken(); token != "" {
+		connect += authorizationHeader + ": " + bearer + token + "\n"
+	}
+	io.WriteString(conn, connect+"\n")
 
 	// Require successful HTTP response
 	// before switching to RPC protocol.
 	resp, err := http.ReadResponse(bufio.NewReader(conn), &http.Request{Method: "CONNECT"})
 
 	if err == nil && resp.Status == connectedStatus {
-		codec := newBulkGobCodec(conn)
-		return rpc.NewClientWithCodec(codec), nil
+		return rpc.NewClientWithCodec(newCodec(conn)), nil
 	}
 	if err == nil {
 		err = errors.New("unexpected HTTP response: " + resp.Status)
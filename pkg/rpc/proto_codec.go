@@ -0,0 +1,286 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+//
+// bulkProtoCodec is a variation on bulkGobCodec that encodes headers and bodies
+// as protocol buffers, so that clients don't have to be written in Go. Headers
+// are ProtoHeaders (see rpc.proto), and bodies must be able to go through
+// MarshalWire. Messages are encoded as follows:
+// 1. length of header (varint), then protobuf-encoded request (or response) header
+// 2. length of body (varint), then protobuf-encoded body
+// 3. crc32 of 1 and 2 (little-endian)
+// 4. if the header has a bulk length: bulk data
+// 5. if the header has a bulk length: crc32 of bulk data (little-endian)
+//
+// Bulk data works the same way as with bulkGobCodec. It shouldn't also be part
+// of the protocol buffer that the body is encoded as.
+//
+// The header carries the version of this framing. Incompatible changes to it
+// must change protoVersion; messages evolve by adding fields instead.
+
+package rpc
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net/rpc"
+)
+
+const (
+	// protoVersion is the version of the framing used by bulkProtoCodec.
+	protoVersion = 1
+
+	// maxProtoMessage is the largest header or body we'll read. Large data
+	// should be sent as bulk data.
+	maxProtoMessage = 64 << 20
+)
+
+// bulkProtoCodec implements both rpc.ClientCodec and rpc.ServerCodec.
+type bulkProtoCodec struct {
+	rwc io.ReadWriteCloser
+
+	r *bufio.Reader
+	w *bufio.Writer
+
+	// The header of the message we're reading.
+	hdr ProtoHeader
+
+	wCrc, rCrc uint32
+	closed     bool
+}
+
+func newBulkProtoCodec(conn io.ReadWriteCloser) *bulkProtoCodec {
+	return &bulkProtoCodec{rwc: conn, r: bufio.NewReader(conn), w: bufio.NewWriter(conn)}
+}
+
+// The codec itself acts as a checksumming writer and reader:
+func (c *bulkProtoCodec) Write(p []byte) (n int, err error) {
+	n, err = c.w.Write(p)
+	c.wCrc = crc32.Update(c.wCrc, crcTable, p[:n])
+	return
+}
+
+func (c *bulkProtoCodec) Read(p []byte) (n int, err error) {
+	n, err = c.r.Read(p)
+	c.rCrc = crc32.Update(c.rCrc, crcTable, p[:n])
+	return
+}
+
+func (c *bulkProtoCodec) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.rCrc = crc32.Update(c.rCrc, crcTable, []byte{b})
+	}
+	return b, err
+}
+
+func (c *bulkProtoCodec) WriteRequest(r *rpc.Request, body interface{}) error {
+	b, err := MarshalWire(body)
+	if err != nil {
+		return err
+	}
+	return c.write(ProtoHeader{Method: r.ServiceMethod, Seq: r.Seq}, body, b)
+}
+
+func (c *bulkProtoCodec) ReadResponseHeader(r *rpc.Response) error {
+	if err := c.readHeader(); err != nil {
+		return err
+	}
+	r.ServiceMethod, r.Seq, r.Error = c.hdr.Method, c.hdr.Seq, c.hdr.Error
+	return nil
+}
+
+func (c *bulkProtoCodec) ReadResponseBody(body interface{}) error {
+	return c.readBody(body)
+}
+
+func (c *bulkProtoCodec) ReadRequestHeader(r *rpc.Request) error {
+	if err := c.readHeader(); err != nil {
+		return err
+	}
+	r.ServiceMethod, r.Seq = c.hdr.Method, c.hdr.Seq
+	return nil
+}
+
+func (c *bulkProtoCodec) ReadRequestBody(body interface{}) error {
+	return c.readBody(body)
+}
+
+func (c *bulkProtoCodec) WriteResponse(r *rpc.Response, body interface{}) error {
+	hdr := ProtoHeader{Method: r.ServiceMethod, Seq: r.Seq, Error: r.Error}
+	b, err := MarshalWire(body)
+	if err != nil {
+		// The method's reply can't be sent with this codec, so send the
+		// reason instead.
+		if hdr.Error == "" {
+			hdr.Error = err.Error()
+		}
+		body, b = nil, nil
+	}
+	return c.write(hdr, body, b)
+}
+
+func (c *bulkProtoCodec) Close() error {
+	if c.closed {
+		// Only call c.rwc.Close once; otherwise the semantics are undefined.
+		return nil
+	}
+	c.closed = true
+	return c.rwc.Close()
+}
+
+// write sends a message with header 'hdr', and 'body', which has already been
+// encoded as 'b'. Bulk data fields aren't encoded, so 'b' doesn't depend on
+// whether the bulk data has been taken out of 'body' yet.
+func (c *bulkProtoCodec) write(hdr ProtoHeader, body interface{}, b []byte) (err error) {
+	var bulkData []byte
+	var exclusive bool
+	if bb, isBulk := body.(BulkData); isBulk {
+		bulkData, exclusive = bb.Get()
+	}
+	hdr.Version = protoVersion
+	hdr.BulkLength = uint32(len(bulkData))
+	h, err := hdr.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if err = c.writeMessages(h, b, bulkData); err != nil {
+		c.Close()
+	}
+	PutBuffer(bulkData, exclusive)
+	return
+}
+
+func (c *bulkProtoCodec) writeMessages(h, b, bulkData []byte) (err error) {
+	// 1. header, and 2. body
+	c.wCrc = 0
+	for _, m := range [][]byte{h, b} {
+		var n [binary.MaxVarintLen64]byte
+		if _, err = c.Write(n[:binary.PutUvarint(n[:], uint64(len(m)))]); err != nil {
+			return
+		}
+		if _, err = c.Write(m); err != nil {
+			return
+		}
+	}
+	// 3. crc32 of 1 and 2 (little-endian)
+	if err = binary.Write(c, binary.LittleEndian, c.wCrc); err != nil {
+		return
+	}
+	if len(bulkData) > 0 {
+		// 4. bulk data
+		c.wCrc = 0
+		if _, err = c.Write(bulkData); err != nil {
+			return
+		}
+		// 5. crc32 of bulk data (little-endian)
+		if err = binary.Write(c, binary.LittleEndian, c.wCrc); err != nil {
+			return
+		}
+	}
+	return c.w.Flush()
+}
+
+// readMessage reads a length-prefixed message.
+func (c *bulkProtoCodec) readMessage() ([]byte, error) {
+	n, err := binary.ReadUvarint(c)
+	if err != nil {
+		return nil, err
+	}
+	if n > maxProtoMessage {
+		return nil, fmt.Errorf("rpc: message of %d bytes is too large", n)
+	}
+	m := make([]byte, n)
+	if _, err = io.ReadFull(c, m); err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return m, err
+}
+
+func (c *bulkProtoCodec) readHeader() error {
+	// 1. header
+	c.rCrc = 0
+	h, err := c.readMessage()
+	if err != nil {
+		return err
+	}
+	c.hdr = ProtoHeader{}
+	if err = c.hdr.Unmarshal(h); err != nil {
+		return err
+	}
+	if c.hdr.Version != protoVersion {
+		return fmt.Errorf("rpc: unsupported protocol version %d", c.hdr.Version)
+	}
+	return nil
+}
+
+// readBody reads the rest of the message, and decodes it into 'body'. The
+// whole message is read even if it can't be decoded, so that the connection
+// can still be used.
+func (c *bulkProtoCodec) readBody(body interface{}) (err error) {
+	// 2. body
+	b, err := c.readMessage()
+	if err != nil {
+		return
+	}
+	// 3. crc32 of 1 and 2 (little-endian)
+	haveCrc := c.rCrc
+	var wantCrc uint32
+	if err = binary.Read(c, binary.LittleEndian, &wantCrc); err != nil {
+		return
+	}
+	if wantCrc != haveCrc {
+		return errChecksumMismatch
+	}
+
+	var bulkData []byte
+	var exclusive bool
+	bb, isBulk := body.(BulkData)
+	if isBulk {
+		// Get a preallocated slice from the body, if it has one.
+		bulkData, exclusive = bb.Get()
+	}
+	if bulkLen := int(c.hdr.BulkLength); bulkLen > 0 {
+		if cap(bulkData) >= bulkLen {
+			bulkData = bulkData[:bulkLen]
+		} else {
+			bulkData = GetBuffer(bulkLen)
+			exclusive = true
+		}
+		// 4. bulk data
+		c.rCrc = 0
+		if _, err = io.ReadFull(c, bulkData); err != nil {
+			return
+		}
+		// 5. crc32 of bulk data (little-endian)
+		haveCrc = c.rCrc
+		if err = binary.Read(c, binary.LittleEndian, &wantCrc); err != nil {
+			return
+		}
+		// As with bulkGobCodec, zero means "don't check this crc".
+		if wantCrc != 0 && wantCrc != haveCrc {
+			return errChecksumMismatch
+		}
+		if !isBulk {
+			PutBuffer(bulkData, exclusive)
+			if body != nil {
+				return fmt.Errorf("type %T doesn't implement BulkData", body)
+			}
+		}
+	}
+
+	if body == nil {
+		// The caller is discarding the body.
+		return nil
+	}
+	if err = UnmarshalWire(b, body); err != nil {
+		return
+	}
+	if isBulk && c.hdr.BulkLength > 0 {
+		bb.Set(bulkData, exclusive)
+	}
+	return
+}
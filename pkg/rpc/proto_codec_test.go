@@ -0,0 +1,297 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/rpc"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+)
+
+type protoTestMsg struct {
+	Field int
+	Data  []byte
+}
+
+func (t *protoTestMsg) MarshalWire() ([]byte, error) {
+	return (&types.Int64Value{Value: int64(t.Field)}).Marshal()
+}
+
+func (t *protoTestMsg) UnmarshalWire(b []byte) error {
+	var m types.Int64Value
+	err := m.Unmarshal(b)
+	t.Field = int(m.Value)
+	return err
+}
+
+func (t *protoTestMsg) Get() ([]byte, bool)  { b := t.Data; t.Data = nil; return b, true }
+func (t *protoTestMsg) Set(b []byte, e bool) { t.Data = b }
+
+func TestProtoCodecRequest(t *testing.T) {
+	buf := &closeBuffer{bytes.Buffer{}}
+
+	bulk := make([]byte, 8<<20)
+	rand.Read(bulk)
+	inBody := &protoTestMsg{Field: 777, Data: bulk}
+
+	inReq := &rpc.Request{ServiceMethod: "method", Seq: 12345}
+	cc := newBulkProtoCodec(buf)
+	if err := cc.WriteRequest(inReq, inBody); err != nil {
+		t.Fatal(err)
+	}
+
+	sc := newBulkProtoCodec(buf)
+	var outReq rpc.Request
+	if err := sc.ReadRequestHeader(&outReq); err != nil {
+		t.Fatal(err)
+	}
+	if outReq.ServiceMethod != inReq.ServiceMethod || outReq.Seq != inReq.Seq {
+		t.Fatal("mismatch")
+	}
+
+	var outBody protoTestMsg
+	if err := sc.ReadRequestBody(&outBody); err != nil {
+		t.Fatal(err)
+	}
+	if outBody.Field != 777 || !bytes.Equal(outBody.Data, bulk) {
+		t.Fatal("mismatch")
+	}
+}
+
+func TestProtoCodecResponse(t *testing.T) {
+	buf := &closeBuffer{bytes.Buffer{}}
+
+	bulk := make([]byte, 8<<20)
+	rand.Read(bulk)
+	inBody := &protoTestMsg{Field: 777, Data: bulk}
+
+	inResp := &rpc.Response{ServiceMethod: "method", Seq: 12345, Error: "none"}
+	sc := newBulkProtoCodec(buf)
+	if err := sc.WriteResponse(inResp, inBody); err != nil {
+		t.Fatal(err)
+	}
+
+	cc := newBulkProtoCodec(buf)
+	var outResp rpc.Response
+	if err := cc.ReadResponseHeader(&outResp); err != nil {
+		t.Fatal(err)
+	}
+	if outResp.ServiceMethod != inResp.ServiceMethod || outResp.Seq != inResp.Seq || outResp.Error != inResp.Error {
+		t.Fatal("mismatch")
+	}
+
+	var outBody protoTestMsg
+	if err := cc.ReadResponseBody(&outBody); err != nil {
+		t.Fatal(err)
+	}
+	if outBody.Field != 777 || !bytes.Equal(outBody.Data, bulk) {
+		t.Fatal("mismatch")
+	}
+}
+
+func TestProtoCodecCorrupt(t *testing.T) {
+	buf := &closeBuffer{bytes.Buffer{}}
+	cc := newBulkProtoCodec(buf)
+	if err := cc.WriteRequest(&rpc.Request{ServiceMethod: "method", Seq: 1}, &protoTestMsg{Field: 777}); err != nil {
+		t.Fatal(err)
+	}
+	b := buf.Bytes()
+	b[len(b)-5]++ // in the body
+
+	sc := newBulkProtoCodec(buf)
+	var req rpc.Request
+	if err := sc.ReadRequestHeader(&req); err != nil {
+		t.Fatal(err)
+	}
+	if err := sc.ReadRequestBody(&protoTestMsg{}); err != errChecksumMismatch {
+		t.Fatalf("expected checksum mismatch, got %v", err)
+	}
+}
+
+// compatHandler is served with both codecs.
+type compatHandler struct{}
+
+type CompatWriteReq struct {
+	Off int64
+	B   []byte
+}
+
+func (r *CompatWriteReq) MarshalWire() ([]byte, error) {
+	return (&types.Int64Value{Value: r.Off}).Marshal()
+}
+
+func (r *CompatWriteReq) UnmarshalWire(b []byte) error {
+	var m types.Int64Value
+	err := m.Unmarshal(b)
+	r.Off = m.Value
+	return err
+}
+
+func (r *CompatWriteReq) Get() ([]byte, bool)  { b := r.B; r.B = nil; return b, false }
+func (r *CompatWriteReq) Set(b []byte, e bool) { r.B = b }
+
+type CompatReadReply struct {
+	N CompatN
+	B []byte
+}
+
+func (r *CompatReadReply) MarshalWire() ([]byte, error) { return r.N.MarshalWire() }
+func (r *CompatReadReply) UnmarshalWire(b []byte) error { return r.N.UnmarshalWire(b) }
+
+func (r *CompatReadReply) Get() ([]byte, bool)  { b := r.B; r.B = nil; return b, true }
+func (r *CompatReadReply) Set(b []byte, e bool) { r.B = b }
+
+// CompatN is a request that's a bare number.
+type CompatN int
+
+func (n *CompatN) MarshalWire() ([]byte, error) {
+	return (&types.Int64Value{Value: int64(*n)}).Marshal()
+}
+
+func (n *CompatN) UnmarshalWire(b []byte) error {
+	var m types.Int64Value
+	err := m.Unmarshal(b)
+	*n = CompatN(m.Value)
+	return err
+}
+
+// CompatPlain can be encoded by gob but not as a protocol buffer.
+type CompatPlain struct {
+	N int
+}
+
+type CompatWriteReply struct {
+	Sum uint64
+}
+
+func (r *CompatWriteReply) MarshalWire() ([]byte, error) {
+	return (&types.UInt64Value{Value: r.Sum}).Marshal()
+}
+
+func (r *CompatWriteReply) UnmarshalWire(b []byte) error {
+	var m types.UInt64Value
+	err := m.Unmarshal(b)
+	r.Sum = m.Value
+	return err
+}
+
+func (compatHandler) Write(req CompatWriteReq, reply *CompatWriteReply) error {
+	for _, b := range req.B {
+		reply.Sum += uint64(b)
+	}
+	reply.Sum += uint64(req.Off)
+	return nil
+}
+
+func (compatHandler) Read(n CompatN, reply *CompatReadReply) error {
+	reply.N = n
+	reply.B = bytes.Repeat([]byte{'x'}, int(n))
+	return nil
+}
+
+func (compatHandler) Fail(s string, reply *string) error {
+	return errors.New("failed: " + s)
+}
+
+func (compatHandler) Plain(n CompatN, reply *CompatPlain) error {
+	reply.N = int(n)
+	return nil
+}
+
+// TestCodecCompat runs the same handler with both codecs, and checks that they
+// get the same results.
+func TestCodecCompat(t *testing.T) {
+	srv := rpc.NewServer()
+	if err := srv.RegisterName("Compat", compatHandler{}); err != nil {
+		t.Fatal(err)
+	}
+
+	type result struct {
+		Write CompatWriteReply
+		Read  CompatReadReply
+		Empty CompatReadReply
+		Fail  string
+	}
+	codecs := []struct {
+		name   string
+		server func(io.ReadWriteCloser) rpc.ServerCodec
+		client func(io.ReadWriteCloser) rpc.ClientCodec
+	}{
+		{"gob",
+			func(c io.ReadWriteCloser) rpc.ServerCodec { return newBulkGobCodec(c) },
+			func(c io.ReadWriteCloser) rpc.ClientCodec { return newBulkGobCodec(c) }},
+		{"proto",
+			func(c io.ReadWriteCloser) rpc.ServerCodec { return newBulkProtoCodec(c) },
+			func(c io.ReadWriteCloser) rpc.ClientCodec { return newBulkProtoCodec(c) }},
+	}
+
+	var results []result
+	for _, codec := range codecs {
+		sconn, cconn := net.Pipe()
+		go srv.ServeCodec(codec.server(sconn))
+		cli := rpc.NewClientWithCodec(codec.client(cconn))
+
+		var res result
+		data := make([]byte, 3<<20)
+		rand.New(rand.NewSource(1)).Read(data)
+		if err := cli.Call("Compat.Write", &CompatWriteReq{Off: 10, B: data}, &res.Write); err != nil {
+			t.Fatalf("%s: %s", codec.name, err)
+		}
+		if err := cli.Call("Compat.Read", CompatN(1<<20), &res.Read); err != nil {
+			t.Fatalf("%s: %s", codec.name, err)
+		}
+		// A preallocated buffer is dropped if there's no data.
+		res.Empty.B = []byte("stale")
+		if err := cli.Call("Compat.Read", CompatN(0), &res.Empty); err != nil {
+			t.Fatalf("%s: %s", codec.name, err)
+		}
+		var s string
+		if err := cli.Call("Compat.Fail", "oops", &s); err != nil {
+			res.Fail = err.Error()
+		}
+		if err := cli.Call("Compat.Nonexistent", "x", &s); err == nil {
+			t.Errorf("%s: expected an error calling a nonexistent method", codec.name)
+		}
+
+		// The connection still works after errors.
+		var again CompatReadReply
+		if err := cli.Call("Compat.Read", CompatN(5), &again); err != nil || again.N != 5 {
+			t.Errorf("%s: connection broken after errors: %v", codec.name, err)
+		}
+		cli.Close()
+		results = append(results, res)
+	}
+
+	if !reflect.DeepEqual(results[0], results[1]) {
+		t.Errorf("codecs disagree:\n%+v\n%+v", results[0].Write, results[1].Write)
+	}
+	if results[0].Fail != "failed: oops" {
+		t.Errorf("wrong error: %q", results[0].Fail)
+	}
+	if len(results[0].Read.B) != 1<<20 {
+		t.Errorf("wrong bulk data length %d", len(results[0].Read.B))
+	}
+
+	// Methods with types that can't be encoded fail cleanly over protobufs.
+	sconn, cconn := net.Pipe()
+	go srv.ServeCodec(newBulkProtoCodec(sconn))
+	cli := rpc.NewClientWithCodec(newBulkProtoCodec(cconn))
+	defer cli.Close()
+	var reply CompatPlain
+	if err := cli.Call("Compat.Plain", CompatN(1), &reply); err == nil || !strings.Contains(err.Error(), "can't be sent as a protocol buffer") {
+		t.Errorf("expected an encoding error, got %v", err)
+	}
+	var read CompatReadReply
+	if err := cli.Call("Compat.Read", CompatN(5), &read); err != nil || read.N != 5 {
+		t.Errorf("connection broken after errors: %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+// Package rpctest has helpers for testing RPC handlers.
+package rpctest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	netrpc "net/rpc"
+	"reflect"
+	"testing"
+
+	"github.com/westerndigitalcorporation/blb/pkg/rpc"
+)
+
+// CodecTester calls the RPC handlers registered with rpc.RegisterName using
+// both gob and protocol buffers, and checks that they get the same results.
+type CodecTester struct {
+	t          *testing.T
+	srv        *httptest.Server
+	gob, proto *netrpc.Client
+}
+
+// NewCodecTester starts serving the registered handlers, and connects to them
+// with each codec.
+func NewCodecTester(t *testing.T) *CodecTester {
+	srv := httptest.NewServer(http.DefaultServeMux)
+	gob, err := netrpc.DialHTTP("tcp", srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("couldn't connect with gob: %s", err)
+	}
+	proto, err := rpc.DialProto(context.Background(), "tcp", srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("couldn't connect with protocol buffers: %s", err)
+	}
+	return &CodecTester{t: t, srv: srv, gob: gob, proto: proto}
+}
+
+// Call calls 'method' with 'req' using each codec, and fails the test if the
+// replies or errors differ. 'reply' is a pointer that gets the reply from gob.
+func (c *CodecTester) Call(method string, req, reply interface{}) error {
+	err := c.gob.Call(method, req, reply)
+	other := reflect.New(reflect.TypeOf(reply).Elem()).Interface()
+	otherErr := c.proto.Call(method, req, other)
+	if (err == nil) != (otherErr == nil) || (err != nil && err.Error() != otherErr.Error()) {
+		c.t.Errorf("%s: gob returned error %v, protocol buffers returned %v", method, err, otherErr)
+	} else if !reflect.DeepEqual(exported(reply), exported(other)) {
+		c.t.Errorf("%s: gob replied %+v, protocol buffers replied %+v", method, reply, other)
+	}
+	return err
+}
+
+// exported returns a copy of the struct that 'p' points to with only the
+// exported fields set, since codecs may use unexported ones for bookkeeping.
+// Other values are returned unchanged.
+func exported(p interface{}) interface{} {
+	v := reflect.ValueOf(p).Elem()
+	if v.Kind() != reflect.Struct {
+		return p
+	}
+	c := reflect.New(v.Type()).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).PkgPath == "" {
+			c.Field(i).Set(v.Field(i))
+		}
+	}
+	return c.Interface()
+}
+
+// Close closes the connections and stops serving.
+func (c *CodecTester) Close() {
+	c.gob.Close()
+	c.proto.Close()
+	c.srv.Close()
+}
@@ -4,27 +4,142 @@
 package rpc
 
 import (
+	"bufio"
+	"encoding/gob"
+	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"net/rpc"
+	"strings"
 	"sync"
 )
 
 const (
 	bulkRPCPath     = "/_goRPC_bulk_crc_"
+	protoRPCPath    = "/_blbRPC_proto_"
 	connectedStatus = "200 Connected to Go RPC" // rpc.connected is not exported
 )
 
 var handleHTTPOnce sync.Once
 
-// RegisterName wraps rpc.RegisterName, which uses the default RPC server.
-func RegisterName(name string, rcvr interface{}) error {
+// service is a receiver registered with RegisterName.
+type service struct {
+	name string
+	rcvr interface{}
+	role Role
+}
+
+var registry struct {
+	lock     sync.Mutex
+	services []service
+	methods  map[string]Role // overrides from RequireRole
+	wire     map[string]bool // from RegisterWireServices
+}
+
+// RegisterName registers the methods of 'rcvr' under 'name', like
+// rpc.RegisterName. Only peers with at least 'role' can call them; see
+// RequireRole to require more for some methods.
+func RegisterName(name string, rcvr interface{}, role Role) error {
 	handleHTTPOnce.Do(func() {
-		rpc.HandleHTTP()
-		http.HandleFunc(bulkRPCPath, bulkServeHTTP)
+		http.HandleFunc(rpc.DefaultRPCPath, codecServeHTTP(func(conn io.ReadWriteCloser) rpc.ServerCodec {
+			return newGobServerCodec(conn)
+		}, false))
+		http.HandleFunc(bulkRPCPath, codecServeHTTP(func(conn io.ReadWriteCloser) rpc.ServerCodec {
+			return newBulkGobCodec(conn)
+		}, false))
+		http.HandleFunc(protoRPCPath, codecServeHTTP(func(conn io.ReadWriteCloser) rpc.ServerCodec {
+			return newBulkProtoCodec(conn)
+		}, true))
 	})
-	return rpc.RegisterName(name, rcvr)
+
+	// Each connection gets its own server, so check that 'rcvr' is valid now.
+	if err := rpc.NewServer().RegisterName(name, rcvr); err != nil {
+		return err
+	}
+
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+	for _, s := range registry.services {
+		if s.name == name {
+			return fmt.Errorf("rpc: service already defined: %s", name)
+		}
+	}
+	registry.services = append(registry.services, service{name: name, rcvr: rcvr, role: role})
+	return nil
+}
+
+// RequireRole makes calls to 'methods' (as Service.Method) require 'role',
+// instead of the role their service was registered with.
+func RequireRole(role Role, methods ...string) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+	if registry.methods == nil {
+		registry.methods = make(map[string]Role)
+	}
+	for _, m := range methods {
+		registry.methods[m] = role
+	}
+}
+
+// RegisterWireServices lets the methods of 'services' be called with the
+// protocol buffer codec (see DialProto). Other methods can only be called with
+// gob. The arguments and replies of the methods must be able to go through
+// MarshalWire.
+func RegisterWireServices(services []WireService) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+	if registry.wire == nil {
+		registry.wire = make(map[string]bool)
+	}
+	for _, svc := range services {
+		for _, m := range svc.Methods {
+			if !isWireType(m.Req) || !isWireType(m.Reply) {
+				log.Fatalf("rpc: %s.%s can't be called with protocol buffers", svc.Name, m.Name)
+			}
+			registry.wire[svc.Name+"."+m.Name] = true
+		}
+	}
+}
+
+// isWireMethod returns true if 'method' can be called with protocol buffers.
+func isWireMethod(method string) bool {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+	return registry.wire[method]
+}
+
+// newServer returns a server for calls from 'caller'.
+func newServer(caller Identity) *rpc.Server {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+	srv := rpc.NewServer()
+	for _, s := range registry.services {
+		rcvr := s.rcvr
+		if ca, ok := rcvr.(CallerAware); ok {
+			rcvr = ca.ForCaller(caller)
+		}
+		if err := srv.RegisterName(s.name, rcvr); err != nil {
+			log.Fatalf("rpc: couldn't register %s: %s", s.name, err)
+		}
+	}
+	return srv
+}
+
+// requiredRole returns the role needed to call 'method'.
+func requiredRole(method string) Role {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+	if role, ok := registry.methods[method]; ok {
+		return role
+	}
+	for _, s := range registry.services {
+		if strings.HasPrefix(method, s.name+".") {
+			return s.role
+		}
+	}
+	// Unknown methods are rejected by the server anyway.
+	return RoleNone
 }
 
 // StartStandaloneRPCServer starts the default RPC server.
@@ -32,19 +147,115 @@ func StartStandaloneRPCServer(addr string) {
 	go http.ListenAndServe(addr, nil)
 }
 
-func bulkServeHTTP(w http.ResponseWriter, req *http.Request) {
-	// Copied from go 1.7.5 net/rpc/server.go, replacing ServeConn with ServeCodec.
-	if req.Method != "CONNECT" {
-		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
-		w.WriteHeader(http.StatusMethodNotAllowed)
-		io.WriteString(w, "405 must CONNECT\n")
-		return
+// codecServeHTTP returns an HTTP handler that serves the registered services
+// using codecs from 'newCodec'. If 'wireOnly' is set, only the methods from
+// RegisterWireServices can be called.
+func codecServeHTTP(newCodec func(io.ReadWriteCloser) rpc.ServerCodec, wireOnly bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		// Copied from go 1.7.5 net/rpc/server.go, replacing ServeConn with ServeCodec.
+		if req.Method != "CONNECT" {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			io.WriteString(w, "405 must CONNECT\n")
+			return
+		}
+		caller, ok := authenticate(req)
+		if !ok {
+			log.Print("rpc: unauthenticated connection from ", req.RemoteAddr)
+			w.WriteHeader(http.StatusUnauthorized)
+			io.WriteString(w, "401 bad or missing token\n")
+			return
+		}
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			log.Print("rpc hijacking ", req.RemoteAddr, ": ", err.Error())
+			return
+		}
+		io.WriteString(conn, "HTTP/1.0 "+connectedStatus+"\n\n")
+		newServer(caller).ServeCodec(&authCodec{ServerCodec: newCodec(conn), caller: caller, wireOnly: wireOnly})
+	}
+}
+
+// authCodec wraps a server codec to reject calls that the caller's role
+// doesn't allow, and if 'wireOnly' is set, calls of methods that aren't wire
+// services. The server reads requests one at a time, so there's no need for
+// locking.
+type authCodec struct {
+	rpc.ServerCodec
+	caller   Identity
+	wireOnly bool
+
+	// If the caller may not call the method of the request we're reading,
+	// why not.
+	denied error
+}
+
+func (c *authCodec) ReadRequestHeader(r *rpc.Request) error {
+	if err := c.ServerCodec.ReadRequestHeader(r); err != nil {
+		return err
+	}
+	c.denied = nil
+	if c.wireOnly && !isWireMethod(r.ServiceMethod) {
+		c.denied = fmt.Errorf("rpc: %s can't be called with this codec", r.ServiceMethod)
+	} else if need := requiredRole(r.ServiceMethod); c.caller.Role < need {
+		c.denied = fmt.Errorf("rpc: permission denied: %s needs role %s, %q has %s",
+			r.ServiceMethod, need, c.caller.Name, c.caller.Role)
+	}
+	return nil
+}
+
+func (c *authCodec) ReadRequestBody(body interface{}) error {
+	// Read the whole body even if the call is denied, so that the connection
+	// can still be used. Returning an error makes the server reply with it
+	// instead of calling the method.
+	err := c.ServerCodec.ReadRequestBody(body)
+	if c.denied != nil {
+		return c.denied
+	}
+	return err
+}
+
+// gobServerCodec is the codec that rpc.Server.ServeConn uses, which isn't
+// exported.
+type gobServerCodec struct {
+	rwc    io.ReadWriteCloser
+	dec    *gob.Decoder
+	enc    *gob.Encoder
+	encBuf *bufio.Writer
+	closed bool
+}
+
+func newGobServerCodec(conn io.ReadWriteCloser) *gobServerCodec {
+	buf := bufio.NewWriter(conn)
+	return &gobServerCodec{rwc: conn, dec: gob.NewDecoder(conn), enc: gob.NewEncoder(buf), encBuf: buf}
+}
+
+func (c *gobServerCodec) ReadRequestHeader(r *rpc.Request) error {
+	return c.dec.Decode(r)
+}
+
+func (c *gobServerCodec) ReadRequestBody(body interface{}) error {
+	return c.dec.Decode(body)
+}
+
+func (c *gobServerCodec) WriteResponse(r *rpc.Response, body interface{}) (err error) {
+	if err = c.enc.Encode(r); err == nil {
+		err = c.enc.Encode(body)
 	}
-	conn, _, err := w.(http.Hijacker).Hijack()
 	if err != nil {
-		log.Print("rpc hijacking ", req.RemoteAddr, ": ", err.Error())
+		// The connection is broken if gob can't encode the response.
+		log.Print("rpc: gob error encoding response: ", err)
+		c.Close()
 		return
 	}
-	io.WriteString(conn, "HTTP/1.0 "+connectedStatus+"\n\n")
-	rpc.DefaultServer.ServeCodec(newBulkGobCodec(conn))
+	return c.encBuf.Flush()
+}
+
+func (c *gobServerCodec) Close() error {
+	if c.closed {
+		// Only call c.rwc.Close once; otherwise the semantics are undefined.
+		return nil
+	}
+	c.closed = true
+	return c.rwc.Close()
 }
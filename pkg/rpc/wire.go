@@ -0,0 +1,88 @@
+// Copyright (c) 2018 Western Digital Corporation or its affiliates. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// WireMessage is implemented by the arguments and replies of methods that can
+// be called with the protocol buffer codec. The wire format is defined by a
+// .proto file; usually a WireMessage converts itself to and from a message
+// generated from that file.
+type WireMessage interface {
+	MarshalWire() ([]byte, error)
+	UnmarshalWire([]byte) error
+}
+
+// WireService lists the methods of a service that can be called with the
+// protocol buffer codec. Name is the name the service is registered with.
+type WireService struct {
+	Name    string
+	Methods []WireMethod
+}
+
+// WireMethod describes one method of a WireService. Req and Reply are values
+// of the method's argument and reply types, which must be able to go through
+// MarshalWire.
+type WireMethod struct {
+	Name       string
+	Req, Reply interface{}
+}
+
+// MarshalWire encodes 'v' as a protocol buffer. 'v' must be a WireMessage, a
+// value whose pointer is a WireMessage, or a string, which is sent as a
+// google.protobuf.StringValue.
+func MarshalWire(v interface{}) ([]byte, error) {
+	switch m := v.(type) {
+	case WireMessage:
+		return m.MarshalWire()
+	case string:
+		return (&types.StringValue{Value: m}).Marshal()
+	case *string:
+		return (&types.StringValue{Value: *m}).Marshal()
+	case nil:
+		return nil, fmt.Errorf("rpc: nil can't be sent as a protocol buffer")
+	}
+	// Arguments are often passed by value.
+	p := reflect.New(reflect.TypeOf(v))
+	p.Elem().Set(reflect.ValueOf(v))
+	if m, ok := p.Interface().(WireMessage); ok {
+		return m.MarshalWire()
+	}
+	return nil, fmt.Errorf("rpc: %T can't be sent as a protocol buffer", v)
+}
+
+// UnmarshalWire decodes the protocol buffer in 'data' into 'v', which must be a
+// WireMessage or a *string.
+func UnmarshalWire(data []byte, v interface{}) error {
+	switch m := v.(type) {
+	case WireMessage:
+		return m.UnmarshalWire(data)
+	case *string:
+		var s types.StringValue
+		if err := s.Unmarshal(data); err != nil {
+			return err
+		}
+		*m = s.Value
+		return nil
+	}
+	return fmt.Errorf("rpc: %T can't be received as a protocol buffer", v)
+}
+
+// isWireType returns true if values of the same type as 'v' can be sent and
+// received with MarshalWire and UnmarshalWire.
+func isWireType(v interface{}) bool {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return false
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.String || reflect.PtrTo(t).Implements(reflect.TypeOf((*WireMessage)(nil)).Elem())
+}